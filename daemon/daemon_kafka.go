@@ -33,6 +33,7 @@ func getKafkaBlocksAsyncProducer(ctx context.Context, logger ulogger.Logger,
 }
 
 // getKafkaBlocksFinalAsyncProducer creates a new Kafka async producer for blocks final using the configuration from settings.
+// The producer reports the delivery of messages, AddBlock can wait for the delivery of the block.
 func getKafkaBlocksFinalAsyncProducer(ctx context.Context, logger ulogger.Logger,
 	settings *settings.Settings) (*kafka.KafkaAsyncProducer, error) {
 	kafkaBlocksFinalConfig := settings.Kafka.BlocksFinalConfig
@@ -40,7 +41,7 @@ func getKafkaBlocksFinalAsyncProducer(ctx context.Context, logger ulogger.Logger
 		return nil, errors.NewConfigurationError("missing Kafka URL for blocks final producer - blocksFinalConfig")
 	}
 
-	return getKafkaAsyncProducer(ctx, logger, kafkaBlocksFinalConfig, &settings.Kafka)
+	return kafka.NewKafkaAsyncProducerFromURLWithOptions(ctx, logger, kafkaBlocksFinalConfig, &settings.Kafka, kafka.WithDeliveryReports())
 }
 
 // getKafkaRejectedTxAsyncProducer creates a new Kafka async producer for rejected transactions using the configuration from settings.
@@ -416,6 +416,14 @@ func (d *Daemon) startRPCService(ctx context.Context, appSettings *settings.Sett
 		return err
 	}
 
+	// Create the subtree store for the transactions of blocks in getblock
+	var subtreeStore blob.Store
+
+	subtreeStore, err = d.daemonStores.GetSubtreeStore(ctx, createLogger(loggerSubtrees), appSettings)
+	if err != nil {
+		return err
+	}
+
 	blockAssemblyClient, err := blockassembly.NewClient(ctx, createLogger("ba"), appSettings)
 	if err != nil {
 		return err
@@ -442,7 +450,7 @@ func (d *Daemon) startRPCService(ctx context.Context, appSettings *settings.Sett
 	// Create the RPC server with the necessary parts
 	var rpcServer *rpc.RPCServer
 
-	rpcServer, err = rpc.NewServer(createLogger(loggerRPC), appSettings, blockchainClient, blockValidationClient, utxoStore, blockAssemblyClient, peerClient, p2pClient)
+	rpcServer, err = rpc.NewServer(createLogger(loggerRPC), appSettings, blockchainClient, blockValidationClient, utxoStore, subtreeStore, blockAssemblyClient, peerClient, p2pClient)
 	if err != nil {
 		return err
 	}
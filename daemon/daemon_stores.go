@@ -12,6 +12,7 @@ import (
 	"github.com/bitcoin-sv/teranode/services/validator"
 	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/stores/blob"
+	"github.com/bitcoin-sv/teranode/stores/blob/checksum"
 	"github.com/bitcoin-sv/teranode/stores/blob/options"
 	utxostore "github.com/bitcoin-sv/teranode/stores/utxo"
 	"github.com/bitcoin-sv/teranode/stores/utxo/aerospike"
@@ -209,7 +210,8 @@ func (d *Stores) GetTxStore(logger ulogger.Logger, appSettings *settings.Setting
 
 // GetSubtreeStore returns the main subtree store instance. If the store hasn't been initialized yet,
 // it creates a new one using the URL from settings. The store is configured with a hash prefix
-// of 2 for optimized storage organization.
+// of 2 for optimized storage organization, and wrapped to store and verify subtree checksums
+// when subtreestore_checksums is enabled.
 func (d *Stores) GetSubtreeStore(ctx context.Context, logger ulogger.Logger, appSettings *settings.Settings) (blob.Store, error) {
 	if d.mainSubtreeStore != nil {
 		return d.mainSubtreeStore, nil
@@ -246,6 +248,10 @@ func (d *Stores) GetSubtreeStore(ctx context.Context, logger ulogger.Logger, app
 		return nil, errors.NewServiceError("could not create subtree store", err)
 	}
 
+	if appSettings.SubtreeValidation.SubtreeStoreChecksums {
+		d.mainSubtreeStore = checksum.New(logger, d.mainSubtreeStore)
+	}
+
 	return d.mainSubtreeStore, nil
 }
 
@@ -41,6 +41,7 @@ var (
 	ErrStorageError               = New(ERR_STORAGE_ERROR, "storage error")
 	ErrStorageNotStarted          = New(ERR_STORAGE_NOT_STARTED, "storage not started")
 	ErrStorageUnavailable         = New(ERR_STORAGE_UNAVAILABLE, "storage unavailable")
+	ErrSubtreeCorrupt             = New(ERR_SUBTREE_CORRUPT, "subtree corrupt")
 	ErrSubtreeError               = New(ERR_SUBTREE_ERROR, "subtree error")
 	ErrSubtreeExists              = New(ERR_SUBTREE_EXISTS, "subtree exists")
 	ErrSubtreeInvalid             = New(ERR_SUBTREE_INVALID, "subtree invalid")
@@ -151,6 +152,11 @@ func NewSubtreeInvalidError(message string, params ...interface{}) *Error {
 	return New(ERR_SUBTREE_INVALID, message, params...)
 }
 
+// NewSubtreeCorruptError creates a new error with the subtree corrupt error code.
+func NewSubtreeCorruptError(message string, params ...interface{}) *Error {
+	return New(ERR_SUBTREE_CORRUPT, message, params...)
+}
+
 // NewSubtreeError creates a new error with the subtree error code.
 func NewSubtreeError(message string, params ...interface{}) *Error {
 	return New(ERR_SUBTREE_ERROR, message, params...)
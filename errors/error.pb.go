@@ -51,6 +51,7 @@ const (
 	ERR_SUBTREE_DESERIALIZE_ERROR ERR = 23
 	ERR_SUBTREE_INVALID_FORMAT    ERR = 24
 	ERR_SUBTREE_EXISTS            ERR = 25
+	ERR_SUBTREE_CORRUPT           ERR = 26
 	ERR_SUBTREE_ERROR             ERR = 29
 	// Transaction errors 30-49
 	ERR_TX_NOT_FOUND            ERR = 30
@@ -129,6 +130,7 @@ var (
 		23:  "SUBTREE_DESERIALIZE_ERROR",
 		24:  "SUBTREE_INVALID_FORMAT",
 		25:  "SUBTREE_EXISTS",
+		26:  "SUBTREE_CORRUPT",
 		29:  "SUBTREE_ERROR",
 		30:  "TX_NOT_FOUND",
 		31:  "TX_INVALID",
@@ -196,6 +198,7 @@ var (
 		"SUBTREE_DESERIALIZE_ERROR":     23,
 		"SUBTREE_INVALID_FORMAT":        24,
 		"SUBTREE_EXISTS":                25,
+		"SUBTREE_CORRUPT":               26,
 		"SUBTREE_ERROR":                 29,
 		"TX_NOT_FOUND":                  30,
 		"TX_INVALID":                    31,
@@ -371,7 +374,7 @@ const file_errors_error_proto_rawDesc = "" +
 	"\fwrappedError\x18\x04 \x01(\v2\x0e.errors.TErrorR\fwrappedError\x12\x12\n" +
 	"\x04file\x18\x05 \x01(\tR\x04file\x12\x12\n" +
 	"\x04line\x18\x06 \x01(\x05R\x04line\x12\x1a\n" +
-	"\bfunction\x18\a \x01(\tR\bfunction*\xd5\n" +
+	"\bfunction\x18\a \x01(\tR\bfunction*\xea\n" +
 	"\n" +
 	"\x03ERR\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\x14\n" +
@@ -399,7 +402,8 @@ const file_errors_error_proto_rawDesc = "" +
 	"\x17SUBTREE_SERIALIZE_ERROR\x10\x16\x12\x1d\n" +
 	"\x19SUBTREE_DESERIALIZE_ERROR\x10\x17\x12\x1a\n" +
 	"\x16SUBTREE_INVALID_FORMAT\x10\x18\x12\x12\n" +
-	"\x0eSUBTREE_EXISTS\x10\x19\x12\x11\n" +
+	"\x0eSUBTREE_EXISTS\x10\x19\x12\x13\n" +
+	"\x0fSUBTREE_CORRUPT\x10\x1a\x12\x11\n" +
 	"\rSUBTREE_ERROR\x10\x1d\x12\x10\n" +
 	"\fTX_NOT_FOUND\x10\x1e\x12\x0e\n" +
 	"\n" +
@@ -191,6 +191,18 @@ func TestNewSubtreeInvalidError(t *testing.T) {
 	assert.Nil(t, err.Data(), "error data should be nil when params are provided")
 }
 
+// TestNewSubtreeCorruptError tests the NewSubtreeCorruptError function to ensure it creates an error with the correct code and message.
+func TestNewSubtreeCorruptError(t *testing.T) {
+	message := "test subtree corrupt error %s %d"
+	params := []interface{}{"param1", 42}
+	err := NewSubtreeCorruptError(message, params...)
+
+	assert.Equal(t, ERR_SUBTREE_CORRUPT, err.Code(), "error code should be ERR_SUBTREE_CORRUPT")
+	assert.Equal(t, "test subtree corrupt error param1 42", err.Message(), "error message should match")
+
+	assert.Nil(t, err.Data(), "error data should be nil when params are provided")
+}
+
 // TestNewSubtreeError tests the NewSubtreeError function to ensure it creates an error with the correct code and message.
 func TestNewSubtreeError(t *testing.T) {
 	message := "test subtree error %s %d"
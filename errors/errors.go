@@ -11,6 +11,7 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -576,9 +577,14 @@ func WrapGRPC(err error) error {
 		return st.Err()
 	}
 
+	// a gRPC status error with details, e.g. returned by a call to another service, already carries its error codes
+	if st, ok := status.FromError(err); ok && len(st.Details()) > 0 {
+		return err
+	}
+
 	st := status.New(ErrorCodeToGRPCCode(ErrUnknown.code), ErrUnknown.message)
 	details, _ := anypb.New(&TError{
-		Code:    ERR_ERROR,
+		Code:    standardErrorCode(err),
 		Message: err.Error(),
 	})
 	st, detailsErr := st.WithDetails(details)
@@ -618,8 +624,9 @@ func UnwrapGRPC(err error) *Error {
 	}
 
 	if len(st.Details()) == 0 {
+		// the error did not pass through WrapGRPC, e.g. the service could not be reached, classify it by its status code
 		return &Error{
-			code:    ERR_ERROR,
+			code:    GRPCCodeToErrorCode(st.Code()),
 			message: err.Error(),
 		}
 	}
@@ -665,9 +672,51 @@ func UnwrapGRPC(err error) *Error {
 		}
 	}
 
+	if currErr == nil {
+		// none of the details are error details of WrapGRPC
+		return &Error{
+			code:    GRPCCodeToErrorCode(st.Code()),
+			message: err.Error(),
+		}
+	}
+
 	return currErr
 }
 
+// GRPCCodeToErrorCode maps a gRPC status code to the error code of an error that has no error details,
+// so errors that did not pass through WrapGRPC, like a service that cannot be reached, can still be
+// classified with Is.
+func GRPCCodeToErrorCode(code codes.Code) ERR {
+	switch code {
+	case codes.InvalidArgument:
+		return ERR_INVALID_ARGUMENT
+	case codes.NotFound:
+		return ERR_NOT_FOUND
+	case codes.ResourceExhausted:
+		return ERR_THRESHOLD_EXCEEDED
+	case codes.Canceled:
+		return ERR_CONTEXT_CANCELED
+	case codes.DeadlineExceeded:
+		return ERR_CONTEXT
+	case codes.Unavailable:
+		return ERR_SERVICE_UNAVAILABLE
+	default:
+		return ERR_ERROR
+	}
+}
+
+// standardErrorCode returns the error code WrapGRPC sends for an error that is not an *Error.
+func standardErrorCode(err error) ERR {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ERR_CONTEXT_CANCELED
+	case errors.Is(err, context.DeadlineExceeded):
+		return ERR_CONTEXT
+	default:
+		return ERR_ERROR
+	}
+}
+
 // ErrorCodeToGRPCCode maps your application-specific error codes to gRPC status codes.
 func ErrorCodeToGRPCCode(code ERR) codes.Code {
 	switch code {
@@ -625,8 +625,8 @@ func TestUnwrapGRPCWithStandardError(t *testing.T) {
 	// Ensure that the unwrapped error is not nil
 	require.NotNil(t, unwrapped)
 
-	// Check that the unwrapped error contains the correct message and code
-	require.Equal(t, ERR_ERROR, unwrapped.Code())
+	// Check that the unwrapped error contains the correct message and the code of the status
+	require.Equal(t, ERR_INVALID_ARGUMENT, unwrapped.Code())
 	require.Equal(t, "rpc error: code = InvalidArgument desc = Invalid argument provided", unwrapped.Message())
 
 	// Test with a different gRPC status code
@@ -639,7 +639,7 @@ func TestUnwrapGRPCWithStandardError(t *testing.T) {
 	require.NotNil(t, unwrappedNotFound)
 
 	// Check that the unwrapped error contains the correct message and code
-	require.Equal(t, ERR_ERROR, unwrappedNotFound.Code())
+	require.Equal(t, ERR_NOT_FOUND, unwrappedNotFound.Code())
 	require.Equal(t, "rpc error: code = NotFound desc = Resource not found", unwrappedNotFound.Message())
 }
 
@@ -700,6 +700,46 @@ func (s *server) TestMethod(_ context.Context, _ *grpctest.TestRequest) (*grpcte
 	return nil, WrapGRPC(level4Err)
 }
 
+// TestWrapUnwrapGRPCWithoutErrorDetails tests the classification of errors that have no error details of WrapGRPC.
+func TestWrapUnwrapGRPCWithoutErrorDetails(t *testing.T) {
+	t.Run("context errors keep their code", func(t *testing.T) {
+		unwrapped := UnwrapGRPC(WrapGRPC(context.Canceled))
+		require.NotNil(t, unwrapped)
+		require.Equal(t, ERR_CONTEXT_CANCELED, unwrapped.Code())
+
+		unwrapped = UnwrapGRPC(WrapGRPC(fmt.Errorf("request failed: %w", context.DeadlineExceeded)))
+		require.NotNil(t, unwrapped)
+		require.Equal(t, ERR_CONTEXT, unwrapped.Code())
+	})
+
+	t.Run("status error with details is passed through", func(t *testing.T) {
+		grpcErr := WrapGRPC(NewTxNotFoundError("tx not found"))
+
+		// a status error returned by a call to another service is passed on as is
+		require.Equal(t, grpcErr, WrapGRPC(grpcErr))
+		require.True(t, UnwrapGRPC(WrapGRPC(grpcErr)).Is(ErrTxNotFound))
+	})
+
+	t.Run("unavailable service", func(t *testing.T) {
+		unwrapped := UnwrapGRPC(status.Error(codes.Unavailable, "connection refused"))
+		require.NotNil(t, unwrapped)
+		require.Equal(t, ERR_SERVICE_UNAVAILABLE, unwrapped.Code())
+		require.True(t, unwrapped.Is(ErrServiceUnavailable))
+	})
+
+	t.Run("details that are not error details", func(t *testing.T) {
+		details, err := anypb.New(&grpctest.TestRequest{})
+		require.NoError(t, err)
+
+		st, err := status.New(codes.ResourceExhausted, "too many requests").WithDetails(details)
+		require.NoError(t, err)
+
+		unwrapped := UnwrapGRPC(st.Err())
+		require.NotNil(t, unwrapped)
+		require.Equal(t, ERR_THRESHOLD_EXCEEDED, unwrapped.Code())
+	})
+}
+
 // TestWrapUnwrapGRPCWithMockGRPCServer tests wrapping and unwrapping gRPC errors with a mock gRPC server.
 func TestWrapUnwrapGRPCWithMockGRPCServer(t *testing.T) {
 	// Set up the server
@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// AffectedBlock describes a block that would be invalidated when invalidating a block, either the block itself or one of its descendants.
+type AffectedBlock struct {
+	Hash        *chainhash.Hash `json:"hash"`          // Hash of the block.
+	Height      uint32          `json:"height"`        // Height of the block in the blockchain.
+	OnBestChain bool            `json:"on_best_chain"` // Whether the block is part of the current best chain.
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"runtime"
 	"sort"
 	"strings"
@@ -40,6 +41,20 @@ const GenesisBlockID = 0
 // LastV1Block https://github.com/bitcoin/bips/blob/master/bip-0034.mediawiki
 const LastV1Block = 227_835
 
+// minSubtreeLength is the minimum number of transactions in a subtree of a block, except the last subtree.
+const minSubtreeLength = 2
+
+// MaxTxSizeBeforeGenesis is the consensus limit on the size of a transaction in bytes in blocks below the
+// Genesis activation height. After Genesis the size of a transaction is only limited by policy.
+const MaxTxSizeBeforeGenesis = 1_000_000
+
+// maxBlockFees is the maximum of the fees of a block, no block can collect more fees than the number of
+// satoshis that can exist (21M BSV).
+const maxBlockFees = 21_000_000 * 100_000_000
+
+// defaultParentCheckConcurrency is the maximum number of concurrent parent checks of a block when none is configured.
+const defaultParentCheckConcurrency = 1024 * 32
+
 var (
 	emptyTX = &bt.Tx{}
 )
@@ -68,7 +83,13 @@ type Block struct {
 	hash            atomic.Pointer[chainhash.Hash]
 	subtreeLength   uint64
 	subtreeSlicesMu sync.RWMutex
-	txMap           txmap.TxMap
+	// subtreeSlicesGeneration is incremented whenever SubtreeSlices is replaced, guarded by subtreeSlicesMu
+	subtreeSlicesGeneration uint64
+	txMap                   txmap.TxMap
+	// txMapMu guards assigning txMap and txMapComplete, FindTx reads the txMap concurrently with Valid
+	txMapMu sync.RWMutex
+	// txMapComplete is set when txMap holds all transactions of the block, guarded by txMapMu
+	txMapComplete   bool
 	medianTimestamp uint32
 }
 
@@ -141,23 +162,91 @@ func NewBlockFromMsgBlock(msgBlock *wire.MsgBlock, optionalSettings *settings.Se
 
 	subtrees := make([]*chainhash.Hash, 0)
 
-	subtree, err := subtreepkg.NewIncompleteTreeByLeafCount(len(msgBlock.Transactions))
+	var subtreeSlices []*subtreepkg.Subtree
+
+	// a block with only a coinbase tx has no subtrees
+	if txCount > 1 {
+		itemsPerSubtree := len(msgBlock.Transactions)
+		if optionalSettings != nil && optionalSettings.BlockAssembly.InitialMerkleItemsPerSubtree > 0 {
+			itemsPerSubtree = optionalSettings.BlockAssembly.InitialMerkleItemsPerSubtree
+		}
+
+		if subtreeSlices, err = subtreesFromMsgTxs(msgBlock.Transactions, itemsPerSubtree); err != nil {
+			return nil, err
+		}
+
+		for _, subtree := range subtreeSlices {
+			subtrees = append(subtrees, subtree.RootHash())
+		}
+	}
+
+	block, err := NewBlock(header, coinbaseTx, subtrees, txCount, sizeInBytes, 0, 0)
 	if err != nil {
-		return nil, errors.NewSubtreeError("failed to create subtree", err)
+		return nil, err
+	}
+
+	if len(subtreeSlices) > 0 {
+		block.SetSubtreeSlices(subtreeSlices)
+	}
+
+	return block, nil
+}
+
+// subtreesFromMsgTxs chunks the transactions of a wire block into subtrees of itemsPerSubtree transactions,
+// rounded down to a power of two, with the coinbase placeholder in the first position of the first subtree.
+//
+// The merkle root over the subtrees only equals the merkle root of the block when the last subtree has the
+// same height as the others. When the remaining transactions would end up in a shallower subtree, all
+// transactions are put into a single subtree instead.
+func subtreesFromMsgTxs(txs []*wire.MsgTx, itemsPerSubtree int) ([]*subtreepkg.Subtree, error) {
+	leaves := 2
+	for leaves*2 <= itemsPerSubtree {
+		leaves *= 2
 	}
 
-	if err = subtree.AddCoinbaseNode(); err != nil {
-		return nil, errors.NewSubtreeError("failed to add coinbase placeholder", err)
+	itemsPerSubtree = leaves
+
+	if remainder := len(txs) % itemsPerSubtree; len(txs) <= itemsPerSubtree || (remainder != 0 && remainder <= itemsPerSubtree/2) {
+		itemsPerSubtree = len(txs)
 	}
-	// TODO: support more than coinbase tx in the subtree
-	// if txCount > 1 {
-	// 	// loop through the transactions ignoring the first coinbase tx and add them to the subtrees list
 
-	// 	// subtrees = append(subtrees, subtree.RootHash())
-	// }
+	subtreeSlices := make([]*subtreepkg.Subtree, 0, (len(txs)+itemsPerSubtree-1)/itemsPerSubtree)
+
+	var (
+		subtree *subtreepkg.Subtree
+		txSize  uint64
+		err     error
+	)
+
+	for idx, tx := range txs {
+		if idx%itemsPerSubtree == 0 {
+			if subtree, err = subtreepkg.NewIncompleteTreeByLeafCount(min(itemsPerSubtree, len(txs)-idx)); err != nil {
+				return nil, errors.NewSubtreeError("failed to create subtree", err)
+			}
+
+			subtreeSlices = append(subtreeSlices, subtree)
+		}
+
+		if idx == 0 {
+			if err = subtree.AddCoinbaseNode(); err != nil {
+				return nil, errors.NewSubtreeError("failed to add coinbase placeholder", err)
+			}
+
+			continue
+		}
+
+		txSize, err = safeconversion.IntToUint64(tx.SerializeSize())
+		if err != nil {
+			return nil, errors.NewProcessingError("failed to convert tx size to uint64", err)
+		}
+
+		// the fees are not known from the wire block, since the inputs are not extended
+		if err = subtree.AddNode(tx.TxHash(), 0, txSize); err != nil {
+			return nil, errors.NewSubtreeError("failed to add tx %s to subtree", tx.TxHash().String(), err)
+		}
+	}
 
-	// Create and return the new Block
-	return NewBlock(header, coinbaseTx, subtrees, txCount, sizeInBytes, 0, 0)
+	return subtreeSlices, nil
 }
 
 func NewBlockFromBytes(blockBytes []byte) (block *Block, err error) {
@@ -340,44 +429,239 @@ type MinedBlockStore interface {
 }
 
 func (b *Block) String() string {
-	b.subtreeSlicesMu.Lock()
-	defer func() {
-		b.subtreeSlicesMu.Unlock()
-	}()
+	b.subtreeSlicesMu.RLock()
+	defer b.subtreeSlicesMu.RUnlock()
 
+	return b.string()
+}
+
+// string returns the description of the block, the caller must hold subtreeSlicesMu
+func (b *Block) string() string {
 	return fmt.Sprintf("Block %s (height: %d, id: %d, txCount: %d, size: %d)", b.Hash().String(), b.Height, b.ID, b.TransactionCount, b.SizeInBytes)
 }
 
-type SubtreeStore interface {
-	GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error)
+// SubtreeSlicesSnapshot returns the loaded subtrees of the block and their generation.
+// The block never modifies a slice after it has been published, replacing the subtrees replaces
+// the slice, so the returned slice is stable for the caller even when the subtrees are taken concurrently.
+func (b *Block) SubtreeSlicesSnapshot() ([]*subtreepkg.Subtree, uint64) {
+	b.subtreeSlicesMu.RLock()
+	defer b.subtreeSlicesMu.RUnlock()
+
+	return b.SubtreeSlices, b.subtreeSlicesGeneration
 }
 
-func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, txMetaStore utxo.Store, oldBlockIDsMap *txmap.SyncedMap[chainhash.Hash, []uint32],
-	recentBlocksBloomFilters []*BlockBloomFilter, currentChain []*BlockHeader, currentBlockHeaderIDs []uint32, bloomStats *BloomStats, settings *settings.Settings) (bool, error) {
-	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "Valid",
-		tracing.WithHistogram(prometheusBlockValid),
-		tracing.WithLogMessage(logger, "[Block:Valid] called for %s", b.Header.String()),
-	)
-	defer deferFn()
+// SetSubtreeSlices replaces the loaded subtrees of the block and returns the new generation.
+// The slice must not be modified by the caller afterwards.
+func (b *Block) SetSubtreeSlices(subtreeSlices []*subtreepkg.Subtree) uint64 {
+	b.subtreeSlicesMu.Lock()
+	defer b.subtreeSlicesMu.Unlock()
+
+	b.SubtreeSlices = subtreeSlices
+	b.subtreeSlicesGeneration++
+
+	return b.subtreeSlicesGeneration
+}
+
+// TakeSubtreeSlices removes the loaded subtrees from the block and returns them with the generation they
+// were taken at, giving the caller exclusive ownership of the slice, for example to release the memory.
+// Readers that took a snapshot before keep using their snapshot.
+func (b *Block) TakeSubtreeSlices() ([]*subtreepkg.Subtree, uint64) {
+	b.subtreeSlicesMu.Lock()
+	defer b.subtreeSlicesMu.Unlock()
+
+	subtreeSlices := b.SubtreeSlices
+	generation := b.subtreeSlicesGeneration
+
+	b.SubtreeSlices = nil
+	b.subtreeSlicesGeneration++
+
+	return subtreeSlices, generation
+}
+
+// SubtreeSlicesGeneration returns the generation of the loaded subtrees, which changes whenever they are replaced or taken.
+func (b *Block) SubtreeSlicesGeneration() uint64 {
+	b.subtreeSlicesMu.RLock()
+	defer b.subtreeSlicesMu.RUnlock()
+
+	return b.subtreeSlicesGeneration
+}
+
+// AddSubtree appends a subtree to the block, adding its hash to Subtrees and the subtree itself to SubtreeSlices,
+// and adds the transactions and size of the subtree to the TransactionCount and SizeInBytes of the block.
+// Subtrees of the block that are not loaded keep a nil entry in SubtreeSlices, so both slices stay aligned.
+// Like SetSubtreeSlices, the subtrees are replaced by a new slice, which changes the generation.
+func (b *Block) AddSubtree(hash *chainhash.Hash, subtree *subtreepkg.Subtree) {
+	b.subtreeSlicesMu.Lock()
+	defer b.subtreeSlicesMu.Unlock()
+
+	subtrees := make([]*chainhash.Hash, len(b.Subtrees), len(b.Subtrees)+1)
+	copy(subtrees, b.Subtrees)
+
+	subtreeSlices := make([]*subtreepkg.Subtree, len(b.Subtrees), len(b.Subtrees)+1)
+	copy(subtreeSlices, b.SubtreeSlices)
+
+	b.Subtrees = append(subtrees, hash)
+	b.SubtreeSlices = append(subtreeSlices, subtree)
+	b.subtreeLength = uint64(len(b.Subtrees))
+	b.subtreeSlicesGeneration++
+
+	b.TransactionCount += uint64(subtree.Length())
+	b.SizeInBytes += subtree.SizeInBytes
+}
+
+// checkTimestampNotInFuture checks that the block timestamp is not further ahead of now than block_maxFutureBlockTime,
+// which defaults to two hours when not set.
+func (b *Block) checkTimestampNotInFuture(now time.Time, tSettings *settings.Settings) error {
+	maxFutureBlockTime := 2 * time.Hour
+	if tSettings != nil && tSettings.Block.MaxFutureBlockTime > 0 {
+		maxFutureBlockTime = tSettings.Block.MaxFutureBlockTime
+	}
+
+	maxTimestamp, err := safeconversion.Int64ToUint32(now.Add(maxFutureBlockTime).Unix())
+	if err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] failed to convert the maximum future timestamp to uint32", b.String(), err)
+	}
+
+	if b.Header.Timestamp > maxTimestamp {
+		return errors.NewBlockInvalidError("[BLOCK][%s] block timestamp is more than %s in the future", b.String(), maxFutureBlockTime)
+	}
+
+	return nil
+}
+
+// ValidateHeaderOnly checks the block header without the coinbase, subtrees or stores of the block, for accepting
+// headers before their blocks are downloaded. These are the first checks of Valid:
+//  1. the block header hash is less than the target difficulty
+//  2. the block timestamp is not more than block_maxFutureBlockTime (default two hours) in the future
+//
+// The settings may be nil, in which case the default maximum future block time is used.
+func (b *Block) ValidateHeaderOnly(ctx context.Context, tSettings *settings.Settings) error {
+	if err := ctx.Err(); err != nil {
+		return errors.NewContextCanceledError("[BLOCK][%s] context done before validating header", b.String(), err)
+	}
+
+	return b.validateHeader(time.Now(), tSettings, nil)
+}
+
+// validateHeader runs the header checks of ValidateHeaderOnly and records them in the report, which may be nil.
+func (b *Block) validateHeader(now time.Time, tSettings *settings.Settings, report *BlockValidationReport) error {
+	report.begin(CheckTargetDifficulty)
 
-	// 1. Check that the block header hash is less than the target difficulty.
 	headerValid, _, err := b.Header.HasMetTargetDifficulty()
 	if err != nil {
-		return false, errors.NewProcessingError("[BLOCK][%s] error checking target difficulty", b.String(), err)
+		return errors.NewProcessingError("[BLOCK][%s] error checking target difficulty", b.String(), err)
 	}
 
 	if !headerValid {
-		return false, errors.NewBlockInvalidError("[BLOCK][%s] block header hash is not less than the target difficulty", b.String())
+		return errors.NewBlockInvalidError("[BLOCK][%s] block header hash is not less than the target difficulty", b.String())
 	}
 
-	// 2. Check that the block timestamp is not more than two hours in the future.
-	twoHoursToTheFutureTimestampUint32, err := safeconversion.Int64ToUint32(time.Now().Add(2 * time.Hour).Unix())
+	report.begin(CheckTimestampNotInFuture)
+
+	return b.checkTimestampNotInFuture(now, tSettings)
+}
+
+type SubtreeStore interface {
+	GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error)
+}
+
+// Valid checks whether the block is valid, see valid for the checks that are done.
+//
+// The rule set depends on the height of the block: blocks below the Genesis activation height of
+// settings.ChainCfgParams are also checked against the pre-Genesis transaction size limit, see IsGenesisActive.
+// The other Genesis dependent rules, like the script rules, the sigops limits and the dust limit, apply to
+// individual transactions and are checked by the validator, with the height of the block the transactions
+// are mined in.
+//
+// When skipRecentBlocksBloomCheck is set, the check whether the transactions of the block were already mined in
+// one of the recent blocks of its chain, using recentBlocksBloomFilters, is skipped. All other checks, including
+// the order of the transactions and their parents being on the chain of the block, are still done. Skipping the
+// check means a block that mines a transaction a second time is accepted, so it is only safe for blocks that are
+// buried deep enough below a tip that is trusted, like historical blocks during catchup. It must never be set for
+// live blocks.
+func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, txMetaStore utxo.Store, oldBlockIDsMap *txmap.SyncedMap[chainhash.Hash, []uint32],
+	recentBlocksBloomFilters []*BlockBloomFilter, currentChain []*BlockHeader, currentBlockHeaderIDs []uint32, bloomStats *BloomStats, skipRecentBlocksBloomCheck bool,
+	settings *settings.Settings) (bool, error) {
+	return b.valid(ctx, logger, subtreeStore, txMetaStore, oldBlockIDsMap, recentBlocksBloomFilters, currentChain, currentBlockHeaderIDs, bloomStats, skipRecentBlocksBloomCheck, settings, nil)
+}
+
+// ValidWithReport runs the same checks as Valid and returns a report of which checks passed, failed or did not apply.
+// It is meant for diagnosing why a block was rejected, the checks only read from the stores.
+func (b *Block) ValidWithReport(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, txMetaStore utxo.Store, oldBlockIDsMap *txmap.SyncedMap[chainhash.Hash, []uint32],
+	recentBlocksBloomFilters []*BlockBloomFilter, currentChain []*BlockHeader, currentBlockHeaderIDs []uint32, bloomStats *BloomStats, settings *settings.Settings) *BlockValidationReport {
+	report := newBlockValidationReport(b)
+
+	_, _ = b.valid(ctx, logger, subtreeStore, txMetaStore, oldBlockIDsMap, recentBlocksBloomFilters, currentChain, currentBlockHeaderIDs, bloomStats, false, settings, report)
+
+	return report
+}
+
+// ValidAtHeight runs the same checks as Valid as of the chain state at validationHeight, instead of the current tip,
+// to reproduce the validation decision of a historical block. Only the blocks of currentChain below validationHeight
+// are treated as being on the chain of the block, so transactions mined in the block itself or in later blocks are
+// not reported as already mined, and parents mined after validationHeight are not on chain. The recent block bloom
+// filters of blocks that are pinned out of the chain are ignored as well.
+//
+// currentChain and currentChainMetas must describe the same blocks and are usually the ancestors of the block, the
+// validation height is usually the height of the block and cannot be above it.
+//
+// The tx meta store is not pinned: its data reflects the current state. Transactions and parents of which the tx meta
+// was pruned are treated like unknown old transactions, so the already mined and parent on chain checks are not done
+// for them, and a subtree without a meta file in the subtree store cannot be rebuilt when its tx meta was pruned. A
+// block that passes is therefore only known to be valid for the tx meta that is still in the store.
+func (b *Block) ValidAtHeight(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, txMetaStore utxo.Store, oldBlockIDsMap *txmap.SyncedMap[chainhash.Hash, []uint32],
+	recentBlocksBloomFilters []*BlockBloomFilter, currentChain []*BlockHeader, currentChainMetas []*BlockHeaderMeta, validationHeight uint32, bloomStats *BloomStats,
+	settings *settings.Settings) (bool, error) {
+	if validationHeight > b.Height {
+		return false, errors.NewInvalidArgumentError("[BLOCK][%s] validation height %d is above the block height %d", b.String(), validationHeight, b.Height)
+	}
+
+	pinnedChain, pinnedBlockHeaderIDs, err := pinChainToHeight(currentChain, currentChainMetas, validationHeight)
 	if err != nil {
-		return false, errors.NewProcessingError("[BLOCK][%s] failed to convert two hours to the future timestamp to uint32", b.String(), err)
+		return false, err
+	}
+
+	return b.valid(ctx, logger, subtreeStore, txMetaStore, oldBlockIDsMap, recentBlocksBloomFilters, pinnedChain, pinnedBlockHeaderIDs, bloomStats, false, settings, nil)
+}
+
+// pinChainToHeight returns the headers and IDs of the blocks of the chain below the given height, in the same order.
+func pinChainToHeight(chain []*BlockHeader, chainMetas []*BlockHeaderMeta, height uint32) ([]*BlockHeader, []uint32, error) {
+	if len(chain) != len(chainMetas) {
+		return nil, nil, errors.NewInvalidArgumentError("chain has %d headers and %d metas", len(chain), len(chainMetas))
+	}
+
+	pinnedChain := make([]*BlockHeader, 0, len(chain))
+	pinnedIDs := make([]uint32, 0, len(chain))
+
+	for i, header := range chain {
+		if chainMetas[i].Height >= height {
+			continue
+		}
+
+		pinnedChain = append(pinnedChain, header)
+		pinnedIDs = append(pinnedIDs, chainMetas[i].ID)
 	}
 
-	if b.Header.Timestamp > twoHoursToTheFutureTimestampUint32 {
-		return false, errors.NewBlockInvalidError("[BLOCK][%s] block timestamp is more than two hours in the future", b.String())
+	return pinnedChain, pinnedIDs, nil
+}
+
+func (b *Block) valid(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, txMetaStore utxo.Store, oldBlockIDsMap *txmap.SyncedMap[chainhash.Hash, []uint32],
+	recentBlocksBloomFilters []*BlockBloomFilter, currentChain []*BlockHeader, currentBlockHeaderIDs []uint32, bloomStats *BloomStats, skipRecentBlocksBloomCheck bool,
+	settings *settings.Settings, report *BlockValidationReport) (ok bool, err error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "Valid",
+		tracing.WithHistogram(prometheusBlockValid),
+		tracing.WithLogMessage(logger, "[Block:Valid] called for %s", b.Header.String()),
+	)
+	defer deferFn()
+
+	defer func() {
+		report.finish(err)
+	}()
+
+	// 1. Check that the block header hash is less than the target difficulty.
+	// 2. Check that the block timestamp is not more than two hours (block_maxFutureBlockTime) in the future.
+	if err = b.validateHeader(time.Now(), settings, report); err != nil {
+		return false, err
 	}
 
 	// 3. Check that the median time past of the block is after the median time past of the last 11 blocks.
@@ -386,6 +670,8 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 	currentChainLength := len(currentChain)
 	// if the current chain length is 0 skip this test
 	if currentChainLength > 0 {
+		report.begin(CheckMedianTimePast)
+
 		if currentChainLength < pruneLength {
 			pruneLength = currentChainLength
 		}
@@ -411,16 +697,20 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 
 		// validate that the block's timestamp is after the median timestamp
 		if b.Header.Timestamp <= b.medianTimestamp {
-			// if we're not on a chain that allows blocks to be generated quickly then return an error
-			if !settings.ChainCfgParams.GenerateSupported {
+			// return an error when enforcement is enabled, test networks that mine blocks quickly disable this
+			if settings.Block.EnforceMedianTimePast {
 				return false, errors.NewBlockInvalidError("block timestamp %d is not after median time past of last %d blocks %d", b.Header.Timestamp, pruneLength, medianTimestamp.Unix())
 			}
 			// otherwise just warn
 			logger.Warnf("block timestamp %d is not after median time past of last %d blocks %d", b.Header.Timestamp, pruneLength, medianTimestamp.Unix())
 		}
+	} else {
+		report.skip(CheckMedianTimePast)
 	}
 
 	// 4. Check that the coinbase transaction is valid (reward checked later).
+	report.begin(CheckCoinbaseTx)
+
 	if b.CoinbaseTx == nil {
 		return false, errors.NewBlockInvalidError("[BLOCK][%s] block has no coinbase tx", b.String())
 	}
@@ -441,6 +731,8 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 
 	// 5. Check that the coinbase transaction includes the correct block height.
 	if b.Header.Version > 1 && b.Height > LastV1Block {
+		report.begin(CheckCoinbaseHeight)
+
 		height, err := b.ExtractCoinbaseHeight()
 		if err != nil {
 			return false, errors.NewBlockInvalidError("[BLOCK][%s] error extracting coinbase height", b.String(), err)
@@ -449,16 +741,32 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 		if height != b.Height {
 			return false, errors.NewBlockInvalidError("[BLOCK][%s] block height in coinbase tx (%d) does not match block height in block header (%d)", b.String(), height, b.Height)
 		}
+	} else {
+		report.skip(CheckCoinbaseHeight)
 	}
 
 	// only do the subtree checks if we have a subtree store
 	// missing the subtreeStore should only happen when we are validating an internal block
 	if subtreeStore != nil && len(b.Subtrees) > 0 {
 		// 6. Get and validate any missing subtrees.
-		if err = b.GetAndValidateSubtrees(ctx, logger, subtreeStore, settings.Block.GetAndValidateSubtreesConcurrency); err != nil {
+		report.begin(CheckSubtrees)
+
+		if err = b.GetAndValidateSubtrees(ctx, logger, subtreeStore, settings.Block.GetAndValidateSubtreesConcurrency, RetryPolicyFromSettings(settings)); err != nil {
 			return false, err
 		}
 
+		// 6b. Check that the block is not larger than the maximum block size (blockmaxsize), if one is configured.
+		//     The size of the block is computed from its subtrees by GetAndValidateSubtrees.
+		if settings.Policy != nil && settings.Policy.BlockMaxSize > 0 {
+			report.begin(CheckMaxBlockSize)
+
+			if err = b.checkMaxBlockSize(uint64(settings.Policy.BlockMaxSize)); err != nil {
+				return false, err
+			}
+		} else {
+			report.skip(CheckMaxBlockSize)
+		}
+
 		// 7. Check that the first transaction in the first subtree is a coinbase placeholder (zeros)
 		// if !b.SubtreeSlices[0].Nodes[0].Hash.Equal(CoinbasePlaceholder) {
 		// 	return false, errors.NewBlockInvalidError("[BLOCK][%s] first transaction in first subtree is not a coinbase placeholder: %s", b.String(), b.SubtreeSlices[0].Nodes[0].Hash.String())
@@ -466,33 +774,62 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 
 		// 8. Calculate the merkle root of the list of subtrees and check it matches the MR in the block header.
 		//    making sure to replace the coinbase placeholder with the coinbase tx hash in the first subtree
+		report.begin(CheckMerkleRoot)
+
 		if err = b.CheckMerkleRoot(ctx); err != nil {
 			return false, err
 		}
+
+		// 8b. Before Genesis, check that no transaction is larger than the consensus limit.
+		//     The check needs the height of the block, it is skipped when the height is not set.
+		if b.Height > 0 && !b.IsGenesisActive(settings.ChainCfgParams) {
+			report.begin(CheckTxSizeBeforeGenesis)
+
+			if err = b.checkTxSizesBeforeGenesis(); err != nil {
+				return false, err
+			}
+		} else {
+			report.skip(CheckTxSizeBeforeGenesis)
+		}
+	} else {
+		report.skip(CheckSubtrees)
+		report.skip(CheckMaxBlockSize)
+		report.skip(CheckMerkleRoot)
+		report.skip(CheckTxSizeBeforeGenesis)
 	}
 
 	// 9. Check that the total fees of the block are less than or equal to the block reward.
 	// 10. Check that the coinbase transaction includes the correct block reward.
 	if b.Height > 0 {
-		err = b.checkBlockRewardAndFees(settings.ChainCfgParams)
+		report.begin(CheckBlockRewardAndFees)
+
+		err = b.checkBlockRewardAndFees(settings.ChainCfgParams, settings.Block.MaxFeePerByte)
 		if err != nil {
 			return false, err
 		}
+	} else {
+		report.skip(CheckBlockRewardAndFees)
 	}
 
 	// 11. Check that there are no duplicate transactions in the block.
 	// we only check when we have a subtree store passed in, otherwise this check cannot / should not be done
 	if subtreeStore != nil {
+		report.begin(CheckDuplicateTransactions)
+
 		// this creates the txMap for the block that is also used in the validOrderAndBlessed check
 		err = b.checkDuplicateTransactions(ctx, settings.Block.CheckDuplicateTransactionsConcurrency)
 		if err != nil {
 			return false, err
 		}
+	} else {
+		report.skip(CheckDuplicateTransactions)
 	}
 
 	// 12. Check that all transactions are in the valid order and blessed
 	//     Can only be done with a valid texMetaStore passed in
 	if txMetaStore != nil {
+		report.begin(CheckValidOrderAndBlessed)
+
 		deps := &validationDependencies{
 			txMetaStore:              txMetaStore,
 			subtreeStore:             subtreeStore,
@@ -501,15 +838,47 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 			currentBlockHeaderIDs:    currentBlockHeaderIDs,
 			bloomStats:               bloomStats,
 			oldBlockIDsMap:           oldBlockIDsMap,
+			getMetaBatchSize:         settings.Block.GetMetaBatchSize,
+			parentCheckConcurrency:   settings.Block.ParentCheckConcurrency,
+			retryPolicy:              RetryPolicyFromSettings(settings),
+			collectAllErrors:         settings.Block.ValidOrderAndBlessedCollectAllErrors,
+			skipRecentBlocksCheck:    skipRecentBlocksBloomCheck,
+		}
+
+		if settings.Block.PreloadSubtreeMeta && subtreeStore != nil {
+			// preloading is an optimisation, the meta slices are loaded per subtree when it fails
+			if deps.subtreeMetaSlices, err = b.PreloadSubtreeMeta(ctx, subtreeStore, settings.Block.PreloadSubtreeMetaConcurrency); err != nil {
+				logger.Warnf("[BLOCK][%s] failed to preload subtree meta, loading per subtree: %v", b.String(), err)
+			}
 		}
+
 		err = b.validOrderAndBlessed(ctx, logger, deps, settings.Block.ValidOrderAndBlessedConcurrency)
 		if err != nil {
 			return false, err
 		}
+
+		// 13. Optionally re-check that no transaction creates more value than it spends.
+		//     This duplicates a check done by the validator and is therefore disabled by default
+		if settings.Block.VerifyValueConservation {
+			report.begin(CheckValueConservation)
+
+			err = b.checkValueConservation(ctx, txMetaStore, settings.Block.VerifyValueConservationSampleRate, settings.Block.ValidOrderAndBlessedConcurrency)
+			if err != nil {
+				return false, err
+			}
+		} else {
+			report.skip(CheckValueConservation)
+		}
+	} else {
+		report.skip(CheckValidOrderAndBlessed)
+		report.skip(CheckValueConservation)
 	}
 
 	// reset the txMap and release the memory
+	b.txMapMu.Lock()
 	b.txMap = nil
+	b.txMapComplete = false
+	b.txMapMu.Unlock()
 
 	return true, nil
 }
@@ -521,23 +890,18 @@ func (b *Block) Valid(ctx context.Context, logger ulogger.Logger, subtreeStore S
 // height of the block we are checking for.
 
 // TODO - do this another way, if necessary
-func (b *Block) checkBlockRewardAndFees(params *chaincfg.Params) error {
+func (b *Block) checkBlockRewardAndFees(params *chaincfg.Params, maxFeePerByte uint64) error {
 	if b.Height == 0 {
 		return nil // Skip this check
 	}
 
-	coinbaseOutputSatoshis := uint64(0)
-	for _, tx := range b.CoinbaseTx.Outputs {
-		coinbaseOutputSatoshis += tx.Satoshis
-	}
-
-	subtreeFees := uint64(0)
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
 
-	for i := 0; i < len(b.SubtreeSlices); i++ {
-		subtree := b.SubtreeSlices[i]
-		subtreeFees += subtree.Fees
+	if err := b.checkSubtreeFees(subtreeSlices, maxFeePerByte); err != nil {
+		return err
 	}
 
+	coinbaseOutputSatoshis, subtreeFees := b.coinbaseOutputAndSubtreeFees(subtreeSlices)
 	coinbaseReward := util.GetBlockSubsidyForHeight(b.Height, params)
 
 	if coinbaseOutputSatoshis > subtreeFees+coinbaseReward {
@@ -547,6 +911,105 @@ func (b *Block) checkBlockRewardAndFees(params *chaincfg.Params) error {
 	return nil
 }
 
+// checkSubtreeFees checks that the fees recorded in the subtrees of the block are sane, so that a corrupted
+// fee field of a subtree cannot be used to claim more than the fees paid by its transactions in the coinbase:
+//   - the fees of a subtree equal the sum of the fees of its transactions
+//   - the total fees of the block do not exceed the number of satoshis that can exist
+//   - when maxFeePerByte is set, the fees of a subtree do not exceed maxFeePerByte satoshis per byte of its size
+func (b *Block) checkSubtreeFees(subtreeSlices []*subtreepkg.Subtree, maxFeePerByte uint64) error {
+	var totalFees uint64
+
+	for sIdx, subtree := range subtreeSlices {
+		if subtree == nil {
+			continue
+		}
+
+		var nodeFees uint64
+
+		for _, node := range subtree.Nodes {
+			if node.Fee > maxBlockFees-nodeFees {
+				return errors.NewBlockInvalidError("[BLOCK][%s][%s:%d] fees of the transactions in the subtree exceed the maximum of %d", b.String(), subtree.RootHash().String(), sIdx, uint64(maxBlockFees))
+			}
+
+			nodeFees += node.Fee
+		}
+
+		if nodeFees != subtree.Fees {
+			return errors.NewBlockInvalidError("[BLOCK][%s][%s:%d] subtree reports fees of %d, but its %d transactions pay %d", b.String(), subtree.RootHash().String(), sIdx, subtree.Fees, len(subtree.Nodes), nodeFees)
+		}
+
+		// a subtree of which the maximum fees would overflow is not limited
+		if maxFeePerByte > 0 && subtree.SizeInBytes <= ^uint64(0)/maxFeePerByte {
+			if maxFees := maxFeePerByte * subtree.SizeInBytes; subtree.Fees > maxFees {
+				return errors.NewBlockInvalidError("[BLOCK][%s][%s:%d] subtree fees of %d exceed the maximum of %d for %d bytes", b.String(), subtree.RootHash().String(), sIdx, subtree.Fees, maxFees, subtree.SizeInBytes)
+			}
+		}
+
+		if subtree.Fees > maxBlockFees-totalFees {
+			return errors.NewBlockInvalidError("[BLOCK][%s] fees of the block exceed the maximum of %d", b.String(), uint64(maxBlockFees))
+		}
+
+		totalFees += subtree.Fees
+	}
+
+	return nil
+}
+
+// VerifyCoinbaseSubsidyExact checks that the coinbase of the block pays exactly the block subsidy
+// for the given height plus the fees collected in the subtrees, so no value is burned. Unlike the
+// reward check in Valid, which only rejects a coinbase that pays too much, this also returns an
+// error when the coinbase pays less. The subtrees of the block must have been loaded, for instance
+// with GetAndValidateSubtrees.
+//
+// As in Valid, the check is skipped for height 0, since the height of blocks before BIP-34 is not known.
+//
+// Parameters:
+//   - height: the height of the block
+//   - params: the chain parameters used to calculate the block subsidy
+//
+// Returns:
+//   - error: if the coinbase output does not equal the subsidy plus fees, or the subtrees are not loaded
+func (b *Block) VerifyCoinbaseSubsidyExact(height uint32, params *chaincfg.Params) error {
+	if height == 0 {
+		return nil // Skip this check
+	}
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	if len(subtreeSlices) != len(b.Subtrees) {
+		return errors.NewProcessingError("[BLOCK][%s] subtrees not loaded: %d of %d", b.String(), len(subtreeSlices), len(b.Subtrees))
+	}
+
+	for i, subtree := range subtreeSlices {
+		if subtree == nil {
+			return errors.NewProcessingError("[BLOCK][%s] missing subtree %d", b.String(), i)
+		}
+	}
+
+	coinbaseOutputSatoshis, subtreeFees := b.coinbaseOutputAndSubtreeFees(subtreeSlices)
+	expected := subtreeFees + util.GetBlockSubsidyForHeight(height, params)
+
+	if coinbaseOutputSatoshis != expected {
+		return errors.NewBlockInvalidError("[BLOCK][%s] coinbase output (%d) does not equal the fees + block subsidy (%d)", b.String(), coinbaseOutputSatoshis, expected)
+	}
+
+	return nil
+}
+
+// coinbaseOutputAndSubtreeFees returns the total value of the coinbase outputs and the total fees of the subtrees.
+func (b *Block) coinbaseOutputAndSubtreeFees(subtreeSlices []*subtreepkg.Subtree) (coinbaseOutputSatoshis uint64, subtreeFees uint64) {
+	for _, tx := range b.CoinbaseTx.Outputs {
+		coinbaseOutputSatoshis += tx.Satoshis
+	}
+
+	for i := 0; i < len(subtreeSlices); i++ {
+		subtree := subtreeSlices[i]
+		subtreeFees += subtree.Fees
+	}
+
+	return coinbaseOutputSatoshis, subtreeFees
+}
+
 // checkDuplicateTransactions checks for duplicate transactions in all the subtrees in the block.
 // It uses a concurrent approach to check for duplicates in each subtree.
 // If a duplicate transaction is found, it returns an error.
@@ -573,16 +1036,22 @@ func (b *Block) checkDuplicateTransactions(ctx context.Context, checkDuplicateTr
 		return errors.NewProcessingError("[checkDuplicateTransactions][%s] failed to convert transaction count to int", b.String(), err)
 	}
 
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
 	// set the expected subtree size based on the first subtree in the block
 	subtreeSize := 0
-	if len(b.SubtreeSlices) > 0 {
-		subtreeSize = b.SubtreeSlices[0].Size()
+	if len(subtreeSlices) > 0 {
+		subtreeSize = subtreeSlices[0].Size()
 	}
 
+	b.txMapMu.Lock()
 	b.txMap = txmap.NewSplitSwissMapUint64(transactionCountUint32)
-	for subIdx := 0; subIdx < len(b.SubtreeSlices); subIdx++ {
+	b.txMapComplete = false
+	b.txMapMu.Unlock()
+
+	for subIdx := 0; subIdx < len(subtreeSlices); subIdx++ {
 		subIdx := subIdx
-		subtree := b.SubtreeSlices[subIdx]
+		subtree := subtreeSlices[subIdx]
 
 		g.Go(func() (err error) {
 			return b.checkDuplicateTransactionsInSubtree(subtree, subIdx, subtreeSize)
@@ -594,6 +1063,10 @@ func (b *Block) checkDuplicateTransactions(ctx context.Context, checkDuplicateTr
 		return err
 	}
 
+	b.txMapMu.Lock()
+	b.txMapComplete = true
+	b.txMapMu.Unlock()
+
 	return nil
 }
 
@@ -636,47 +1109,150 @@ func (b *Block) checkDuplicateTransactionsInSubtree(subtree *subtreepkg.Subtree,
 	return nil
 }
 
-type validationDependencies struct {
-	txMetaStore              utxo.Store
-	subtreeStore             SubtreeStore
-	recentBlocksBloomFilters []*BlockBloomFilter
-	currentChain             []*BlockHeader
-	currentBlockHeaderIDs    []uint32
-	bloomStats               *BloomStats
-	oldBlockIDsMap           *txmap.SyncedMap[chainhash.Hash, []uint32]
-}
-
-func (b *Block) validOrderAndBlessed(ctx context.Context, logger ulogger.Logger, deps *validationDependencies, validOrderAndBlessedConcurrency int) error {
-	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "validOrderAndBlessed")
+// checkValueConservation checks that the sum of the inputs of the transactions in the block is greater than or
+// equal to the sum of the outputs, using the transactions and previous outputs from the tx meta store.
+// This is a backstop against a buggy or compromised validator, since the validator already checks this.
+//
+// Parameters:
+// - ctx: the context to use for tracing and cancellation
+// - txMetaStore: the store to get the transactions and their previous outputs from
+// - sampleRate: the fraction of transactions to check, a value >= 1 checks all transactions
+// - concurrency: the maximum number of subtrees to check concurrently
+//
+// Returns:
+// - error: BlockInvalidError if a transaction creates value, or an error if a transaction could not be loaded
+func (b *Block) checkValueConservation(ctx context.Context, txMetaStore utxo.Store, sampleRate float64, concurrency int) error {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "checkValueConservation")
 	defer deferFn()
 
-	if b.txMap == nil {
-		return errors.NewStorageError("[validOrderAndBlessed][%s] txMap is nil, cannot check transaction order", b.String())
-	}
-
-	validationCtx := &validationContext{
-		currentBlockHeaderHashesMap: b.buildBlockHeaderHashesMap(deps.currentChain),
-		currentBlockHeaderIDsMap:    b.buildBlockHeaderIDsMap(deps.currentBlockHeaderIDs),
-		parentSpendsMap:             txmap.NewSyncedMap[subtreepkg.Inpoint, struct{}](),
+	if sampleRate <= 0 {
+		return nil
 	}
 
-	concurrency := b.getValidationConcurrency(validOrderAndBlessedConcurrency)
 	g, gCtx := errgroup.WithContext(ctx)
-	util.SafeSetLimit(g, concurrency)
+	util.SafeSetLimit(g, b.getValidationConcurrency(concurrency))
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
 
-	for sIdx := 0; sIdx < len(b.SubtreeSlices); sIdx++ {
-		subtree := b.SubtreeSlices[sIdx]
+	for sIdx := 0; sIdx < len(subtreeSlices); sIdx++ {
+		subtree := subtreeSlices[sIdx]
 		sIdx := sIdx
 
 		g.Go(func() error {
-			return b.validateSubtree(gCtx, logger, deps, validationCtx, subtree, sIdx)
-		})
-	}
+			for txIdx := 0; txIdx < len(subtree.Nodes); txIdx++ {
+				if sIdx == 0 && txIdx == 0 && subtree.Nodes[txIdx].Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+					continue
+				}
+
+				if sampleRate < 1 && rand.Float64() >= sampleRate { //nolint:gosec // sampling does not need a secure random number
+					continue
+				}
+
+				if err := b.checkTxValueConservation(gCtx, txMetaStore, &subtree.Nodes[txIdx].Hash); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
 
-	// do not wrap the error again, the error is already wrapped
 	return g.Wait()
 }
 
+func (b *Block) checkTxValueConservation(ctx context.Context, txMetaStore utxo.Store, txHash *chainhash.Hash) error {
+	txMeta, err := txMetaStore.Get(ctx, txHash, fields.Tx)
+	if err != nil {
+		return errors.NewStorageError("[checkValueConservation][%s] error getting transaction %s", b.String(), txHash.String(), err)
+	}
+
+	tx := txMeta.Tx
+	if tx == nil {
+		return errors.NewProcessingError("[checkValueConservation][%s] transaction %s not returned by the tx meta store", b.String(), txHash.String())
+	}
+
+	if !tx.IsExtended() {
+		if err = txMetaStore.PreviousOutputsDecorate(ctx, tx); err != nil {
+			return errors.NewStorageError("[checkValueConservation][%s] error getting previous outputs of transaction %s", b.String(), txHash.String(), err)
+		}
+	}
+
+	totalInputs := tx.TotalInputSatoshis()
+	totalOutputs := tx.TotalOutputSatoshis()
+
+	if totalOutputs > totalInputs {
+		prometheusBlockValueConservationViolations.Inc()
+
+		return errors.NewBlockInvalidError("[BLOCK][%s] transaction %s spends %d satoshis but creates %d satoshis", b.String(), txHash.String(), totalInputs, totalOutputs)
+	}
+
+	return nil
+}
+
+type validationDependencies struct {
+	txMetaStore              utxo.Store
+	subtreeStore             SubtreeStore
+	recentBlocksBloomFilters []*BlockBloomFilter
+	currentChain             []*BlockHeader
+	currentBlockHeaderIDs    []uint32
+	bloomStats               *BloomStats
+	oldBlockIDsMap           *txmap.SyncedMap[chainhash.Hash, []uint32]
+	getMetaBatchSize         int
+	parentCheckConcurrency   int         // maximum number of concurrent parent checks of all subtrees, <= 0 uses defaultParentCheckConcurrency
+	retryPolicy              RetryPolicy // retries of the subtree meta and parent tx meta fetches
+	collectAllErrors         bool        // continue validating after a failed transaction and return all the errors
+	skipRecentBlocksCheck    bool        // do not check whether the transactions were already mined in the recent blocks, see Block.Valid
+	// subtreeMetaSlices are the subtree meta slices loaded up front by PreloadSubtreeMeta, nil when they are loaded per subtree.
+	// Subtrees that are missing from the map are rebuilt from the txMetaStore.
+	subtreeMetaSlices map[chainhash.Hash]*subtreepkg.SubtreeMeta
+}
+
+func (b *Block) validOrderAndBlessed(ctx context.Context, logger ulogger.Logger, deps *validationDependencies, validOrderAndBlessedConcurrency int) error {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "validOrderAndBlessed")
+	defer deferFn()
+
+	if b.txMap == nil {
+		return errors.NewStorageError("[validOrderAndBlessed][%s] txMap is nil, cannot check transaction order", b.String())
+	}
+
+	validationCtx := &validationContext{
+		currentBlockHeaderHashesMap: b.buildBlockHeaderHashesMap(deps.currentChain),
+		currentBlockHeaderIDsMap:    NewBlockIDSet(deps.currentBlockHeaderIDs),
+		parentSpendsMap:             txmap.NewSyncedMap[subtreepkg.Inpoint, struct{}](),
+		parentCheckSem:              make(chan struct{}, getParentCheckConcurrency(deps.parentCheckConcurrency)),
+	}
+
+	if deps.collectAllErrors {
+		validationCtx.errs = &validationErrors{}
+	}
+
+	concurrency := b.getValidationConcurrency(validOrderAndBlessedConcurrency)
+	g, gCtx := errgroup.WithContext(ctx)
+	util.SafeSetLimit(g, concurrency)
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	for sIdx := 0; sIdx < len(subtreeSlices); sIdx++ {
+		subtree := subtreeSlices[sIdx]
+		sIdx := sIdx
+
+		g.Go(func() error {
+			if err := b.validateSubtree(gCtx, logger, deps, validationCtx, subtree, sIdx); err != nil && !validationCtx.collect(err) {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	// do not wrap the error again, the error is already wrapped
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	return validationCtx.errs.err(b)
+}
+
 func (b *Block) validateSubtree(ctx context.Context, logger ulogger.Logger, deps *validationDependencies,
 	validationCtx *validationContext, subtree *subtreepkg.Subtree, sIdx int) error {
 	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "validateSubtree")
@@ -686,18 +1262,32 @@ func (b *Block) validateSubtree(ctx context.Context, logger ulogger.Logger, deps
 		subtreeMetaSlice    *subtreepkg.SubtreeMeta
 		subtreeHash         = subtree.RootHash()
 		checkParentTxHashes = make([]missingParentTx, 0, len(subtree.Nodes))
+		metrics             = &subtreeValidationMetrics{}
+		phaseStart          = time.Now()
 		err                 error
 	)
 
-	subtreeMetaSlice, err = retry.Retry(ctx, logger, func() (*subtreepkg.SubtreeMeta, error) {
-		return b.getSubtreeMetaSlice(ctx, deps.subtreeStore, *subtreeHash, subtree)
-	}, retry.WithMessage(fmt.Sprintf("[validOrderAndBlessed][%s][%s:%d] error getting subtree meta slice", b.String(), subtreeHash.String(), sIdx)))
+	defer metrics.observe()
+
+	if preloaded, ok := deps.subtreeMetaSlices[*subtreeHash]; ok {
+		subtreeMetaSlice = preloaded
+	} else if deps.subtreeMetaSlices != nil {
+		// the meta file was not found when preloading, rebuild the meta slice from the txMetaStore
+		subtreeMetaSlice, err = b.getSubtreeMetaSliceFromTxMetaStore(ctx, deps.txMetaStore, subtree, deps.getMetaBatchSize)
+	} else {
+		subtreeMetaSlice, err = retry.Retry(ctx, logger, func() (*subtreepkg.SubtreeMeta, error) {
+			return b.getSubtreeMetaSlice(ctx, deps.subtreeStore, *subtreeHash, subtree)
+		}, deps.retryPolicy.options(fmt.Sprintf("[validOrderAndBlessed][%s][%s:%d] error getting subtree meta slice", b.String(), subtreeHash.String(), sIdx))...)
+	}
 
 	// a subtreeMetaSlice is required for further block validation, so if we cannot get it, we return an error
 	if err != nil {
 		return errors.NewProcessingError("[validOrderAndBlessed][%s][%s:%d] error getting subtree meta slice: %v", b.String(), subtreeHash.String(), sIdx, err)
 	}
 
+	metrics.subtreeMetaTime = time.Since(phaseStart)
+	phaseStart = time.Now()
+
 	if deps.bloomStats != nil {
 		deps.bloomStats.mu.Lock()
 		deps.bloomStats.QueryCounter += uint64(len(subtree.Nodes))
@@ -718,24 +1308,66 @@ func (b *Block) validateSubtree(ctx context.Context, logger ulogger.Logger, deps
 			sIdx:             sIdx,
 			snIdx:            snIdx,
 			subtreeNode:      subtreeNode,
+			metrics:          metrics,
 		})
 		if err != nil {
+			if validationCtx.collect(err) {
+				continue
+			}
+
 			return err
 		}
 
 		checkParentTxHashes = append(checkParentTxHashes, missingParents...)
 	}
 
+	metrics.transactionsTime = time.Since(phaseStart)
+
 	if len(checkParentTxHashes) > 0 {
-		// check all the parent transactions in parallel, this allows us to batch read from the txMetaStore
+		phaseStart = time.Now()
+
+		defer func() {
+			metrics.parentCheckTime = time.Since(phaseStart)
+		}()
+
+		var parentTxMetaMap map[chainhash.Hash]*utxo.UnresolvedMetaData
+
+		if deps.getMetaBatchSize > 0 {
+			// fetch all the parent transactions of the subtree in batches, instead of 1 round-trip per parent
+			if parentTxMetaMap, err = b.batchGetParentTxMeta(ctx, logger, deps, checkParentTxHashes, subtreeHash, sIdx); err != nil {
+				return err
+			}
+		}
+
+		// check all the parent transactions in parallel, this allows us to batch read from the txMetaStore.
+		// The concurrency is bounded by the pool shared by all subtrees, to bound the load on the txMetaStore on wide blocks
 		parentG := new(errgroup.Group)
-		util.SafeSetLimit(parentG, 1024*32)
 
 		for _, parentTxStruct := range checkParentTxHashes {
 			parentTxStruct := parentTxStruct
 
+			select {
+			case validationCtx.parentCheckSem <- struct{}{}:
+			case <-ctx.Done():
+				_ = parentG.Wait()
+				return errors.NewContextCanceledError("[validOrderAndBlessed][%s][%s:%d] context done while checking parent transactions", b.String(), subtreeHash.String(), sIdx, ctx.Err())
+			}
+
 			parentG.Go(func() error {
-				oldParentBlockIDs, err := b.checkParentExistsOnChain(ctx, logger, deps.txMetaStore, parentTxStruct, validationCtx.currentBlockHeaderIDsMap)
+				defer func() {
+					<-validationCtx.parentCheckSem
+				}()
+
+				var (
+					oldParentBlockIDs []uint32
+					err               error
+				)
+
+				if parentTxMetaMap != nil {
+					oldParentBlockIDs, err = b.checkParentTxMetaOnChain(ctx, logger, deps.txMetaStore, parentTxStruct, parentTxMetaMap[parentTxStruct.parentTxHash], validationCtx.currentBlockHeaderIDsMap)
+				} else {
+					oldParentBlockIDs, err = b.checkParentExistsOnChain(ctx, logger, deps.txMetaStore, parentTxStruct, validationCtx.currentBlockHeaderIDsMap)
+				}
 
 				// there are old blocks we need to return to the validator
 				if err == nil && len(oldParentBlockIDs) > 0 {
@@ -744,6 +1376,10 @@ func (b *Block) validateSubtree(ctx context.Context, logger ulogger.Logger, deps
 					deps.oldBlockIDsMap.Set(parentTxStruct.txHash, oldParentBlockIDs)
 				}
 
+				if err != nil && validationCtx.collect(err) {
+					return nil
+				}
+
 				return err
 			})
 		}
@@ -761,6 +1397,124 @@ type validationContext struct {
 	currentBlockHeaderHashesMap map[chainhash.Hash]struct{}
 	currentBlockHeaderIDsMap    map[uint32]struct{}
 	parentSpendsMap             *txmap.SyncedMap[subtreepkg.Inpoint, struct{}]
+	parentCheckSem              chan struct{}     // bounds the concurrent parent checks of all subtrees
+	errs                        *validationErrors // nil when validation stops at the first error
+}
+
+// collect adds the error to the collected errors and returns true, or returns false when
+// the validation should stop at the first error.
+func (v *validationContext) collect(err error) bool {
+	if v.errs == nil {
+		return false
+	}
+
+	v.errs.add(err)
+
+	return true
+}
+
+// maxReportedValidationErrors is the maximum number of collected errors listed in the error of validOrderAndBlessed
+const maxReportedValidationErrors = 1_000
+
+// validationErrors collects the errors of all the transactions that failed validOrderAndBlessed,
+// when the block validation is configured to not stop at the first error.
+type validationErrors struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (v *validationErrors) add(err error) {
+	v.mu.Lock()
+	v.errs = append(v.errs, err)
+	v.mu.Unlock()
+}
+
+// err returns a single error listing all the collected errors, or nil when no errors were collected.
+// The error is a block invalid error when all the collected errors are, otherwise the block could not be
+// fully validated and the first other error is wrapped, to not invalidate the block on e.g. a storage error.
+func (v *validationErrors) err(b *Block) error {
+	if v == nil {
+		return nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	var otherErr error
+
+	messages := make([]string, 0, len(v.errs))
+
+	for _, err := range v.errs {
+		if otherErr == nil && !errors.Is(err, errors.ErrBlockInvalid) {
+			otherErr = err
+		}
+
+		messages = append(messages, err.Error())
+	}
+
+	// the errors are collected concurrently, sort them for a stable report
+	sort.Strings(messages)
+
+	if len(messages) > maxReportedValidationErrors {
+		messages = append(messages[:maxReportedValidationErrors], fmt.Sprintf("... and %d more", len(messages)-maxReportedValidationErrors))
+	}
+
+	report := strings.Join(messages, "\n")
+
+	if otherErr != nil {
+		return errors.NewProcessingError("[validOrderAndBlessed][%s] %d errors validating transactions:\n%s", b.String(), len(v.errs), report, otherErr)
+	}
+
+	return errors.NewBlockInvalidError("[validOrderAndBlessed][%s] %d invalid transactions:\n%s", b.String(), len(v.errs), report)
+}
+
+// subtreeValidationMetrics records the work done while validating a single subtree in validOrderAndBlessed.
+// The transactions of a subtree are validated sequentially, so the counters do not need to be synchronized.
+// All methods can be called on a nil receiver, in which case nothing is recorded.
+type subtreeValidationMetrics struct {
+	getMetaCalls        uint64
+	bloomPositives      uint64
+	bloomFalsePositives uint64
+	subtreeMetaTime     time.Duration
+	transactionsTime    time.Duration
+	parentCheckTime     time.Duration
+}
+
+func (m *subtreeValidationMetrics) addGetMetaCall() {
+	if m != nil {
+		m.getMetaCalls++
+	}
+}
+
+func (m *subtreeValidationMetrics) addBloomPositive() {
+	if m != nil {
+		m.bloomPositives++
+	}
+}
+
+func (m *subtreeValidationMetrics) addBloomFalsePositive() {
+	if m != nil {
+		m.bloomFalsePositives++
+	}
+}
+
+// observe exposes the recorded values of the subtree in the prometheus histograms.
+func (m *subtreeValidationMetrics) observe() {
+	if m == nil {
+		return
+	}
+
+	prometheusBlockValidateSubtreePhase.WithLabelValues("subtree_meta").Observe(m.subtreeMetaTime.Seconds())
+	prometheusBlockValidateSubtreePhase.WithLabelValues("transactions").Observe(m.transactionsTime.Seconds())
+	prometheusBlockValidateSubtreePhase.WithLabelValues("parent_check").Observe(m.parentCheckTime.Seconds())
+
+	prometheusBlockValidateSubtreeCounts.WithLabelValues("get_meta").Observe(float64(m.getMetaCalls))
+	prometheusBlockValidateSubtreeCounts.WithLabelValues("bloom_positive").Observe(float64(m.bloomPositives))
+	prometheusBlockValidateSubtreeCounts.WithLabelValues("bloom_false_positive").Observe(float64(m.bloomFalsePositives))
 }
 
 func (b *Block) buildBlockHeaderHashesMap(currentChain []*BlockHeader) map[chainhash.Hash]struct{} {
@@ -772,13 +1526,14 @@ func (b *Block) buildBlockHeaderHashesMap(currentChain []*BlockHeader) map[chain
 	return currentBlockHeaderHashesMap
 }
 
-func (b *Block) buildBlockHeaderIDsMap(currentBlockHeaderIDs []uint32) map[uint32]struct{} {
-	currentBlockHeaderIDsMap := make(map[uint32]struct{}, len(currentBlockHeaderIDs))
-	for _, id := range currentBlockHeaderIDs {
-		currentBlockHeaderIDsMap[id] = struct{}{}
+// getParentCheckConcurrency returns the maximum number of concurrent parent checks, falling back to
+// defaultParentCheckConcurrency when none is configured.
+func getParentCheckConcurrency(parentCheckConcurrency int) int {
+	if parentCheckConcurrency <= 0 {
+		return defaultParentCheckConcurrency
 	}
 
-	return currentBlockHeaderIDsMap
+	return parentCheckConcurrency
 }
 
 func (b *Block) getValidationConcurrency(validOrderAndBlessedConcurrency int) int {
@@ -791,7 +1546,7 @@ func (b *Block) getValidationConcurrency(validOrderAndBlessedConcurrency int) in
 }
 
 func (b *Block) checkTxInRecentBlocks(ctx context.Context, deps *validationDependencies, validationCtx *validationContext,
-	subtreeNode subtreepkg.SubtreeNode, subtreeHash *chainhash.Hash, sIdx, snIdx int) error {
+	subtreeNode subtreepkg.SubtreeNode, subtreeHash *chainhash.Hash, sIdx, snIdx int, metrics *subtreeValidationMetrics) error {
 	// get first 8 bytes of the subtreeNode hash
 	n64 := binary.BigEndian.Uint64(subtreeNode.Hash[:])
 
@@ -812,6 +1567,9 @@ func (b *Block) checkTxInRecentBlocks(ctx context.Context, deps *validationDepen
 			deps.bloomStats.mu.Unlock()
 		}
 
+		metrics.addBloomPositive()
+		metrics.addGetMetaCall()
+
 		// there is a chance that the bloom filter has a false positive, but the txMetaStore has pruned
 		// the transaction. This will cause the block to be incorrectly invalidated, but this is the safe
 		// option for now.
@@ -825,11 +1583,9 @@ func (b *Block) checkTxInRecentBlocks(ctx context.Context, deps *validationDepen
 				b.String(), subtreeHash.String(), sIdx, snIdx, subtreeNode.Hash.String(), err)
 		}
 
-		for _, blockID := range txMeta.BlockIDs {
-			if _, found := validationCtx.currentBlockHeaderIDsMap[blockID]; found {
-				return errors.NewBlockInvalidError("[validOrderAndBlessed][%s][%s:%d]:%d transaction %s has already been mined in block %d",
-					b.String(), subtreeHash.String(), sIdx, snIdx, subtreeNode.Hash.String(), blockID)
-			}
+		if blockID, found := FirstBlockIDInSet(txMeta.BlockIDs, validationCtx.currentBlockHeaderIDsMap); found {
+			return errors.NewBlockInvalidError("[validOrderAndBlessed][%s][%s:%d]:%d transaction %s has already been mined in block %d",
+				b.String(), subtreeHash.String(), sIdx, snIdx, subtreeNode.Hash.String(), blockID)
 		}
 
 		if deps.bloomStats != nil {
@@ -837,6 +1593,8 @@ func (b *Block) checkTxInRecentBlocks(ctx context.Context, deps *validationDepen
 			deps.bloomStats.FalsePositiveCounter++
 			deps.bloomStats.mu.Unlock()
 		}
+
+		metrics.addBloomFalsePositive()
 	}
 
 	return nil
@@ -849,13 +1607,33 @@ func (b *Block) checkParentExistsOnChain(gCtx context.Context, logger ulogger.Lo
 	// for the first situation we don't start validating the current block until the parent is validated.
 	// parent tx meta was not found, must be old, ignore | it is a coinbase, which obviously is mined in a block
 	parentTxMeta, err := getParentTxMetaBlockIDs(gCtx, txMetaStore, parentTxStruct)
+	if err != nil {
+		return nil, err
+	}
 
-	var oldBlockIDs []uint32
+	return b.checkParentOnChain(gCtx, logger, txMetaStore, parentTxStruct, parentTxMeta, currentBlockHeaderIDsMap)
+}
+
+// checkParentTxMetaOnChain performs the same check as checkParentExistsOnChain, using the parent tx meta data
+// that was fetched in a batch, with the same not found and missing block IDs semantics as getParentTxMetaBlockIDs.
+func (b *Block) checkParentTxMetaOnChain(gCtx context.Context, logger ulogger.Logger, txMetaStore utxo.Store, parentTxStruct missingParentTx,
+	unresolvedParent *utxo.UnresolvedMetaData, currentBlockHeaderIDsMap map[uint32]struct{}) ([]uint32, error) {
+	if unresolvedParent == nil {
+		return nil, errors.NewProcessingError("[BLOCK][%s] parent transaction %s of tx %s was not fetched", b.String(), parentTxStruct.parentTxHash.String(), parentTxStruct.txHash.String())
+	}
 
+	parentTxMeta, err := resolveParentTxMetaBlockIDs(unresolvedParent.Data, unresolvedParent.Err, parentTxStruct)
 	if err != nil {
-		return oldBlockIDs, err
+		return nil, err
 	}
 
+	return b.checkParentOnChain(gCtx, logger, txMetaStore, parentTxStruct, parentTxMeta, currentBlockHeaderIDsMap)
+}
+
+func (b *Block) checkParentOnChain(gCtx context.Context, logger ulogger.Logger, txMetaStore utxo.Store, parentTxStruct missingParentTx,
+	parentTxMeta *meta.Data, currentBlockHeaderIDsMap map[uint32]struct{}) ([]uint32, error) {
+	var oldBlockIDs []uint32
+
 	if parentTxMeta == nil {
 		return oldBlockIDs, nil
 	}
@@ -913,6 +1691,7 @@ type transactionValidationParams struct {
 	subtreeHash      *chainhash.Hash
 	sIdx, snIdx      int
 	subtreeNode      subtreepkg.SubtreeNode
+	metrics          *subtreeValidationMetrics
 }
 
 func (b *Block) validateTransaction(ctx context.Context, deps *validationDependencies, validationCtx *validationContext,
@@ -941,9 +1720,11 @@ func (b *Block) validateTransaction(ctx context.Context, deps *validationDepende
 	}
 
 	// Check if transaction has been mined in recent blocks
-	err = b.checkTxInRecentBlocks(ctx, deps, validationCtx, params.subtreeNode, params.subtreeHash, params.sIdx, params.snIdx)
-	if err != nil {
-		return nil, err
+	if !deps.skipRecentBlocksCheck {
+		err = b.checkTxInRecentBlocks(ctx, deps, validationCtx, params.subtreeNode, params.subtreeHash, params.sIdx, params.snIdx, params.metrics)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Check parent transactions
@@ -1001,7 +1782,7 @@ func filterCurrentBlockHeaderIDsMap(parentTxMeta *meta.Data, currentBlockHeaderI
 			minBlockID = blockID
 		}
 
-		if _, found := currentBlockHeaderIDsMap[blockID]; found {
+		if IsBlockIDInSet(blockID, currentBlockHeaderIDsMap) {
 			foundInPreviousBlocks[blockID] = struct{}{}
 		}
 	}
@@ -1009,8 +1790,67 @@ func filterCurrentBlockHeaderIDsMap(parentTxMeta *meta.Data, currentBlockHeaderI
 	return foundInPreviousBlocks, minBlockID
 }
 
+// batchGetParentTxMeta fetches the block IDs of all the given parent transactions from the tx meta store, in batches
+// of deps.getMetaBatchSize. Every batch is retried on failure, errors for individual hashes (e.g. not found) are
+// returned in the Err field of the map entries.
+func (b *Block) batchGetParentTxMeta(ctx context.Context, logger ulogger.Logger, deps *validationDependencies, parentTxs []missingParentTx,
+	subtreeHash *chainhash.Hash, sIdx int) (map[chainhash.Hash]*utxo.UnresolvedMetaData, error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "batchGetParentTxMeta")
+	defer deferFn()
+
+	parentTxMetaMap := make(map[chainhash.Hash]*utxo.UnresolvedMetaData, len(parentTxs))
+	unresolved := make([]*utxo.UnresolvedMetaData, 0, len(parentTxs))
+
+	for _, parentTx := range parentTxs {
+		if _, found := parentTxMetaMap[parentTx.parentTxHash]; found {
+			continue
+		}
+
+		unresolvedParent := &utxo.UnresolvedMetaData{
+			Hash:   parentTx.parentTxHash,
+			Idx:    len(unresolved),
+			Fields: []fields.FieldName{fields.BlockIDs},
+		}
+
+		parentTxMetaMap[parentTx.parentTxHash] = unresolvedParent
+		unresolved = append(unresolved, unresolvedParent)
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	util.SafeSetLimit(g, b.getValidationConcurrency(0))
+
+	for i := 0; i < len(unresolved); i += deps.getMetaBatchSize {
+		batch := unresolved[i:subtreepkg.Min(i+deps.getMetaBatchSize, len(unresolved))]
+
+		g.Go(func() error {
+			_, err := retry.Retry(gCtx, logger, func() (struct{}, error) {
+				return struct{}{}, deps.txMetaStore.BatchDecorate(gCtx, batch, fields.BlockIDs)
+			}, deps.retryPolicy.options(fmt.Sprintf("[validOrderAndBlessed][%s][%s:%d] error batch getting parent transactions", b.String(), subtreeHash.String(), sIdx))...)
+			if err != nil {
+				return errors.NewStorageError("[validOrderAndBlessed][%s][%s:%d] error batch getting parent transactions from txMetaStore", b.String(), subtreeHash.String(), sIdx, err)
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return parentTxMetaMap, nil
+}
+
 func getParentTxMetaBlockIDs(gCtx context.Context, txMetaStore utxo.Store, parentTxStruct missingParentTx) (*meta.Data, error) {
 	parentTxMeta, err := txMetaStore.Get(gCtx, &parentTxStruct.parentTxHash, fields.BlockIDs)
+
+	return resolveParentTxMetaBlockIDs(parentTxMeta, err, parentTxStruct)
+}
+
+// resolveParentTxMetaBlockIDs interprets the result of a parent tx meta lookup: a parent that is not found is old
+// and returns nil, a parent without block IDs is invalid. Stores can return no data without an error for a missing
+// record, which is treated as not found.
+func resolveParentTxMetaBlockIDs(parentTxMeta *meta.Data, err error, parentTxStruct missingParentTx) (*meta.Data, error) {
 	if err != nil {
 		if errors.Is(err, errors.ErrTxNotFound) {
 			return nil, nil
@@ -1019,6 +1859,10 @@ func getParentTxMetaBlockIDs(gCtx context.Context, txMetaStore utxo.Store, paren
 		return nil, errors.NewStorageError("error getting parent transaction %s from txMetaStore", parentTxStruct.parentTxHash.String(), err)
 	}
 
+	if parentTxMeta == nil {
+		return nil, nil
+	}
+
 	if len(parentTxMeta.BlockIDs) == 0 {
 		return nil, errors.NewBlockInvalidError("parent transaction %s of tx %s has no block IDs", parentTxStruct.parentTxHash.String(), parentTxStruct.txHash.String())
 	}
@@ -1026,48 +1870,169 @@ func getParentTxMetaBlockIDs(gCtx context.Context, txMetaStore utxo.Store, paren
 	return parentTxMeta, nil
 }
 
-func (b *Block) GetSubtrees(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, getAndValidateSubtreesConcurrency int) ([]*subtreepkg.Subtree, error) {
+// GetCounterConflictingTxs returns the conflicting transactions in the subtrees of the block, together with
+// the parents of those transactions that are themselves marked as conflicting (double spending parents).
+// The conflicting nodes of each subtree are looked up concurrently in the tx meta store, and the context
+// is checked before each subtree is processed, so a cancelled validation returns promptly.
+//
+// Parameters:
+// - ctx: the context to use for tracing and cancellation
+// - txMetaStore: the store to look up the transaction meta data of the conflicting transactions
+// - concurrency: the maximum number of concurrent lookups, a value <= 0 uses a default based on the number of CPUs
+//
+// Returns:
+// - []chainhash.Hash: the hashes of the conflicting transactions in the block
+// - []chainhash.Hash: the hashes of the conflicting parents of the conflicting transactions
+// - error: if the context was cancelled or a lookup in the tx meta store failed
+func (b *Block) GetCounterConflictingTxs(ctx context.Context, txMetaStore utxo.Store, concurrency int) ([]chainhash.Hash, []chainhash.Hash, error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "GetCounterConflictingTxs")
+	defer deferFn()
+
+	if concurrency <= 0 {
+		concurrency = subtreepkg.Max(4, runtime.NumCPU()/2)
+	}
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	var (
+		mu                 sync.Mutex
+		conflictingTxs     = make([]chainhash.Hash, 0)
+		conflictingParents = make(map[chainhash.Hash]struct{})
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	util.SafeSetLimit(g, concurrency)
+
+	for _, subtree := range subtreeSlices {
+		if subtree == nil || len(subtree.ConflictingNodes) == 0 {
+			continue
+		}
+
+		// stop scheduling new lookups as soon as the context is done
+		if err := gCtx.Err(); err != nil {
+			_ = g.Wait()
+			return nil, nil, errors.NewContextCanceledError("[GetCounterConflictingTxs][%s] context done", b.String(), err)
+		}
+
+		for _, conflictingNode := range subtree.ConflictingNodes {
+			conflictingNode := conflictingNode
+
+			g.Go(func() error {
+				txMeta, err := txMetaStore.Get(gCtx, &conflictingNode, fields.TxInpoints)
+				if err != nil {
+					return errors.NewStorageError("[GetCounterConflictingTxs][%s] error getting conflicting transaction %s", b.String(), conflictingNode.String(), err)
+				}
+
+				parents, err := b.getConflictingParents(gCtx, txMetaStore, txMeta.TxInpoints.ParentTxHashes)
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				conflictingTxs = append(conflictingTxs, conflictingNode)
+
+				for _, parent := range parents {
+					conflictingParents[parent] = struct{}{}
+				}
+
+				return nil
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	parentHashes := make([]chainhash.Hash, 0, len(conflictingParents))
+	for parent := range conflictingParents {
+		parentHashes = append(parentHashes, parent)
+	}
+
+	return conflictingTxs, parentHashes, nil
+}
+
+// getConflictingParents returns the parent transactions that are marked as conflicting in the tx meta store.
+// Parents that are not found in the store are assumed to be mined and are not double spends.
+func (b *Block) getConflictingParents(ctx context.Context, txMetaStore utxo.Store, parentTxHashes []chainhash.Hash) ([]chainhash.Hash, error) {
+	conflictingParents := make([]chainhash.Hash, 0)
+
+	for _, parentTxHash := range parentTxHashes {
+		parentTxHash := parentTxHash
+
+		parentTxMeta, err := txMetaStore.Get(ctx, &parentTxHash, fields.Conflicting)
+		if err != nil {
+			if errors.Is(err, errors.ErrTxNotFound) {
+				continue
+			}
+
+			return nil, errors.NewStorageError("[GetCounterConflictingTxs][%s] error getting parent transaction %s", b.String(), parentTxHash.String(), err)
+		}
+
+		if parentTxMeta.Conflicting {
+			conflictingParents = append(conflictingParents, parentTxHash)
+		}
+	}
+
+	return conflictingParents, nil
+}
+
+func (b *Block) GetSubtrees(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, getAndValidateSubtreesConcurrency int,
+	fallbacks ...SubtreeFallbackSource) ([]*subtreepkg.Subtree, error) {
 	startTime := time.Now()
 	defer func() {
 		prometheusBlockGetSubtrees.Observe(time.Since(startTime).Seconds())
 	}()
 
 	// get the subtree slices from the subtree store
-	if err := b.GetAndValidateSubtrees(ctx, logger, subtreeStore, getAndValidateSubtreesConcurrency); err != nil {
+	if err := b.GetAndValidateSubtrees(ctx, logger, subtreeStore, getAndValidateSubtreesConcurrency, RetryPolicy{}, fallbacks...); err != nil {
 		return nil, err
 	}
 
-	return b.SubtreeSlices, nil
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	return subtreeSlices, nil
 }
 
-func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, getAndValidateSubtreesConcurrency int) error {
+// subtreeSlicesLoaded returns whether all subtrees of the block are loaded, the caller must hold subtreeSlicesMu
+func (b *Block) subtreeSlicesLoaded() bool {
+	if len(b.Subtrees) != len(b.SubtreeSlices) {
+		return false
+	}
+
+	for i := range b.Subtrees {
+		if b.SubtreeSlices[i] == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetAndValidateSubtrees loads the subtrees of the block into SubtreeSlices and validates their sizes.
+// Subtrees that are not found in the subtree store are fetched from the fallback sources, which are tried in order.
+// The fetches and deserialization of the subtrees are retried according to retryPolicy.
+func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, getAndValidateSubtreesConcurrency int,
+	retryPolicy RetryPolicy, fallbacks ...SubtreeFallbackSource) error {
 	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "GetAndValidateSubtrees",
 		tracing.WithHistogram(prometheusBlockGetAndValidateSubtrees),
 	)
 	defer deferFn()
 
+	// the subtrees are loaded into a local slice without holding the lock, so that readers of the block, like
+	// String, are not blocked while the subtrees are fetched
 	b.subtreeSlicesMu.Lock()
-	defer func() {
+	if b.subtreeSlicesLoaded() {
 		b.subtreeSlicesMu.Unlock()
-	}()
-
-	if len(b.Subtrees) == len(b.SubtreeSlices) {
-		missing := false
-
-		for i := range b.Subtrees {
-			if b.SubtreeSlices[i] == nil {
-				missing = true
-				break
-			}
-		}
-
-		if !missing {
-			// already loaded
-			return nil
-		}
+		return nil
 	}
 
-	b.SubtreeSlices = make([]*subtreepkg.Subtree, len(b.Subtrees))
+	subtreeHashes := b.Subtrees
+	b.subtreeSlicesMu.Unlock()
+
+	subtreeSlices := make([]*subtreepkg.Subtree, len(subtreeHashes))
 
 	var (
 		sizeInBytes atomic.Uint64
@@ -1082,9 +2047,9 @@ func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logge
 	g, gCtx := errgroup.WithContext(ctx)
 	util.SafeSetLimit(g, concurrency)
 	// we have the hashes. Get the actual subtrees from the subtree store
-	for i, subtreeHash := range b.Subtrees {
+	for i, subtreeHash := range subtreeHashes {
 		i := i
-		if b.SubtreeSlices[i] == nil {
+		if subtreeSlices[i] == nil {
 			blockHash := b.Hash()
 			blockID := b.ID
 			subtreeHash := subtreeHash
@@ -1096,26 +2061,39 @@ func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logge
 
 				findSubtree := func() (io.ReadCloser, error) {
 					readCloser, err := subtreeStore.GetIoReader(gCtx, subtreeHash[:], fileformat.FileTypeSubtree)
-					if err != nil {
-						if errors.Is(err, errors.ErrNotFound) {
-							return subtreeStore.GetIoReader(gCtx, subtreeHash[:], fileformat.FileTypeSubtree)
-						}
+					if err != nil && isSubtreeNotFound(err) {
+						readCloser, err = subtreeStore.GetIoReader(gCtx, subtreeHash[:], fileformat.FileTypeSubtree)
+					}
 
-						return nil, err
+					if err == nil {
+						prometheusBlockSubtreeFetchSource.WithLabelValues(subtreeSourceStore).Inc()
+
+						return readCloser, nil
 					}
 
-					return readCloser, err
+					if isSubtreeNotFound(err) && len(fallbacks) > 0 {
+						return getSubtreeFromFallbacks(gCtx, logger, *subtreeHash, fallbacks)
+					}
+
+					return nil, err
 				}
 				subtreeReader, err := retry.Retry(
 					gCtx,
 					logger,
 					findSubtree,
-					retry.WithMessage(fmt.Sprintf("[BLOCK][%s][ID %d] failed to get subtree %s", blockHash, blockID, subtreeHash)),
-					retry.WithRetryCount(3),
-					retry.WithBackoffDurationType(100*time.Millisecond),
+					retryPolicy.options(
+						fmt.Sprintf("[BLOCK][%s][ID %d] failed to get subtree %s", blockHash, blockID, subtreeHash),
+						retry.WithRetryCount(3),
+						retry.WithBackoffDurationType(100*time.Millisecond),
+					)...,
 				)
 
 				if err != nil {
+					// a subtree that fails its checksum is corrupt in the store, this is not a consensus failure of the block
+					if errors.Is(err, errors.ErrSubtreeCorrupt) {
+						return errors.NewSubtreeCorruptError("[BLOCK][%s][ID %d] subtree %s is corrupt", blockHash, blockID, subtreeHash, err)
+					}
+
 					return errors.NewStorageError("[BLOCK][%s][ID %d] failed to get subtree %s", blockHash, blockID, subtreeHash, err)
 				}
 
@@ -1123,14 +2101,14 @@ func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logge
 				if err != nil {
 					_, err = retry.Retry(gCtx, logger, func() (struct{}, error) {
 						return struct{}{}, subtree.DeserializeFromReader(subtreeReader)
-					}, retry.WithMessage(fmt.Sprintf("[BLOCK][%s][ID %d] failed to deserialize subtree %s", blockHash, blockID, subtreeHash)))
+					}, retryPolicy.options(fmt.Sprintf("[BLOCK][%s][ID %d] failed to deserialize subtree %s", blockHash, blockID, subtreeHash))...)
 
 					if err != nil {
 						return errors.NewStorageError("[BLOCK][%s][ID %d] failed to deserialize subtree %s", blockHash, blockID, subtreeHash, err)
 					}
 				}
 
-				b.SubtreeSlices[i] = subtree
+				subtreeSlices[i] = subtree
 
 				sizeInBytes.Add(subtree.SizeInBytes)
 				txCount.Add(uint64(subtree.Length())) // nolint: gosec
@@ -1147,24 +2125,60 @@ func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logge
 		return err
 	}
 
-	// check that the size of all subtrees is the same
+	// check that all subtrees, except the last one, have the same power of two length, which the merkle root
+	// calculation in CheckMerkleRoot relies on
 	var subtreeSize int
 
-	nrOfSubtrees := len(b.Subtrees)
+	nrOfSubtrees := len(subtreeHashes)
 
-	for sIdx := 0; sIdx < len(b.SubtreeSlices); sIdx++ {
-		subtree := b.SubtreeSlices[sIdx]
+	for sIdx := 0; sIdx < len(subtreeSlices); sIdx++ {
+		subtree := subtreeSlices[sIdx]
 		if subtree == nil {
 			return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] subtree %d of %d was loaded but is nil", b.String(), b.ID, sIdx, nrOfSubtrees)
 		}
+
+		length := subtree.Length()
+
+		if sIdx == nrOfSubtrees-1 {
+			// the last subtree can be partially filled, but not larger than the other subtrees
+			if length == 0 {
+				return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] subtree %d is empty", b.String(), b.ID, sIdx)
+			}
+
+			if sIdx > 0 && length > subtreeSize {
+				return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] last subtree %d has length %d, larger than the length %d of the other subtrees", b.String(), b.ID, sIdx, length, subtreeSize)
+			}
+
+			continue
+		}
+
+		if length < minSubtreeLength || !subtreepkg.IsPowerOfTwo(length) {
+			return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] subtree %d has length %d, expected a power of two of at least %d", b.String(), b.ID, sIdx, length, minSubtreeLength)
+		}
+
 		if sIdx == 0 {
-			subtreeSize = subtree.Length()
-		} else if subtree.Length() != subtreeSize && sIdx != nrOfSubtrees-1 {
+			subtreeSize = length
+		} else if length != subtreeSize {
 			// all subtrees need to be the same size as the first tree, except the last one
-			return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] subtree %d has length %d, expected %d", b.String(), b.ID, sIdx, subtree.Length(), subtreeSize)
+			return errors.NewBlockInvalidError("[BLOCK][%s][ID %d] subtree %d has length %d, expected %d", b.String(), b.ID, sIdx, length, subtreeSize)
 		}
 	}
 
+	b.subtreeSlicesMu.Lock()
+	defer b.subtreeSlicesMu.Unlock()
+
+	if b.subtreeSlicesLoaded() {
+		// loaded by a concurrent call in the meantime
+		return nil
+	}
+
+	if len(b.Subtrees) != len(subtreeSlices) {
+		return errors.NewProcessingError("[BLOCK][%s][ID %d] subtrees of the block changed while loading them", b.string(), b.ID)
+	}
+
+	b.SubtreeSlices = subtreeSlices
+	b.subtreeSlicesGeneration++
+
 	b.TransactionCount = txCount.Load()
 	// header + transaction count + size in bytes + coinbase tx size
 	b.SizeInBytes = sizeInBytes.Load() + 80 + util.VarintSize(b.TransactionCount) + uint64(b.CoinbaseTx.Size()) // nolint: gosec
@@ -1174,6 +2188,244 @@ func (b *Block) GetAndValidateSubtrees(ctx context.Context, logger ulogger.Logge
 	return nil
 }
 
+// TxHashes calls fn for each transaction in the block, in block order, with the index of the transaction in the block
+// and its txid. The coinbase placeholder of the first subtree is substituted with the txid of the coinbase transaction.
+//
+// Unlike GetAndValidateSubtrees, the subtrees are read from the subtree store one at a time and are not kept in
+// SubtreeSlices, so the memory used is bounded by the size of a single subtree.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - subtreeStore: Store to read the subtrees from
+//   - fn: Called for each transaction, iteration stops when fn returns an error
+//
+// Returns:
+//   - error: The error returned by fn, or an error if a subtree could not be read
+func (b *Block) TxHashes(ctx context.Context, subtreeStore SubtreeStore, fn func(idx uint64, hash chainhash.Hash) error) error {
+	var idx uint64
+
+	for sIdx, subtreeHash := range b.Subtrees {
+		if err := ctx.Err(); err != nil {
+			return errors.NewContextCanceledError("[BLOCK][%s] context done while iterating tx hashes", b.String(), err)
+		}
+
+		subtree, err := b.readSubtree(ctx, subtreeStore, subtreeHash)
+		if err != nil {
+			return err
+		}
+
+		for nodeIdx := range subtree.Nodes {
+			hash := subtree.Nodes[nodeIdx].Hash
+
+			if sIdx == 0 && nodeIdx == 0 && hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+				if b.CoinbaseTx == nil {
+					return errors.NewProcessingError("[BLOCK][%s] missing coinbase transaction", b.String())
+				}
+
+				hash = *b.CoinbaseTx.TxIDChainHash()
+			}
+
+			if err = fn(idx, hash); err != nil {
+				return err
+			}
+
+			idx++
+		}
+	}
+
+	return nil
+}
+
+// FindTx returns the location of a transaction in the block: the index of its subtree in Subtrees and its index
+// in that subtree. The coinbase transaction is found at the coinbase placeholder, the first node of the first subtree.
+//
+// When the txMap of the block was completely built by the duplicate transactions check, the location is looked up in the txMap
+// without reading any subtree. Otherwise the subtrees are searched in block order, using the subtrees loaded in
+// SubtreeSlices and reading the others from the subtree store one at a time, and the search stops at the subtree
+// that contains the transaction.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - subtreeStore: Store to read the subtrees from that are not loaded
+//   - txHash: Hash of the transaction to find
+//
+// Returns:
+//   - int: The index of the subtree of the transaction in Subtrees
+//   - int: The index of the transaction in its subtree
+//   - bool: Whether the transaction is in the block
+//   - error: If a subtree could not be read
+func (b *Block) FindTx(ctx context.Context, subtreeStore SubtreeStore, txHash *chainhash.Hash) (subtreeIdx int, nodeIdx int, found bool, err error) {
+	// the coinbase is the first transaction of the block, even when the block has no subtrees
+	if b.CoinbaseTx != nil && b.CoinbaseTx.TxIDChainHash().IsEqual(txHash) {
+		return 0, 0, true, nil
+	}
+
+	if len(b.Subtrees) == 0 {
+		return 0, 0, false, nil
+	}
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	b.txMapMu.RLock()
+	txMap := b.txMap
+	txMapComplete := b.txMapComplete
+	b.txMapMu.RUnlock()
+
+	if txMap != nil && txMapComplete && len(subtreeSlices) == len(b.Subtrees) && subtreeSlices[0] != nil {
+		// the txMap index of a transaction is based on the size of the first subtree, see checkDuplicateTransactions
+		idx, ok := txMap.Get(*txHash)
+		if !ok {
+			return 0, 0, false, nil
+		}
+
+		subtreeSize := uint64(subtreeSlices[0].Size()) //nolint:gosec
+
+		return int(idx / subtreeSize), int(idx % subtreeSize), true, nil //nolint:gosec
+	}
+
+	for sIdx, subtreeHash := range b.Subtrees {
+		if err = ctx.Err(); err != nil {
+			return 0, 0, false, errors.NewContextCanceledError("[BLOCK][%s] context done while finding tx %s", b.String(), txHash.String(), err)
+		}
+
+		var subtree *subtreepkg.Subtree
+
+		if sIdx < len(subtreeSlices) && subtreeSlices[sIdx] != nil {
+			subtree = subtreeSlices[sIdx]
+		} else if subtree, err = b.readSubtree(ctx, subtreeStore, subtreeHash); err != nil {
+			return 0, 0, false, err
+		}
+
+		for idx := range subtree.Nodes {
+			if subtree.Nodes[idx].Hash.Equal(*txHash) {
+				return sIdx, idx, true, nil
+			}
+		}
+	}
+
+	return 0, 0, false, nil
+}
+
+// ToWireMsgBlock converts the block into a full wire.MsgBlock, with the transactions in block order and the
+// coinbase first, for relay to legacy peers that expect complete blocks. It is the inverse of NewBlockFromMsgBlock.
+//
+// Subtrees that are not loaded are read from the subtree store. The transactions are read from the subtree
+// data files in the subtree store.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - subtreeStore: Store to read the subtrees and subtree data files from
+//
+// Returns:
+//   - *wire.MsgBlock: The full block
+//   - error: If a subtree or subtree data file could not be read, or a transaction could not be converted
+func (b *Block) ToWireMsgBlock(ctx context.Context, subtreeStore SubtreeStore) (*wire.MsgBlock, error) {
+	if b.CoinbaseTx == nil {
+		return nil, errors.NewProcessingError("[ToWireMsgBlock][%s] block has no coinbase transaction", b.String())
+	}
+
+	msgBlock := wire.NewMsgBlock(b.Header.ToWireBlockHeader())
+	msgBlock.Transactions = make([]*wire.MsgTx, 0, b.TransactionCount)
+
+	coinbaseMsgTx, err := toWireMsgTx(b.CoinbaseTx)
+	if err != nil {
+		return nil, errors.NewProcessingError("[ToWireMsgBlock][%s] failed to convert coinbase transaction", b.String(), err)
+	}
+
+	msgBlock.Transactions = append(msgBlock.Transactions, coinbaseMsgTx)
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	for sIdx, subtreeHash := range b.Subtrees {
+		var subtree *subtreepkg.Subtree
+
+		if sIdx < len(subtreeSlices) && subtreeSlices[sIdx] != nil {
+			subtree = subtreeSlices[sIdx]
+		} else if subtree, err = b.readSubtree(ctx, subtreeStore, subtreeHash); err != nil {
+			return nil, err
+		}
+
+		subtreeData, err := b.readSubtreeData(ctx, subtreeStore, subtree)
+		if err != nil {
+			return nil, err
+		}
+
+		for nIdx, tx := range subtreeData.Txs {
+			if sIdx == 0 && nIdx == 0 && subtree.Nodes[0].Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+				// the coinbase is already added
+				continue
+			}
+
+			if tx == nil {
+				return nil, errors.NewProcessingError("[ToWireMsgBlock][%s] transaction %d of subtree %s is missing in the subtree data", b.String(), nIdx, subtreeHash.String())
+			}
+
+			msgTx, err := toWireMsgTx(tx)
+			if err != nil {
+				return nil, errors.NewProcessingError("[ToWireMsgBlock][%s] failed to convert transaction %s", b.String(), tx.TxID(), err)
+			}
+
+			msgBlock.Transactions = append(msgBlock.Transactions, msgTx)
+		}
+	}
+
+	if uint64(len(msgBlock.Transactions)) != b.TransactionCount {
+		return nil, errors.NewProcessingError("[ToWireMsgBlock][%s] block has %d transactions, but %d were read", b.String(), b.TransactionCount, len(msgBlock.Transactions))
+	}
+
+	return msgBlock, nil
+}
+
+// readSubtreeData reads the transactions of the subtree from its subtree data file in the subtree store.
+func (b *Block) readSubtreeData(ctx context.Context, subtreeStore SubtreeStore, subtree *subtreepkg.Subtree) (*subtreepkg.SubtreeData, error) {
+	subtreeHash := subtree.RootHash()
+
+	subtreeDataReader, err := subtreeStore.GetIoReader(ctx, subtreeHash[:], fileformat.FileTypeSubtreeData)
+	if err != nil {
+		return nil, errors.NewStorageError("[BLOCK][%s] failed to get subtree data %s", b.String(), subtreeHash.String(), err)
+	}
+
+	defer func() {
+		_ = subtreeDataReader.Close()
+	}()
+
+	subtreeData, err := subtreepkg.NewSubtreeDataFromReader(subtree, subtreeDataReader)
+	if err != nil {
+		return nil, errors.NewStorageError("[BLOCK][%s] failed to deserialize subtree data %s", b.String(), subtreeHash.String(), err)
+	}
+
+	return subtreeData, nil
+}
+
+// toWireMsgTx converts a transaction into a wire.MsgTx.
+func toWireMsgTx(tx *bt.Tx) (*wire.MsgTx, error) {
+	msgTx := &wire.MsgTx{}
+	if err := msgTx.Deserialize(bytes.NewReader(tx.Bytes())); err != nil {
+		return nil, err
+	}
+
+	return msgTx, nil
+}
+
+// readSubtree reads and deserializes a single subtree from the subtree store.
+func (b *Block) readSubtree(ctx context.Context, subtreeStore SubtreeStore, subtreeHash *chainhash.Hash) (*subtreepkg.Subtree, error) {
+	subtreeReader, err := subtreeStore.GetIoReader(ctx, subtreeHash[:], fileformat.FileTypeSubtree)
+	if err != nil {
+		return nil, errors.NewStorageError("[BLOCK][%s] failed to get subtree %s", b.String(), subtreeHash.String(), err)
+	}
+
+	defer func() {
+		_ = subtreeReader.Close()
+	}()
+
+	subtree := &subtreepkg.Subtree{}
+	if err = subtree.DeserializeFromReader(subtreeReader); err != nil {
+		return nil, errors.NewStorageError("[BLOCK][%s] failed to deserialize subtree %s", b.String(), subtreeHash.String(), err)
+	}
+
+	return subtree, nil
+}
+
 func (b *Block) getSubtreeMetaSlice(ctx context.Context, subtreeStore SubtreeStore, subtreeHash chainhash.Hash, subtree *subtreepkg.Subtree) (*subtreepkg.SubtreeMeta, error) {
 	// get subtree meta
 	subtreeMetaReader, err := subtreeStore.GetIoReader(ctx, subtreeHash[:], fileformat.FileTypeSubtreeMeta)
@@ -1194,36 +2446,90 @@ func (b *Block) getSubtreeMetaSlice(ctx context.Context, subtreeStore SubtreeSto
 	return subtreeMetaSlice, nil
 }
 
-func (b *Block) CheckMerkleRoot(ctx context.Context) (err error) {
-	if len(b.Subtrees) != len(b.SubtreeSlices) {
-		return errors.NewStorageError("[BLOCK][%s] number of subtrees does not match number of subtree slices, have you called block.GetAndValidateSubtrees()?", b.String())
+// checkMaxBlockSize checks that the size of the block is not larger than maxBlockSize. The size of the block is
+// the size computed from the subtrees by GetAndValidateSubtrees, not the size reported with the block.
+func (b *Block) checkMaxBlockSize(maxBlockSize uint64) error {
+	if b.SizeInBytes > maxBlockSize {
+		return errors.NewBlockInvalidError("[BLOCK][%s] block size %d exceeds the maximum block size of %d bytes", b.String(), b.SizeInBytes, maxBlockSize)
+	}
+
+	return nil
+}
+
+// checkTxSizesBeforeGenesis checks that the coinbase transaction and the transactions in the subtrees of the
+// block are not larger than MaxTxSizeBeforeGenesis. The sizes of the transactions are taken from the subtrees.
+func (b *Block) checkTxSizesBeforeGenesis() error {
+	if size := b.CoinbaseTx.Size(); size > MaxTxSizeBeforeGenesis {
+		return errors.NewBlockInvalidError("[BLOCK][%s] coinbase tx size %d exceeds the pre-Genesis limit of %d bytes", b.String(), size, MaxTxSizeBeforeGenesis)
 	}
 
-	_, _, deferFn := tracing.Tracer("block").Start(ctx, "CheckMerkleRoot",
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	for _, subtree := range subtreeSlices {
+		if subtree == nil {
+			continue
+		}
+
+		for _, node := range subtree.Nodes {
+			if node.SizeInBytes > MaxTxSizeBeforeGenesis {
+				return errors.NewBlockInvalidError("[BLOCK][%s] tx %s size %d exceeds the pre-Genesis limit of %d bytes", b.String(), node.Hash.String(), node.SizeInBytes, MaxTxSizeBeforeGenesis)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckMerkleRoot verifies that the merkle root in the block header matches the merkle root computed
+// from the block's subtrees. The error includes both roots when they differ.
+func (b *Block) CheckMerkleRoot(ctx context.Context) (err error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "CheckMerkleRoot",
 		tracing.WithHistogram(prometheusBlockCheckMerkleRoot),
 	)
 	defer deferFn()
 
+	calculatedMerkleRootHash, err := b.ComputeMerkleRoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !b.Header.HashMerkleRoot.IsEqual(calculatedMerkleRootHash) {
+		return errors.NewBlockInvalidError("[BLOCK][%s] merkle root does not match, expected %s, computed %s", b.String(), b.Header.HashMerkleRoot.String(), calculatedMerkleRootHash.String())
+	}
+
+	return nil
+}
+
+// ComputeMerkleRoot calculates the merkle root of the block from its subtrees, with the coinbase tx
+// substituted into the first position of subtree 0. The subtrees must have been loaded, see
+// GetAndValidateSubtrees.
+func (b *Block) ComputeMerkleRoot(_ context.Context) (*chainhash.Hash, error) {
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
+	if len(b.Subtrees) != len(subtreeSlices) {
+		return nil, errors.NewStorageError("[BLOCK][%s] number of subtrees does not match number of subtree slices, have you called block.GetAndValidateSubtrees()?", b.String())
+	}
+
 	hashes := make([]chainhash.Hash, len(b.Subtrees))
 
-	for sIdx := 0; sIdx < len(b.SubtreeSlices); sIdx++ {
-		subtree := b.SubtreeSlices[sIdx]
+	for sIdx := 0; sIdx < len(subtreeSlices); sIdx++ {
+		subtree := subtreeSlices[sIdx]
 		if subtree == nil {
-			return errors.NewProcessingError("[BLOCK][%s] missing subtree %d of %d", b.String(), sIdx, len(b.Subtrees))
+			return nil, errors.NewProcessingError("[BLOCK][%s] missing subtree %d of %d", b.String(), sIdx, len(b.Subtrees))
 		}
 
 		if sIdx == 0 {
 			// We need to inject the coinbase tx id into the first position of the first subtree
 			rootHash, err := subtree.RootHashWithReplaceRootNode(b.CoinbaseTx.TxIDChainHash(), 0, uint64(b.CoinbaseTx.Size())) // nolint: gosec
 			if err != nil {
-				return errors.NewProcessingError("[BLOCK][%s] error replacing root node in subtree", b.String(), err)
+				return nil, errors.NewProcessingError("[BLOCK][%s] error replacing root node in subtree", b.String(), err)
 			}
 
 			hashes[sIdx] = *rootHash
 		} else {
 			rootHash := subtree.RootHash()
 			if rootHash == nil {
-				return errors.NewProcessingError("[BLOCK][%s] subtree %d returned nil root hash", b.String(), sIdx)
+				return nil, errors.NewProcessingError("[BLOCK][%s] subtree %d returned nil root hash", b.String(), sIdx)
 			}
 
 			hashes[sIdx] = *rootHash
@@ -1239,13 +2545,13 @@ func (b *Block) CheckMerkleRoot(ctx context.Context) (err error) {
 		// Create a new subtree with the hashes of the subtrees
 		st, err := subtreepkg.NewIncompleteTreeByLeafCount(len(b.Subtrees))
 		if err != nil {
-			return errors.NewProcessingError("[BLOCK][%s] error creating new root tree", b.String(), err)
+			return nil, errors.NewProcessingError("[BLOCK][%s] error creating new root tree", b.String(), err)
 		}
 
 		for _, hash := range hashes {
 			err = st.AddNode(hash, 1, 0)
 			if err != nil {
-				return errors.NewProcessingError("[BLOCK][%s] error adding node to root tree", b.String(), err)
+				return nil, errors.NewProcessingError("[BLOCK][%s] error adding node to root tree", b.String(), err)
 			}
 		}
 
@@ -1253,17 +2559,13 @@ func (b *Block) CheckMerkleRoot(ctx context.Context) (err error) {
 
 		calculatedMerkleRootHash, err = chainhash.NewHash(calculatedMerkleRoot[:])
 		if err != nil {
-			return errors.NewProcessingError("[BLOCK][%s] error creating calculated merkle root hash", b.String(), err)
+			return nil, errors.NewProcessingError("[BLOCK][%s] error creating calculated merkle root hash", b.String(), err)
 		}
 	default:
 		calculatedMerkleRootHash = b.CoinbaseTx.TxIDChainHash()
 	}
 
-	if !b.Header.HashMerkleRoot.IsEqual(calculatedMerkleRootHash) {
-		return errors.NewBlockInvalidError("[BLOCK][%s] merkle root does not match", b.String())
-	}
-
-	return nil
+	return calculatedMerkleRootHash, nil
 }
 
 // ExtractCoinbaseHeight attempts to extract the height of the block from the
@@ -1281,6 +2583,30 @@ func (b *Block) ExtractCoinbaseHeight() (uint32, error) {
 	return util.ExtractCoinbaseHeight(b.CoinbaseTx)
 }
 
+// GetCoinbaseTx returns the coinbase transaction of the block, or nil when the block has none.
+func (b *Block) GetCoinbaseTx() *bt.Tx {
+	return b.CoinbaseTx
+}
+
+// CoinbaseMatureAtHeight returns whether the coinbase outputs of the block are spendable in a block
+// at currentHeight. Coinbase outputs become spendable once the spending block is at least
+// params.CoinbaseMaturity blocks above the block that created them, the same rule the utxo store
+// applies when it records the spending height of coinbase outputs.
+func (b *Block) CoinbaseMatureAtHeight(currentHeight uint32, params *chaincfg.Params) bool {
+	if params == nil {
+		return false
+	}
+
+	// compare with uint64 to avoid an overflow for blocks near the maximum height
+	return uint64(currentHeight) >= uint64(b.Height)+uint64(params.CoinbaseMaturity)
+}
+
+// IsGenesisActive returns whether the block is validated with the Genesis rules of the network, which
+// apply from params.GenesisActivationHeight, including the activation block itself.
+func (b *Block) IsGenesisActive(params *chaincfg.Params) bool {
+	return b.Height >= params.GenesisActivationHeight
+}
+
 func (b *Block) SubTreeBytes() ([]byte, error) {
 	// write the subtree list
 	buf := bytes.NewBuffer(nil)
@@ -1340,34 +2666,53 @@ func (b *Block) SubTreesFromBytes(subtreesBytes []byte) error {
 	return nil
 }
 
+// Bytes returns the serialized block, see SerializeTo for the format.
 func (b *Block) Bytes() ([]byte, error) {
 	if b.Header == nil {
 		return nil, errors.NewBlockInvalidError("[BLOCK][%s] block has no header", b.String())
 	}
 
+	buf := bytes.NewBuffer(make([]byte, 0, BlockHeaderSize+4*wire.MaxVarIntPayload+len(b.Subtrees)*chainhash.HashSize))
+
+	if err := b.SerializeTo(buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SerializeTo writes the serialized block to w, without buffering the whole block in memory:
+// the header, the transaction count and size in bytes as varints, the subtree list, the coinbase
+// transaction and the height as a varint. The output is identical to Bytes.
+func (b *Block) SerializeTo(w io.Writer) error {
+	if b.Header == nil {
+		return errors.NewBlockInvalidError("[BLOCK][%s] block has no header", b.String())
+	}
+
 	// write the header
-	buf := bytes.NewBuffer(b.Header.Bytes())
+	if _, err := w.Write(b.Header.Bytes()); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing header", b.String(), err)
+	}
 
 	// write the transaction count
-	err := wire.WriteVarInt(buf, 0, b.TransactionCount)
-	if err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing transaction count", b.String(), err)
+	if err := wire.WriteVarInt(w, 0, b.TransactionCount); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing transaction count", b.String(), err)
 	}
 
 	// write the size in bytes
-	err = wire.WriteVarInt(buf, 0, b.SizeInBytes)
-	if err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing size in bytes", b.String(), err)
+	if err := wire.WriteVarInt(w, 0, b.SizeInBytes); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing size in bytes", b.String(), err)
 	}
 
 	// write the subtree list
-	subtreeBytes, err := b.SubTreeBytes()
-	if err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing subtree list", b.String(), err)
+	if err := wire.WriteVarInt(w, 0, uint64(len(b.Subtrees))); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing subtree list", b.String(), err)
 	}
 
-	if _, err := buf.Write(subtreeBytes); err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing subtree list", b.String(), err)
+	for _, subTree := range b.Subtrees {
+		if _, err := w.Write(subTree[:]); err != nil {
+			return errors.NewProcessingError("[BLOCK][%s] error writing subtree list", b.String(), err)
+		}
 	}
 
 	coinbaseTX := b.CoinbaseTx
@@ -1376,35 +2721,35 @@ func (b *Block) Bytes() ([]byte, error) {
 	}
 
 	// write the coinbase tx
-	_, err = buf.Write(coinbaseTX.Bytes())
-	if err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing coinbase tx", b.String(), err)
+	if _, err := w.Write(coinbaseTX.Bytes()); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing coinbase tx", b.String(), err)
 	}
 
-	err = wire.WriteVarInt(buf, 0, uint64(b.Height))
-	if err != nil {
-		return nil, errors.NewProcessingError("[BLOCK][%s] error writing height", b.String(), err)
+	if err := wire.WriteVarInt(w, 0, uint64(b.Height)); err != nil {
+		return errors.NewProcessingError("[BLOCK][%s] error writing height", b.String(), err)
 	}
 
-	return buf.Bytes(), nil
+	return nil
 }
 
 func (b *Block) NewOptimizedBloomFilter(ctx context.Context, logger ulogger.Logger, subtreeStore SubtreeStore, getAndValidateSubtreesConcurrency int) (*blobloom.Filter, error) {
-	err := b.GetAndValidateSubtrees(ctx, logger, subtreeStore, getAndValidateSubtreesConcurrency)
+	err := b.GetAndValidateSubtrees(ctx, logger, subtreeStore, getAndValidateSubtreesConcurrency, RetryPolicy{})
 	if err != nil {
 		// just return the error from the call above
 		return nil, err
 	}
 
 	filter := blobloom.NewOptimized(blobloom.Config{
-		Capacity: b.TransactionCount, // Expected number of keys.
-		FPRate:   1e-6,               // Accept one false positive per 100,000 lookups.
+		Capacity: b.TransactionCount,     // Expected number of keys.
+		FPRate:   BlockBloomFilterFPRate, // Accept one false positive per 1,000,000 lookups.
 	})
 
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+
 	var n64 uint64
 	// insert all transaction ids first 8 bytes to the filter
-	for sIdx := 0; sIdx < len(b.SubtreeSlices); sIdx++ {
-		subtree := b.SubtreeSlices[sIdx]
+	for sIdx := 0; sIdx < len(subtreeSlices); sIdx++ {
+		subtree := subtreeSlices[sIdx]
 		if subtree == nil {
 			return nil, errors.NewProcessingError("[BLOCK][%s] missing subtree %d", b.String(), sIdx)
 		}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// Equal returns whether both blocks describe the same block: the same header, coinbase txid, subtree hashes,
+// transaction count and size.
+//
+// Height and ID are not compared, since they depend on the source the block was loaded from.
+func (b *Block) Equal(other *Block) bool {
+	return len(b.diff(other, false)) == 0
+}
+
+// DiffReport returns a description of the fields in which the blocks differ, one field per line,
+// or an empty string when the blocks are equal. For the subtree hashes only the first differing
+// subtree is reported.
+//
+// As in Equal, Height and ID are not compared.
+func (b *Block) DiffReport(other *Block) string {
+	return strings.Join(b.diff(other, true), "\n")
+}
+
+// diff returns the differences between the blocks, stopping at the first difference unless all is set.
+func (b *Block) diff(other *Block, all bool) []string {
+	if b == nil || other == nil {
+		if b == nil && other == nil {
+			return nil
+		}
+
+		return []string{fmt.Sprintf("block: %s != %s", blockOrNil(b), blockOrNil(other))}
+	}
+
+	var diffs []string
+
+	add := func(format string, args ...interface{}) bool {
+		diffs = append(diffs, fmt.Sprintf(format, args...))
+		return !all
+	}
+
+	if done := diffHeaders(b.Header, other.Header, add); done {
+		return diffs
+	}
+
+	if !hashesEqual(coinbaseTxID(b), coinbaseTxID(other)) {
+		if add("coinbase txid: %s != %s", hashOrNil(coinbaseTxID(b)), hashOrNil(coinbaseTxID(other))) {
+			return diffs
+		}
+	}
+
+	if len(b.Subtrees) != len(other.Subtrees) {
+		if add("subtree count: %d != %d", len(b.Subtrees), len(other.Subtrees)) {
+			return diffs
+		}
+	}
+
+	for i := 0; i < len(b.Subtrees) && i < len(other.Subtrees); i++ {
+		if !hashesEqual(b.Subtrees[i], other.Subtrees[i]) {
+			if add("subtree %d: %s != %s", i, hashOrNil(b.Subtrees[i]), hashOrNil(other.Subtrees[i])) {
+				return diffs
+			}
+
+			break
+		}
+	}
+
+	if b.TransactionCount != other.TransactionCount {
+		if add("transaction count: %d != %d", b.TransactionCount, other.TransactionCount) {
+			return diffs
+		}
+	}
+
+	if b.SizeInBytes != other.SizeInBytes {
+		add("size in bytes: %d != %d", b.SizeInBytes, other.SizeInBytes)
+	}
+
+	return diffs
+}
+
+// diffHeaders reports the differing header fields, returns true when add asked to stop.
+func diffHeaders(a, b *BlockHeader, add func(format string, args ...interface{}) bool) bool {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return false
+		}
+
+		return add("header: %s != %s", headerOrNil(a), headerOrNil(b))
+	}
+
+	if a.Version != b.Version && add("header version: %d != %d", a.Version, b.Version) {
+		return true
+	}
+
+	if !hashesEqual(a.HashPrevBlock, b.HashPrevBlock) && add("header previous block: %s != %s", hashOrNil(a.HashPrevBlock), hashOrNil(b.HashPrevBlock)) {
+		return true
+	}
+
+	if !hashesEqual(a.HashMerkleRoot, b.HashMerkleRoot) && add("header merkle root: %s != %s", hashOrNil(a.HashMerkleRoot), hashOrNil(b.HashMerkleRoot)) {
+		return true
+	}
+
+	if a.Timestamp != b.Timestamp && add("header timestamp: %d != %d", a.Timestamp, b.Timestamp) {
+		return true
+	}
+
+	if a.Bits != b.Bits && add("header bits: %s != %s", a.Bits.String(), b.Bits.String()) {
+		return true
+	}
+
+	if a.Nonce != b.Nonce && add("header nonce: %d != %d", a.Nonce, b.Nonce) {
+		return true
+	}
+
+	return false
+}
+
+func coinbaseTxID(b *Block) *chainhash.Hash {
+	if b.CoinbaseTx == nil {
+		return nil
+	}
+
+	return b.CoinbaseTx.TxIDChainHash()
+}
+
+func hashesEqual(a, b *chainhash.Hash) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.IsEqual(b)
+}
+
+func hashOrNil(hash *chainhash.Hash) string {
+	if hash == nil {
+		return "<nil>"
+	}
+
+	return hash.String()
+}
+
+func headerOrNil(header *BlockHeader) string {
+	if header == nil {
+		return "<nil>"
+	}
+
+	return header.Hash().String()
+}
+
+func blockOrNil(b *Block) string {
+	if b == nil {
+		return "<nil>"
+	}
+
+	return b.String()
+}
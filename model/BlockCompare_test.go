@@ -0,0 +1,98 @@
+package model
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlock_EqualAndDiffReport(t *testing.T) {
+	newBlock := func(t *testing.T) *Block {
+		blockHeaderBytes, _ := hex.DecodeString(block1Header)
+		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+		require.NoError(t, err)
+
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
+		require.NoError(t, err)
+
+		subtree1 := chainhash.HashH([]byte("subtree1"))
+		subtree2 := chainhash.HashH([]byte("subtree2"))
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{&subtree1, &subtree2}, 10, 1000, 1, 1)
+		require.NoError(t, err)
+
+		return block
+	}
+
+	t.Run("equal blocks", func(t *testing.T) {
+		a := newBlock(t)
+		b := newBlock(t)
+
+		assert.True(t, a.Equal(b))
+		assert.Empty(t, a.DiffReport(b))
+	})
+
+	t.Run("height and ID are not compared", func(t *testing.T) {
+		a := newBlock(t)
+		b := newBlock(t)
+		b.Height = 100
+		b.ID = 200
+
+		assert.True(t, a.Equal(b))
+	})
+
+	t.Run("header fields", func(t *testing.T) {
+		a := newBlock(t)
+		b := newBlock(t)
+		b.Header.Nonce++
+		b.Header.Timestamp++
+
+		assert.False(t, a.Equal(b))
+
+		report := a.DiffReport(b)
+		assert.Contains(t, report, "header timestamp")
+		assert.Contains(t, report, "header nonce")
+		assert.NotContains(t, report, "header version")
+	})
+
+	t.Run("first differing subtree", func(t *testing.T) {
+		a := newBlock(t)
+		b := newBlock(t)
+
+		other := chainhash.HashH([]byte("other"))
+		b.Subtrees[1] = &other
+		b.TransactionCount = 11
+		b.SizeInBytes = 1001
+
+		assert.False(t, a.Equal(b))
+		assert.Equal(t, "subtree 1: "+a.Subtrees[1].String()+" != "+other.String()+"\n"+
+			"transaction count: 10 != 11\n"+
+			"size in bytes: 1000 != 1001", a.DiffReport(b))
+	})
+
+	t.Run("subtree count and coinbase", func(t *testing.T) {
+		a := newBlock(t)
+		b := newBlock(t)
+		b.Subtrees = b.Subtrees[:1]
+		b.CoinbaseTx = nil
+
+		report := a.DiffReport(b)
+		assert.Contains(t, report, "coinbase txid: "+a.CoinbaseTx.TxIDChainHash().String()+" != <nil>")
+		assert.Contains(t, report, "subtree count: 2 != 1")
+	})
+
+	t.Run("nil blocks", func(t *testing.T) {
+		var nilBlock *Block
+
+		a := newBlock(t)
+
+		assert.True(t, nilBlock.Equal(nil))
+		assert.False(t, a.Equal(nil))
+		assert.False(t, nilBlock.Equal(a))
+		assert.Contains(t, a.DiffReport(nil), "!= <nil>")
+	})
+}
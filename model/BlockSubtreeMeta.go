@@ -0,0 +1,138 @@
+package model
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/stores/utxo"
+	"github.com/bitcoin-sv/teranode/stores/utxo/fields"
+	"github.com/bitcoin-sv/teranode/util"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"golang.org/x/sync/errgroup"
+)
+
+// subtreeMetaFallbackBatchSize is the number of transactions that are looked up in a single batch in the tx meta store,
+// when a subtree meta slice is rebuilt from the tx meta store and no batch size is configured.
+const subtreeMetaFallbackBatchSize = 1024
+
+// PreloadSubtreeMeta reads the subtree meta files of all subtrees of the block concurrently from the subtree store,
+// so that the block validation does not have to wait on a blob store read for every subtree it validates.
+// The subtrees of the block must be loaded.
+//
+// Subtrees of which the meta file is not found in the subtree store are left out of the returned map. The block
+// validation rebuilds the meta slices of these subtrees from the tx meta store.
+//
+// Parameters:
+// - ctx: the context to use for tracing and cancellation
+// - subtreeStore: the store to read the subtree meta files from
+// - concurrency: the maximum number of concurrent reads, a value <= 0 uses the number of CPUs with a minimum of 4
+//
+// Returns:
+// - map[chainhash.Hash]*subtreepkg.SubtreeMeta: the subtree meta slices, keyed by subtree hash
+// - error: if the subtrees are not loaded, the context was cancelled or a meta file could not be read or deserialized
+func (b *Block) PreloadSubtreeMeta(ctx context.Context, subtreeStore SubtreeStore, concurrency int) (map[chainhash.Hash]*subtreepkg.SubtreeMeta, error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "PreloadSubtreeMeta")
+	defer deferFn()
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+	if len(subtreeSlices) != len(b.Subtrees) {
+		return nil, errors.NewProcessingError("[PreloadSubtreeMeta][%s] %d of %d subtrees are loaded", b.String(), len(subtreeSlices), len(b.Subtrees))
+	}
+
+	if concurrency <= 0 {
+		concurrency = subtreepkg.Max(4, runtime.NumCPU())
+	}
+
+	var (
+		mu                sync.Mutex
+		subtreeMetaSlices = make(map[chainhash.Hash]*subtreepkg.SubtreeMeta, len(subtreeSlices))
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	util.SafeSetLimit(g, concurrency)
+
+	for sIdx, subtree := range subtreeSlices {
+		if subtree == nil {
+			_ = g.Wait()
+			return nil, errors.NewProcessingError("[PreloadSubtreeMeta][%s] subtree %d is not loaded", b.String(), sIdx)
+		}
+
+		g.Go(func() error {
+			subtreeHash := *subtree.RootHash()
+
+			subtreeMetaSlice, err := b.getSubtreeMetaSlice(gCtx, subtreeStore, subtreeHash, subtree)
+			if err != nil {
+				if errors.Is(err, errors.ErrNotFound) {
+					return nil
+				}
+
+				return errors.NewStorageError("[PreloadSubtreeMeta][%s][%s:%d] error getting subtree meta slice", b.String(), subtreeHash.String(), sIdx, err)
+			}
+
+			mu.Lock()
+			subtreeMetaSlices[subtreeHash] = subtreeMetaSlice
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return subtreeMetaSlices, nil
+}
+
+// getSubtreeMetaSliceFromTxMetaStore rebuilds the subtree meta slice of a subtree from the inpoints of its transactions
+// in the tx meta store, for subtrees of which the meta file is not in the subtree store.
+// The coinbase placeholder in the first subtree of the block has no inpoints and is skipped.
+func (b *Block) getSubtreeMetaSliceFromTxMetaStore(ctx context.Context, txMetaStore utxo.Store, subtree *subtreepkg.Subtree, batchSize int) (*subtreepkg.SubtreeMeta, error) {
+	if batchSize <= 0 {
+		batchSize = subtreeMetaFallbackBatchSize
+	}
+
+	subtreeHash := subtree.RootHash()
+	subtreeMetaSlice := subtreepkg.NewSubtreeMeta(subtree)
+
+	for i := 0; i < len(subtree.Nodes); i += batchSize {
+		batchNodes := subtree.Nodes[i:subtreepkg.Min(i+batchSize, len(subtree.Nodes))]
+		unresolved := make([]*utxo.UnresolvedMetaData, 0, len(batchNodes))
+
+		for idx, node := range batchNodes {
+			if node.Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+				continue
+			}
+
+			unresolved = append(unresolved, &utxo.UnresolvedMetaData{
+				Hash:   node.Hash,
+				Idx:    i + idx,
+				Fields: []fields.FieldName{fields.TxInpoints},
+			})
+		}
+
+		if err := txMetaStore.BatchDecorate(ctx, unresolved, fields.TxInpoints); err != nil {
+			return nil, errors.NewStorageError("[BLOCK][%s][%s] error batch getting transaction inpoints from txMetaStore", b.String(), subtreeHash.String(), err)
+		}
+
+		for _, txMeta := range unresolved {
+			if txMeta.Err != nil {
+				return nil, errors.NewStorageError("[BLOCK][%s][%s] error getting transaction %s from txMetaStore", b.String(), subtreeHash.String(), txMeta.Hash.String(), txMeta.Err)
+			}
+
+			if txMeta.Data == nil {
+				return nil, errors.NewProcessingError("[BLOCK][%s][%s] transaction %s is missing in txMetaStore", b.String(), subtreeHash.String(), txMeta.Hash.String())
+			}
+
+			if err := subtreeMetaSlice.SetTxInpoints(txMeta.Idx, txMeta.Data.TxInpoints); err != nil {
+				return nil, errors.NewProcessingError("[BLOCK][%s][%s] failed to set inpoints of transaction %s in subtree meta", b.String(), subtreeHash.String(), txMeta.Hash.String(), err)
+			}
+		}
+	}
+
+	return subtreeMetaSlice, nil
+}
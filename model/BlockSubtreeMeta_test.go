@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlock_PreloadSubtreeMeta(t *testing.T) {
+	ctx := context.Background()
+
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	txMetaStore := createTestUTXOStore(t)
+
+	newTx := func(t *testing.T, i int) *bt.Tx {
+		tx := bt.NewTx()
+
+		parentHash := chainhash.HashH([]byte{byte(i)})
+		require.NoError(t, tx.From(parentHash.String(), uint32(i), "76a914eb0bd5edba389198e73f8efabddfc61666969ff788ac", 10_000))
+		tx.Inputs[0].UnlockingScript = &bscript.Script{}
+		require.NoError(t, tx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", 1000))
+
+		_, err := txMetaStore.Create(ctx, tx, 1)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	// the first subtree holds the coinbase placeholder and 1 transaction, the second subtree 2 transactions
+	txs := []*bt.Tx{newTx(t, 1), newTx(t, 2), newTx(t, 3)}
+
+	subtree0, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree0.AddCoinbaseNode())
+	require.NoError(t, subtree0.AddNode(*txs[0].TxIDChainHash(), 1, uint64(txs[0].Size())))
+
+	subtree1, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+
+	for _, tx := range txs[1:] {
+		require.NoError(t, subtree1.AddNode(*tx.TxIDChainHash(), 1, uint64(tx.Size())))
+	}
+
+	newBlock := func(t *testing.T) *Block {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree0.RootHash(), subtree1.RootHash()}, uint64(len(txs)+1), 123, 0, 0)
+		require.NoError(t, err)
+
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree0, subtree1})
+
+		return block
+	}
+
+	// only the meta file of the first subtree is stored
+	subtreeStore := memory.New()
+
+	subtreeMeta0 := subtreepkg.NewSubtreeMeta(subtree0)
+	txInpoints, err := subtreepkg.NewTxInpointsFromTx(txs[0])
+	require.NoError(t, err)
+	require.NoError(t, subtreeMeta0.SetTxInpoints(1, txInpoints))
+
+	subtreeMeta0Bytes, err := subtreeMeta0.Serialize()
+	require.NoError(t, err)
+	require.NoError(t, subtreeStore.Set(ctx, subtree0.RootHash()[:], fileformat.FileTypeSubtreeMeta, subtreeMeta0Bytes))
+
+	t.Run("meta files are preloaded", func(t *testing.T) {
+		subtreeMetaSlices, err := newBlock(t).PreloadSubtreeMeta(ctx, subtreeStore, 2)
+		require.NoError(t, err)
+
+		require.Len(t, subtreeMetaSlices, 1)
+		require.Contains(t, subtreeMetaSlices, *subtree0.RootHash())
+
+		parentTxHashes, err := subtreeMetaSlices[*subtree0.RootHash()].GetParentTxHashes(1)
+		require.NoError(t, err)
+		assert.Equal(t, []chainhash.Hash{*txs[0].Inputs[0].PreviousTxIDChainHash()}, parentTxHashes)
+	})
+
+	t.Run("missing meta file is rebuilt from the tx meta store", func(t *testing.T) {
+		subtreeMetaSlice, err := newBlock(t).getSubtreeMetaSliceFromTxMetaStore(ctx, txMetaStore, subtree1, 1)
+		require.NoError(t, err)
+
+		for idx, tx := range txs[1:] {
+			parentTxHashes, err := subtreeMetaSlice.GetParentTxHashes(idx)
+			require.NoError(t, err)
+			assert.Equal(t, []chainhash.Hash{*tx.Inputs[0].PreviousTxIDChainHash()}, parentTxHashes)
+		}
+	})
+
+	t.Run("coinbase placeholder is skipped when rebuilding", func(t *testing.T) {
+		subtreeMetaSlice, err := newBlock(t).getSubtreeMetaSliceFromTxMetaStore(ctx, txMetaStore, subtree0, 0)
+		require.NoError(t, err)
+
+		parentTxHashes, err := subtreeMetaSlice.GetParentTxHashes(1)
+		require.NoError(t, err)
+		assert.Equal(t, []chainhash.Hash{*txs[0].Inputs[0].PreviousTxIDChainHash()}, parentTxHashes)
+	})
+
+	t.Run("subtrees not loaded", func(t *testing.T) {
+		block := newBlock(t)
+		block.SetSubtreeSlices(nil)
+
+		_, err := block.PreloadSubtreeMeta(ctx, subtreeStore, 0)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrProcessing))
+	})
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/stores/utxo"
+	"github.com/bitcoin-sv/teranode/stores/utxo/fields"
+	"github.com/bitcoin-sv/teranode/util"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"golang.org/x/sync/errgroup"
+)
+
+// blockTxStatsBatchSize is the number of transactions that are looked up in a single batch in the tx meta store.
+const blockTxStatsBatchSize = 1024
+
+// BlockTxStats holds aggregate statistics of the transactions in a block, including the coinbase transaction.
+type BlockTxStats struct {
+	InputCount          uint64
+	OutputCount         uint64
+	TotalOutputSatoshis uint64
+}
+
+// add adds the inputs and outputs of the stats of another set of transactions to s.
+func (s *BlockTxStats) add(other BlockTxStats) {
+	s.InputCount += other.InputCount
+	s.OutputCount += other.OutputCount
+	s.TotalOutputSatoshis += other.TotalOutputSatoshis
+}
+
+// Stats returns the number of inputs and outputs and the total output value of the transactions in the block.
+// The subtrees of the block must be loaded, the transactions are looked up in the tx meta store in batches,
+// with a bounded number of concurrent lookups. The context is checked before each batch is scheduled.
+//
+// Parameters:
+// - ctx: the context to use for tracing and cancellation
+// - txMetaStore: the store to look up the transactions of the block
+//
+// Returns:
+// - BlockTxStats: the statistics of the transactions in the block
+// - error: if the subtrees are not loaded, the context was cancelled or a lookup in the tx meta store failed
+func (b *Block) Stats(ctx context.Context, txMetaStore utxo.Store) (BlockTxStats, error) {
+	ctx, _, deferFn := tracing.Tracer("block").Start(ctx, "Stats")
+	defer deferFn()
+
+	subtreeSlices, _ := b.SubtreeSlicesSnapshot()
+	if len(subtreeSlices) != len(b.Subtrees) {
+		return BlockTxStats{}, errors.NewProcessingError("[Stats][%s] %d of %d subtrees are loaded", b.String(), len(subtreeSlices), len(b.Subtrees))
+	}
+
+	var (
+		mu    sync.Mutex
+		stats BlockTxStats
+	)
+
+	if b.CoinbaseTx != nil {
+		stats.InputCount = uint64(len(b.CoinbaseTx.Inputs))
+		stats.OutputCount = uint64(len(b.CoinbaseTx.Outputs))
+		stats.TotalOutputSatoshis = b.CoinbaseTx.TotalOutputSatoshis()
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	util.SafeSetLimit(g, subtreepkg.Max(4, runtime.NumCPU()/2))
+
+	for sIdx, subtree := range subtreeSlices {
+		if subtree == nil {
+			_ = g.Wait()
+			return BlockTxStats{}, errors.NewProcessingError("[Stats][%s] subtree %d is not loaded", b.String(), sIdx)
+		}
+
+		nodes := subtree.Nodes
+		if sIdx == 0 && len(nodes) > 0 && nodes[0].Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+			nodes = nodes[1:]
+		}
+
+		for i := 0; i < len(nodes); i += blockTxStatsBatchSize {
+			// stop scheduling new lookups as soon as the context is done
+			if err := gCtx.Err(); err != nil {
+				_ = g.Wait()
+				return BlockTxStats{}, errors.NewContextCanceledError("[Stats][%s] context done", b.String(), err)
+			}
+
+			batchNodes := nodes[i:subtreepkg.Min(i+blockTxStatsBatchSize, len(nodes))]
+
+			g.Go(func() error {
+				batchStats, err := b.batchTxStats(gCtx, txMetaStore, batchNodes)
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				stats.add(batchStats)
+				mu.Unlock()
+
+				return nil
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return BlockTxStats{}, err
+	}
+
+	return stats, nil
+}
+
+// batchTxStats looks up the transactions of the given subtree nodes in the tx meta store and returns their statistics.
+func (b *Block) batchTxStats(ctx context.Context, txMetaStore utxo.Store, nodes []subtreepkg.SubtreeNode) (BlockTxStats, error) {
+	unresolved := make([]*utxo.UnresolvedMetaData, len(nodes))
+
+	for idx, node := range nodes {
+		unresolved[idx] = &utxo.UnresolvedMetaData{
+			Hash:   node.Hash,
+			Idx:    idx,
+			Fields: []fields.FieldName{fields.Tx},
+		}
+	}
+
+	if err := txMetaStore.BatchDecorate(ctx, unresolved, fields.Tx); err != nil {
+		return BlockTxStats{}, errors.NewStorageError("[Stats][%s] error batch getting transactions from txMetaStore", b.String(), err)
+	}
+
+	var stats BlockTxStats
+
+	for _, txMeta := range unresolved {
+		if txMeta.Err != nil {
+			return BlockTxStats{}, errors.NewStorageError("[Stats][%s] error getting transaction %s from txMetaStore", b.String(), txMeta.Hash.String(), txMeta.Err)
+		}
+
+		if txMeta.Data == nil || txMeta.Data.Tx == nil {
+			return BlockTxStats{}, errors.NewProcessingError("[Stats][%s] transaction %s is missing in txMetaStore", b.String(), txMeta.Hash.String())
+		}
+
+		stats.InputCount += uint64(len(txMeta.Data.Tx.Inputs))
+		stats.OutputCount += uint64(len(txMeta.Data.Tx.Outputs))
+		stats.TotalOutputSatoshis += txMeta.Data.Tx.TotalOutputSatoshis()
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlock_Stats(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	txMetaStore := createTestUTXOStore(t)
+
+	// transactions with 1 input and 1 output, 2 inputs and 2 outputs, ...
+	txs := make([]*bt.Tx, 0, 3)
+
+	for i := 1; i <= 3; i++ {
+		tx := bt.NewTx()
+
+		for j := 0; j < i; j++ {
+			parentHash := chainhash.HashH([]byte{byte(i), byte(j)})
+			require.NoError(t, tx.From(parentHash.String(), 0, "76a914eb0bd5edba389198e73f8efabddfc61666969ff788ac", 10_000))
+			tx.Inputs[j].UnlockingScript = &bscript.Script{}
+
+			require.NoError(t, tx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", uint64(1000*i)))
+		}
+
+		_, err = txMetaStore.Create(context.Background(), tx, 1)
+		require.NoError(t, err)
+
+		txs = append(txs, tx)
+	}
+
+	newBlock := func(t *testing.T, txs []*bt.Tx) *Block {
+		subtree, err := subtreepkg.NewTreeByLeafCount(4)
+		require.NoError(t, err)
+		require.NoError(t, subtree.AddCoinbaseNode())
+
+		for _, tx := range txs {
+			require.NoError(t, subtree.AddNode(*tx.TxIDChainHash(), 1, uint64(tx.Size())))
+		}
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, uint64(len(txs)+1), 123, 0, 0)
+		require.NoError(t, err)
+
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+
+		return block
+	}
+
+	t.Run("inputs and outputs", func(t *testing.T) {
+		stats, err := newBlock(t, txs).Stats(context.Background(), txMetaStore)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(len(coinbase.Inputs)+1+2+3), stats.InputCount)
+		assert.Equal(t, uint64(len(coinbase.Outputs)+1+2+3), stats.OutputCount)
+		assert.Equal(t, coinbase.TotalOutputSatoshis()+1000+2*2000+3*3000, stats.TotalOutputSatoshis)
+	})
+
+	t.Run("missing transaction", func(t *testing.T) {
+		unknownTx := bt.NewTx()
+		require.NoError(t, unknownTx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", 1000))
+
+		_, err := newBlock(t, append([]*bt.Tx{txs[0]}, unknownTx)).Stats(context.Background(), txMetaStore)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+	})
+
+	t.Run("subtrees not loaded", func(t *testing.T) {
+		block := newBlock(t, txs)
+		block.SetSubtreeSlices(nil)
+
+		_, err := block.Stats(context.Background(), txMetaStore)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrProcessing))
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := newBlock(t, txs).Stats(ctx, txMetaStore)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrContextCanceled))
+	})
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"time"
+)
+
+// BlockValidationCheck identifies one of the checks performed by Block.Valid.
+type BlockValidationCheck string
+
+const (
+	CheckTargetDifficulty      BlockValidationCheck = "target_difficulty"
+	CheckTimestampNotInFuture  BlockValidationCheck = "timestamp_not_in_future"
+	CheckMedianTimePast        BlockValidationCheck = "median_time_past"
+	CheckCoinbaseTx            BlockValidationCheck = "coinbase_tx"
+	CheckCoinbaseHeight        BlockValidationCheck = "coinbase_height"
+	CheckSubtrees              BlockValidationCheck = "subtrees"
+	CheckMaxBlockSize          BlockValidationCheck = "max_block_size"
+	CheckMerkleRoot            BlockValidationCheck = "merkle_root"
+	CheckTxSizeBeforeGenesis   BlockValidationCheck = "tx_size_before_genesis"
+	CheckBlockRewardAndFees    BlockValidationCheck = "block_reward_and_fees"
+	CheckDuplicateTransactions BlockValidationCheck = "duplicate_transactions"
+	CheckValidOrderAndBlessed  BlockValidationCheck = "valid_order_and_blessed"
+	CheckValueConservation     BlockValidationCheck = "value_conservation"
+)
+
+// Statuses of a BlockValidationCheckResult.
+const (
+	BlockValidationCheckPassed  = "passed"
+	BlockValidationCheckFailed  = "failed"
+	BlockValidationCheckSkipped = "skipped"
+	BlockValidationCheckNotRun  = "not_run"
+	blockValidationCheckRunning = "running"
+)
+
+// blockValidationChecks lists the checks in the order they are performed by Block.Valid.
+var blockValidationChecks = []BlockValidationCheck{
+	CheckTargetDifficulty,
+	CheckTimestampNotInFuture,
+	CheckMedianTimePast,
+	CheckCoinbaseTx,
+	CheckCoinbaseHeight,
+	CheckSubtrees,
+	CheckMaxBlockSize,
+	CheckMerkleRoot,
+	CheckTxSizeBeforeGenesis,
+	CheckBlockRewardAndFees,
+	CheckDuplicateTransactions,
+	CheckValidOrderAndBlessed,
+	CheckValueConservation,
+}
+
+// BlockValidationCheckResult is the outcome of a single check of Block.Valid.
+type BlockValidationCheckResult struct {
+	Check BlockValidationCheck `json:"check"`
+	// Status is passed, failed, skipped when the check does not apply, or not_run when an earlier check failed
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BlockValidationReport describes which checks of Block.Valid passed or failed for a block.
+// The checks stop at the first failure, later checks are reported as not_run.
+type BlockValidationReport struct {
+	BlockHash string                       `json:"blockHash"`
+	Height    uint32                       `json:"height"`
+	Valid     bool                         `json:"valid"`
+	Error     string                       `json:"error,omitempty"`
+	Duration  string                       `json:"duration"`
+	Checks    []BlockValidationCheckResult `json:"checks"`
+
+	startTime time.Time
+}
+
+func newBlockValidationReport(b *Block) *BlockValidationReport {
+	return &BlockValidationReport{
+		BlockHash: b.Hash().String(),
+		Height:    b.Height,
+		Checks:    make([]BlockValidationCheckResult, 0, len(blockValidationChecks)),
+		startTime: time.Now(),
+	}
+}
+
+// begin records that the given check is running, which implies the check running before it passed.
+func (r *BlockValidationReport) begin(check BlockValidationCheck) {
+	if r == nil {
+		return
+	}
+
+	r.passRunning()
+	r.Checks = append(r.Checks, BlockValidationCheckResult{Check: check, Status: blockValidationCheckRunning})
+}
+
+// skip records that the given check does not apply to the block.
+func (r *BlockValidationReport) skip(check BlockValidationCheck) {
+	if r == nil {
+		return
+	}
+
+	r.passRunning()
+	r.Checks = append(r.Checks, BlockValidationCheckResult{Check: check, Status: BlockValidationCheckSkipped})
+}
+
+// finish records the result of the check running when the validation returned with err,
+// and reports the checks that were never reached as not_run.
+func (r *BlockValidationReport) finish(err error) {
+	if r == nil {
+		return
+	}
+
+	if n := len(r.Checks); n > 0 && r.Checks[n-1].Status == blockValidationCheckRunning && err != nil {
+		r.Checks[n-1].Status = BlockValidationCheckFailed
+		r.Checks[n-1].Error = err.Error()
+	}
+
+	r.passRunning()
+
+	for _, check := range blockValidationChecks[min(len(r.Checks), len(blockValidationChecks)):] {
+		r.Checks = append(r.Checks, BlockValidationCheckResult{Check: check, Status: BlockValidationCheckNotRun})
+	}
+
+	r.Valid = err == nil
+
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	r.Duration = time.Since(r.startTime).String()
+}
+
+func (r *BlockValidationReport) passRunning() {
+	if n := len(r.Checks); n > 0 && r.Checks[n-1].Status == blockValidationCheckRunning {
+		r.Checks[n-1].Status = BlockValidationCheckPassed
+	}
+}
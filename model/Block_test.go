@@ -8,8 +8,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math"
 	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +20,8 @@ import (
 	"github.com/bitcoin-sv/teranode/pkg/fileformat"
 	"github.com/bitcoin-sv/teranode/services/legacy/bsvutil"
 	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/stores/blob/checksum"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
 	"github.com/bitcoin-sv/teranode/stores/blob/null"
 	"github.com/bitcoin-sv/teranode/stores/blob/options"
 	"github.com/bitcoin-sv/teranode/stores/utxo"
@@ -26,6 +31,7 @@ import (
 	"github.com/bitcoin-sv/teranode/util"
 	"github.com/bitcoin-sv/teranode/util/test"
 	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/bsv-blockchain/go-chaincfg"
 	subtreepkg "github.com/bsv-blockchain/go-subtree"
@@ -33,6 +39,7 @@ import (
 	"github.com/bsv-blockchain/go-wire"
 	"github.com/greatroar/blobloom"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -227,7 +234,7 @@ func TestZeroCoverageFunctions(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 		defer cancel()
 
-		err := block.checkTxInRecentBlocks(ctx, deps, validationCtx, subtreeNode, txHash, 0, 0)
+		err := block.checkTxInRecentBlocks(ctx, deps, validationCtx, subtreeNode, txHash, 0, 0, nil)
 		assert.NoError(t, err)
 	})
 
@@ -281,7 +288,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		bloomStats := NewBloomStats()
 
 		// This should hit many validation paths
-		valid, err := block.Valid(ctx, logger, subtreeStore, txMetaStore, oldBlockIDsMap, recentBlocksBloomFilters, currentChain, currentBlockHeaderIDs, bloomStats, settings)
+		valid, err := block.Valid(ctx, logger, subtreeStore, txMetaStore, oldBlockIDsMap, recentBlocksBloomFilters, currentChain, currentBlockHeaderIDs, bloomStats, false, settings)
 		// May pass or fail, but we're testing coverage
 		_ = valid
 		_ = err
@@ -313,7 +320,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		logger := ulogger.TestLogger{}
 
 		// This should fail validation (may hit difficulty or timestamp validation)
-		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		assert.False(t, valid)
 		assert.Error(t, err) // Just verify it fails - the specific error depends on validation order
 	})
@@ -337,7 +344,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		logger := ulogger.TestLogger{}
 
 		// This should hit the nil coinbase validation path
-		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		assert.False(t, valid)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "no coinbase tx")
@@ -370,7 +377,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		logger := ulogger.TestLogger{}
 
 		// This should hit the median timestamp validation path
-		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, currentChain, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, currentChain, []uint32{}, NewBloomStats(), false, tSettings)
 		// May pass or fail, but we're testing the median timestamp code path
 		_ = valid
 		_ = err
@@ -395,7 +402,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		logger := ulogger.TestLogger{}
 
 		// This should hit the coinbase height validation path
-		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		// Will likely fail due to height mismatch, but we're testing the code path
 		_ = valid
 		_ = err
@@ -423,7 +430,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		subtreeStore := &mockSubtreeStore{shouldError: true} // Empty store
 
 		// This should hit the subtree validation path
-		valid, err := block.Valid(ctx, logger, subtreeStore, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, subtreeStore, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		// Will likely fail due to missing subtree, but we're testing the code path
 		_ = valid
 		_ = err
@@ -446,7 +453,7 @@ func TestBlock_Valid_ComprehensiveCoverage(t *testing.T) {
 		logger := ulogger.TestLogger{}
 
 		// This should skip median timestamp validation due to empty chain
-		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+		valid, err := block.Valid(ctx, logger, nil, createTestUTXOStore(t), txmap.NewSyncedMap[chainhash.Hash, []uint32](), []*BlockBloomFilter{}, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		// Should hit the empty chain path
 		_ = valid
 		_ = err
@@ -855,6 +862,75 @@ func TestBlock_NewBlockFromMsgBlock_ComprehensiveCoverage(t *testing.T) {
 		assert.Equal(t, uint32(2), block.Header.Version)
 	})
 
+	t.Run("successful conversion with multiple subtrees", func(t *testing.T) {
+		msgBlock := &wire.MsgBlock{
+			Header: wire.BlockHeader{
+				Version:   1,
+				Timestamp: time.Unix(1640995200, 0),
+				Bits:      0x1d00ffff,
+			},
+		}
+
+		for i := 0; i < 7; i++ {
+			msgBlock.Transactions = append(msgBlock.Transactions, &wire.MsgTx{
+				Version: 1,
+				TxIn: []*wire.TxIn{{
+					PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+					SignatureScript:  []byte{0x51},
+					Sequence:         0xffffffff,
+				}},
+				TxOut: []*wire.TxOut{{
+					Value:    int64(1000 + i),
+					PkScript: []byte{0x51},
+				}},
+			})
+		}
+
+		// calculate the merkle root over all transactions in a single tree
+		merkleTree, err := subtreepkg.NewIncompleteTreeByLeafCount(len(msgBlock.Transactions))
+		require.NoError(t, err)
+		require.NoError(t, merkleTree.AddCoinbaseNode())
+
+		for _, tx := range msgBlock.Transactions[1:] {
+			require.NoError(t, merkleTree.AddNode(tx.TxHash(), 0, uint64(tx.SerializeSize()))) // nolint: gosec
+		}
+
+		coinbaseHash := msgBlock.Transactions[0].TxHash()
+		merkleRoot, err := merkleTree.RootHashWithReplaceRootNode(&coinbaseHash, 0, uint64(msgBlock.Transactions[0].SerializeSize())) // nolint: gosec
+		require.NoError(t, err)
+
+		msgBlock.Header.MerkleRoot = *merkleRoot
+
+		tSettings := settings.NewSettings()
+		tSettings.BlockAssembly.InitialMerkleItemsPerSubtree = 4
+
+		block, err := NewBlockFromMsgBlock(msgBlock, tSettings)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), block.TransactionCount)
+		require.Len(t, block.Subtrees, 2)
+
+		subtreeSlices, _ := block.SubtreeSlicesSnapshot()
+		require.Len(t, subtreeSlices, 2)
+		assert.True(t, subtreeSlices[0].Nodes[0].Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue))
+		assert.Equal(t, msgBlock.Transactions[4].TxHash(), subtreeSlices[1].Nodes[0].Hash)
+		assert.Equal(t, 3, subtreeSlices[1].Length())
+		require.NoError(t, block.CheckMerkleRoot(context.Background()))
+
+		// a last subtree that would be shallower than the others falls back to a single subtree
+		tSettings.BlockAssembly.InitialMerkleItemsPerSubtree = 2
+
+		block, err = NewBlockFromMsgBlock(msgBlock, tSettings)
+		require.NoError(t, err)
+		require.Len(t, block.Subtrees, 1)
+		require.NoError(t, block.CheckMerkleRoot(context.Background()))
+
+		// without settings the subtree size is derived from the number of transactions
+		block, err = NewBlockFromMsgBlock(msgBlock, nil)
+		require.NoError(t, err)
+		require.Len(t, block.Subtrees, 2)
+		require.NoError(t, block.CheckMerkleRoot(context.Background()))
+	})
+
 	t.Run("edge cases and boundary values", func(t *testing.T) {
 		// Test with minimum valid values
 		msgBlock := &wire.MsgBlock{
@@ -1069,6 +1145,12 @@ func TestBlock_CheckMerkleRoot_ComprehensiveCoverage(t *testing.T) {
 		err = block.CheckMerkleRoot(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "merkle root does not match")
+		assert.Contains(t, err.Error(), "expected "+wrongHash.String())
+		assert.Contains(t, err.Error(), "computed "+coinbase.TxIDChainHash().String())
+
+		computed, err := block.ComputeMerkleRoot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, coinbase.TxIDChainHash(), computed)
 	})
 
 	t.Run("first subtree root hash replacement error handling", func(t *testing.T) {
@@ -1425,7 +1507,7 @@ func TestBlock_ValidWithOneTransaction(t *testing.T) {
 
 	currentChain[0].HashPrevBlock = &chainhash.Hash{}
 	oldBlockIDs := txmap.NewSyncedMap[chainhash.Hash, []uint32]()
-	v, err := b.Valid(context.Background(), ulogger.TestLogger{}, subtreeStore, utxoStore, oldBlockIDs, nil, currentChain, currentChainIDs, NewBloomStats(), settings)
+	v, err := b.Valid(context.Background(), ulogger.TestLogger{}, subtreeStore, utxoStore, oldBlockIDs, nil, currentChain, currentChainIDs, NewBloomStats(), false, settings)
 	require.NoError(t, err)
 	require.True(t, v)
 
@@ -1433,6 +1515,152 @@ func TestBlock_ValidWithOneTransaction(t *testing.T) {
 	require.False(t, hasTransactionsReferencingOldBlocks)
 }
 
+func TestGetAndValidateSubtrees_CorruptSubtree(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+	require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1, 1))
+
+	subtreeBytes, err := subtree.Serialize()
+	require.NoError(t, err)
+
+	underlying := memory.New()
+	subtreeStore := checksum.New(ulogger.TestLogger{}, underlying)
+	require.NoError(t, subtreeStore.Set(t.Context(), subtree.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+	// flip a bit in the last node hash, the subtree still deserializes fine
+	corrupted := bytes.Clone(subtreeBytes)
+	corrupted[len(corrupted)-1] ^= 0x01
+	require.NoError(t, underlying.Set(t.Context(), subtree.RootHash()[:], fileformat.FileTypeSubtree, corrupted, options.WithAllowOverwrite(true)))
+
+	block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 0, 0)
+	require.NoError(t, err)
+
+	err = block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, subtreeStore, 1, RetryPolicy{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errors.ErrSubtreeCorrupt))
+}
+
+func TestBlock_checkMaxBlockSize(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+	require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1, 250))
+
+	subtreeBytes, err := subtree.Serialize()
+	require.NoError(t, err)
+
+	subtreeStore := memory.New()
+	require.NoError(t, subtreeStore.Set(t.Context(), subtree.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+	// the block reports a size that is much smaller than the size of its transactions
+	block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 1, 0, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, subtreeStore, 1, RetryPolicy{}))
+
+	blockSize := block.SizeInBytes
+	require.Greater(t, blockSize, uint64(250))
+
+	t.Run("block just under the maximum", func(t *testing.T) {
+		require.NoError(t, block.checkMaxBlockSize(blockSize+1))
+	})
+
+	t.Run("block at the maximum", func(t *testing.T) {
+		require.NoError(t, block.checkMaxBlockSize(blockSize))
+	})
+
+	t.Run("block just over the maximum", func(t *testing.T) {
+		err := block.checkMaxBlockSize(blockSize - 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+}
+
+func TestGetAndValidateSubtrees_SubtreeLengths(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	// newBlock stores subtrees with the given lengths and returns a block referencing them
+	newBlock := func(t *testing.T, lengths ...int) (*Block, *memory.Memory) {
+		subtreeStore := memory.New()
+		subtreeHashes := make([]*chainhash.Hash, 0, len(lengths))
+
+		for sIdx, length := range lengths {
+			subtree, err := subtreepkg.NewTreeByLeafCount(subtreepkg.CeilPowerOfTwo(length))
+			require.NoError(t, err)
+
+			for i := 0; i < length; i++ {
+				if sIdx == 0 && i == 0 {
+					require.NoError(t, subtree.AddCoinbaseNode())
+					continue
+				}
+
+				require.NoError(t, subtree.AddNode(chainhash.HashH([]byte(fmt.Sprintf("tx-%d-%d", sIdx, i))), 1, 1))
+			}
+
+			subtreeBytes, err := subtree.Serialize()
+			require.NoError(t, err)
+			require.NoError(t, subtreeStore.Set(t.Context(), subtree.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+			subtreeHashes = append(subtreeHashes, subtree.RootHash())
+		}
+
+		block, err := NewBlock(blockHeader, coinbase, subtreeHashes, 0, 123, 0, 0)
+		require.NoError(t, err)
+
+		return block, subtreeStore
+	}
+
+	tests := []struct {
+		name    string
+		lengths []int
+		valid   bool
+	}{
+		{name: "single partial subtree", lengths: []int{3}, valid: true},
+		{name: "single subtree with only the coinbase", lengths: []int{1}, valid: true},
+		{name: "full subtrees and a partial last subtree", lengths: []int{4, 4, 3}, valid: true},
+		{name: "full subtrees", lengths: []int{4, 4}, valid: true},
+		{name: "subtree length not a power of two", lengths: []int{3, 3, 2}},
+		{name: "subtree length below the minimum", lengths: []int{1, 1}},
+		{name: "subtrees of different lengths", lengths: []int{4, 2, 2}},
+		{name: "last subtree larger than the other subtrees", lengths: []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, subtreeStore := newBlock(t, tt.lengths...)
+
+			err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, subtreeStore, 1, RetryPolicy{})
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		})
+	}
+}
+
 func TestGetAndValidateSubtrees(t *testing.T) {
 	tSettings := test.CreateBaseTestSettings(t)
 	blockHeaderBytes, _ := hex.DecodeString(block1Header)
@@ -1454,7 +1682,7 @@ func TestGetAndValidateSubtrees(t *testing.T) {
 	require.NoError(t, err)
 
 	mockBlobStore, _ := New(ulogger.TestLogger{})
-	err = b.GetAndValidateSubtrees(context.Background(), ulogger.TestLogger{}, mockBlobStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = b.GetAndValidateSubtrees(context.Background(), ulogger.TestLogger{}, mockBlobStore, tSettings.Block.GetAndValidateSubtreesConcurrency, RetryPolicy{})
 	require.NoError(t, err)
 }
 
@@ -1800,6 +2028,102 @@ func TestBlock_ExtractCoinbaseHeight(t *testing.T) {
 	})
 }
 
+func TestBlock_GetCoinbaseTx(t *testing.T) {
+	coinbaseTx, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	b := &Block{CoinbaseTx: coinbaseTx}
+	assert.Same(t, coinbaseTx, b.GetCoinbaseTx())
+
+	b = &Block{}
+	assert.Nil(t, b.GetCoinbaseTx())
+}
+
+func TestBlock_CoinbaseMatureAtHeight(t *testing.T) {
+	// teratestnet params with a coinbase maturity of 10, as set by a network params file
+	teraTestNetParams := chaincfg.TeraTestNetParams
+	teraTestNetParams.CoinbaseMaturity = 10
+
+	t.Run("teratestnet", func(t *testing.T) {
+		b := &Block{Height: 100}
+
+		assert.False(t, b.CoinbaseMatureAtHeight(100, &teraTestNetParams))
+		assert.False(t, b.CoinbaseMatureAtHeight(109, &teraTestNetParams))
+		assert.True(t, b.CoinbaseMatureAtHeight(110, &teraTestNetParams))
+		assert.True(t, b.CoinbaseMatureAtHeight(111, &teraTestNetParams))
+	})
+
+	t.Run("mainnet", func(t *testing.T) {
+		b := &Block{Height: 1000}
+
+		assert.False(t, b.CoinbaseMatureAtHeight(1099, &chaincfg.MainNetParams))
+		assert.True(t, b.CoinbaseMatureAtHeight(1100, &chaincfg.MainNetParams))
+	})
+
+	t.Run("current height below block height", func(t *testing.T) {
+		b := &Block{Height: 100}
+
+		assert.False(t, b.CoinbaseMatureAtHeight(50, &teraTestNetParams))
+	})
+
+	t.Run("no overflow near the maximum height", func(t *testing.T) {
+		b := &Block{Height: math.MaxUint32 - 5}
+
+		assert.False(t, b.CoinbaseMatureAtHeight(math.MaxUint32, &teraTestNetParams))
+	})
+
+	t.Run("nil params", func(t *testing.T) {
+		b := &Block{Height: 100}
+
+		assert.False(t, b.CoinbaseMatureAtHeight(1000, nil))
+	})
+}
+
+func TestBlock_IsGenesisActive(t *testing.T) {
+	params := chaincfg.MainNetParams
+	params.GenesisActivationHeight = 100
+
+	assert.False(t, (&Block{Height: 99}).IsGenesisActive(&params))
+	assert.True(t, (&Block{Height: 100}).IsGenesisActive(&params))
+	assert.True(t, (&Block{Height: 101}).IsGenesisActive(&params))
+
+	assert.False(t, (&Block{Height: 620_537}).IsGenesisActive(&chaincfg.MainNetParams))
+	assert.True(t, (&Block{Height: 620_538}).IsGenesisActive(&chaincfg.MainNetParams))
+}
+
+func TestBlock_checkTxSizesBeforeGenesis(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	newBlock := func(t *testing.T, txSize uint64) *Block {
+		subtree, err := subtreepkg.NewTreeByLeafCount(2)
+		require.NoError(t, err)
+		require.NoError(t, subtree.AddCoinbaseNode())
+		require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx")), 1, txSize))
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 0, 0)
+		require.NoError(t, err)
+
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+
+		return block
+	}
+
+	t.Run("tx at the limit", func(t *testing.T) {
+		require.NoError(t, newBlock(t, MaxTxSizeBeforeGenesis).checkTxSizesBeforeGenesis())
+	})
+
+	t.Run("tx above the limit", func(t *testing.T) {
+		err := newBlock(t, MaxTxSizeBeforeGenesis+1).checkTxSizesBeforeGenesis()
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+}
+
 func TestBlock_SubTreesFromBytes(t *testing.T) {
 	t.Run("valid subtrees bytes", func(t *testing.T) {
 		hash1, _ := chainhash.NewHashFromStr("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206")
@@ -1902,7 +2226,7 @@ func TestBlock_NewOptimizedBloomFilter(t *testing.T) {
 		mockSubtreeStore := &mockSubtreeStore{shouldError: true}
 
 		// This will fail because the subtree doesn't exist in the store
-		err = block.GetAndValidateSubtrees(ctx, logger, mockSubtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+		err = block.GetAndValidateSubtrees(ctx, logger, mockSubtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, RetryPolicy{})
 		require.Error(t, err)
 		// With timeout, we expect context deadline exceeded or mock error
 		assert.True(t, err != nil)
@@ -1922,8 +2246,67 @@ func TestBlock_CheckBlockRewardAndFees(t *testing.T) {
 		require.NoError(t, err)
 
 		// Test the function exists and handles basic input
-		err = block.checkBlockRewardAndFees(&chaincfg.MainNetParams)
+		err = block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("subtree fees", func(t *testing.T) {
+		blockHeaderBytes, _ := hex.DecodeString(block1Header)
+		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+		require.NoError(t, err)
+
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
 		require.NoError(t, err)
+
+		// the coinbase of the block pays the subsidy of 50 BSV, the subtree a 200 byte transaction paying 1000 satoshis
+		newBlock := func(t *testing.T) (*Block, *subtreepkg.Subtree) {
+			subtree, err := subtreepkg.NewTreeByLeafCount(2)
+			require.NoError(t, err)
+			require.NoError(t, subtree.AddCoinbaseNode())
+			require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1000, 200))
+
+			block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 1, 0)
+			require.NoError(t, err)
+
+			block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+
+			return block, subtree
+		}
+
+		t.Run("consistent fees", func(t *testing.T) {
+			block, _ := newBlock(t)
+			require.NoError(t, block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0))
+			require.NoError(t, block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 5))
+		})
+
+		t.Run("fees inconsistent with the transactions", func(t *testing.T) {
+			block, subtree := newBlock(t)
+			subtree.Fees = 50 * 100_000_000
+
+			err := block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+			assert.Contains(t, err.Error(), "its 2 transactions pay 1000")
+		})
+
+		t.Run("fees over the maximum fee rate", func(t *testing.T) {
+			block, _ := newBlock(t)
+
+			err := block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 4)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+			assert.Contains(t, err.Error(), "exceed the maximum of 800 for 200 bytes")
+		})
+
+		t.Run("fees over the maximum of the block", func(t *testing.T) {
+			block, subtree := newBlock(t)
+			subtree.Nodes[1].Fee = maxBlockFees + 1
+			subtree.Fees = maxBlockFees + 1
+
+			err := block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		})
 	})
 }
 
@@ -2097,72 +2480,384 @@ func TestBlock_ValidOrderAndBlessed_ErrorCases(t *testing.T) {
 	})
 }
 
-func TestBlock_ValidOrderAndBlessed_WithSubtrees(t *testing.T) {
-	t.Run("with empty subtree slices", func(t *testing.T) {
-		tSettings := test.CreateBaseTestSettings(t)
-		blockHeaderBytes, _ := hex.DecodeString(block1Header)
-		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
-		require.NoError(t, err)
+func TestBlock_ValidOrderAndBlessed_CollectAllErrors(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
 
-		coinbase, err := bt.NewTxFromString(CoinbaseHex)
-		require.NoError(t, err)
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
 
-		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	// 3 transactions spending the same output, the second and third have duplicate inputs
+	subtree, err := subtreepkg.NewTreeByLeafCount(4)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+
+	txHashes := []chainhash.Hash{chainhash.HashH([]byte("tx1")), chainhash.HashH([]byte("tx2")), chainhash.HashH([]byte("tx3"))}
+	for _, txHash := range txHashes {
+		require.NoError(t, subtree.AddNode(txHash, 1, 100))
+	}
+
+	parentHash := chainhash.HashH([]byte("parent"))
+
+	subtreeMeta := subtreepkg.NewSubtreeMeta(subtree)
+	subtreeMeta.TxInpoints[0] = subtreepkg.NewTxInpoints()
+
+	for i := 1; i < subtree.Length(); i++ {
+		txInpoints := subtreepkg.NewTxInpoints()
+		txInpoints.ParentTxHashes = []chainhash.Hash{parentHash}
+		txInpoints.Idxs = [][]uint32{{0}}
+		subtreeMeta.TxInpoints[i] = txInpoints
+	}
+
+	subtreeMetaBytes, err := subtreeMeta.Serialize()
+	require.NoError(t, err)
+
+	validate := func(t *testing.T, collectAllErrors bool) error {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 4, 123, 0, 0)
 		require.NoError(t, err)
 
-		// Initialize txMap but leave SubtreeSlices empty
+		block.SubtreeSlices = []*subtreepkg.Subtree{subtree}
 		block.txMap = txmap.NewSplitSwissMapUint64(10)
-		block.SubtreeSlices = []*subtreepkg.Subtree{}
 
-		ctx := context.Background()
-		logger := ulogger.TestLogger{}
+		for i, txHash := range txHashes {
+			require.NoError(t, block.txMap.Put(txHash, uint64(i+1)))
+		}
 
 		deps := &validationDependencies{
-			txMetaStore:              createTestUTXOStore(t),
-			subtreeStore:             &mockSubtreeStore{shouldError: true},
-			recentBlocksBloomFilters: []*BlockBloomFilter{},
-			currentChain:             []*BlockHeader{},
-			currentBlockHeaderIDs:    []uint32{},
-			bloomStats:               NewBloomStats(),
-			oldBlockIDsMap:           txmap.NewSyncedMap[chainhash.Hash, []uint32](),
+			txMetaStore:      createTestUTXOStore(t),
+			subtreeStore:     &mockSubtreeStore{data: map[string][]byte{string(subtree.RootHash()[:]): subtreeMetaBytes}},
+			bloomStats:       NewBloomStats(),
+			oldBlockIDsMap:   txmap.NewSyncedMap[chainhash.Hash, []uint32](),
+			collectAllErrors: collectAllErrors,
 		}
 
-		err = block.validOrderAndBlessed(ctx, logger, deps, tSettings.Block.ValidOrderAndBlessedConcurrency)
-		require.NoError(t, err) // Should succeed with empty subtrees
+		return block.validOrderAndBlessed(context.Background(), ulogger.TestLogger{}, deps, tSettings.Block.ValidOrderAndBlessedConcurrency)
+	}
+
+	t.Run("fail fast", func(t *testing.T) {
+		err := validate(t, false)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.Equal(t, 1, strings.Count(err.Error(), "has duplicate inputs"))
 	})
-}
 
-func TestBlock_Bytes_ErrorCases(t *testing.T) {
-	t.Run("nil header", func(t *testing.T) {
-		coinbase, err := bt.NewTxFromString(CoinbaseHex)
-		require.NoError(t, err)
+	t.Run("collect all errors", func(t *testing.T) {
+		err := validate(t, true)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.Contains(t, err.Error(), "2 invalid transactions")
+		assert.Contains(t, err.Error(), "transaction "+txHashes[1].String()+" has duplicate inputs")
+		assert.Contains(t, err.Error(), "transaction "+txHashes[2].String()+" has duplicate inputs")
+	})
 
-		block := &Block{
-			Header:           nil, // nil header should cause error
-			CoinbaseTx:       coinbase,
-			TransactionCount: 1,
-			SizeInBytes:      123,
-		}
+	t.Run("non block invalid errors are not reported as invalid block", func(t *testing.T) {
+		errs := &validationErrors{}
+		errs.add(errors.NewBlockInvalidError("invalid tx"))
+		errs.add(errors.NewStorageError("storage failure"))
 
-		_, err = block.Bytes()
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+		require.NoError(t, err)
+
+		err = errs.err(block)
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "block has no header")
+		assert.False(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+		assert.Contains(t, err.Error(), "invalid tx")
+
+		var noErrs *validationErrors
+		assert.NoError(t, noErrs.err(block))
 	})
 }
 
-func TestBlock_CheckMerkleRoot_MoreCases(t *testing.T) {
-	t.Run("mismatched subtrees and slices", func(t *testing.T) {
-		blockHeaderBytes, _ := hex.DecodeString(block1Header)
-		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
-		require.NoError(t, err)
+func TestBlock_ValidOrderAndBlessed_ParentCheckConcurrency(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
 
-		coinbase, err := bt.NewTxFromString(CoinbaseHex)
-		require.NoError(t, err)
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
 
-		hash1, _ := chainhash.NewHashFromStr("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206")
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
 
-		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{hash1}, 1, 123, 0, 0)
-		require.NoError(t, err)
+	// 2 subtrees with 3 transactions, each spending a parent that is not in the block
+	subtree0, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree0.AddCoinbaseNode())
+
+	subtree1, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+
+	txHashes := []chainhash.Hash{chainhash.HashH([]byte("tx1")), chainhash.HashH([]byte("tx2")), chainhash.HashH([]byte("tx3"))}
+	require.NoError(t, subtree0.AddNode(txHashes[0], 1, 100))
+	require.NoError(t, subtree1.AddNode(txHashes[1], 1, 100))
+	require.NoError(t, subtree1.AddNode(txHashes[2], 1, 100))
+
+	parentHashes := []chainhash.Hash{chainhash.HashH([]byte("parent1")), chainhash.HashH([]byte("parent2")), chainhash.HashH([]byte("parent3"))}
+
+	subtreeMeta0 := subtreepkg.NewSubtreeMeta(subtree0)
+	subtreeMeta0.TxInpoints[0] = subtreepkg.NewTxInpoints()
+	subtreeMeta0.TxInpoints[1] = subtreepkg.TxInpoints{ParentTxHashes: []chainhash.Hash{parentHashes[0]}, Idxs: [][]uint32{{0}}}
+
+	subtreeMeta1 := subtreepkg.NewSubtreeMeta(subtree1)
+	subtreeMeta1.TxInpoints[0] = subtreepkg.TxInpoints{ParentTxHashes: []chainhash.Hash{parentHashes[1]}, Idxs: [][]uint32{{0}}}
+	subtreeMeta1.TxInpoints[1] = subtreepkg.TxInpoints{ParentTxHashes: []chainhash.Hash{parentHashes[2]}, Idxs: [][]uint32{{0}}}
+
+	subtreeMeta0Bytes, err := subtreeMeta0.Serialize()
+	require.NoError(t, err)
+
+	subtreeMeta1Bytes, err := subtreeMeta1.Serialize()
+	require.NoError(t, err)
+
+	validate := func(t *testing.T, txMetaStore utxo.Store, parentCheckConcurrency int) error {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree0.RootHash(), subtree1.RootHash()}, 4, 123, 0, 0)
+		require.NoError(t, err)
+
+		block.SubtreeSlices = []*subtreepkg.Subtree{subtree0, subtree1}
+		block.txMap = txmap.NewSplitSwissMapUint64(10)
+
+		for i, txHash := range txHashes {
+			require.NoError(t, block.txMap.Put(txHash, uint64(i+1)))
+		}
+
+		deps := &validationDependencies{
+			txMetaStore: txMetaStore,
+			subtreeStore: &mockSubtreeStore{data: map[string][]byte{
+				string(subtree0.RootHash()[:]): subtreeMeta0Bytes,
+				string(subtree1.RootHash()[:]): subtreeMeta1Bytes,
+			}},
+			currentBlockHeaderIDs:  []uint32{1},
+			bloomStats:             NewBloomStats(),
+			oldBlockIDsMap:         txmap.NewSyncedMap[chainhash.Hash, []uint32](),
+			parentCheckConcurrency: parentCheckConcurrency,
+		}
+
+		return block.validOrderAndBlessed(context.Background(), ulogger.TestLogger{}, deps, tSettings.Block.ValidOrderAndBlessedConcurrency)
+	}
+
+	t.Run("parents are checked with a single shared worker", func(t *testing.T) {
+		require.NoError(t, validate(t, createTestUTXOStore(t), 1))
+	})
+
+	t.Run("invalid parent is reported with a single shared worker", func(t *testing.T) {
+		txMetaStore := createTestUTXOStore(t)
+
+		// the parent of the last transaction is in the store, but has not been mined
+		parentTx := bt.NewTx()
+		require.NoError(t, parentTx.From(chainhash.HashH([]byte("grandparent")).String(), 0, "76a914eb0bd5edba389198e73f8efabddfc61666969ff788ac", 2000))
+		require.NoError(t, parentTx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", 1000))
+		parentTx.Inputs[0].UnlockingScript = &bscript.Script{}
+
+		_, err := txMetaStore.Create(context.Background(), parentTx, 1)
+		require.NoError(t, err)
+
+		subtreeMeta1.TxInpoints[1] = subtreepkg.TxInpoints{ParentTxHashes: []chainhash.Hash{*parentTx.TxIDChainHash()}, Idxs: [][]uint32{{0}}}
+		subtreeMeta1Bytes, err = subtreeMeta1.Serialize()
+		require.NoError(t, err)
+
+		err = validate(t, txMetaStore, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.Contains(t, err.Error(), "has no block IDs")
+	})
+
+	t.Run("default concurrency", func(t *testing.T) {
+		assert.Equal(t, defaultParentCheckConcurrency, getParentCheckConcurrency(0))
+		assert.Equal(t, defaultParentCheckConcurrency, getParentCheckConcurrency(-1))
+		assert.Equal(t, 16, getParentCheckConcurrency(16))
+	})
+}
+
+func TestBlock_ValidOrderAndBlessed_SkipRecentBlocksCheck(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	parentHash := chainhash.HashH([]byte("parent"))
+
+	// transaction that has already been mined in block 1 of the current chain
+	tx := bt.NewTx()
+	require.NoError(t, tx.From(parentHash.String(), 0, "76a914eb0bd5edba389198e73f8efabddfc61666969ff788ac", 2000))
+	require.NoError(t, tx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", 1000))
+	tx.Inputs[0].UnlockingScript = &bscript.Script{}
+
+	txMetaStore := createTestUTXOStore(t)
+	_, err = txMetaStore.Create(context.Background(), tx, 1, utxo.WithMinedBlockInfo(utxo.MinedBlockInfo{BlockID: 1, BlockHeight: 1}))
+	require.NoError(t, err)
+
+	subtree, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+	require.NoError(t, subtree.AddNode(*tx.TxIDChainHash(), 1, 100))
+
+	subtreeMeta := subtreepkg.NewSubtreeMeta(subtree)
+	subtreeMeta.TxInpoints[0] = subtreepkg.NewTxInpoints()
+	subtreeMeta.TxInpoints[1] = subtreepkg.TxInpoints{ParentTxHashes: []chainhash.Hash{parentHash}, Idxs: [][]uint32{{0}}}
+
+	subtreeMetaBytes, err := subtreeMeta.Serialize()
+	require.NoError(t, err)
+
+	bloomFilter := &BlockBloomFilter{
+		BlockHash: blockHeader.Hash(),
+		Filter:    blobloom.NewOptimized(blobloom.Config{Capacity: 1000, FPRate: 0.01}),
+	}
+	bloomFilter.Filter.Add(binary.BigEndian.Uint64(tx.TxIDChainHash()[:]))
+
+	validate := func(t *testing.T, skipRecentBlocksCheck bool) error {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 0, 0)
+		require.NoError(t, err)
+
+		block.SubtreeSlices = []*subtreepkg.Subtree{subtree}
+		block.txMap = txmap.NewSplitSwissMapUint64(10)
+		require.NoError(t, block.txMap.Put(*tx.TxIDChainHash(), 1))
+
+		deps := &validationDependencies{
+			txMetaStore:              txMetaStore,
+			subtreeStore:             &mockSubtreeStore{data: map[string][]byte{string(subtree.RootHash()[:]): subtreeMetaBytes}},
+			recentBlocksBloomFilters: []*BlockBloomFilter{bloomFilter},
+			currentChain:             []*BlockHeader{blockHeader},
+			currentBlockHeaderIDs:    []uint32{1},
+			bloomStats:               NewBloomStats(),
+			oldBlockIDsMap:           txmap.NewSyncedMap[chainhash.Hash, []uint32](),
+			skipRecentBlocksCheck:    skipRecentBlocksCheck,
+		}
+
+		return block.validOrderAndBlessed(context.Background(), ulogger.TestLogger{}, deps, tSettings.Block.ValidOrderAndBlessedConcurrency)
+	}
+
+	t.Run("already mined transaction is rejected", func(t *testing.T) {
+		err := validate(t, false)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.Contains(t, err.Error(), "has already been mined in block 1")
+	})
+
+	t.Run("recent blocks check is skipped", func(t *testing.T) {
+		require.NoError(t, validate(t, true))
+	})
+}
+
+func TestBlock_ValidOrderAndBlessed_WithSubtrees(t *testing.T) {
+	t.Run("with empty subtree slices", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		blockHeaderBytes, _ := hex.DecodeString(block1Header)
+		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+		require.NoError(t, err)
+
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
+		require.NoError(t, err)
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+		require.NoError(t, err)
+
+		// Initialize txMap but leave SubtreeSlices empty
+		block.txMap = txmap.NewSplitSwissMapUint64(10)
+		block.SubtreeSlices = []*subtreepkg.Subtree{}
+
+		ctx := context.Background()
+		logger := ulogger.TestLogger{}
+
+		deps := &validationDependencies{
+			txMetaStore:              createTestUTXOStore(t),
+			subtreeStore:             &mockSubtreeStore{shouldError: true},
+			recentBlocksBloomFilters: []*BlockBloomFilter{},
+			currentChain:             []*BlockHeader{},
+			currentBlockHeaderIDs:    []uint32{},
+			bloomStats:               NewBloomStats(),
+			oldBlockIDsMap:           txmap.NewSyncedMap[chainhash.Hash, []uint32](),
+		}
+
+		err = block.validOrderAndBlessed(ctx, logger, deps, tSettings.Block.ValidOrderAndBlessedConcurrency)
+		require.NoError(t, err) // Should succeed with empty subtrees
+	})
+}
+
+func TestBlock_Bytes_ErrorCases(t *testing.T) {
+	t.Run("nil header", func(t *testing.T) {
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
+		require.NoError(t, err)
+
+		block := &Block{
+			Header:           nil, // nil header should cause error
+			CoinbaseTx:       coinbase,
+			TransactionCount: 1,
+			SizeInBytes:      123,
+		}
+
+		_, err = block.Bytes()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "block has no header")
+	})
+}
+
+func TestBlock_SerializeTo(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	hash1, _ := chainhash.NewHashFromStr("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206")
+	hash2, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
+
+	block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{hash1, hash2}, 1_000_000, 400_000_000, 800000, 0)
+	require.NoError(t, err)
+
+	t.Run("output matches the serialization format", func(t *testing.T) {
+		subtreeBytes, err := block.SubTreeBytes()
+		require.NoError(t, err)
+
+		expected := bytes.NewBuffer(blockHeader.Bytes())
+		require.NoError(t, wire.WriteVarInt(expected, 0, block.TransactionCount))
+		require.NoError(t, wire.WriteVarInt(expected, 0, block.SizeInBytes))
+		expected.Write(subtreeBytes)
+		expected.Write(coinbase.Bytes())
+		require.NoError(t, wire.WriteVarInt(expected, 0, uint64(block.Height)))
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, block.SerializeTo(buf))
+		assert.Equal(t, expected.Bytes(), buf.Bytes())
+
+		blockBytes, err := block.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, expected.Bytes(), blockBytes)
+	})
+
+	t.Run("nil header", func(t *testing.T) {
+		err := (&Block{CoinbaseTx: coinbase}).SerializeTo(&bytes.Buffer{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "block has no header")
+	})
+
+	t.Run("writer error", func(t *testing.T) {
+		_, w := io.Pipe()
+		require.NoError(t, w.Close())
+
+		require.Error(t, block.SerializeTo(w))
+	})
+}
+
+func TestBlock_CheckMerkleRoot_MoreCases(t *testing.T) {
+	t.Run("mismatched subtrees and slices", func(t *testing.T) {
+		blockHeaderBytes, _ := hex.DecodeString(block1Header)
+		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+		require.NoError(t, err)
+
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
+		require.NoError(t, err)
+
+		hash1, _ := chainhash.NewHashFromStr("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206")
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{hash1}, 1, 123, 0, 0)
+		require.NoError(t, err)
 
 		// Mismatch: 1 subtree hash but 0 subtree slices
 		block.SubtreeSlices = []*subtreepkg.Subtree{}
@@ -2246,7 +2941,7 @@ func TestBlock_CheckRewardAndFees_WithHeight(t *testing.T) {
 
 		// Test with a height that triggers the reward calculation logic
 		// This should error because coinbase output is too high
-		err = block.checkBlockRewardAndFees(&chaincfg.MainNetParams)
+		err = block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "coinbase output")
 	})
@@ -2370,7 +3065,7 @@ func TestBlock_Valid_MoreCoverage(t *testing.T) {
 
 		// Call with txMetaStore to trigger validOrderAndBlessed path
 		valid, err := block.Valid(ctx, logger, mockBlobStore, txMetaStore, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 
 		// This might error due to missing subtrees, but we're testing the path
 		_ = valid
@@ -2457,9 +3152,9 @@ func TestBlock_CoverageBoost(t *testing.T) {
 		hashMap := block.buildBlockHeaderHashesMap(headers)
 		assert.Len(t, hashMap, 1)
 
-		// Test buildBlockHeaderIDsMap
+		// Test NewBlockIDSet
 		ids := []uint32{1, 2, 3}
-		idMap := block.buildBlockHeaderIDsMap(ids)
+		idMap := NewBlockIDSet(ids)
 		assert.Len(t, idMap, 3)
 	})
 
@@ -2573,7 +3268,7 @@ func TestTargetedCoverageIncrease(t *testing.T) {
 		subtreeNode := subtreepkg.SubtreeNode{Hash: *hash1}
 
 		// Should succeed with empty bloom filters
-		err = block.checkTxInRecentBlocks(ctx, deps, validationCtx, subtreeNode, hash1, 0, 0)
+		err = block.checkTxInRecentBlocks(ctx, deps, validationCtx, subtreeNode, hash1, 0, 0, nil)
 		require.NoError(t, err)
 	})
 
@@ -2644,7 +3339,7 @@ func TestTargetedCoverageIncrease(t *testing.T) {
 
 		// Test with nil subtreeStore to skip the subtree check
 		valid, err := block.Valid(ctx, logger, nil, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 
 		// Should succeed because we're skipping most validation
 		require.NoError(t, err)
@@ -2652,7 +3347,7 @@ func TestTargetedCoverageIncrease(t *testing.T) {
 
 		// Test with subtreeStore but no txMetaStore to test different paths
 		valid, err = block.Valid(ctx, logger, mockSubtreeStore, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 
 		// This will error due to missing subtrees but tests the path
 		_ = valid
@@ -2660,7 +3355,7 @@ func TestTargetedCoverageIncrease(t *testing.T) {
 
 		// Test with txMetaStore to trigger validOrderAndBlessed
 		valid, err = block.Valid(ctx, logger, nil, txMetaStore, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 
 		_ = valid
 		_ = err
@@ -2795,14 +3490,14 @@ func TestAdditionalCoverageFunctions(t *testing.T) {
 		// Test with only subtreeStore
 		mockSubtreeStore := &mockSubtreeStore{shouldError: true}
 		_, err = block.Valid(ctx, logger, mockSubtreeStore, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		// Will error but exercises the subtree validation path
 		_ = err
 
 		// Test checkBlockRewardAndFees path with height > 0
 		block.Height = 100
 		_, err = block.Valid(ctx, logger, nil, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		// Will error but exercises checkBlockRewardAndFees path
 		_ = err
 	})
@@ -2971,7 +3666,7 @@ func TestMaximumCoverageBoost(t *testing.T) {
 
 		block1.SubtreeSlices = []*subtreepkg.Subtree{subtree}
 		_, err = block1.Valid(ctx, logger, nil, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		_ = err // Exercises checkBlockRewardAndFees path safely
 
 		// Test path 2: GetAndValidateSubtrees path
@@ -2983,7 +3678,7 @@ func TestMaximumCoverageBoost(t *testing.T) {
 		mockSubtreeStore := &mockSubtreeStore{shouldError: true}
 
 		_, err = block2.Valid(ctx, logger, mockSubtreeStore, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		_ = err // Exercises GetAndValidateSubtrees path
 
 		// Test path 3: validOrderAndBlessed path with txMetaStore
@@ -2991,7 +3686,7 @@ func TestMaximumCoverageBoost(t *testing.T) {
 		require.NoError(t, err)
 		txMetaStore := createTestUTXOStore(t)
 		_, err = block3.Valid(ctx, logger, nil, txMetaStore, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		_ = err // Exercises validOrderAndBlessed path
 
 		// Test path 4: CheckMerkleRoot path
@@ -3006,7 +3701,7 @@ func TestMaximumCoverageBoost(t *testing.T) {
 
 		block4.SubtreeSlices = []*subtreepkg.Subtree{subtree2}
 		_, err = block4.Valid(ctx, logger, nil, nil, oldBlockIDs,
-			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), tSettings)
+			nil, []*BlockHeader{}, []uint32{}, NewBloomStats(), false, tSettings)
 		_ = err // Exercises CheckMerkleRoot path
 	})
 
@@ -3434,7 +4129,7 @@ func TestBlock_CheckTxInRecentBlocks_ComprehensiveCoverage(t *testing.T) {
 		subtreeHash, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
 
 		// Test with no bloom filters
-		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0)
+		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0, nil)
 		assert.NoError(t, err) // Should pass with no filters
 	})
 
@@ -3486,7 +4181,7 @@ func TestBlock_CheckTxInRecentBlocks_ComprehensiveCoverage(t *testing.T) {
 		subtreeHash, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
 
 		// Test - should skip the bloom filter since it's not on current chain
-		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0)
+		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0, nil)
 		assert.NoError(t, err) // Should pass since filter not on chain
 	})
 
@@ -3540,7 +4235,7 @@ func TestBlock_CheckTxInRecentBlocks_ComprehensiveCoverage(t *testing.T) {
 		subtreeHash, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
 
 		// Test - should pass since bloom filter doesn't contain the hash
-		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0)
+		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0, nil)
 		assert.NoError(t, err) // Should pass since filter doesn't contain hash
 	})
 
@@ -3599,7 +4294,7 @@ func TestBlock_CheckTxInRecentBlocks_ComprehensiveCoverage(t *testing.T) {
 		subtreeHash, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
 
 		// Test - should pass since tx not found in store (false positive)
-		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0)
+		err = block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreeNode, subtreeHash, 0, 0, nil)
 		assert.NoError(t, err)                                 // Should pass - false positive
 		assert.Equal(t, uint64(1), bloomStats.PositiveCounter) // Should increment positive counter
 	})
@@ -4791,3 +5486,1099 @@ func TestBlock_MedianTimestampValidation(t *testing.T) {
 		assert.False(t, blockTime.After(*medianTimestamp), "block timestamp should not be after median")
 	})
 }
+
+func TestBlock_GetCounterConflictingTxs(t *testing.T) {
+	conflictingTx := chainhash.HashH([]byte("conflicting"))
+	conflictingParent := chainhash.HashH([]byte("conflicting parent"))
+	validParent := chainhash.HashH([]byte("valid parent"))
+	minedParent := chainhash.HashH([]byte("mined parent"))
+
+	block := &Block{
+		Header: &BlockHeader{
+			HashPrevBlock:  &chainhash.Hash{},
+			HashMerkleRoot: &chainhash.Hash{},
+		},
+		CoinbaseTx: newTx(0),
+		SubtreeSlices: []*subtreepkg.Subtree{
+			{ConflictingNodes: []chainhash.Hash{conflictingTx}},
+			{},
+		},
+	}
+
+	t.Run("collects conflicting txs and double spending parents", func(t *testing.T) {
+		mockStore := &utxo.MockUtxostore{}
+
+		mockStore.On("Get", mock.Anything, &conflictingTx, mock.Anything).Return(&meta.Data{
+			Conflicting: true,
+			TxInpoints: subtreepkg.TxInpoints{
+				ParentTxHashes: []chainhash.Hash{conflictingParent, validParent, minedParent},
+			},
+		}, nil)
+		mockStore.On("Get", mock.Anything, &conflictingParent, mock.Anything).Return(&meta.Data{Conflicting: true}, nil)
+		mockStore.On("Get", mock.Anything, &validParent, mock.Anything).Return(&meta.Data{}, nil)
+		mockStore.On("Get", mock.Anything, &minedParent, mock.Anything).Return(nil, errors.NewTxNotFoundError("not found"))
+
+		conflictingTxs, conflictingParents, err := block.GetCounterConflictingTxs(context.Background(), mockStore, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, []chainhash.Hash{conflictingTx}, conflictingTxs)
+		assert.Equal(t, []chainhash.Hash{conflictingParent}, conflictingParents)
+
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("store error is returned", func(t *testing.T) {
+		mockStore := &utxo.MockUtxostore{}
+		mockStore.On("Get", mock.Anything, &conflictingTx, mock.Anything).Return(nil, errors.NewStorageError("store down"))
+
+		_, _, err := block.GetCounterConflictingTxs(context.Background(), mockStore, 2)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		mockStore := &utxo.MockUtxostore{}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err := block.GetCounterConflictingTxs(ctx, mockStore, 2)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrContextCanceled))
+
+		mockStore.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestBlock_checkValueConservation(t *testing.T) {
+	newValueTx := func(inputSatoshis, outputSatoshis uint64, extended bool) *bt.Tx {
+		tx := newTx(0)
+		tx.Inputs[0].PreviousTxSatoshis = inputSatoshis
+
+		if extended {
+			tx.Inputs[0].PreviousTxScript = &bscript.Script{}
+		}
+
+		tx.Outputs = []*bt.Output{{Satoshis: outputSatoshis, LockingScript: &bscript.Script{}}}
+
+		return tx
+	}
+
+	newValueBlock := func(txs ...*bt.Tx) *Block {
+		nodes := []subtreepkg.SubtreeNode{{Hash: *subtreepkg.CoinbasePlaceholderHash}}
+		for _, tx := range txs {
+			nodes = append(nodes, subtreepkg.SubtreeNode{Hash: *tx.TxIDChainHash()})
+		}
+
+		return &Block{
+			Header: &BlockHeader{
+				HashPrevBlock:  &chainhash.Hash{},
+				HashMerkleRoot: &chainhash.Hash{},
+			},
+			SubtreeSlices: []*subtreepkg.Subtree{{Nodes: nodes}},
+		}
+	}
+
+	t.Run("extended transaction conserving value", func(t *testing.T) {
+		tx := newValueTx(1000, 900, true)
+		block := newValueBlock(tx)
+
+		mockStore := &utxo.MockUtxostore{}
+		mockStore.On("Get", mock.Anything, tx.TxIDChainHash(), mock.Anything).Return(&meta.Data{Tx: tx}, nil)
+
+		require.NoError(t, block.checkValueConservation(context.Background(), mockStore, 1, 1))
+
+		mockStore.AssertNotCalled(t, "PreviousOutputsDecorate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("transaction creating value", func(t *testing.T) {
+		tx := newValueTx(1000, 1001, true)
+		block := newValueBlock(tx)
+
+		mockStore := &utxo.MockUtxostore{}
+		mockStore.On("Get", mock.Anything, tx.TxIDChainHash(), mock.Anything).Return(&meta.Data{Tx: tx}, nil)
+
+		err := block.checkValueConservation(context.Background(), mockStore, 1, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+
+	t.Run("non-extended transaction is decorated", func(t *testing.T) {
+		tx := newValueTx(0, 1000, false)
+		block := newValueBlock(tx)
+
+		mockStore := &utxo.MockUtxostore{}
+		mockStore.On("Get", mock.Anything, tx.TxIDChainHash(), mock.Anything).Return(&meta.Data{Tx: tx}, nil)
+		mockStore.On("PreviousOutputsDecorate", mock.Anything, tx).Run(func(args mock.Arguments) {
+			args.Get(1).(*bt.Tx).Inputs[0].PreviousTxSatoshis = 1000
+		}).Return(nil)
+
+		require.NoError(t, block.checkValueConservation(context.Background(), mockStore, 1, 1))
+
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("sample rate of 0 checks nothing", func(t *testing.T) {
+		tx := newValueTx(1000, 1001, true)
+		block := newValueBlock(tx)
+
+		mockStore := &utxo.MockUtxostore{}
+
+		require.NoError(t, block.checkValueConservation(context.Background(), mockStore, 0, 1))
+
+		mockStore.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestBlock_Valid_EnforceMedianTimePast(t *testing.T) {
+	bits, _ := NewNBitFromString("207fffff")
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	mineHeader := func(prevHash *chainhash.Hash, timestamp uint32) *BlockHeader {
+		header := &BlockHeader{
+			Version:        1,
+			HashPrevBlock:  prevHash,
+			HashMerkleRoot: coinbase.TxIDChainHash(),
+			Timestamp:      timestamp,
+			Bits:           *bits,
+		}
+
+		for {
+			// an error is returned while the header does not meet the target
+			if ok, _, _ := header.HasMetTargetDifficulty(); ok {
+				return header
+			}
+
+			header.Nonce++
+		}
+	}
+
+	// mine 12 blocks, each with a timestamp 10 minutes before its parent
+	startTime := uint32(time.Now().Unix()) // nolint: gosec
+	headers := make([]*BlockHeader, 0, 12)
+	prevHash := &chainhash.Hash{}
+
+	for i := uint32(0); i < 12; i++ {
+		header := mineHeader(prevHash, startTime-i*600)
+		headers = append(headers, header)
+		prevHash = header.Hash()
+	}
+
+	currentChain := headers[:11]
+
+	block, err := NewBlock(headers[11], coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+	require.NoError(t, err)
+
+	t.Run("rejected when enforced", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.EnforceMedianTimePast = true
+
+		valid, err := block.Valid(context.Background(), ulogger.TestLogger{}, nil, nil, nil, nil, currentChain, nil, nil, false, tSettings)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+		assert.False(t, valid)
+	})
+
+	t.Run("accepted when not enforced", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.EnforceMedianTimePast = false
+
+		valid, err := block.Valid(context.Background(), ulogger.TestLogger{}, nil, nil, nil, nil, currentChain, nil, nil, false, tSettings)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
+
+func TestBlock_batchGetParentTxMeta(t *testing.T) {
+	block := &Block{
+		Header: &BlockHeader{
+			HashPrevBlock:  &chainhash.Hash{},
+			HashMerkleRoot: &chainhash.Hash{},
+		},
+	}
+
+	txHash := chainhash.HashH([]byte("tx"))
+	parent1 := chainhash.HashH([]byte("parent1"))
+	parent2 := chainhash.HashH([]byte("parent2"))
+	parent3 := chainhash.HashH([]byte("parent3"))
+
+	parentTxs := []missingParentTx{
+		{parentTxHash: parent1, txHash: txHash},
+		{parentTxHash: parent2, txHash: txHash},
+		{parentTxHash: parent1, txHash: txHash}, // duplicate parent is only fetched once
+		{parentTxHash: parent3, txHash: txHash},
+	}
+
+	t.Run("fetches unique parents in batches", func(t *testing.T) {
+		mockStore := &utxo.MockUtxostore{}
+		mockStore.On("BatchDecorate", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			for _, item := range args.Get(1).([]*utxo.UnresolvedMetaData) {
+				if item.Hash.Equal(parent3) {
+					item.Err = errors.NewTxNotFoundError("not found")
+					continue
+				}
+
+				item.Data = &meta.Data{BlockIDs: []uint32{1}}
+			}
+		}).Return(nil).Times(2)
+
+		deps := &validationDependencies{txMetaStore: mockStore, getMetaBatchSize: 2}
+
+		parentTxMetaMap, err := block.batchGetParentTxMeta(context.Background(), ulogger.TestLogger{}, deps, parentTxs, &chainhash.Hash{}, 0)
+		require.NoError(t, err)
+		require.Len(t, parentTxMetaMap, 3)
+
+		assert.Equal(t, []uint32{1}, parentTxMetaMap[parent1].Data.BlockIDs)
+		assert.Equal(t, []uint32{1}, parentTxMetaMap[parent2].Data.BlockIDs)
+		assert.True(t, errors.Is(parentTxMetaMap[parent3].Err, errors.ErrTxNotFound))
+
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("resolves parents with the same semantics as single lookups", func(t *testing.T) {
+		currentBlockHeaderIDsMap := map[uint32]struct{}{1: {}, 2: {}}
+
+		// not found is treated as an old parent
+		oldBlockIDs, err := block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0],
+			&utxo.UnresolvedMetaData{Hash: parent1, Err: errors.NewTxNotFoundError("not found")}, currentBlockHeaderIDsMap)
+		require.NoError(t, err)
+		assert.Empty(t, oldBlockIDs)
+
+		// no data without an error is treated as not found
+		oldBlockIDs, err = block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0],
+			&utxo.UnresolvedMetaData{Hash: parent1}, currentBlockHeaderIDsMap)
+		require.NoError(t, err)
+		assert.Empty(t, oldBlockIDs)
+
+		// found on the current chain
+		oldBlockIDs, err = block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0],
+			&utxo.UnresolvedMetaData{Hash: parent1, Data: &meta.Data{BlockIDs: []uint32{2}}}, currentBlockHeaderIDsMap)
+		require.NoError(t, err)
+		assert.Empty(t, oldBlockIDs)
+
+		// parent without block IDs is invalid
+		_, err = block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0],
+			&utxo.UnresolvedMetaData{Hash: parent1, Data: &meta.Data{}}, currentBlockHeaderIDsMap)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+
+		// storage errors are returned
+		_, err = block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0],
+			&utxo.UnresolvedMetaData{Hash: parent1, Err: errors.NewStorageError("store down")}, currentBlockHeaderIDsMap)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+
+		// parent that was not fetched
+		_, err = block.checkParentTxMetaOnChain(context.Background(), ulogger.TestLogger{}, nil, parentTxs[0], nil, currentBlockHeaderIDsMap)
+		require.Error(t, err)
+	})
+}
+
+func TestBlock_VerifyCoinbaseSubsidyExact(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	t.Run("coinbase pays exactly the subsidy", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 1, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, block.VerifyCoinbaseSubsidyExact(1, &chaincfg.MainNetParams))
+	})
+
+	t.Run("coinbase pays less than the subsidy plus fees", func(t *testing.T) {
+		subtree, err := subtreepkg.NewTreeByLeafCount(2)
+		require.NoError(t, err)
+		require.NoError(t, subtree.AddCoinbaseNode())
+		require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1000, 250))
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 1, 0)
+		require.NoError(t, err)
+
+		block.SubtreeSlices = []*subtreepkg.Subtree{subtree}
+
+		// the less strict reward check accepts the block
+		require.NoError(t, block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0))
+
+		err = block.VerifyCoinbaseSubsidyExact(1, &chaincfg.MainNetParams)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+
+	t.Run("coinbase pays more than the subsidy", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 800000, 0)
+		require.NoError(t, err)
+
+		require.Error(t, block.VerifyCoinbaseSubsidyExact(800000, &chaincfg.MainNetParams))
+	})
+
+	t.Run("height 0 is skipped", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 800000, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, block.VerifyCoinbaseSubsidyExact(0, &chaincfg.MainNetParams))
+	})
+
+	t.Run("subtrees not loaded", func(t *testing.T) {
+		subtreeHash, _ := chainhash.NewHashFromStr("9daba5e5c8ecdb80e811ef93558e960a6ffed0c481182bd47ac381547361ff25")
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtreeHash}, 1, 123, 1, 0)
+		require.NoError(t, err)
+
+		err = block.VerifyCoinbaseSubsidyExact(1, &chaincfg.MainNetParams)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrProcessing))
+	})
+}
+
+func TestBlock_checkTxInRecentBlocks_Metrics(t *testing.T) {
+	prevHash, _ := chainhash.NewHashFromStr("000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd")
+	merkleRoot, _ := chainhash.NewHashFromStr("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206")
+	bits, _ := NewNBitFromString("207fffff")
+
+	block, err := NewBlock(&BlockHeader{
+		Version:        1,
+		HashPrevBlock:  prevHash,
+		HashMerkleRoot: merkleRoot,
+		Bits:           *bits,
+	}, &bt.Tx{}, []*chainhash.Hash{}, 1, 123, 0, 0)
+	require.NoError(t, err)
+
+	txHash := chainhash.HashH([]byte("tx"))
+	subtreeHash := chainhash.HashH([]byte("subtree"))
+	blockHash := chainhash.HashH([]byte("block"))
+
+	filter := blobloom.NewOptimized(blobloom.Config{Capacity: 10, FPRate: 0.01})
+	filter.Add(binary.BigEndian.Uint64(txHash[:]))
+
+	validationCtx := &validationContext{
+		currentBlockHeaderHashesMap: map[chainhash.Hash]struct{}{blockHash: {}},
+		currentBlockHeaderIDsMap:    map[uint32]struct{}{1: {}},
+	}
+
+	t.Run("false positive", func(t *testing.T) {
+		txMetaStore := &utxo.MockUtxostore{}
+		txMetaStore.On("GetMeta", mock.Anything, &txHash).Return(&meta.Data{BlockIDs: []uint32{5}}, nil)
+
+		deps := &validationDependencies{
+			txMetaStore:              txMetaStore,
+			recentBlocksBloomFilters: []*BlockBloomFilter{{Filter: filter, BlockHash: &blockHash}},
+		}
+
+		metrics := &subtreeValidationMetrics{}
+
+		err := block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreepkg.SubtreeNode{Hash: txHash}, &subtreeHash, 0, 1, metrics)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), metrics.getMetaCalls)
+		assert.Equal(t, uint64(1), metrics.bloomPositives)
+		assert.Equal(t, uint64(1), metrics.bloomFalsePositives)
+	})
+
+	t.Run("already mined", func(t *testing.T) {
+		txMetaStore := &utxo.MockUtxostore{}
+		txMetaStore.On("GetMeta", mock.Anything, &txHash).Return(&meta.Data{BlockIDs: []uint32{1}}, nil)
+
+		deps := &validationDependencies{
+			txMetaStore:              txMetaStore,
+			recentBlocksBloomFilters: []*BlockBloomFilter{{Filter: filter, BlockHash: &blockHash}},
+		}
+
+		metrics := &subtreeValidationMetrics{}
+
+		err := block.checkTxInRecentBlocks(context.Background(), deps, validationCtx, subtreepkg.SubtreeNode{Hash: txHash}, &subtreeHash, 0, 1, metrics)
+		require.Error(t, err)
+
+		assert.Equal(t, uint64(1), metrics.getMetaCalls)
+		assert.Equal(t, uint64(1), metrics.bloomPositives)
+		assert.Equal(t, uint64(0), metrics.bloomFalsePositives)
+
+		// recording on a nil receiver is a no-op
+		var nilMetrics *subtreeValidationMetrics
+
+		nilMetrics.addGetMetaCall()
+		nilMetrics.observe()
+	})
+}
+
+func TestBlock_ValidWithReport(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	statuses := func(report *BlockValidationReport) map[BlockValidationCheck]string {
+		result := make(map[BlockValidationCheck]string, len(report.Checks))
+		for _, check := range report.Checks {
+			result[check.Check] = check.Status
+		}
+
+		return result
+	}
+
+	t.Run("valid block", func(t *testing.T) {
+		coinbase, err := bt.NewTxFromString(CoinbaseHex)
+		require.NoError(t, err)
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+		require.NoError(t, err)
+
+		report := block.ValidWithReport(t.Context(), ulogger.TestLogger{}, nil, nil, txmap.NewSyncedMap[chainhash.Hash, []uint32](), nil, nil, nil, NewBloomStats(), tSettings)
+
+		assert.True(t, report.Valid)
+		assert.Empty(t, report.Error)
+		assert.Equal(t, block.Hash().String(), report.BlockHash)
+		require.Len(t, report.Checks, len(blockValidationChecks))
+
+		for i, check := range report.Checks {
+			assert.Equal(t, blockValidationChecks[i], check.Check)
+		}
+
+		status := statuses(report)
+		assert.Equal(t, BlockValidationCheckPassed, status[CheckTargetDifficulty])
+		assert.Equal(t, BlockValidationCheckPassed, status[CheckCoinbaseTx])
+		assert.Equal(t, BlockValidationCheckSkipped, status[CheckMedianTimePast])
+		assert.Equal(t, BlockValidationCheckSkipped, status[CheckSubtrees])
+		assert.Equal(t, BlockValidationCheckSkipped, status[CheckValidOrderAndBlessed])
+	})
+
+	t.Run("failed check stops the validation", func(t *testing.T) {
+		block := &Block{
+			Header:           blockHeader,
+			CoinbaseTx:       nil,
+			TransactionCount: 1,
+			SizeInBytes:      123,
+			Subtrees:         []*chainhash.Hash{},
+		}
+
+		report := block.ValidWithReport(t.Context(), ulogger.TestLogger{}, nil, nil, txmap.NewSyncedMap[chainhash.Hash, []uint32](), nil, nil, nil, NewBloomStats(), tSettings)
+
+		assert.False(t, report.Valid)
+		assert.Contains(t, report.Error, "no coinbase tx")
+		require.Len(t, report.Checks, len(blockValidationChecks))
+
+		status := statuses(report)
+		assert.Equal(t, BlockValidationCheckPassed, status[CheckTimestampNotInFuture])
+		assert.Equal(t, BlockValidationCheckFailed, status[CheckCoinbaseTx])
+		assert.Equal(t, BlockValidationCheckNotRun, status[CheckCoinbaseHeight])
+		assert.Equal(t, BlockValidationCheckNotRun, status[CheckValueConservation])
+
+		for _, check := range report.Checks {
+			if check.Check == CheckCoinbaseTx {
+				assert.Contains(t, check.Error, "no coinbase tx")
+			} else {
+				assert.Empty(t, check.Error)
+			}
+		}
+	})
+}
+
+func TestBlock_ValidAtHeight(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 1, 0)
+	require.NoError(t, err)
+
+	t.Run("valid block", func(t *testing.T) {
+		ok, err := block.ValidAtHeight(t.Context(), ulogger.TestLogger{}, nil, nil, txmap.NewSyncedMap[chainhash.Hash, []uint32](), nil, nil, nil, 1, NewBloomStats(), tSettings)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("validation height above the block height", func(t *testing.T) {
+		ok, err := block.ValidAtHeight(t.Context(), ulogger.TestLogger{}, nil, nil, txmap.NewSyncedMap[chainhash.Hash, []uint32](), nil, nil, nil, 2, NewBloomStats(), tSettings)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrInvalidArgument))
+		assert.False(t, ok)
+	})
+
+	t.Run("headers and metas do not match", func(t *testing.T) {
+		ok, err := block.ValidAtHeight(t.Context(), ulogger.TestLogger{}, nil, nil, txmap.NewSyncedMap[chainhash.Hash, []uint32](), nil, []*BlockHeader{GenesisBlockHeader}, nil, 1, NewBloomStats(), tSettings)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrInvalidArgument))
+		assert.False(t, ok)
+	})
+}
+
+func TestPinChainToHeight(t *testing.T) {
+	chain := []*BlockHeader{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}, {Nonce: 4}}
+	chainMetas := []*BlockHeaderMeta{{ID: 11, Height: 1}, {ID: 12, Height: 2}, {ID: 13, Height: 3}, {ID: 14, Height: 4}}
+
+	pinnedChain, pinnedIDs, err := pinChainToHeight(chain, chainMetas, 3)
+	require.NoError(t, err)
+	assert.Equal(t, chain[:2], pinnedChain)
+	assert.Equal(t, []uint32{11, 12}, pinnedIDs)
+
+	// the order of the chain is kept, newest first
+	pinnedChain, pinnedIDs, err = pinChainToHeight([]*BlockHeader{chain[3], chain[2], chain[1]}, []*BlockHeaderMeta{chainMetas[3], chainMetas[2], chainMetas[1]}, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []*BlockHeader{chain[2], chain[1]}, pinnedChain)
+	assert.Equal(t, []uint32{13, 12}, pinnedIDs)
+
+	pinnedChain, pinnedIDs, err = pinChainToHeight(chain, chainMetas, 1)
+	require.NoError(t, err)
+	assert.Empty(t, pinnedChain)
+	assert.Empty(t, pinnedIDs)
+}
+
+func TestBlock_TxHashes(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree1, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree1.AddCoinbaseNode())
+	require.NoError(t, subtree1.AddNode(chainhash.HashH([]byte("tx1")), 1, 1))
+
+	subtree2, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree2.AddNode(chainhash.HashH([]byte("tx2")), 1, 1))
+
+	subtree1Bytes, err := subtree1.Serialize()
+	require.NoError(t, err)
+
+	subtree2Bytes, err := subtree2.Serialize()
+	require.NoError(t, err)
+
+	subtreeStore := &mockSubtreeStore{
+		data: map[string][]byte{
+			string(subtree1.RootHash()[:]): subtree1Bytes,
+			string(subtree2.RootHash()[:]): subtree2Bytes,
+		},
+	}
+
+	block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree1.RootHash(), subtree2.RootHash()}, 3, 123, 0, 0)
+	require.NoError(t, err)
+
+	t.Run("all tx hashes with the coinbase txid", func(t *testing.T) {
+		var (
+			indexes []uint64
+			hashes  []chainhash.Hash
+		)
+
+		err := block.TxHashes(t.Context(), subtreeStore, func(idx uint64, hash chainhash.Hash) error {
+			indexes = append(indexes, idx)
+			hashes = append(hashes, hash)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{0, 1, 2}, indexes)
+		assert.Equal(t, []chainhash.Hash{*coinbase.TxIDChainHash(), chainhash.HashH([]byte("tx1")), chainhash.HashH([]byte("tx2"))}, hashes)
+
+		// the subtrees are not kept in the block
+		assert.Empty(t, block.SubtreeSlices)
+	})
+
+	t.Run("callback error stops the iteration", func(t *testing.T) {
+		calls := 0
+
+		err := block.TxHashes(t.Context(), subtreeStore, func(idx uint64, _ chainhash.Hash) error {
+			calls++
+
+			if idx == 1 {
+				return errors.NewProcessingError("stop")
+			}
+
+			return nil
+		})
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "stop")
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("missing subtree", func(t *testing.T) {
+		err := block.TxHashes(t.Context(), &mockSubtreeStore{}, func(uint64, chainhash.Hash) error {
+			return nil
+		})
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+	})
+}
+
+func TestBlock_FindTx(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	tx1, tx2, tx3 := chainhash.HashH([]byte("tx1")), chainhash.HashH([]byte("tx2")), chainhash.HashH([]byte("tx3"))
+
+	subtree1, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree1.AddCoinbaseNode())
+	require.NoError(t, subtree1.AddNode(tx1, 1, 1))
+
+	subtree2, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree2.AddNode(tx2, 1, 1))
+	require.NoError(t, subtree2.AddNode(tx3, 1, 1))
+
+	subtree1Bytes, err := subtree1.Serialize()
+	require.NoError(t, err)
+
+	subtree2Bytes, err := subtree2.Serialize()
+	require.NoError(t, err)
+
+	subtreeStore := &mockSubtreeStore{
+		data: map[string][]byte{
+			string(subtree1.RootHash()[:]): subtree1Bytes,
+			string(subtree2.RootHash()[:]): subtree2Bytes,
+		},
+	}
+
+	newBlock := func(t *testing.T) *Block {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree1.RootHash(), subtree2.RootHash()}, 4, 123, 0, 0)
+		require.NoError(t, err)
+
+		return block
+	}
+
+	assertFound := func(t *testing.T, block *Block, store SubtreeStore, txHash chainhash.Hash, expectedSubtreeIdx, expectedNodeIdx int) {
+		subtreeIdx, nodeIdx, found, err := block.FindTx(t.Context(), store, &txHash)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, expectedSubtreeIdx, subtreeIdx)
+		assert.Equal(t, expectedNodeIdx, nodeIdx)
+	}
+
+	t.Run("subtrees are read from the store", func(t *testing.T) {
+		block := newBlock(t)
+
+		assertFound(t, block, subtreeStore, *coinbase.TxIDChainHash(), 0, 0)
+		assertFound(t, block, subtreeStore, tx1, 0, 1)
+		assertFound(t, block, subtreeStore, tx3, 1, 1)
+
+		unknown := chainhash.HashH([]byte("unknown"))
+		_, _, found, err := block.FindTx(t.Context(), subtreeStore, &unknown)
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		// the subtrees are not kept in the block
+		assert.Empty(t, block.SubtreeSlices)
+	})
+
+	t.Run("search stops at the subtree of the transaction", func(t *testing.T) {
+		// the second subtree is not in the store
+		firstSubtreeStore := &mockSubtreeStore{data: map[string][]byte{string(subtree1.RootHash()[:]): subtree1Bytes}}
+
+		assertFound(t, newBlock(t), firstSubtreeStore, tx1, 0, 1)
+
+		_, _, _, err := newBlock(t).FindTx(t.Context(), firstSubtreeStore, &tx2)
+		require.Error(t, err)
+	})
+
+	t.Run("txMap is used when the subtrees are loaded", func(t *testing.T) {
+		block := newBlock(t)
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree1, subtree2})
+		require.NoError(t, block.checkDuplicateTransactions(t.Context(), 1))
+
+		// no subtree is read from the store
+		errorStore := &mockSubtreeStore{shouldError: true}
+
+		assertFound(t, block, errorStore, tx1, 0, 1)
+		assertFound(t, block, errorStore, tx2, 1, 0)
+		assertFound(t, block, errorStore, tx3, 1, 1)
+
+		unknown := chainhash.HashH([]byte("unknown"))
+		_, _, found, err := block.FindTx(t.Context(), errorStore, &unknown)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("incomplete txMap is not used", func(t *testing.T) {
+		block := newBlock(t)
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree1, subtree2})
+
+		// a txMap that is still being built by the duplicate transactions check
+		block.txMap = txmap.NewSplitSwissMapUint64(4)
+		require.NoError(t, block.checkDuplicateTransactionsInSubtree(subtree1, 0, subtree1.Size()))
+
+		assertFound(t, block, subtreeStore, tx3, 1, 1)
+	})
+
+	t.Run("coinbase of a block without subtrees", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 0, 0)
+		require.NoError(t, err)
+
+		assertFound(t, block, subtreeStore, *coinbase.TxIDChainHash(), 0, 0)
+
+		_, _, found, err := block.FindTx(t.Context(), subtreeStore, &tx1)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestBlock_SubtreeSlicesGeneration(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+	require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1, 1))
+
+	newBlock := func(t *testing.T) *Block {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 1, 0)
+		require.NoError(t, err)
+
+		return block
+	}
+
+	t.Run("take gives ownership and bumps the generation", func(t *testing.T) {
+		block := newBlock(t)
+
+		generation := block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+		assert.Equal(t, uint64(1), generation)
+
+		snapshot, snapshotGeneration := block.SubtreeSlicesSnapshot()
+		assert.Equal(t, generation, snapshotGeneration)
+
+		taken, takenGeneration := block.TakeSubtreeSlices()
+		assert.Equal(t, generation, takenGeneration)
+		assert.Equal(t, []*subtreepkg.Subtree{subtree}, taken)
+		assert.Nil(t, block.SubtreeSlices)
+		assert.Equal(t, uint64(2), block.SubtreeSlicesGeneration())
+
+		// the snapshot taken before is not affected
+		assert.Equal(t, []*subtreepkg.Subtree{subtree}, snapshot)
+	})
+
+	t.Run("concurrent readers while the subtrees are taken", func(t *testing.T) {
+		// reproduces the race of reading the subtrees of a cached block while another goroutine frees them,
+		// run with -race to detect unguarded access
+		block := newBlock(t)
+		block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 200; j++ {
+					_ = block.checkBlockRewardAndFees(&chaincfg.MainNetParams, 0)
+					_ = block.VerifyCoinbaseSubsidyExact(1, &chaincfg.MainNetParams)
+					_ = block.CheckMerkleRoot(t.Context())
+					_ = block.String()
+
+					if subtreeSlices, _ := block.SubtreeSlicesSnapshot(); len(subtreeSlices) > 0 {
+						assert.NotNil(t, subtreeSlices[0])
+					}
+				}
+			}()
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			lastGeneration := block.SubtreeSlicesGeneration()
+
+			for j := 0; j < 200; j++ {
+				_, generation := block.TakeSubtreeSlices()
+				assert.GreaterOrEqual(t, generation, lastGeneration)
+
+				lastGeneration = block.SetSubtreeSlices([]*subtreepkg.Subtree{subtree})
+			}
+		}()
+
+		wg.Wait()
+	})
+}
+
+func TestBlock_AddSubtree(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree1, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree1.AddCoinbaseNode())
+	require.NoError(t, subtree1.AddNode(chainhash.HashH([]byte("tx1")), 1, 100))
+
+	subtree2, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree2.AddNode(chainhash.HashH([]byte("tx2")), 1, 200))
+	require.NoError(t, subtree2.AddNode(chainhash.HashH([]byte("tx3")), 1, 300))
+
+	t.Run("subtrees are appended", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, nil, 0, 80, 1, 0)
+		require.NoError(t, err)
+
+		block.AddSubtree(subtree1.RootHash(), subtree1)
+		block.AddSubtree(subtree2.RootHash(), subtree2)
+
+		assert.Equal(t, []*chainhash.Hash{subtree1.RootHash(), subtree2.RootHash()}, block.Subtrees)
+		assert.Equal(t, []*subtreepkg.Subtree{subtree1, subtree2}, block.SubtreeSlices)
+		assert.Equal(t, uint64(2), block.subtreeLength)
+		assert.Equal(t, uint64(4), block.TransactionCount)
+		assert.Equal(t, uint64(680), block.SizeInBytes)
+		assert.Equal(t, uint64(2), block.SubtreeSlicesGeneration())
+	})
+
+	t.Run("subtrees that are not loaded stay aligned", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree1.RootHash()}, 2, 180, 1, 0)
+		require.NoError(t, err)
+
+		block.AddSubtree(subtree2.RootHash(), subtree2)
+
+		assert.Equal(t, []*chainhash.Hash{subtree1.RootHash(), subtree2.RootHash()}, block.Subtrees)
+		assert.Equal(t, []*subtreepkg.Subtree{nil, subtree2}, block.SubtreeSlices)
+		assert.Equal(t, uint64(2), block.subtreeLength)
+		assert.Equal(t, uint64(4), block.TransactionCount)
+	})
+
+	t.Run("snapshots are not modified", func(t *testing.T) {
+		block, err := NewBlock(blockHeader, coinbase, nil, 0, 0, 1, 0)
+		require.NoError(t, err)
+
+		block.AddSubtree(subtree1.RootHash(), subtree1)
+		snapshot, _ := block.SubtreeSlicesSnapshot()
+
+		block.AddSubtree(subtree2.RootHash(), subtree2)
+
+		assert.Equal(t, []*subtreepkg.Subtree{subtree1}, snapshot)
+	})
+}
+
+func TestBlock_checkTimestampNotInFuture(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	newBlock := func(timestamp time.Time) *Block {
+		return &Block{Header: &BlockHeader{
+			HashPrevBlock:  &chainhash.Hash{},
+			HashMerkleRoot: &chainhash.Hash{},
+			Timestamp:      uint32(timestamp.Unix()),
+		}}
+	}
+
+	t.Run("default tolerance of two hours", func(t *testing.T) {
+		require.NoError(t, newBlock(now.Add(2*time.Hour)).checkTimestampNotInFuture(now, nil))
+
+		err := newBlock(now.Add(2*time.Hour+time.Second)).checkTimestampNotInFuture(now, nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+
+	t.Run("configured tolerance", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.MaxFutureBlockTime = 6 * time.Hour
+
+		require.NoError(t, newBlock(now.Add(6*time.Hour)).checkTimestampNotInFuture(now, tSettings))
+		require.Error(t, newBlock(now.Add(6*time.Hour+time.Second)).checkTimestampNotInFuture(now, tSettings))
+	})
+
+	t.Run("zero tolerance uses the default", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.MaxFutureBlockTime = 0
+
+		require.NoError(t, newBlock(now.Add(2*time.Hour)).checkTimestampNotInFuture(now, tSettings))
+		require.Error(t, newBlock(now.Add(2*time.Hour+time.Second)).checkTimestampNotInFuture(now, tSettings))
+	})
+}
+
+func TestBlock_ValidateHeaderOnly(t *testing.T) {
+	newBlock := func(t *testing.T, nonceDelta byte) *Block {
+		blockHeaderBytes := GenesisBlockHeader.Bytes()
+		blockHeaderBytes[76] += nonceDelta
+
+		blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+		require.NoError(t, err)
+
+		return &Block{Header: blockHeader}
+	}
+
+	t.Run("valid header", func(t *testing.T) {
+		require.NoError(t, newBlock(t, 0).ValidateHeaderOnly(context.Background(), nil))
+	})
+
+	t.Run("target difficulty not met", func(t *testing.T) {
+		block := newBlock(t, 1)
+
+		require.Error(t, block.ValidateHeaderOnly(context.Background(), nil))
+	})
+
+	t.Run("timestamp in the future", func(t *testing.T) {
+		block := newBlock(t, 0)
+		now := time.Unix(int64(block.Header.Timestamp), 0).Add(-3 * time.Hour)
+
+		err := block.validateHeader(now, nil, nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+	})
+
+	t.Run("checks are recorded in the report", func(t *testing.T) {
+		block := newBlock(t, 0)
+		report := newBlockValidationReport(block)
+
+		require.NoError(t, block.validateHeader(time.Now(), nil, report))
+		report.finish(nil)
+
+		require.GreaterOrEqual(t, len(report.Checks), 2)
+		assert.Equal(t, CheckTargetDifficulty, report.Checks[0].Check)
+		assert.Equal(t, BlockValidationCheckPassed, report.Checks[0].Status)
+		assert.Equal(t, CheckTimestampNotInFuture, report.Checks[1].Check)
+		assert.Equal(t, BlockValidationCheckPassed, report.Checks[1].Status)
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := newBlock(t, 0).ValidateHeaderOnly(ctx, nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrContextCanceled))
+	})
+}
+
+func TestBlock_ToWireMsgBlock(t *testing.T) {
+	ctx := context.Background()
+
+	msgBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   1,
+			Timestamp: time.Unix(1640995200, 0),
+			Bits:      0x1d00ffff,
+		},
+	}
+
+	for i := 0; i < 8; i++ {
+		prevOutPoint := wire.OutPoint{Hash: chainhash.HashH([]byte{byte(i)})}
+		if i == 0 {
+			prevOutPoint = wire.OutPoint{Index: 0xffffffff}
+		}
+
+		msgBlock.Transactions = append(msgBlock.Transactions, &wire.MsgTx{
+			Version: 1,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: prevOutPoint,
+				SignatureScript:  []byte{0x51},
+				Sequence:         0xffffffff,
+			}},
+			TxOut: []*wire.TxOut{{
+				Value:    int64(1000 + i),
+				PkScript: []byte{0x51},
+			}},
+		})
+	}
+
+	tSettings := settings.NewSettings()
+	tSettings.BlockAssembly.InitialMerkleItemsPerSubtree = 4
+
+	newBlock := func(t *testing.T) *Block {
+		block, err := NewBlockFromMsgBlock(msgBlock, tSettings)
+		require.NoError(t, err)
+		require.Len(t, block.Subtrees, 2)
+
+		return block
+	}
+
+	// store the subtrees and the subtree data files of the block
+	subtreeStore := memory.New()
+
+	subtreeSlices, _ := newBlock(t).SubtreeSlicesSnapshot()
+	for sIdx, subtree := range subtreeSlices {
+		subtreeData := subtreepkg.NewSubtreeData(subtree)
+
+		for nIdx := range subtree.Nodes {
+			if sIdx == 0 && nIdx == 0 {
+				continue
+			}
+
+			var txBytes bytes.Buffer
+			require.NoError(t, msgBlock.Transactions[sIdx*4+nIdx].Serialize(&txBytes))
+
+			tx, err := bt.NewTxFromBytes(txBytes.Bytes())
+			require.NoError(t, err)
+			require.NoError(t, subtreeData.AddTx(tx, nIdx))
+		}
+
+		subtreeBytes, err := subtree.Serialize()
+		require.NoError(t, err)
+		require.NoError(t, subtreeStore.Set(ctx, subtree.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+		subtreeDataBytes, err := subtreeData.Serialize()
+		require.NoError(t, err)
+		require.NoError(t, subtreeStore.Set(ctx, subtree.RootHash()[:], fileformat.FileTypeSubtreeData, subtreeDataBytes))
+	}
+
+	assertRoundTrip := func(t *testing.T, block *Block) {
+		wireBlock, err := block.ToWireMsgBlock(ctx, subtreeStore)
+		require.NoError(t, err)
+
+		assert.Equal(t, msgBlock.BlockHash(), wireBlock.BlockHash())
+		require.Len(t, wireBlock.Transactions, len(msgBlock.Transactions))
+
+		for i, tx := range msgBlock.Transactions {
+			assert.Equal(t, tx.TxHash(), wireBlock.Transactions[i].TxHash())
+		}
+
+		roundTripBlock, err := NewBlockFromMsgBlock(wireBlock, tSettings)
+		require.NoError(t, err)
+		assert.Equal(t, block.Hash(), roundTripBlock.Hash())
+		assert.Equal(t, block.Subtrees, roundTripBlock.Subtrees)
+		assert.Equal(t, block.SizeInBytes, roundTripBlock.SizeInBytes)
+	}
+
+	t.Run("loaded subtrees", func(t *testing.T) {
+		assertRoundTrip(t, newBlock(t))
+	})
+
+	t.Run("subtrees are read from the store", func(t *testing.T) {
+		block := newBlock(t)
+		block.SetSubtreeSlices(nil)
+
+		assertRoundTrip(t, block)
+	})
+
+	t.Run("coinbase only block", func(t *testing.T) {
+		coinbaseBlock := &wire.MsgBlock{Header: msgBlock.Header, Transactions: msgBlock.Transactions[:1]}
+
+		block, err := NewBlockFromMsgBlock(coinbaseBlock, tSettings)
+		require.NoError(t, err)
+
+		wireBlock, err := block.ToWireMsgBlock(ctx, subtreeStore)
+		require.NoError(t, err)
+		require.Len(t, wireBlock.Transactions, 1)
+		assert.Equal(t, coinbaseBlock.BlockHash(), wireBlock.BlockHash())
+	})
+
+	t.Run("missing subtree data", func(t *testing.T) {
+		_, err := newBlock(t).ToWireMsgBlock(ctx, memory.New())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+	})
+}
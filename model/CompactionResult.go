@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// CompactionResult describes what was removed from the blockchain store during a compaction run.
+type CompactionResult struct {
+	RemovedBlocks    []chainhash.Hash `json:"removed_blocks"`     // Hashes of the invalid blocks that were removed.
+	RemovedStateKeys []string         `json:"removed_state_keys"` // Keys of the stale state entries that were removed.
+	ReclaimedBytes   uint64           `json:"reclaimed_bytes"`    // Approximate number of bytes of row data that was removed.
+}
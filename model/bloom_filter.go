@@ -3,47 +3,170 @@ package model
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"math"
 	"sync"
 	"time"
 
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/greatroar/blobloom"
 )
 
+const (
+	// BlockBloomFilterFPRate is the false positive rate the block bloom filters are created with.
+	BlockBloomFilterFPRate = 1e-6
+
+	// blockBloomFilterVersion is the version of the serialized bloom filter format.
+	blockBloomFilterVersion uint8 = 1
+
+	// blockBloomFilterHeaderSize is the size of the serialized header: version, block height, capacity and FP rate.
+	blockBloomFilterHeaderSize = 1 + 4 + 8 + 8
+)
+
+// BlockBloomFilterStore is the store the block bloom filters are persisted in, under the block hash.
+type BlockBloomFilterStore interface {
+	SubtreeStore
+	Get(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) ([]byte, error)
+	Set(ctx context.Context, key []byte, fileType fileformat.FileType, value []byte, opts ...options.FileOption) error
+}
+
 type BlockBloomFilter struct {
 	Filter       *blobloom.Filter
 	BlockHash    *chainhash.Hash
 	CreationTime time.Time
 	BlockHeight  uint32
+	Capacity     uint64
+	FPRate       float64
 }
 
+// Serialize encodes the bloom filter in a versioned format:
+//
+//	version (1 byte) | block height (4 bytes) | capacity (8 bytes) | FP rate (8 bytes) | raw filter bits
+//
+// All integers are little-endian, the raw filter bits are in the blobloom dump format.
 func (bbf *BlockBloomFilter) Serialize() ([]byte, error) {
+	if bbf.Filter == nil {
+		return nil, errors.NewProcessingError("bloom filter is not initialized")
+	}
+
 	buf := new(bytes.Buffer)
-	_, err := blobloom.Dump(buf, bbf.Filter, "filter")
 
-	if err != nil {
-		return nil, err
+	var header [blockBloomFilterHeaderSize]byte
+
+	header[0] = blockBloomFilterVersion
+	binary.LittleEndian.PutUint32(header[1:5], bbf.BlockHeight)
+	binary.LittleEndian.PutUint64(header[5:13], bbf.Capacity)
+	binary.LittleEndian.PutUint64(header[13:21], math.Float64bits(bbf.FPRate))
+
+	buf.Write(header[:])
+
+	if _, err := blobloom.Dump(buf, bbf.Filter, "filter"); err != nil {
+		return nil, errors.NewProcessingError("error dumping bloom filter", err)
 	}
 
 	return buf.Bytes(), nil
 }
 
+// Deserialize decodes a bloom filter that was encoded with Serialize.
+// An error is returned for unknown versions, which callers should treat as a cache miss.
 func (bbf *BlockBloomFilter) Deserialize(data []byte) error {
-	buf := bytes.NewBuffer(data)
+	if len(data) < blockBloomFilterHeaderSize {
+		return errors.NewProcessingError("bloom filter data too short: %d bytes", len(data))
+	}
 
-	l, err := blobloom.NewLoader(buf)
+	if data[0] != blockBloomFilterVersion {
+		return errors.NewProcessingError("unsupported bloom filter version %d", data[0])
+	}
+
+	l, err := blobloom.NewLoader(bytes.NewReader(data[blockBloomFilterHeaderSize:]))
+	if err != nil {
+		return errors.NewProcessingError("error reading bloom filter header", err)
+	}
+
+	filter, err := l.Load(nil)
 	if err != nil {
-		return err
+		return errors.NewProcessingError("error loading bloom filter", err)
 	}
 
-	bbf.Filter, err = l.Load(bbf.Filter)
+	bbf.Filter = filter
+	bbf.BlockHeight = binary.LittleEndian.Uint32(data[1:5])
+	bbf.Capacity = binary.LittleEndian.Uint64(data[5:13])
+	bbf.FPRate = math.Float64frombits(binary.LittleEndian.Uint64(data[13:21]))
+
+	return nil
+}
+
+// Store persists the bloom filter in the store under the block hash.
+func (bbf *BlockBloomFilter) Store(ctx context.Context, store BlockBloomFilterStore, opts ...options.FileOption) error {
+	filterBytes, err := bbf.Serialize()
 	if err != nil {
-		return err
+		return errors.NewProcessingError("[BlockBloomFilter][%s] failed to serialize bloom filter", bbf.BlockHash.String(), err)
+	}
+
+	if err = store.Set(ctx, bbf.BlockHash[:], fileformat.FileTypeBloomFilter, filterBytes, opts...); err != nil {
+		return errors.NewStorageError("[BlockBloomFilter][%s] failed to store bloom filter", bbf.BlockHash.String(), err)
 	}
 
 	return nil
 }
 
+// LoadBlockBloomFilter reads a previously persisted bloom filter for the given block hash from the store.
+func LoadBlockBloomFilter(ctx context.Context, store BlockBloomFilterStore, hash *chainhash.Hash) (*BlockBloomFilter, error) {
+	filterBytes, err := store.Get(ctx, hash[:], fileformat.FileTypeBloomFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	bbf := &BlockBloomFilter{
+		CreationTime: time.Now(),
+		BlockHash:    hash,
+	}
+
+	if err = bbf.Deserialize(filterBytes); err != nil {
+		return nil, err
+	}
+
+	return bbf, nil
+}
+
+// GetOrCreateBloomFilter returns the persisted bloom filter of the block, or, when it cannot be loaded,
+// creates it from the subtrees of the block and persists it in the store with the given options.
+func (b *Block) GetOrCreateBloomFilter(ctx context.Context, logger ulogger.Logger, store BlockBloomFilterStore, getAndValidateSubtreesConcurrency int,
+	opts ...options.FileOption) (*BlockBloomFilter, error) {
+	if bbf, err := LoadBlockBloomFilter(ctx, store, b.Hash()); err == nil {
+		return bbf, nil
+	} else if !errors.Is(err, errors.ErrNotFound) {
+		// the persisted filter is unreadable, e.g. an older format, replace it with a recreated one
+		logger.Warnf("[GetOrCreateBloomFilter][%s] failed to load bloom filter, recreating: %v", b.Hash().String(), err)
+
+		opts = append(opts, options.WithAllowOverwrite(true))
+	}
+
+	filter, err := b.NewOptimizedBloomFilter(ctx, logger, store, getAndValidateSubtreesConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	bbf := &BlockBloomFilter{
+		Filter:       filter,
+		BlockHash:    b.Hash(),
+		CreationTime: time.Now(),
+		BlockHeight:  b.Height,
+		Capacity:     b.TransactionCount,
+		FPRate:       BlockBloomFilterFPRate,
+	}
+
+	if err = bbf.Store(ctx, store, opts...); err != nil {
+		return nil, err
+	}
+
+	return bbf, nil
+}
+
 type BloomStats struct {
 	QueryCounter         uint64
 	PositiveCounter      uint64
@@ -59,6 +182,26 @@ func NewBloomStats() *BloomStats {
 	}
 }
 
+// Counters returns a consistent snapshot of the query, positive and false positive counters.
+func (bs *BloomStats) Counters() (queries, positives, falsePositives uint64) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.QueryCounter, bs.PositiveCounter, bs.FalsePositiveCounter
+}
+
+// Add adds the counters of other to the counters of bs.
+func (bs *BloomStats) Add(other *BloomStats) {
+	queries, positives, falsePositives := other.Counters()
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	bs.QueryCounter += queries
+	bs.PositiveCounter += positives
+	bs.FalsePositiveCounter += falsePositives
+}
+
 func (bs *BloomStats) BloomFilterStatsProcessor(ctx context.Context) {
 	go func() {
 		ticker := time.NewTicker(5 * time.Second)
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/greatroar/blobloom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockBloomFilter_SerializeDeserialize(t *testing.T) {
+	filter := blobloom.NewOptimized(blobloom.Config{Capacity: 10, FPRate: BlockBloomFilterFPRate})
+	filter.Add(12345)
+
+	bbf := &BlockBloomFilter{
+		Filter:      filter,
+		BlockHash:   &chainhash.Hash{1},
+		BlockHeight: 42,
+		Capacity:    10,
+		FPRate:      BlockBloomFilterFPRate,
+	}
+
+	data, err := bbf.Serialize()
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		loaded := &BlockBloomFilter{}
+		require.NoError(t, loaded.Deserialize(data))
+
+		assert.Equal(t, uint32(42), loaded.BlockHeight)
+		assert.Equal(t, uint64(10), loaded.Capacity)
+		assert.Equal(t, BlockBloomFilterFPRate, loaded.FPRate)
+		assert.True(t, loaded.Filter.Has(12345))
+		assert.Equal(t, filter.NumBits(), loaded.Filter.NumBits())
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		invalid := append([]byte{}, data...)
+		invalid[0] = 0
+
+		require.Error(t, (&BlockBloomFilter{}).Deserialize(invalid))
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		require.Error(t, (&BlockBloomFilter{}).Deserialize(data[:3]))
+	})
+
+	t.Run("uninitialized filter", func(t *testing.T) {
+		_, err := (&BlockBloomFilter{}).Serialize()
+		require.Error(t, err)
+	})
+}
+
+func TestBlock_GetOrCreateBloomFilter(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	t.Run("creates and persists on a miss", func(t *testing.T) {
+		store := memory.New()
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 7, 0)
+		require.NoError(t, err)
+
+		bbf, err := block.GetOrCreateBloomFilter(context.Background(), ulogger.TestLogger{}, store, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(7), bbf.BlockHeight)
+		assert.Equal(t, uint64(1), bbf.Capacity)
+
+		exists, err := store.Exists(context.Background(), block.Hash()[:], fileformat.FileTypeBloomFilter)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		loaded, err := LoadBlockBloomFilter(context.Background(), store, block.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(7), loaded.BlockHeight)
+		assert.Equal(t, block.Hash(), loaded.BlockHash)
+	})
+
+	t.Run("reuses the persisted filter", func(t *testing.T) {
+		store := memory.New()
+
+		// the subtree does not exist in the store, so recreating the filter would fail
+		subtreeHash, _ := chainhash.NewHashFromStr("9daba5e5c8ecdb80e811ef93558e960a6ffed0c481182bd47ac381547361ff25")
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtreeHash}, 10, 123, 8, 0)
+		require.NoError(t, err)
+
+		filter := blobloom.NewOptimized(blobloom.Config{Capacity: 10, FPRate: BlockBloomFilterFPRate})
+		filter.Add(12345)
+
+		persisted := &BlockBloomFilter{
+			Filter:      filter,
+			BlockHash:   block.Hash(),
+			BlockHeight: 8,
+			Capacity:    10,
+			FPRate:      BlockBloomFilterFPRate,
+		}
+		require.NoError(t, persisted.Store(context.Background(), store))
+
+		bbf, err := block.GetOrCreateBloomFilter(context.Background(), ulogger.TestLogger{}, store, 1)
+		require.NoError(t, err)
+		assert.True(t, bbf.Filter.Has(12345))
+		assert.Equal(t, uint32(8), bbf.BlockHeight)
+	})
+
+	t.Run("recreates an unreadable filter", func(t *testing.T) {
+		store := memory.New()
+
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{}, 1, 123, 9, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, store.Set(context.Background(), block.Hash()[:], fileformat.FileTypeBloomFilter, []byte("legacy")))
+
+		bbf, err := block.GetOrCreateBloomFilter(context.Background(), ulogger.TestLogger{}, store, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(9), bbf.BlockHeight)
+
+		_, err = LoadBlockBloomFilter(context.Background(), store, block.Hash())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,32 @@
+package model
+
+// NewBlockIDSet returns a set of the given block IDs, for repeated IsBlockIDInSet lookups.
+func NewBlockIDSet(blockIDs []uint32) map[uint32]struct{} {
+	blockIDSet := make(map[uint32]struct{}, len(blockIDs))
+	for _, blockID := range blockIDs {
+		blockIDSet[blockID] = struct{}{}
+	}
+
+	return blockIDSet
+}
+
+// IsBlockIDInSet reports whether the block with blockID is on the current chain, given the set of the IDs of
+// the block headers of the current chain created with NewBlockIDSet.
+func IsBlockIDInSet(blockID uint32, currentBlockHeaderIDsSet map[uint32]struct{}) bool {
+	_, found := currentBlockHeaderIDsSet[blockID]
+
+	return found
+}
+
+// FirstBlockIDInSet returns the first of the given block IDs, like the IDs of the blocks a transaction was
+// mined in, that is on the current chain given as a set created with NewBlockIDSet.
+// Returns false when none of the blocks are on the current chain.
+func FirstBlockIDInSet(blockIDs []uint32, currentBlockHeaderIDsSet map[uint32]struct{}) (uint32, bool) {
+	for _, blockID := range blockIDs {
+		if IsBlockIDInSet(blockID, currentBlockHeaderIDsSet) {
+			return blockID, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBlockIDInSet(t *testing.T) {
+	currentBlockHeaderIDsSet := NewBlockIDSet([]uint32{7, 5, 3, 5})
+
+	assert.Len(t, currentBlockHeaderIDsSet, 3)
+	assert.True(t, IsBlockIDInSet(3, currentBlockHeaderIDsSet))
+	assert.False(t, IsBlockIDInSet(4, currentBlockHeaderIDsSet))
+	assert.False(t, IsBlockIDInSet(3, nil))
+}
+
+func TestFirstBlockIDInSet(t *testing.T) {
+	currentBlockHeaderIDsSet := NewBlockIDSet([]uint32{7, 5, 3})
+
+	t.Run("first block on the current chain", func(t *testing.T) {
+		blockID, found := FirstBlockIDInSet([]uint32{2, 5, 3}, currentBlockHeaderIDsSet)
+		assert.True(t, found)
+		assert.Equal(t, uint32(5), blockID)
+	})
+
+	t.Run("no block on the current chain", func(t *testing.T) {
+		_, found := FirstBlockIDInSet([]uint32{2, 4}, currentBlockHeaderIDsSet)
+		assert.False(t, found)
+
+		_, found = FirstBlockIDInSet(nil, currentBlockHeaderIDsSet)
+		assert.False(t, found)
+	})
+}
@@ -9,14 +9,18 @@ import (
 )
 
 var (
-	prometheusBlockFromBytes              prometheus.Histogram
-	prometheusBlockValid                  prometheus.Histogram
-	prometheusBlockCheckMerkleRoot        prometheus.Histogram
-	prometheusBlockGetSubtrees            prometheus.Histogram
-	prometheusBlockGetAndValidateSubtrees prometheus.Histogram
-	prometheusBloomQueryCounter           prometheus.Gauge
-	prometheusBloomPositiveCounter        prometheus.Gauge
-	prometheusBloomFalsePositiveCounter   prometheus.Gauge
+	prometheusBlockFromBytes                   prometheus.Histogram
+	prometheusBlockValid                       prometheus.Histogram
+	prometheusBlockCheckMerkleRoot             prometheus.Histogram
+	prometheusBlockGetSubtrees                 prometheus.Histogram
+	prometheusBlockGetAndValidateSubtrees      prometheus.Histogram
+	prometheusBloomQueryCounter                prometheus.Gauge
+	prometheusBloomPositiveCounter             prometheus.Gauge
+	prometheusBloomFalsePositiveCounter        prometheus.Gauge
+	prometheusBlockValueConservationViolations prometheus.Counter
+	prometheusBlockValidateSubtreePhase        *prometheus.HistogramVec
+	prometheusBlockValidateSubtreeCounts       *prometheus.HistogramVec
+	prometheusBlockSubtreeFetchSource          *prometheus.CounterVec
 )
 
 var (
@@ -108,4 +112,45 @@ func _initPrometheusMetrics() {
 			Help:      "Number of false positives from the bloom filter",
 		},
 	)
+
+	prometheusBlockValueConservationViolations = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "block",
+			Name:      "value_conservation_violations",
+			Help:      "Number of transactions found in blocks that create more value than they spend",
+		},
+	)
+
+	prometheusBlockValidateSubtreePhase = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "block",
+			Name:      "validate_subtree_phase",
+			Help:      "Histogram of the duration of each phase of validating a subtree in Block.validOrderAndBlessed",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+		[]string{"phase"},
+	)
+
+	prometheusBlockValidateSubtreeCounts = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "block",
+			Name:      "validate_subtree_counts",
+			Help:      "Histogram of the number of tx meta lookups and bloom filter hits per subtree in Block.validOrderAndBlessed",
+			Buckets:   util.MetricsBucketsSize,
+		},
+		[]string{"counter"},
+	)
+
+	prometheusBlockSubtreeFetchSource = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "block",
+			Name:      "subtree_fetch_source",
+			Help:      "Number of subtrees loaded in Block.GetAndValidateSubtrees by the source that returned the subtree",
+		},
+		[]string{"source"},
+	)
 }
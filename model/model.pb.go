@@ -33,6 +33,7 @@ const (
 	NotificationType_FSMState         NotificationType = 4
 	NotificationType_BlockSubtreesSet NotificationType = 5
 	NotificationType_PeerFailure      NotificationType = 6 // Peer failed to provide data (catchup, subtree, block, etc)
+	NotificationType_Reorg            NotificationType = 7 // Best chain switched to another fork, the metadata holds the old tip, new tip, fork point and depth
 )
 
 // Enum value maps for NotificationType.
@@ -45,6 +46,7 @@ var (
 		4: "FSMState",
 		5: "BlockSubtreesSet",
 		6: "PeerFailure",
+		7: "Reorg",
 	}
 	NotificationType_value = map[string]int32{
 		"PING":             0,
@@ -54,6 +56,7 @@ var (
 		"FSMState":         4,
 		"BlockSubtreesSet": 5,
 		"PeerFailure":      6,
+		"Reorg":            7,
 	}
 )
 
@@ -84,6 +87,56 @@ func (NotificationType) EnumDescriptor() ([]byte, []int) {
 	return file_model_model_proto_rawDescGZIP(), []int{0}
 }
 
+// swagger:enum BlockSelection
+type BlockSelection int32
+
+const (
+	BlockSelection_MainChain           BlockSelection = 0 // Only blocks on the main chain
+	BlockSelection_MainChainAndOrphans BlockSelection = 1 // Blocks on the main chain and orphaned blocks
+	BlockSelection_OrphansOnly         BlockSelection = 2 // Only orphaned blocks, blocks that are not on the main chain
+)
+
+// Enum value maps for BlockSelection.
+var (
+	BlockSelection_name = map[int32]string{
+		0: "MainChain",
+		1: "MainChainAndOrphans",
+		2: "OrphansOnly",
+	}
+	BlockSelection_value = map[string]int32{
+		"MainChain":           0,
+		"MainChainAndOrphans": 1,
+		"OrphansOnly":         2,
+	}
+)
+
+func (x BlockSelection) Enum() *BlockSelection {
+	p := new(BlockSelection)
+	*p = x
+	return p
+}
+
+func (x BlockSelection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockSelection) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_model_proto_enumTypes[1].Descriptor()
+}
+
+func (BlockSelection) Type() protoreflect.EnumType {
+	return &file_model_model_proto_enumTypes[1]
+}
+
+func (x BlockSelection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockSelection.Descriptor instead.
+func (BlockSelection) EnumDescriptor() ([]byte, []int) {
+	return file_model_model_proto_rawDescGZIP(), []int{1}
+}
+
 // swagger:model MiningCandidate
 type MiningCandidate struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -305,7 +358,7 @@ func (x *MiningSolution) GetBlockHash() []byte {
 // swagger:model NotificationMetadata
 type NotificationMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// define a map of string to string
+	//define a map of string to string
 	Metadata      map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -867,7 +920,7 @@ const file_model_model_proto_rawDesc = "" +
 	"\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x1c\n" +
 	"\tbranchlen\x18\x03 \x01(\rR\tbranchlen\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status*v\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status*\x81\x01\n" +
 	"\x10NotificationType\x12\b\n" +
 	"\x04PING\x10\x00\x12\v\n" +
 	"\aSubtree\x10\x01\x12\t\n" +
@@ -875,7 +928,12 @@ const file_model_model_proto_rawDesc = "" +
 	"\aNotUsed\x10\x03\x12\f\n" +
 	"\bFSMState\x10\x04\x12\x14\n" +
 	"\x10BlockSubtreesSet\x10\x05\x12\x0f\n" +
-	"\vPeerFailure\x10\x06B&Z$github.com/bitcoin-sv/teranode/modelb\x06proto3"
+	"\vPeerFailure\x10\x06\x12\t\n" +
+	"\x05Reorg\x10\a*I\n" +
+	"\x0eBlockSelection\x12\r\n" +
+	"\tMainChain\x10\x00\x12\x17\n" +
+	"\x13MainChainAndOrphans\x10\x01\x12\x0f\n" +
+	"\vOrphansOnly\x10\x02B&Z$github.com/bitcoin-sv/teranode/modelb\x06proto3"
 
 var (
 	file_model_model_proto_rawDescOnce sync.Once
@@ -889,26 +947,27 @@ func file_model_model_proto_rawDescGZIP() []byte {
 	return file_model_model_proto_rawDescData
 }
 
-var file_model_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_model_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_model_model_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_model_model_proto_goTypes = []any{
 	(NotificationType)(0),         // 0: model.NotificationType
-	(*MiningCandidate)(nil),       // 1: model.MiningCandidate
-	(*MiningSolution)(nil),        // 2: model.MiningSolution
-	(*NotificationMetadata)(nil),  // 3: model.NotificationMetadata
-	(*BlockInfo)(nil),             // 4: model.BlockInfo
-	(*SuitableBlock)(nil),         // 5: model.SuitableBlock
-	(*BlockStats)(nil),            // 6: model.BlockStats
-	(*DataPoint)(nil),             // 7: model.DataPoint
-	(*BlockDataPoints)(nil),       // 8: model.BlockDataPoints
-	(*ChainTip)(nil),              // 9: model.ChainTip
-	nil,                           // 10: model.NotificationMetadata.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(BlockSelection)(0),           // 1: model.BlockSelection
+	(*MiningCandidate)(nil),       // 2: model.MiningCandidate
+	(*MiningSolution)(nil),        // 3: model.MiningSolution
+	(*NotificationMetadata)(nil),  // 4: model.NotificationMetadata
+	(*BlockInfo)(nil),             // 5: model.BlockInfo
+	(*SuitableBlock)(nil),         // 6: model.SuitableBlock
+	(*BlockStats)(nil),            // 7: model.BlockStats
+	(*DataPoint)(nil),             // 8: model.DataPoint
+	(*BlockDataPoints)(nil),       // 9: model.BlockDataPoints
+	(*ChainTip)(nil),              // 10: model.ChainTip
+	nil,                           // 11: model.NotificationMetadata.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_model_model_proto_depIdxs = []int32{
-	10, // 0: model.NotificationMetadata.metadata:type_name -> model.NotificationMetadata.MetadataEntry
-	11, // 1: model.BlockInfo.seen_at:type_name -> google.protobuf.Timestamp
-	7,  // 2: model.BlockDataPoints.data_points:type_name -> model.DataPoint
+	11, // 0: model.NotificationMetadata.metadata:type_name -> model.NotificationMetadata.MetadataEntry
+	12, // 1: model.BlockInfo.seen_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: model.BlockDataPoints.data_points:type_name -> model.DataPoint
 	3,  // [3:3] is the sub-list for method output_type
 	3,  // [3:3] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
@@ -927,7 +986,7 @@ func file_model_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_model_proto_rawDesc), len(file_model_model_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
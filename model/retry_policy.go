@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/util/retry"
+)
+
+// RetryPolicy configures the retries of the subtree, subtree meta and parent tx meta fetches of block
+// validation, which can fail on transient errors of the blob and utxo stores. Fields that are not set
+// keep the defaults of the fetch, so the zero value retries as before.
+type RetryPolicy struct {
+	// RetryCount is the number of retries after the first attempt, 0 keeps the default
+	RetryCount int
+	// Backoff is the base delay between the retries, 0 keeps the default
+	Backoff time.Duration
+	// MaxBackoff switches to an exponential backoff from the base delay, capped at MaxBackoff, when set
+	MaxBackoff time.Duration
+}
+
+// RetryPolicyFromSettings returns the retry policy of the block validation fetches configured in the block settings.
+func RetryPolicyFromSettings(tSettings *settings.Settings) RetryPolicy {
+	if tSettings == nil {
+		return RetryPolicy{}
+	}
+
+	return RetryPolicy{
+		RetryCount: tSettings.Block.FetchRetryCount,
+		Backoff:    tSettings.Block.FetchRetryBackoff,
+		MaxBackoff: tSettings.Block.FetchRetryMaxBackoff,
+	}
+}
+
+// options returns the retry options of a fetch, the configured fields of the policy override the defaults of the fetch.
+func (p RetryPolicy) options(message string, defaults ...retry.Options) []retry.Options {
+	opts := append([]retry.Options{retry.WithMessage(message)}, defaults...)
+
+	if p.RetryCount > 0 {
+		opts = append(opts, retry.WithRetryCount(p.RetryCount))
+	}
+
+	if p.Backoff > 0 {
+		opts = append(opts, retry.WithBackoffDurationType(p.Backoff))
+	}
+
+	if p.MaxBackoff > 0 {
+		opts = append(opts, retry.WithExponentialBackoff(), retry.WithMaxBackoff(p.MaxBackoff))
+	}
+
+	return opts
+}
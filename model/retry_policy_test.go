@@ -0,0 +1,36 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/util/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_options(t *testing.T) {
+	defaults := []retry.Options{retry.WithRetryCount(3), retry.WithBackoffDurationType(100 * time.Millisecond)}
+
+	t.Run("unset policy keeps the defaults of the fetch", func(t *testing.T) {
+		opts := retry.NewSetOptions(RetryPolicy{}.options("fetch failed", defaults...)...)
+
+		assert.Equal(t, "fetch failed", opts.Message)
+		assert.Equal(t, 3, opts.RetryCount)
+		assert.Equal(t, 100*time.Millisecond, opts.BackoffDurationType)
+		assert.False(t, opts.ExponentialBackoff)
+	})
+
+	t.Run("configured fields override the defaults", func(t *testing.T) {
+		policy := RetryPolicy{RetryCount: 10, Backoff: 50 * time.Millisecond, MaxBackoff: 5 * time.Second}
+		opts := retry.NewSetOptions(policy.options("fetch failed", defaults...)...)
+
+		assert.Equal(t, 10, opts.RetryCount)
+		assert.Equal(t, 50*time.Millisecond, opts.BackoffDurationType)
+		assert.True(t, opts.ExponentialBackoff)
+		assert.Equal(t, 5*time.Second, opts.MaxBackoff)
+	})
+
+	t.Run("policy from settings", func(t *testing.T) {
+		assert.Equal(t, RetryPolicy{}, RetryPolicyFromSettings(nil))
+	})
+}
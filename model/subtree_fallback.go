@@ -0,0 +1,119 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bitcoin-sv/teranode/util"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+)
+
+const (
+	// subtreeSourceStore is the metric label of subtrees read from the subtree store
+	subtreeSourceStore = "store"
+	// subtreeSourceFallback is the metric label of subtrees fetched from a fallback source, fallback sources
+	// are not labeled by name, which may be a peer URL, to keep the cardinality of the metric fixed
+	subtreeSourceFallback = "fallback"
+)
+
+// SubtreeFallbackSource is a source a subtree is fetched from when it is not found in the subtree store,
+// for example a peer that announced the block.
+type SubtreeFallbackSource struct {
+	// Name identifies the source in logs
+	Name string
+	// Get returns a reader for the serialized subtree, the caller closes the reader
+	Get func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error)
+}
+
+// SubtreeFallbackFromFunc adapts a single fallback function to a list of fallback sources.
+func SubtreeFallbackFromFunc(name string, get func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error)) []SubtreeFallbackSource {
+	return []SubtreeFallbackSource{{Name: name, Get: get}}
+}
+
+// SubtreeFallbacksFromURLs returns a fallback source for each peer base URL, the subtrees are fetched
+// from the /subtree/:hash endpoint of the asset service of the peers in the given order.
+func SubtreeFallbacksFromURLs(baseURLs ...string) []SubtreeFallbackSource {
+	sources := make([]SubtreeFallbackSource, 0, len(baseURLs))
+
+	for _, baseURL := range baseURLs {
+		baseURL := baseURL
+
+		sources = append(sources, SubtreeFallbackSource{
+			Name: baseURL,
+			Get: func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error) {
+				return util.DoHTTPRequestBodyReader(ctx, fmt.Sprintf("%s/subtree/%s", baseURL, subtreeHash.String()))
+			},
+		})
+	}
+
+	return sources
+}
+
+// isSubtreeNotFound returns whether the subtree store does not have the subtree
+func isSubtreeNotFound(err error) bool {
+	return errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBlobNotFound)
+}
+
+// getSubtreeFromFallbacks tries the fallback sources in order and returns a reader of the subtree from the first
+// source that returns a valid subtree with the given hash. Sources that return a subtree that cannot be deserialized
+// or that has a different root hash are skipped, as fallback sources are not trusted.
+func getSubtreeFromFallbacks(ctx context.Context, logger ulogger.Logger, subtreeHash chainhash.Hash, fallbacks []SubtreeFallbackSource) (io.ReadCloser, error) {
+	var lastErr error
+
+	for _, source := range fallbacks {
+		if source.Get == nil {
+			continue
+		}
+
+		subtreeBytes, err := readSubtreeFromFallback(ctx, source, subtreeHash)
+		if err == nil {
+			prometheusBlockSubtreeFetchSource.WithLabelValues(subtreeSourceFallback).Inc()
+
+			return io.NopCloser(bytes.NewReader(subtreeBytes)), nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, errors.NewContextCanceledError("context done while fetching subtree %s from fallback sources", subtreeHash.String(), ctx.Err())
+		}
+
+		logger.Debugf("[BLOCK] subtree %s not fetched from fallback source %s: %v", subtreeHash.String(), source.Name, err)
+
+		lastErr = err
+	}
+
+	return nil, errors.NewNotFoundError("subtree %s not found in the subtree store or %d fallback sources", subtreeHash.String(), len(fallbacks), lastErr)
+}
+
+// readSubtreeFromFallback reads the serialized subtree from the fallback source and checks that it is the
+// subtree with the given hash.
+func readSubtreeFromFallback(ctx context.Context, source SubtreeFallbackSource, subtreeHash chainhash.Hash) ([]byte, error) {
+	readCloser, err := source.Get(ctx, subtreeHash)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = readCloser.Close()
+	}()
+
+	subtreeBytes, err := io.ReadAll(readCloser)
+	if err != nil {
+		return nil, errors.NewProcessingError("failed to read subtree %s from fallback source %s", subtreeHash.String(), source.Name, err)
+	}
+
+	subtree, err := subtreepkg.NewSubtreeFromBytes(subtreeBytes)
+	if err != nil {
+		return nil, errors.NewProcessingError("failed to deserialize subtree %s from fallback source %s", subtreeHash.String(), source.Name, err)
+	}
+
+	if !subtree.RootHash().IsEqual(&subtreeHash) {
+		return nil, errors.NewProcessingError("fallback source %s returned subtree %s instead of subtree %s", source.Name, subtree.RootHash().String(), subtreeHash.String())
+	}
+
+	return subtreeBytes, nil
+}
@@ -0,0 +1,203 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAndValidateSubtrees_Fallbacks(t *testing.T) {
+	blockHeaderBytes, _ := hex.DecodeString(block1Header)
+	blockHeader, err := NewBlockHeaderFromBytes(blockHeaderBytes)
+	require.NoError(t, err)
+
+	coinbase, err := bt.NewTxFromString(CoinbaseHex)
+	require.NoError(t, err)
+
+	subtree, err := subtreepkg.NewTreeByLeafCount(2)
+	require.NoError(t, err)
+	require.NoError(t, subtree.AddCoinbaseNode())
+	require.NoError(t, subtree.AddNode(chainhash.HashH([]byte("tx1")), 1, 1))
+
+	subtreeBytes, err := subtree.Serialize()
+	require.NoError(t, err)
+
+	newBlock := func(t *testing.T) *Block {
+		block, err := NewBlock(blockHeader, coinbase, []*chainhash.Hash{subtree.RootHash()}, 2, 123, 0, 0)
+		require.NoError(t, err)
+
+		return block
+	}
+
+	notFound := func(context.Context, chainhash.Hash) (io.ReadCloser, error) {
+		return nil, errors.NewNotFoundError("subtree not found")
+	}
+
+	found := func(_ context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error) {
+		if !subtreeHash.IsEqual(subtree.RootHash()) {
+			return nil, errors.NewNotFoundError("unexpected subtree")
+		}
+
+		return io.NopCloser(bytes.NewReader(subtreeBytes)), nil
+	}
+
+	t.Run("fallback sources are tried in order", func(t *testing.T) {
+		var called []string
+
+		record := func(name string, get func(context.Context, chainhash.Hash) (io.ReadCloser, error)) SubtreeFallbackSource {
+			return SubtreeFallbackSource{
+				Name: name,
+				Get: func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error) {
+					called = append(called, name)
+					return get(ctx, subtreeHash)
+				},
+			}
+		}
+
+		block := newBlock(t)
+
+		err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{},
+			record("peer1", notFound), record("peer2", found), record("peer3", found))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"peer1", "peer2"}, called)
+		require.Len(t, block.SubtreeSlices, 1)
+		assert.Equal(t, subtree.RootHash(), block.SubtreeSlices[0].RootHash())
+	})
+
+	t.Run("single fallback adapter", func(t *testing.T) {
+		block := newBlock(t)
+
+		err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{}, SubtreeFallbackFromFunc("peer", found)...)
+		require.NoError(t, err)
+		require.Len(t, block.SubtreeSlices, 1)
+	})
+
+	t.Run("fallback from peer URLs", func(t *testing.T) {
+		missing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer missing.Close()
+
+		var requestedPath string
+
+		peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedPath = r.URL.Path
+			_, _ = w.Write(subtreeBytes)
+		}))
+		defer peer.Close()
+
+		block := newBlock(t)
+
+		err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{}, SubtreeFallbacksFromURLs(missing.URL, peer.URL)...)
+		require.NoError(t, err)
+
+		assert.Equal(t, "/subtree/"+subtree.RootHash().String(), requestedPath)
+		require.Len(t, block.SubtreeSlices, 1)
+	})
+
+	t.Run("fallback sources returning another or an invalid subtree are skipped", func(t *testing.T) {
+		otherSubtree, err := subtreepkg.NewTreeByLeafCount(2)
+		require.NoError(t, err)
+		require.NoError(t, otherSubtree.AddCoinbaseNode())
+		require.NoError(t, otherSubtree.AddNode(chainhash.HashH([]byte("tx2")), 1, 1))
+
+		otherSubtreeBytes, err := otherSubtree.Serialize()
+		require.NoError(t, err)
+
+		other := func(context.Context, chainhash.Hash) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(otherSubtreeBytes)), nil
+		}
+
+		garbage := func(context.Context, chainhash.Hash) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader([]byte("not a subtree"))), nil
+		}
+
+		block := newBlock(t)
+
+		err = block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{},
+			SubtreeFallbackSource{Name: "other", Get: other}, SubtreeFallbackSource{Name: "garbage", Get: garbage}, SubtreeFallbackSource{Name: "peer", Get: found})
+		require.NoError(t, err)
+
+		require.Len(t, block.SubtreeSlices, 1)
+		assert.Equal(t, subtree.RootHash(), block.SubtreeSlices[0].RootHash())
+
+		block = newBlock(t)
+
+		err = block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{}, SubtreeFallbackFromFunc("other", other)...)
+		require.Error(t, err)
+	})
+
+	t.Run("block is readable while subtrees are fetched", func(t *testing.T) {
+		block := newBlock(t)
+
+		fetching := make(chan struct{})
+		release := make(chan struct{})
+
+		blocking := func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error) {
+			close(fetching)
+			<-release
+
+			return found(ctx, subtreeHash)
+		}
+
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{}, SubtreeFallbackFromFunc("peer", blocking)...)
+		}()
+
+		<-fetching
+
+		stringCh := make(chan string, 1)
+		go func() {
+			stringCh <- block.String()
+		}()
+
+		select {
+		case str := <-stringCh:
+			assert.Contains(t, str, block.Hash().String())
+		case <-time.After(time.Second):
+			t.Fatal("String blocked while the subtrees were fetched")
+		}
+
+		close(release)
+		require.NoError(t, <-errCh)
+		require.Len(t, block.SubtreeSlices, 1)
+	})
+
+	t.Run("error when no fallback source has the subtree", func(t *testing.T) {
+		block := newBlock(t)
+
+		err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{}, 1, RetryPolicy{}, SubtreeFallbackFromFunc("peer", notFound)...)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrStorageError))
+	})
+
+	t.Run("store errors other than not found do not use the fallbacks", func(t *testing.T) {
+		called := false
+
+		block := newBlock(t)
+
+		err := block.GetAndValidateSubtrees(t.Context(), ulogger.TestLogger{}, &mockSubtreeStore{shouldError: true}, 1, RetryPolicy{},
+			SubtreeFallbackFromFunc("peer", func(ctx context.Context, subtreeHash chainhash.Hash) (io.ReadCloser, error) {
+				called = true
+				return found(ctx, subtreeHash)
+			})...)
+		require.Error(t, err)
+		assert.False(t, called)
+	})
+}
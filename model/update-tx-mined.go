@@ -179,7 +179,10 @@ func updateTxMinedStatus(ctx context.Context, logger ulogger.Logger, tSettings *
 		blockInvalidErrorMu = sync.Mutex{}
 	)
 
-	for subtreeIdx, subtree := range block.SubtreeSlices {
+	// iterate a snapshot, the subtrees of a cached block may be replaced or taken concurrently
+	subtreeSlices, _ := block.SubtreeSlicesSnapshot()
+
+	for subtreeIdx, subtree := range subtreeSlices {
 		subtreeIdx := subtreeIdx
 		subtree := subtree
 
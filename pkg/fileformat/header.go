@@ -10,25 +10,26 @@ import (
 type FileType string
 
 const (
-	FileTypeUtxoAdditions  FileType = "utxo-additions"
-	FileTypeUtxoDeletions  FileType = "utxo-deletions"
-	FileTypeUtxoHeaders    FileType = "utxo-headers"
-	FileTypeUtxoSet        FileType = "utxo-set"
-	FileTypeBlock          FileType = "block"
-	FileTypeSubtree        FileType = "subtree"
-	FileTypeSubtreeToCheck FileType = "subtreeToCheck"
-	FileTypeSubtreeData    FileType = "subtreeData"
-	FileTypeSubtreeMeta    FileType = "subtreeMeta"
-	FileTypeTx             FileType = "tx"
-	FileTypeOutputs        FileType = "outputs"
-	FileTypeBloomFilter    FileType = "bloomfilter"
-	FileTypeDat            FileType = "dat"
-	FileTypeMsgBlock       FileType = "msgBlock"
-	FileTypeTesting        FileType = "testing"
-	FileTypeBatchData      FileType = "batch-data"
-	FileTypeBatchKeys      FileType = "batch-keys"
-	FileTypePreserveUntil  FileType = "preserveUntil"
-	FileTypeUnknown        FileType = ""
+	FileTypeUtxoAdditions   FileType = "utxo-additions"
+	FileTypeUtxoDeletions   FileType = "utxo-deletions"
+	FileTypeUtxoHeaders     FileType = "utxo-headers"
+	FileTypeUtxoSet         FileType = "utxo-set"
+	FileTypeBlock           FileType = "block"
+	FileTypeSubtree         FileType = "subtree"
+	FileTypeSubtreeToCheck  FileType = "subtreeToCheck"
+	FileTypeSubtreeData     FileType = "subtreeData"
+	FileTypeSubtreeMeta     FileType = "subtreeMeta"
+	FileTypeSubtreeChecksum FileType = "subtreeChecksum"
+	FileTypeTx              FileType = "tx"
+	FileTypeOutputs         FileType = "outputs"
+	FileTypeBloomFilter     FileType = "bloomfilter"
+	FileTypeDat             FileType = "dat"
+	FileTypeMsgBlock        FileType = "msgBlock"
+	FileTypeTesting         FileType = "testing"
+	FileTypeBatchData       FileType = "batch-data"
+	FileTypeBatchKeys       FileType = "batch-keys"
+	FileTypePreserveUntil   FileType = "preserveUntil"
+	FileTypeUnknown         FileType = ""
 )
 
 func (f FileType) String() string {
@@ -41,66 +42,69 @@ func (f FileType) ToMagicBytes() [8]byte {
 
 // Magic header types for file identification - exactly 8 ASCII characters (8 bytes)
 var (
-	magicUtxoAdditions  = [8]byte{'U', '-', 'A', '-', '1', '.', '0', ' '} // U-A-1.0
-	magicUtxoDeletions  = [8]byte{'U', '-', 'D', '-', '1', '.', '0', ' '} // U-D-1.0
-	magicUtxoHeaders    = [8]byte{'U', '-', 'H', '-', '1', '.', '0', ' '} // U-H-1.0
-	magicUtxoSet        = [8]byte{'U', '-', 'S', '-', '1', '.', '0', ' '} // U-S-1.0
-	magicBlock          = [8]byte{'B', '-', '1', '.', '0', ' ', ' ', ' '} // B-1.0
-	magicSubtree        = [8]byte{'S', '-', '1', '.', '0', ' ', ' ', ' '} // S-1.0
-	magicSubtreeToCheck = [8]byte{'S', 'C', '-', '1', '.', '0', ' ', ' '} // SC-1.0
-	magicSubtreeData    = [8]byte{'S', 'D', '-', '1', '.', '0', ' ', ' '} // SD-1.0
-	magicSubtreeMeta    = [8]byte{'S', 'M', '-', '1', '.', '0', ' ', ' '} // SM-1.0
-	magicTx             = [8]byte{'T', '-', '1', '.', '0', ' ', ' ', ' '} // T-1.0
-	magicOutputs        = [8]byte{'O', '-', '1', '.', '0', ' ', ' ', ' '} // O-1.0
-	magicBloomFilter    = [8]byte{'B', 'F', '-', '1', '.', '0', ' ', ' '} // BF-1.0
-	magicMsgBlock       = [8]byte{'M', 'B', '-', '1', '.', '0', ' ', ' '} // MB-1.0
-	magicDat            = [8]byte{'D', 'A', 'T', '-', '1', '.', '0', ' '} // DAT-1.0
-	magicTesting        = [8]byte{'T', 'E', 'S', 'T', 'I', 'N', 'G', ' '} // TESTING
-	magicBatchData      = [8]byte{'B', 'D', '-', '1', '.', '0', ' ', ' '} // BD-1.0
-	magicBatchKeys      = [8]byte{'B', 'K', '-', '1', '.', '0', ' ', ' '} // BK-1.0
-	magicPreserveUntil  = [8]byte{'P', 'U', '-', '1', '.', '0', ' ', ' '} // PU-1.0
+	magicUtxoAdditions   = [8]byte{'U', '-', 'A', '-', '1', '.', '0', ' '} // U-A-1.0
+	magicUtxoDeletions   = [8]byte{'U', '-', 'D', '-', '1', '.', '0', ' '} // U-D-1.0
+	magicUtxoHeaders     = [8]byte{'U', '-', 'H', '-', '1', '.', '0', ' '} // U-H-1.0
+	magicUtxoSet         = [8]byte{'U', '-', 'S', '-', '1', '.', '0', ' '} // U-S-1.0
+	magicBlock           = [8]byte{'B', '-', '1', '.', '0', ' ', ' ', ' '} // B-1.0
+	magicSubtree         = [8]byte{'S', '-', '1', '.', '0', ' ', ' ', ' '} // S-1.0
+	magicSubtreeToCheck  = [8]byte{'S', 'C', '-', '1', '.', '0', ' ', ' '} // SC-1.0
+	magicSubtreeData     = [8]byte{'S', 'D', '-', '1', '.', '0', ' ', ' '} // SD-1.0
+	magicSubtreeMeta     = [8]byte{'S', 'M', '-', '1', '.', '0', ' ', ' '} // SM-1.0
+	magicSubtreeChecksum = [8]byte{'S', 'X', '-', '1', '.', '0', ' ', ' '} // SX-1.0
+	magicTx              = [8]byte{'T', '-', '1', '.', '0', ' ', ' ', ' '} // T-1.0
+	magicOutputs         = [8]byte{'O', '-', '1', '.', '0', ' ', ' ', ' '} // O-1.0
+	magicBloomFilter     = [8]byte{'B', 'F', '-', '1', '.', '0', ' ', ' '} // BF-1.0
+	magicMsgBlock        = [8]byte{'M', 'B', '-', '1', '.', '0', ' ', ' '} // MB-1.0
+	magicDat             = [8]byte{'D', 'A', 'T', '-', '1', '.', '0', ' '} // DAT-1.0
+	magicTesting         = [8]byte{'T', 'E', 'S', 'T', 'I', 'N', 'G', ' '} // TESTING
+	magicBatchData       = [8]byte{'B', 'D', '-', '1', '.', '0', ' ', ' '} // BD-1.0
+	magicBatchKeys       = [8]byte{'B', 'K', '-', '1', '.', '0', ' ', ' '} // BK-1.0
+	magicPreserveUntil   = [8]byte{'P', 'U', '-', '1', '.', '0', ' ', ' '} // PU-1.0
 )
 
 var fileTypeToMagic = map[FileType][8]byte{
-	FileTypeUtxoAdditions:  magicUtxoAdditions,
-	FileTypeUtxoDeletions:  magicUtxoDeletions,
-	FileTypeUtxoHeaders:    magicUtxoHeaders,
-	FileTypeUtxoSet:        magicUtxoSet,
-	FileTypeBlock:          magicBlock,
-	FileTypeSubtree:        magicSubtree,
-	FileTypeSubtreeToCheck: magicSubtreeToCheck,
-	FileTypeSubtreeData:    magicSubtreeData,
-	FileTypeSubtreeMeta:    magicSubtreeMeta,
-	FileTypeTx:             magicTx,
-	FileTypeOutputs:        magicOutputs,
-	FileTypeBloomFilter:    magicBloomFilter,
-	FileTypeMsgBlock:       magicMsgBlock,
-	FileTypeDat:            magicDat,
-	FileTypeTesting:        magicTesting,
-	FileTypeBatchData:      magicBatchData,
-	FileTypeBatchKeys:      magicBatchKeys,
-	FileTypePreserveUntil:  magicPreserveUntil,
+	FileTypeUtxoAdditions:   magicUtxoAdditions,
+	FileTypeUtxoDeletions:   magicUtxoDeletions,
+	FileTypeUtxoHeaders:     magicUtxoHeaders,
+	FileTypeUtxoSet:         magicUtxoSet,
+	FileTypeBlock:           magicBlock,
+	FileTypeSubtree:         magicSubtree,
+	FileTypeSubtreeToCheck:  magicSubtreeToCheck,
+	FileTypeSubtreeData:     magicSubtreeData,
+	FileTypeSubtreeMeta:     magicSubtreeMeta,
+	FileTypeSubtreeChecksum: magicSubtreeChecksum,
+	FileTypeTx:              magicTx,
+	FileTypeOutputs:         magicOutputs,
+	FileTypeBloomFilter:     magicBloomFilter,
+	FileTypeMsgBlock:        magicMsgBlock,
+	FileTypeDat:             magicDat,
+	FileTypeTesting:         magicTesting,
+	FileTypeBatchData:       magicBatchData,
+	FileTypeBatchKeys:       magicBatchKeys,
+	FileTypePreserveUntil:   magicPreserveUntil,
 }
 
 var magicToFileType = map[[8]byte]FileType{
-	magicUtxoAdditions:  FileTypeUtxoAdditions,
-	magicUtxoDeletions:  FileTypeUtxoDeletions,
-	magicUtxoHeaders:    FileTypeUtxoHeaders,
-	magicUtxoSet:        FileTypeUtxoSet,
-	magicBlock:          FileTypeBlock,
-	magicSubtree:        FileTypeSubtree,
-	magicSubtreeToCheck: FileTypeSubtreeToCheck,
-	magicSubtreeData:    FileTypeSubtreeData,
-	magicSubtreeMeta:    FileTypeSubtreeMeta,
-	magicTx:             FileTypeTx,
-	magicOutputs:        FileTypeOutputs,
-	magicBloomFilter:    FileTypeBloomFilter,
-	magicMsgBlock:       FileTypeMsgBlock,
-	magicDat:            FileTypeDat,
-	magicTesting:        FileTypeTesting,
-	magicBatchData:      FileTypeBatchData,
-	magicBatchKeys:      FileTypeBatchKeys,
-	magicPreserveUntil:  FileTypePreserveUntil,
+	magicUtxoAdditions:   FileTypeUtxoAdditions,
+	magicUtxoDeletions:   FileTypeUtxoDeletions,
+	magicUtxoHeaders:     FileTypeUtxoHeaders,
+	magicUtxoSet:         FileTypeUtxoSet,
+	magicBlock:           FileTypeBlock,
+	magicSubtree:         FileTypeSubtree,
+	magicSubtreeToCheck:  FileTypeSubtreeToCheck,
+	magicSubtreeData:     FileTypeSubtreeData,
+	magicSubtreeMeta:     FileTypeSubtreeMeta,
+	magicSubtreeChecksum: FileTypeSubtreeChecksum,
+	magicTx:              FileTypeTx,
+	magicOutputs:         FileTypeOutputs,
+	magicBloomFilter:     FileTypeBloomFilter,
+	magicMsgBlock:        FileTypeMsgBlock,
+	magicDat:             FileTypeDat,
+	magicTesting:         FileTypeTesting,
+	magicBatchData:       FileTypeBatchData,
+	magicBatchKeys:       FileTypeBatchKeys,
+	magicPreserveUntil:   FileTypePreserveUntil,
 }
 
 type Header struct {
@@ -88,6 +88,7 @@ func TestNewHeader_AllFileTypes(t *testing.T) {
 		FileTypeSubtreeToCheck,
 		FileTypeSubtreeData,
 		FileTypeSubtreeMeta,
+		FileTypeSubtreeChecksum,
 		FileTypeTx,
 		FileTypeOutputs,
 		FileTypeBloomFilter,
@@ -270,6 +271,7 @@ func TestHeader_FileType(t *testing.T) {
 		{FileTypeSubtreeToCheck, magicSubtreeToCheck},
 		{FileTypeSubtreeData, magicSubtreeData},
 		{FileTypeSubtreeMeta, magicSubtreeMeta},
+		{FileTypeSubtreeChecksum, magicSubtreeChecksum},
 		{FileTypeTx, magicTx},
 		{FileTypeOutputs, magicOutputs},
 		{FileTypeBloomFilter, magicBloomFilter},
@@ -318,6 +320,7 @@ func TestFileTypeFromExtension(t *testing.T) {
 		{"subtreeToCheck", FileTypeSubtreeToCheck, false},
 		{"subtreeData", FileTypeSubtreeData, false},
 		{"subtreeMeta", FileTypeSubtreeMeta, false},
+		{"subtreeChecksum", FileTypeSubtreeChecksum, false},
 		{"tx", FileTypeTx, false},
 		{"outputs", FileTypeOutputs, false},
 		{"bloomfilter", FileTypeBloomFilter, false},
@@ -348,24 +351,25 @@ func TestFileTypeFromExtension(t *testing.T) {
 // TestMagicConstants tests that all magic constants are properly defined
 func TestMagicConstants(t *testing.T) {
 	expectedMagics := map[FileType][8]byte{
-		FileTypeUtxoAdditions:  magicUtxoAdditions,
-		FileTypeUtxoDeletions:  magicUtxoDeletions,
-		FileTypeUtxoHeaders:    magicUtxoHeaders,
-		FileTypeUtxoSet:        magicUtxoSet,
-		FileTypeBlock:          magicBlock,
-		FileTypeSubtree:        magicSubtree,
-		FileTypeSubtreeToCheck: magicSubtreeToCheck,
-		FileTypeSubtreeData:    magicSubtreeData,
-		FileTypeSubtreeMeta:    magicSubtreeMeta,
-		FileTypeTx:             magicTx,
-		FileTypeOutputs:        magicOutputs,
-		FileTypeBloomFilter:    magicBloomFilter,
-		FileTypeMsgBlock:       magicMsgBlock,
-		FileTypeDat:            magicDat,
-		FileTypeTesting:        magicTesting,
-		FileTypeBatchData:      magicBatchData,
-		FileTypeBatchKeys:      magicBatchKeys,
-		FileTypePreserveUntil:  magicPreserveUntil,
+		FileTypeUtxoAdditions:   magicUtxoAdditions,
+		FileTypeUtxoDeletions:   magicUtxoDeletions,
+		FileTypeUtxoHeaders:     magicUtxoHeaders,
+		FileTypeUtxoSet:         magicUtxoSet,
+		FileTypeBlock:           magicBlock,
+		FileTypeSubtree:         magicSubtree,
+		FileTypeSubtreeToCheck:  magicSubtreeToCheck,
+		FileTypeSubtreeData:     magicSubtreeData,
+		FileTypeSubtreeMeta:     magicSubtreeMeta,
+		FileTypeSubtreeChecksum: magicSubtreeChecksum,
+		FileTypeTx:              magicTx,
+		FileTypeOutputs:         magicOutputs,
+		FileTypeBloomFilter:     magicBloomFilter,
+		FileTypeMsgBlock:        magicMsgBlock,
+		FileTypeDat:             magicDat,
+		FileTypeTesting:         magicTesting,
+		FileTypeBatchData:       magicBatchData,
+		FileTypeBatchKeys:       magicBatchKeys,
+		FileTypePreserveUntil:   magicPreserveUntil,
 	}
 
 	for fileType, expectedMagic := range expectedMagics {
@@ -408,6 +412,7 @@ func TestHeader_RoundTripAllTypes(t *testing.T) {
 		FileTypeSubtreeToCheck,
 		FileTypeSubtreeData,
 		FileTypeSubtreeMeta,
+		FileTypeSubtreeChecksum,
 		FileTypeTx,
 		FileTypeOutputs,
 		FileTypeBloomFilter,
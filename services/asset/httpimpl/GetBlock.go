@@ -4,7 +4,6 @@
 package httpimpl
 
 import (
-	"encoding/hex"
 	"net/http"
 	"strconv"
 	"strings"
@@ -189,19 +188,7 @@ func (h *HTTP) GetBlockByHeight(mode ReadMode) func(c echo.Context) error {
 			return c.JSONPretty(200, blockExtended, "  ")
 		}
 
-		b, err := block.Bytes()
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-		}
-
-		switch mode {
-		case BINARY_STREAM:
-			return c.Blob(200, echo.MIMEOctetStream, b)
-		case HEX:
-			return c.String(200, hex.EncodeToString(b))
-		default:
-			return echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("bad read mode").Error())
-		}
+		return writeBlocks(c, mode, block)
 	}
 }
 
@@ -340,18 +327,6 @@ func (h *HTTP) GetBlockByHash(mode ReadMode) func(c echo.Context) error {
 			return c.JSONPretty(200, blockExtended, "  ")
 		}
 
-		b, err := block.Bytes()
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-		}
-
-		switch mode {
-		case BINARY_STREAM:
-			return c.Blob(200, echo.MIMEOctetStream, b)
-		case HEX:
-			return c.String(200, hex.EncodeToString(b))
-		default:
-			return echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("bad read mode").Error())
-		}
+		return writeBlocks(c, mode, block)
 	}
 }
@@ -25,7 +25,10 @@ import (
 //   - limit: Maximum number of blocks to return (default: 20, max: 100)
 //     Example: ?limit=50
 //
-//   - includeOrphans: Whether to include orphaned blocks (default: false)
+//   - chain: Which blocks to return, "main", "orphans" or "all" (default: main)
+//     Example: ?chain=all
+//
+//   - includeOrphans: Whether to include orphaned blocks, same as chain=all (default: false)
 //     Example: ?includeOrphans=true
 //
 // Returns:
@@ -66,6 +69,8 @@ import (
 //   - Invalid limit parameter
 //     Example: {"message": "strconv.Atoi: parsing \"invalid\": invalid syntax"}
 //
+//   - Invalid chain parameter
+//
 //   - 404 Not Found:
 //
 //   - No blocks found
@@ -90,14 +95,14 @@ import (
 //	GET /blocks?offset=100&limit=50
 //
 //	# Include orphaned blocks
-//	GET /blocks?limit=50&includeOrphans=true
+//	GET /blocks?limit=50&chain=all
 //
 // Notes:
-//   - Blocks are returned in descending order (newest first)
+//   - Blocks are returned in descending order (newest first), blocks at the same height are ordered by hash
 //   - Offset is calculated from the chain tip
 //   - Total records includes genesis block (height 0)
 //   - Response is pretty-printed JSON for readability
-//   - When includeOrphans=true, orphaned blocks at the same height are included
+//   - When chain=all, orphaned blocks at the same height are included
 func (h *HTTP) GetBlocks(c echo.Context) error {
 	ctx, _, deferFn := tracing.Tracer("asset").Start(c.Request().Context(), "GetBlocks_http",
 		tracing.WithParentStat(AssetStat),
@@ -111,7 +116,11 @@ func (h *HTTP) GetBlocks(c echo.Context) error {
 		return err
 	}
 
-	includeOrphans := c.QueryParam("includeOrphans") == "true"
+	selection, err := h.getBlockSelection(c)
+	if err != nil {
+		// err is already an echo.HTTPError
+		return err
+	}
 
 	// First we find the latest block height
 	_, blockMeta, err := h.repository.GetBestBlockHeader(ctx)
@@ -128,7 +137,7 @@ func (h *HTTP) GetBlocks(c echo.Context) error {
 
 	h.logger.Debugf("[Asset_http] GetBlockChain for %s with offset = %d, limit = %d and fromHeight = %d", c.Request().RemoteAddr, offset, limit, fromHeight)
 
-	blocks, err := h.repository.GetLastNBlocks(ctx, int64(limit), includeOrphans, fromHeight)
+	blocks, err := h.repository.GetLastNBlocks(ctx, int64(limit), selection, fromHeight)
 	if err != nil {
 		if errors.Is(err, errors.ErrNotFound) || strings.Contains(err.Error(), "not found") {
 			return echo.NewHTTPError(http.StatusNotFound, errors.NewNotFoundError("blocks not found").Error())
@@ -13,7 +13,7 @@ import (
 )
 
 // GetLastNBlocks handles HTTP GET requests to retrieve the most recent blocks
-// in the blockchain. It supports filtering and optional inclusion of orphaned blocks.
+// in the blockchain. It supports filtering and the selection of main chain blocks, orphaned blocks or both.
 //
 // Parameters:
 //   - c: Echo context containing the HTTP request and response
@@ -26,7 +26,10 @@ import (
 //   - fromHeight: Starting block height for retrieval (default: 0)
 //     Example: ?fromHeight=100000
 //
-//   - includeOrphans: Whether to include orphaned blocks (default: false)
+//   - chain: Which blocks to return, "main", "orphans" or "all" (default: main)
+//     Example: ?chain=all
+//
+//   - includeOrphans: Whether to include orphaned blocks, same as chain=all (default: false)
 //     Example: ?includeOrphans=true
 //
 // Returns:
@@ -60,6 +63,8 @@ import (
 //   - Invalid fromHeight parameter
 //     Example: {"message": "strconv.ParseInt: parsing \"invalid\": invalid syntax"}
 //
+//   - Invalid chain parameter
+//
 //   - 404 Not Found:
 //
 //   - No blocks found
@@ -86,13 +91,16 @@ import (
 //	GET /blocks/last?fromHeight=100000
 //
 //	# Get last 10 blocks including orphans
-//	GET /blocks/last?includeOrphans=true
+//	GET /blocks/last?chain=all
+//
+//	# Get last 10 orphaned blocks
+//	GET /blocks/last?chain=orphans
 //
 // Notes:
-//   - Blocks are returned in descending order (newest first)
+//   - Blocks are returned in descending order (newest first), blocks at the same height are ordered by hash
 //   - When fromHeight is specified, counting starts from that height downward
 //   - Response is pretty-printed JSON for readability
-//   - When includeOrphans=true, orphaned blocks at the same height are included
+//   - When chain=all, orphaned blocks at the same height are included
 func (h *HTTP) GetLastNBlocks(c echo.Context) error {
 	queryN := c.QueryParam("n")
 	queryFromHeight := c.QueryParam("fromHeight")
@@ -122,14 +130,18 @@ func (h *HTTP) GetLastNBlocks(c echo.Context) error {
 		}
 	}
 
-	includeOrphans := c.QueryParam("includeOrphans") == "true"
+	selection, err := h.getBlockSelection(c)
+	if err != nil {
+		// err is already an echo.HTTPError
+		return err
+	}
 
 	fromHeightUint32, err := safeconversion.Uint64ToUint32(fromHeight)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("invalid 'fromHeight' parameter", err).Error())
 	}
 
-	blocks, err := h.repository.GetLastNBlocks(ctx, n, includeOrphans, fromHeightUint32)
+	blocks, err := h.repository.GetLastNBlocks(ctx, n, selection, fromHeightUint32)
 	if err != nil {
 		if errors.Is(err, errors.ErrNotFound) || strings.Contains(err.Error(), "not found") {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
@@ -115,6 +115,58 @@ func TestGetLastNBlocks(t *testing.T) {
 		assert.Equal(t, "INVALID_ARGUMENT (1): invalid 'fromHeight' parameter -> UNKNOWN (0): strconv.ParseUint: parsing \"invalid\": invalid syntax", echoErr.Message)
 	})
 
+	t.Run("chain parameter selects the blocks", func(t *testing.T) {
+		for _, tc := range []struct {
+			chain          string
+			includeOrphans string
+			expected       model.BlockSelection
+		}{
+			{chain: "", expected: model.BlockSelection_MainChain},
+			{chain: "", includeOrphans: "true", expected: model.BlockSelection_MainChainAndOrphans},
+			{chain: "main", expected: model.BlockSelection_MainChain},
+			{chain: "main", includeOrphans: "true", expected: model.BlockSelection_MainChain},
+			{chain: "orphans", expected: model.BlockSelection_OrphansOnly},
+			{chain: "all", expected: model.BlockSelection_MainChainAndOrphans},
+		} {
+			httpServer, mockRepo, echoContext, responseRecorder := GetMockHTTP(t, nil)
+
+			mockRepo.On("GetLastNBlocks", int64(10), tc.expected, uint32(0)).Return([]*model.BlockInfo{testBlockInfo}, nil)
+
+			echoContext.SetPath("/blocks/last")
+
+			if tc.chain != "" {
+				echoContext.QueryParams().Set("chain", tc.chain)
+			}
+
+			if tc.includeOrphans != "" {
+				echoContext.QueryParams().Set("includeOrphans", tc.includeOrphans)
+			}
+
+			require.NoError(t, httpServer.GetLastNBlocks(echoContext))
+			assert.Equal(t, http.StatusOK, responseRecorder.Code)
+			mockRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("Invalid 'chain' parameter", func(t *testing.T) {
+		httpServer, _, echoContext, _ := GetMockHTTP(t, nil)
+
+		// set echo context
+		echoContext.SetPath("/blocks/last")
+		echoContext.QueryParams().Set("chain", "invalid")
+
+		// Call GetLastNBlocks handler
+		err := httpServer.GetLastNBlocks(echoContext)
+		echoErr := &echo.HTTPError{}
+		require.True(t, errors.As(err, &echoErr))
+
+		// Check response status code
+		assert.Equal(t, http.StatusBadRequest, echoErr.Code)
+
+		// Check response body
+		assert.Equal(t, "INVALID_ARGUMENT (1): invalid 'chain' parameter, expected main, orphans or all", echoErr.Message)
+	})
+
 	t.Run("Repository error", func(t *testing.T) {
 		httpServer, mockRepo, echoContext, _ := GetMockHTTP(t, nil)
 
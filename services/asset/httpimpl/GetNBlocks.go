@@ -3,11 +3,13 @@ package httpimpl
 
 import (
 	"encoding/hex"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/util/tracing"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
@@ -169,25 +171,45 @@ func (h *HTTP) GetNBlocks(mode ReadMode) func(c echo.Context) error {
 			return c.JSONPretty(200, blocks, "  ")
 		}
 
-		bytes := make([]byte, 0, len(blocks)*32*1024)
+		return writeBlocks(c, mode, blocks...)
+	}
+}
 
-		for _, block := range blocks {
-			blockBytes, err := block.Bytes()
-			if err != nil {
-				// error is already properly formed
-				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-			}
+// writeBlocks streams the serialized blocks to the response, hex encoded in HEX mode, without
+// buffering them in memory. A block that cannot be serialized is detected before the response
+// is committed, later errors can only come from writing to the client.
+func writeBlocks(c echo.Context, mode ReadMode, blocks ...*model.Block) error {
+	var contentType string
+
+	switch mode {
+	case BINARY_STREAM:
+		contentType = echo.MIMEOctetStream
+	case HEX:
+		contentType = echo.MIMETextPlainCharsetUTF8
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("bad read mode").Error())
+	}
 
-			bytes = append(bytes, blockBytes...)
+	for _, block := range blocks {
+		if block.Header == nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, errors.NewBlockInvalidError("[BLOCK][%s] block has no header", block.String()).Error())
 		}
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, contentType)
+	resp.WriteHeader(http.StatusOK)
 
-		switch mode {
-		case BINARY_STREAM:
-			return c.Blob(200, echo.MIMEOctetStream, bytes)
-		case HEX:
-			return c.String(200, hex.EncodeToString(bytes))
-		default:
-			return echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("bad read mode").Error())
+	var w io.Writer = resp
+	if mode == HEX {
+		w = hex.NewEncoder(resp)
+	}
+
+	for _, block := range blocks {
+		if err := block.SerializeTo(w); err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
@@ -4,8 +4,11 @@ package httpimpl
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
 	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/labstack/echo/v4"
@@ -78,20 +81,48 @@ func NewBlockHandler(blockchainClient blockchain.ClientI, logger ulogger.Logger)
 // Returns:
 //   - error: Any error encountered during operation processing
 func (h *BlockHandler) handleBlockOperation(c echo.Context, operationName string, operation blockOperation) error {
+	blockHash, err := h.parseBlockRequest(c, operationName)
+	if err != nil {
+		return err
+	}
+
+	// Call the blockchain service to perform the operation
+	if err = operation(c, blockHash); err != nil {
+		h.logger.Errorf("Failed to %s block %s: %v", operationName, blockHash, err)
+
+		if errors.Is(err, errors.ErrThresholdExceeded) {
+			return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("Failed to %s block: %s", operationName, err.Error()))
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to %s block: %s", operationName, err.Error()))
+	}
+
+	h.logger.Infof("Block %s successfully: %s", operationName, blockHash)
+
+	// Return success response
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Block %s successfully", operationName),
+	})
+}
+
+// parseBlockRequest parses the block hash from the request body and checks that the block exists.
+// The returned error is an echo.HTTPError with the status code to respond with.
+func (h *BlockHandler) parseBlockRequest(c echo.Context, operationName string) (*chainhash.Hash, error) {
 	// Parse the block hash from the request body
 	var request blockRequest
 	if err := c.Bind(&request); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid request body: "+err.Error())
 	}
 
 	if request.BlockHash == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "BlockHash is required")
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "BlockHash is required")
 	}
 
 	// Convert the string hash to chainhash.Hash
 	blockHash, err := chainhash.NewHashFromStr(request.BlockHash)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid block hash format: "+err.Error())
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid block hash format: "+err.Error())
 	}
 
 	ctx := c.Request().Context()
@@ -102,27 +133,15 @@ func (h *BlockHandler) handleBlockOperation(c echo.Context, operationName string
 	exists, err := h.blockchainClient.GetBlockExists(ctx, blockHash)
 	if err != nil {
 		h.logger.Errorf("Error checking if block %s exists: %v", blockHash, err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Error checking if block exists: "+err.Error())
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Error checking if block exists: "+err.Error())
 	}
 
 	if !exists {
 		h.logger.Warnf("Block not found for %s: %s", operationName, blockHash)
-		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Block with hash %s not found", blockHash))
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Block with hash %s not found", blockHash))
 	}
 
-	// Call the blockchain service to perform the operation
-	if err := operation(c, blockHash); err != nil {
-		h.logger.Errorf("Failed to %s block %s: %v", operationName, blockHash, err)
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to %s block: %s", operationName, err.Error()))
-	}
-
-	h.logger.Infof("Block %s successfully: %s", operationName, blockHash)
-
-	// Return success response
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Block %s successfully", operationName),
-	})
+	return blockHash, nil
 }
 
 // InvalidateBlock handles HTTP requests to invalidate a block.
@@ -133,11 +152,17 @@ func (h *BlockHandler) handleBlockOperation(c echo.Context, operationName string
 // The endpoint expects a JSON request body with a 'blockHash' field containing the
 // hexadecimal string representation of the block hash to invalidate.
 //
+// Query Parameters:
+//   - dryrun: When true (e.g. dryrun=1), nothing is invalidated. The response lists the
+//     blocks that would be invalidated, with their height and whether they are on the best chain
+//
 // HTTP Method: POST
 // Response Codes:
-//   - 200: Block successfully invalidated
+//   - 200: Block successfully invalidated, or the affected blocks for a dry run
 //   - 400: Invalid request format or block hash
 //   - 404: Block not found
+//   - 409: Block is deeper than blockchain_maxReorgDepth below the best block, it can only be
+//     invalidated with force through the blockchain service
 //   - 500: Internal server error during invalidation
 //
 // Parameters:
@@ -146,10 +171,22 @@ func (h *BlockHandler) handleBlockOperation(c echo.Context, operationName string
 // Returns:
 //   - error: Any error encountered during block invalidation
 func (h *BlockHandler) InvalidateBlock(c echo.Context) error {
+	if dryRunStr := c.QueryParam("dryrun"); dryRunStr != "" {
+		dryRun, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid dryrun parameter: "+err.Error())
+		}
+
+		if dryRun {
+			return h.invalidateBlockDryRun(c)
+		}
+	}
+
 	return h.handleBlockOperation(c, "invalidate", func(ctx echo.Context, blockHash *chainhash.Hash) error {
 		h.logger.Infof("[InvalidateBlock] HTTP request to invalidate block %s", blockHash.String())
 
-		invalidatedHashes, err := h.blockchainClient.InvalidateBlock(ctx.Request().Context(), blockHash)
+		// invalidations requested by an operator are limited to the max reorg depth of the blockchain service
+		invalidatedHashes, err := h.blockchainClient.InvalidateBlock(ctx.Request().Context(), blockHash, options.WithCheckMaxReorgDepth(true))
 		if err != nil {
 			return err
 		}
@@ -159,6 +196,30 @@ func (h *BlockHandler) InvalidateBlock(c echo.Context) error {
 	})
 }
 
+// invalidateBlockDryRun responds with the blocks that would be invalidated by invalidating
+// the block in the request, without invalidating them.
+func (h *BlockHandler) invalidateBlockDryRun(c echo.Context) error {
+	blockHash, err := h.parseBlockRequest(c, "dry run invalidate")
+	if err != nil {
+		return err
+	}
+
+	affectedBlocks, err := h.blockchainClient.InvalidateBlockDryRun(c.Request().Context(), blockHash)
+	if err != nil {
+		h.logger.Errorf("Failed to dry run invalidate block %s: %v", blockHash, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to dry run invalidate block: "+err.Error())
+	}
+
+	h.logger.Infof("[InvalidateBlock] Dry run: invalidating block %s would invalidate %d blocks", blockHash.String(), len(affectedBlocks))
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"dryRun":  true,
+		"count":   len(affectedBlocks),
+		"blocks":  affectedBlocks,
+	})
+}
+
 // RevalidateBlock handles HTTP requests to revalidate a previously invalidated block.
 // This endpoint attempts to return a block that was previously marked as invalid
 // back to the validated state. The operation may trigger reprocessing of the block
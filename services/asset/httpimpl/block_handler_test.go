@@ -334,6 +334,111 @@ func TestInvalidateBlock(t *testing.T) {
 		// Verify that the mock was called with the correct arguments
 		mockClient.AssertCalled(t, "InvalidateBlock", mock.Anything, blockHash)
 	})
+
+	t.Run("Block deeper than the max reorg depth", func(t *testing.T) {
+		requestBody := `{"blockHash": "` + validBlockHash + `"}`
+		handler, mockClient, c, _ := setupBlockHandlerTest(t, requestBody)
+
+		blockHash, _ := chainhash.NewHashFromStr(validBlockHash)
+		mockClient.On("GetBlockExists", mock.Anything, blockHash).Return(true, nil)
+		mockClient.On("InvalidateBlock", mock.Anything, blockHash).Return([]chainhash.Hash{}, errors.NewThresholdExceededError("block is too deep"))
+
+		err := handler.InvalidateBlock(c)
+
+		httpErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, httpErr.Code)
+	})
+}
+
+// TestInvalidateBlockDryRun tests the InvalidateBlock method with the dryrun query parameter
+func TestInvalidateBlockDryRun(t *testing.T) {
+	const validBlockHash = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f" // Bitcoin genesis block hash
+
+	setupDryRunTest := func(t *testing.T, dryRun string) (*BlockHandler, *blockchain.Mock, echo.Context, *httptest.ResponseRecorder) {
+		handler, mockClient, c, rec := setupBlockHandlerTest(t, `{"blockHash": "`+validBlockHash+`"}`)
+		c.QueryParams().Set("dryrun", dryRun)
+
+		return handler, mockClient, c, rec
+	}
+
+	t.Run("Success case", func(t *testing.T) {
+		handler, mockClient, c, rec := setupDryRunTest(t, "1")
+
+		blockHash, _ := chainhash.NewHashFromStr(validBlockHash)
+		childHash := chainhash.Hash{1}
+
+		mockClient.On("GetBlockExists", mock.Anything, blockHash).Return(true, nil)
+		mockClient.On("InvalidateBlockDryRun", mock.Anything, blockHash).Return([]*model.AffectedBlock{
+			{Hash: blockHash, Height: 0, OnBestChain: true},
+			{Hash: &childHash, Height: 1, OnBestChain: false},
+		}, nil)
+
+		err := handler.InvalidateBlock(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Success bool                   `json:"success"`
+			DryRun  bool                   `json:"dryRun"`
+			Count   int                    `json:"count"`
+			Blocks  []*model.AffectedBlock `json:"blocks"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+		assert.True(t, response.Success)
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 2, response.Count)
+		require.Len(t, response.Blocks, 2)
+		assert.Equal(t, blockHash, response.Blocks[0].Hash)
+		assert.True(t, response.Blocks[0].OnBestChain)
+		assert.Equal(t, &childHash, response.Blocks[1].Hash)
+		assert.Equal(t, uint32(1), response.Blocks[1].Height)
+		assert.False(t, response.Blocks[1].OnBestChain)
+
+		mockClient.AssertNotCalled(t, "InvalidateBlock", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Dry run disabled", func(t *testing.T) {
+		handler, mockClient, c, rec := setupDryRunTest(t, "0")
+
+		blockHash, _ := chainhash.NewHashFromStr(validBlockHash)
+		mockClient.On("GetBlockExists", mock.Anything, blockHash).Return(true, nil)
+		mockClient.On("InvalidateBlock", mock.Anything, blockHash).Return([]chainhash.Hash{*blockHash}, nil)
+
+		err := handler.InvalidateBlock(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		mockClient.AssertCalled(t, "InvalidateBlock", mock.Anything, blockHash)
+		mockClient.AssertNotCalled(t, "InvalidateBlockDryRun", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Invalid dryrun parameter", func(t *testing.T) {
+		handler, _, c, _ := setupDryRunTest(t, "maybe")
+
+		err := handler.InvalidateBlock(c)
+
+		httpErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		assert.Contains(t, httpErr.Message, "Invalid dryrun parameter")
+	})
+
+	t.Run("Error from InvalidateBlockDryRun", func(t *testing.T) {
+		handler, mockClient, c, _ := setupDryRunTest(t, "true")
+
+		blockHash, _ := chainhash.NewHashFromStr(validBlockHash)
+		mockClient.On("GetBlockExists", mock.Anything, blockHash).Return(true, nil)
+		mockClient.On("InvalidateBlockDryRun", mock.Anything, blockHash).Return(nil, errors.ErrServiceError)
+
+		err := handler.InvalidateBlock(c)
+
+		httpErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+		assert.Contains(t, httpErr.Message, "Failed to dry run invalidate block")
+	})
 }
 
 // TestRevalidateBlockWithEmptyRequest tests the RevalidateBlock method with an empty request
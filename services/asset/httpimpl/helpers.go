@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/labstack/echo/v4"
 )
 
@@ -96,3 +97,43 @@ func (h *HTTP) getLimitOffset(c echo.Context) (int, int, error) {
 
 	return offset, limit, nil
 }
+
+// getBlockSelection extracts which blocks to return from the request, for the endpoints that list
+// the most recent blocks.
+//
+// Parameters:
+//   - c: Echo context containing the HTTP request
+//
+// Returns:
+//   - model.BlockSelection: The selected blocks (default: main chain)
+//   - error: Validation error if the chain parameter is invalid
+//
+// Query Parameters:
+//
+//   - chain: Which blocks to return, "main", "orphans" or "all" (optional)
+//     Example: ?chain=orphans
+//
+//   - includeOrphans: Whether to include orphaned blocks, same as chain=all (optional)
+//     Example: ?includeOrphans=true
+//
+// Error Responses:
+//   - 400 Bad Request:
+//   - Invalid chain parameter
+func (h *HTTP) getBlockSelection(c echo.Context) (model.BlockSelection, error) {
+	switch c.QueryParam("chain") {
+	case "":
+		if c.QueryParam("includeOrphans") == "true" {
+			return model.BlockSelection_MainChainAndOrphans, nil
+		}
+
+		return model.BlockSelection_MainChain, nil
+	case "main":
+		return model.BlockSelection_MainChain, nil
+	case "orphans":
+		return model.BlockSelection_OrphansOnly, nil
+	case "all":
+		return model.BlockSelection_MainChainAndOrphans, nil
+	default:
+		return model.BlockSelection_MainChain, echo.NewHTTPError(http.StatusBadRequest, errors.NewInvalidArgumentError("invalid 'chain' parameter, expected main, orphans or all").Error())
+	}
+}
@@ -108,8 +108,8 @@ func (m *Mock) GetBlockByHeight(_ context.Context, height uint32) (*model.Block,
 	return args.Get(0).(*model.Block), args.Error(1)
 }
 
-func (m *Mock) GetLastNBlocks(_ context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
-	args := m.Called(n, includeOrphans, fromHeight)
+func (m *Mock) GetLastNBlocks(_ context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
+	args := m.Called(n, selection, fromHeight)
 
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
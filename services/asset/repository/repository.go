@@ -36,7 +36,7 @@ type Interface interface {
 	GetBlockByHash(ctx context.Context, hash *chainhash.Hash) (*model.Block, error)
 	GetBlockByHeight(ctx context.Context, height uint32) (*model.Block, error)
 	GetBlockHeader(ctx context.Context, hash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error)
-	GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error)
+	GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error)
 	GetBlocks(ctx context.Context, hash *chainhash.Hash, n uint32) ([]*model.Block, error)
 	GetBlockHeaders(ctx context.Context, hash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
 	GetBlockHeadersToCommonAncestor(ctx context.Context, hashTarget *chainhash.Hash, blockLocatorHashes []*chainhash.Hash, maxHeaders uint32) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
@@ -315,16 +315,16 @@ func (repo *Repository) GetBlockHeader(ctx context.Context, hash *chainhash.Hash
 // Parameters:
 //   - ctx: Context for the operation
 //   - n: Number of blocks to retrieve
-//   - includeOrphans: Whether to include orphaned blocks
+//   - selection: Whether to return main chain blocks, orphaned blocks or both
 //   - fromHeight: Starting height for block retrieval
 //
 // Returns:
-//   - []*model.BlockInfo: Array of block information
+//   - []*model.BlockInfo: Array of block information, ordered by height descending and then by hash
 //   - error: Any error encountered during retrieval
-func (repo *Repository) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (repo *Repository) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	repo.logger.Debugf("[Repository] GetLastNBlocks: %d", n)
 
-	blockInfo, err := repo.BlockchainClient.GetLastNBlocks(ctx, n, includeOrphans, fromHeight)
+	blockInfo, err := repo.BlockchainClient.GetLastNBlocks(ctx, n, selection, fromHeight)
 	if err != nil {
 		return nil, err
 	}
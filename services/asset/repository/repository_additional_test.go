@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/pkg/fileformat"
 	"github.com/bitcoin-sv/teranode/services/asset/repository"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
@@ -574,7 +575,7 @@ func TestRepository_80PercentCoverage_ErrorPaths(t *testing.T) {
 	assert.Nil(t, headerMeta)
 
 	// Test GetLastNBlocks - might succeed with empty results
-	blockInfos, err := repo.GetLastNBlocks(ctx, 999999, false, 0)
+	blockInfos, err := repo.GetLastNBlocks(ctx, 999999, model.BlockSelection_MainChain, 0)
 	if err != nil {
 		assert.Nil(t, blockInfos)
 	} else {
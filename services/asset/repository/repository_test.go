@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/pkg/fileformat"
 	"github.com/bitcoin-sv/teranode/services/asset/repository"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
@@ -370,17 +371,17 @@ func TestRepository_GetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// Test with different parameters
-		blocks, err := repo.GetLastNBlocks(ctx, 10, false, 0)
+		blocks, err := repo.GetLastNBlocks(ctx, 10, model.BlockSelection_MainChain, 0)
 		assert.NoError(t, err)
 		assert.NotNil(t, blocks)
 
 		// Test with include orphans
-		blocks, err = repo.GetLastNBlocks(ctx, 5, true, 0)
+		blocks, err = repo.GetLastNBlocks(ctx, 5, model.BlockSelection_MainChainAndOrphans, 0)
 		assert.NoError(t, err)
 		assert.NotNil(t, blocks)
 
 		// Test with from height
-		blocks, err = repo.GetLastNBlocks(ctx, 3, false, 100)
+		blocks, err = repo.GetLastNBlocks(ctx, 3, model.BlockSelection_MainChain, 100)
 		assert.NoError(t, err)
 		assert.NotNil(t, blocks)
 	})
@@ -135,7 +135,8 @@ type BlockAssembler struct {
 	// blockchainSubscriptionCh receives blockchain notifications
 	blockchainSubscriptionCh chan *blockchain.Notification
 
-	// defaultMiningNBits stores the default mining difficulty
+	// defaultMiningNBits stores the difficulty used when the next work required cannot be retrieved,
+	// nil unless mining_n_bits is configured
 	defaultMiningNBits *model.NBit
 
 	// resetCh handles reset requests for the assembler
@@ -202,19 +203,19 @@ type CachedMiningCandidate struct {
 //   - *BlockAssembler: New block assembler instance
 func NewBlockAssembler(ctx context.Context, logger ulogger.Logger, tSettings *settings.Settings, stats *gocore.Stat, utxoStore utxo.Store,
 	subtreeStore blob.Store, blockchainClient blockchain.ClientI, newSubtreeChan chan subtreeprocessor.NewSubtreeRequest) (*BlockAssembler, error) {
-	bytesLittleEndian := make([]byte, 4)
-
 	if tSettings.ChainCfgParams == nil {
 		return nil, errors.NewError("chain cfg params are nil")
 	}
 
-	binary.LittleEndian.PutUint32(bytesLittleEndian, tSettings.ChainCfgParams.PowLimitBits)
-
-	defaultMiningBits, err := model.NewNBitFromSlice(bytesLittleEndian)
+	defaultMiningBits, err := getDefaultMiningNBits(tSettings)
 	if err != nil {
 		return nil, err
 	}
 
+	if defaultMiningBits != nil {
+		logger.Infof("[BlockAssembler] using fallback mining difficulty %s from the mining_n_bits setting", defaultMiningBits.String())
+	}
+
 	subtreeProcessor, err := subtreeprocessor.NewSubtreeProcessor(ctx, logger, tSettings, subtreeStore, blockchainClient, utxoStore, newSubtreeChan)
 	if err != nil {
 		return nil, err
@@ -243,6 +244,29 @@ func NewBlockAssembler(ctx context.Context, logger ulogger.Logger, tSettings *se
 	return b, nil
 }
 
+// getDefaultMiningNBits returns the difficulty to mine at when the next work required cannot be retrieved.
+// There is only a fallback when mining_n_bits is configured explicitly, otherwise nil is returned and the
+// error is returned to the caller, so a network that expects real difficulty never mines at an easy difficulty.
+//
+// Parameters:
+//   - tSettings: Settings containing the mining_n_bits setting
+//
+// Returns:
+//   - *model.NBit: Fallback difficulty bits, nil when mining_n_bits is not configured
+//   - error: Any error encountered parsing the configured difficulty bits
+func getDefaultMiningNBits(tSettings *settings.Settings) (*model.NBit, error) {
+	if tSettings.BlockAssembly.MiningNBits == "" {
+		return nil, nil
+	}
+
+	nBits, err := model.NewNBitFromString(tSettings.BlockAssembly.MiningNBits)
+	if err != nil {
+		return nil, errors.NewConfigurationError("invalid mining_n_bits %q", tSettings.BlockAssembly.MiningNBits, err)
+	}
+
+	return nBits, nil
+}
+
 // TxCount returns the total number of transactions in the assembler.
 //
 // Returns:
@@ -1351,14 +1375,22 @@ FoundAncestor:
 }
 
 // getNextNbits retrieves the next required work difficulty target.
+// When the next work required cannot be retrieved and mining_n_bits is configured, the configured
+// difficulty is returned, otherwise the error is returned.
 //
 // Returns:
 //   - *model.NBit: Next difficulty target
 //   - error: Any error encountered during retrieval
 func (b *BlockAssembler) getNextNbits(nextBlockTime int64) (*model.NBit, error) {
 	nbit, err := b.blockchainClient.GetNextWorkRequired(context.Background(), b.bestBlockHeader.Load().Hash(), nextBlockTime)
-	if err != nil {
-		return nil, errors.NewProcessingError("error getting next work required", err)
+	if err != nil || nbit == nil {
+		if b.defaultMiningNBits == nil {
+			return nil, errors.NewProcessingError("error getting next work required", err)
+		}
+
+		b.logger.Warnf("[BlockAssembler] could not get next work required, using %s from the mining_n_bits setting: %v", b.defaultMiningNBits.String(), err)
+
+		return b.defaultMiningNBits, nil
 	}
 
 	return nbit, nil
@@ -1201,6 +1201,81 @@ func createTestSettings(t *testing.T) *settings.Settings {
 	return tSettings
 }
 
+func TestGetDefaultMiningNBits(t *testing.T) {
+	t.Run("no fallback when mining_n_bits is not set", func(t *testing.T) {
+		tSettings := createTestSettings(t)
+		tSettings.ChainCfgParams = &chaincfg.MainNetParams
+		tSettings.BlockAssembly.MiningNBits = ""
+
+		nBits, err := getDefaultMiningNBits(tSettings)
+		require.NoError(t, err)
+		assert.Nil(t, nBits)
+	})
+
+	t.Run("configured mining_n_bits", func(t *testing.T) {
+		tSettings := createTestSettings(t)
+		tSettings.ChainCfgParams = &chaincfg.MainNetParams
+		tSettings.BlockAssembly.MiningNBits = "2000ffff"
+
+		nBits, err := getDefaultMiningNBits(tSettings)
+		require.NoError(t, err)
+		require.NotNil(t, nBits)
+		assert.Equal(t, "2000ffff", nBits.String())
+	})
+
+	t.Run("invalid mining_n_bits", func(t *testing.T) {
+		tSettings := createTestSettings(t)
+		tSettings.BlockAssembly.MiningNBits = "not hex"
+
+		_, err := getDefaultMiningNBits(tSettings)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrConfiguration))
+	})
+}
+
+func TestBlockAssembler_getNextNbits(t *testing.T) {
+	defaultNBits, _ := model.NewNBitFromString("1d00ffff")
+	nextNBits, _ := model.NewNBitFromString("180a2268")
+
+	newBlockAssembler := func(blockchainClient blockchain.ClientI, defaultMiningNBits *model.NBit) *BlockAssembler {
+		b := &BlockAssembler{
+			logger:             ulogger.TestLogger{},
+			blockchainClient:   blockchainClient,
+			defaultMiningNBits: defaultMiningNBits,
+		}
+		b.bestBlockHeader.Store(model.GenesisBlockHeader)
+
+		return b
+	}
+
+	t.Run("next work required", func(t *testing.T) {
+		blockchainClient := &blockchain.Mock{}
+		blockchainClient.On("GetNextWorkRequired", mock.Anything, mock.Anything, mock.Anything).Return(nextNBits, nil)
+
+		nBits, err := newBlockAssembler(blockchainClient, defaultNBits).getNextNbits(time.Now().Unix())
+		require.NoError(t, err)
+		assert.Equal(t, nextNBits, nBits)
+	})
+
+	t.Run("configured mining difficulty when next work required fails", func(t *testing.T) {
+		blockchainClient := &blockchain.Mock{}
+		blockchainClient.On("GetNextWorkRequired", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.ErrNotFound)
+
+		nBits, err := newBlockAssembler(blockchainClient, defaultNBits).getNextNbits(time.Now().Unix())
+		require.NoError(t, err)
+		assert.Equal(t, defaultNBits, nBits)
+	})
+
+	t.Run("error when next work required fails without mining_n_bits", func(t *testing.T) {
+		blockchainClient := &blockchain.Mock{}
+		blockchainClient.On("GetNextWorkRequired", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.ErrNotFound)
+
+		nBits, err := newBlockAssembler(blockchainClient, nil).getNextNbits(time.Now().Unix())
+		require.Error(t, err)
+		assert.Nil(t, nBits)
+	})
+}
+
 // TestBlockAssembler_CachingFunctionality tests the new caching functionality for mining candidates
 func TestBlockAssembler_CachingFunctionality(t *testing.T) {
 	t.Run("Cache Hit", func(t *testing.T) {
@@ -7,6 +7,7 @@ package blockchain
 
 import (
 	"context"
+	"math/big"
 	"net/http"
 	"strings"
 	"sync"
@@ -31,9 +32,10 @@ import (
 
 // clientSubscriber represents a subscriber to blockchain notifications.
 type clientSubscriber struct {
-	source string                            // Source identifier of the subscriber
-	ch     chan *blockchain_api.Notification // Channel for receiving notifications
-	id     string                            // Unique identifier for the subscriber
+	source            string                            // Source identifier of the subscriber
+	ch                chan *blockchain_api.Notification // Channel for receiving notifications
+	id                string                            // Unique identifier for the subscriber
+	notificationTypes uint64                            // Bitmask of the notification types to send, 0 means all types
 }
 
 // Client represents a blockchain service client.
@@ -186,6 +188,10 @@ func NewClientWithAddress(ctx context.Context, logger ulogger.Logger, tSettings
 					}
 
 					for _, s := range c.subscribers {
+						if !matchesNotificationTypeFilter(s.notificationTypes, notification.Type) {
+							continue
+						}
+
 						go func(ch chan *blockchain_api.Notification, notification *blockchain_api.Notification) {
 							utils.SafeSend(ch, notification)
 						}(s.ch, notification)
@@ -226,17 +232,18 @@ func (c *Client) AddBlock(ctx context.Context, block *model.Block, peerID string
 
 	external := peerID != ""
 	req := &blockchain_api.AddBlockRequest{
-		Header:            block.Header.Bytes(),
-		CoinbaseTx:        block.CoinbaseTx.Bytes(),
-		SubtreeHashes:     make([][]byte, 0, len(block.Subtrees)),
-		TransactionCount:  block.TransactionCount,
-		SizeInBytes:       block.SizeInBytes,
-		External:          external,
-		PeerId:            peerID,
-		OptionMinedSet:    storeBlockOptions.MinedSet,
-		OptionSubtreesSet: storeBlockOptions.SubtreesSet,
-		OptionInvalid:     storeBlockOptions.Invalid,
-		OptionID:          storeBlockOptions.ID,
+		Header:             block.Header.Bytes(),
+		CoinbaseTx:         block.CoinbaseTx.Bytes(),
+		SubtreeHashes:      make([][]byte, 0, len(block.Subtrees)),
+		TransactionCount:   block.TransactionCount,
+		SizeInBytes:        block.SizeInBytes,
+		External:           external,
+		PeerId:             peerID,
+		OptionMinedSet:     storeBlockOptions.MinedSet,
+		OptionSubtreesSet:  storeBlockOptions.SubtreesSet,
+		OptionInvalid:      storeBlockOptions.Invalid,
+		OptionID:           storeBlockOptions.ID,
+		OptionWaitForKafka: storeBlockOptions.WaitForKafka,
 	}
 
 	for _, subtreeHash := range block.Subtrees {
@@ -325,6 +332,30 @@ func (c *Client) GetBlockByHeight(ctx context.Context, height uint32) (*model.Bl
 	return c.blockFromResponse(resp)
 }
 
+// GetBlocksByHeightRange retrieves the blocks in an inclusive height range.
+func (c *Client) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	resp, err := c.client.GetBlocksByHeightRange(ctx, &blockchain_api.GetBlocksByHeightRangeRequest{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	blocks := make([]*model.Block, 0, len(resp.Blocks))
+
+	for _, blockBytes := range resp.Blocks {
+		block, err := model.NewBlockFromBytes(blockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
 // GetBlockByID retrieves a block by its ID.
 func (c *Client) GetBlockByID(ctx context.Context, id uint64) (*model.Block, error) {
 	resp, err := c.client.GetBlockByID(ctx, &blockchain_api.GetBlockByIDRequest{
@@ -419,11 +450,13 @@ func (c *Client) GetBlockGraphData(ctx context.Context, periodMillis uint64) (*m
 }
 
 // GetLastNBlocks retrieves the most recent N blocks from the blockchain.
-func (c *Client) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (c *Client) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	resp, err := c.client.GetLastNBlocks(ctx, &blockchain_api.GetLastNBlocksRequest{
 		NumberOfBlocks: n,
-		IncludeOrphans: includeOrphans,
+		// includeOrphans is still set for servers that do not know the selection yet
+		IncludeOrphans: selection != model.BlockSelection_MainChain,
 		FromHeight:     fromHeight,
+		Selection:      selection,
 	})
 	if err != nil {
 		return nil, errors.UnwrapGRPC(err)
@@ -578,6 +611,65 @@ func (c *Client) GetNextWorkRequired(ctx context.Context, previousBlockHash *cha
 	return bits, err
 }
 
+// GetDifficultyInfo retrieves the difficulty state at the tip of the best chain.
+func (c *Client) GetDifficultyInfo(ctx context.Context) (*DifficultyInfo, error) {
+	resp, err := c.client.GetDifficultyInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	blockHash, err := chainhash.NewHash(resp.BlockHash)
+	if err != nil {
+		return nil, errors.NewProcessingError("invalid block hash in difficulty info", err)
+	}
+
+	bits, err := model.NewNBitFromSlice(resp.Bits)
+	if err != nil {
+		return nil, errors.NewProcessingError("invalid bits in difficulty info", err)
+	}
+
+	target, ok := new(big.Int).SetString(resp.Target, 10)
+	if !ok {
+		return nil, errors.NewProcessingError("invalid target in difficulty info: %s", resp.Target)
+	}
+
+	return &DifficultyInfo{
+		BlockHash:          blockHash,
+		Height:             resp.Height,
+		Bits:               *bits,
+		Target:             target,
+		ChainWork:          new(big.Int).SetBytes(resp.ChainWork),
+		AdjustmentWindow:   resp.DifficultyAdjustmentWindow,
+		NextRetargetHeight: resp.NextRetargetHeight,
+	}, nil
+}
+
+// GetMiningCandidateContext retrieves the chain state a block template is built on top of the best block.
+func (c *Client) GetMiningCandidateContext(ctx context.Context) (*MiningCandidateContext, error) {
+	resp, err := c.client.GetMiningCandidateContext(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	previousBlockHash, err := chainhash.NewHash(resp.PreviousBlockHash)
+	if err != nil {
+		return nil, errors.NewProcessingError("invalid previous block hash in mining candidate context", err)
+	}
+
+	bits, err := model.NewNBitFromSlice(resp.Bits)
+	if err != nil {
+		return nil, errors.NewProcessingError("invalid bits in mining candidate context", err)
+	}
+
+	return &MiningCandidateContext{
+		PreviousBlockHash: previousBlockHash,
+		Height:            resp.Height,
+		Bits:              *bits,
+		MinTime:           resp.MinTime,
+		CurTime:           resp.CurTime,
+	}, nil
+}
+
 // GetBlockExists checks if a block with the given hash exists in the blockchain.
 func (c *Client) GetBlockExists(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	resp, err := c.client.GetBlockExists(ctx, &blockchain_api.GetBlockRequest{
@@ -662,6 +754,84 @@ func (c *Client) GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash)
 		return nil, nil, errors.UnwrapGRPC(err)
 	}
 
+	return blockHeaderFromResponse(resp)
+}
+
+// GetBlockHeaderByHeight retrieves the header of the block at a specific height in the current chain.
+func (c *Client) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	resp, err := c.client.GetBlockHeaderByHeight(ctx, &blockchain_api.GetBlockByHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, nil, errors.UnwrapGRPC(err)
+	}
+
+	return blockHeaderFromResponse(resp)
+}
+
+// GetBlockHeadersByHashes retrieves the headers of a list of blocks, in the order of the given hashes.
+// The header and meta of a block that is not found are nil, instead of failing the whole request.
+func (c *Client) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	hashBytes := make([][]byte, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		hashBytes[i] = blockHash.CloneBytes()
+	}
+
+	resp, err := c.client.GetBlockHeadersByHashes(ctx, &blockchain_api.GetBlockHeadersByHashesRequest{
+		BlockHashes: hashBytes,
+	})
+	if err != nil {
+		return nil, nil, errors.UnwrapGRPC(err)
+	}
+
+	if len(resp.Found) != len(blockHashes) || len(resp.BlockHeaders) != len(blockHashes) || len(resp.Metas) != len(blockHashes) {
+		return nil, nil, errors.NewProcessingError("[GetBlockHeadersByHashes] expected %d headers in response, got %d", len(blockHashes), len(resp.Found))
+	}
+
+	headers := make([]*model.BlockHeader, len(blockHashes))
+	metas := make([]*model.BlockHeaderMeta, len(blockHashes))
+
+	for i, found := range resp.Found {
+		if !found {
+			continue
+		}
+
+		if headers[i], err = model.NewBlockHeaderFromBytes(resp.BlockHeaders[i]); err != nil {
+			return nil, nil, err
+		}
+
+		if metas[i], err = model.NewBlockHeaderMetaFromBytes(resp.Metas[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return headers, metas, nil
+}
+
+// WaitForBlockHeight waits until the best block reaches the given height and returns the block header at
+// the height in the main chain. The blockchain service checks the best block on every new block, instead of
+// the caller polling GetBestBlockHeader. Use a context with a timeout to limit the wait.
+//
+// Parameters:
+//   - ctx: Context for the operation, the wait ends when the context is done
+//   - height: Height the best block should reach
+//
+// Returns:
+//   - *model.BlockHeader: The block header at the height
+//   - *model.BlockHeaderMeta: Metadata of the block at the height
+//   - error: Any error encountered, including when the context is done before the height is reached
+func (c *Client) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	resp, err := c.client.WaitForBlockHeight(ctx, &blockchain_api.WaitForBlockHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, nil, errors.UnwrapGRPC(err)
+	}
+
+	return blockHeaderFromResponse(resp)
+}
+
+func blockHeaderFromResponse(resp *blockchain_api.GetBlockHeaderResponse) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
 	header, err := model.NewBlockHeaderFromBytes(resp.BlockHeader)
 	if err != nil {
 		return nil, nil, err
@@ -925,9 +1095,13 @@ func (c *Client) GetBlockHeadersByHeight(ctx context.Context, startHeight, endHe
 }
 
 // InvalidateBlock marks a block as invalid in the blockchain.
-func (c *Client) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+// The max reorg depth of the blockchain service is only checked when WithCheckMaxReorgDepth is set.
+func (c *Client) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, opts ...options.InvalidateBlockOption) ([]chainhash.Hash, error) {
+	invalidateOpts := options.ProcessInvalidateBlockOptions(opts...)
+
 	resp, err := c.client.InvalidateBlock(ctx, &blockchain_api.InvalidateBlockRequest{
 		BlockHash: blockHash.CloneBytes(),
+		Force:     !invalidateOpts.CheckMaxReorgDepth,
 	})
 	if err != nil {
 		return nil, errors.UnwrapGRPC(err)
@@ -950,6 +1124,37 @@ func (c *Client) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash)
 	return invalidatedHashes, nil
 }
 
+// InvalidateBlockDryRun returns the blocks that would be invalidated by InvalidateBlock, without invalidating them.
+func (c *Client) InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	resp, err := c.client.InvalidateBlock(ctx, &blockchain_api.InvalidateBlockRequest{
+		BlockHash: blockHash.CloneBytes(),
+		DryRun:    true,
+	})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	if resp == nil {
+		return nil, errors.NewProcessingError("invalidate block dry run did not return a valid response")
+	}
+
+	affectedBlocks := make([]*model.AffectedBlock, 0, len(resp.AffectedBlocks))
+	for _, affectedBlock := range resp.AffectedBlocks {
+		hash, err := chainhash.NewHash(affectedBlock.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		affectedBlocks = append(affectedBlocks, &model.AffectedBlock{
+			Hash:        hash,
+			Height:      affectedBlock.Height,
+			OnBestChain: affectedBlock.OnBestChain,
+		})
+	}
+
+	return affectedBlocks, nil
+}
+
 // RevalidateBlock restores a previously invalidated block.
 func (c *Client) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
 	_, err := c.client.RevalidateBlock(ctx, &blockchain_api.RevalidateBlockRequest{
@@ -1013,23 +1218,28 @@ func (c *Client) SendNotification(ctx context.Context, notification *blockchain_
 
 // Subscribe creates a new subscription to blockchain notifications.
 // Returns a channel that will receive notifications until the context is cancelled.
-func (c *Client) Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error) {
+// Only the notification types given with options.WithNotificationTypes are sent, all types when none are given.
+func (c *Client) Subscribe(ctx context.Context, source string, opts ...options.SubscribeOption) (chan *blockchain_api.Notification, error) {
+	subscribeOptions := options.ProcessSubscribeOptions(opts...)
+
 	// create a new buffered channel for the subscriber
 	ch := make(chan *blockchain_api.Notification, 1_000)
 
 	id := uuid.New().String()
+	notificationTypes := newNotificationTypeFilter(subscribeOptions.NotificationTypes)
 
 	// add the subscriber to the list of subscribers
 	c.subscribersMu.Lock()
 	c.subscribers = append(c.subscribers, clientSubscriber{
-		source: source,
-		ch:     ch,
-		id:     id,
+		source:            source,
+		ch:                ch,
+		id:                id,
+		notificationTypes: notificationTypes,
 	})
 
 	// Send the last block notification to the new subscriber if available
 	// This ensures new subscribers get the current state immediately
-	if c.lastBlockNotification != nil {
+	if c.lastBlockNotification != nil && matchesNotificationTypeFilter(notificationTypes, model.NotificationType_Block) {
 		lastNotification := c.lastBlockNotification
 		go func() {
 			utils.SafeSend(ch, lastNotification)
@@ -1218,6 +1428,20 @@ func (c *Client) SetState(ctx context.Context, key string, data []byte) error {
 	return nil
 }
 
+// CompareAndSetState replaces the value of a key in the blockchain state storage, only if the current value equals expectedData.
+func (c *Client) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	resp, err := c.client.CompareAndSetState(ctx, &blockchain_api.CompareAndSetStateRequest{
+		Key:          key,
+		ExpectedData: expectedData,
+		Data:         newData,
+	})
+	if err != nil {
+		return false, errors.UnwrapGRPC(err)
+	}
+
+	return resp.Swapped, nil
+}
+
 // GetBlockIsMined checks whether a specific block has been marked as mined.
 // This method queries the blockchain service to determine if a block has been
 // processed through the mining pipeline and marked as successfully mined.
@@ -1460,6 +1684,25 @@ func (c *Client) IsFSMCurrentState(ctx context.Context, state FSMStateType) (boo
 	return *currentState == state, nil
 }
 
+// IsCurrent reports whether the node is synced with the network, as determined by the blockchain service
+// from the best block and the FSM state.
+//
+// Parameters:
+//   - ctx: Context for the operation with timeout and cancellation support
+//
+// Returns:
+//   - bool: Whether the node is current
+//   - []NotCurrentReason: The reasons the node is not current, empty when it is current
+//   - error: Any error encountered during the check
+func (c *Client) IsCurrent(ctx context.Context) (bool, []NotCurrentReason, error) {
+	resp, err := c.client.IsCurrent(ctx, &emptypb.Empty{})
+	if err != nil {
+		return false, nil, errors.UnwrapGRPC(err)
+	}
+
+	return resp.Current, resp.Reasons, nil
+}
+
 // WaitForFSMtoTransitionToGivenState waits for the FSM to reach a specific state.
 func (c *Client) WaitForFSMtoTransitionToGivenState(ctx context.Context, targetState FSMStateType) error {
 	if _, err := c.client.WaitFSMToTransitionToGivenState(ctx, &blockchain_api.WaitFSMToTransitionRequest{
@@ -1544,6 +1787,44 @@ func (c *Client) WaitUntilFSMTransitionFromIdleState(ctx context.Context) error
 	return nil
 }
 
+// SubscribeFSMState subscribes to the state transitions of the blockchain FSM.
+// The returned channel receives the current state first, followed by every transition
+// of the FSM, and is closed when the context is cancelled or the stream ends. Callers
+// should subscribe again when the channel is closed before their context is done.
+func (c *Client) SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error) {
+	stream, err := c.client.SubscribeFSMState(ctx, &blockchain_api.SubscribeFSMStateRequest{
+		Source: source,
+	})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	ch := make(chan *blockchain_api.FSMStateChange, 100)
+
+	go func() {
+		defer close(ch)
+
+		for {
+			stateChange, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					c.logger.Warnf("[Blockchain Client] FSM state subscription %s ended: %v", source, errors.UnwrapGRPC(err))
+				}
+
+				return
+			}
+
+			select {
+			case ch <- stateChange:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
 // IsFullyReady checks if the blockchain service is fully operational.
 // This method verifies that the blockchain service is ready for normal operations,
 // which includes both the FSM being in a non-IDLE state and the subscription
@@ -1830,6 +2111,32 @@ func (c *Client) GetBlockLocator(ctx context.Context, blockHeaderHash *chainhash
 		return nil, errors.UnwrapGRPC(err)
 	}
 
+	return blockLocatorFromResponse(resp)
+}
+
+// GetBlockLocatorByHeight creates a block locator starting at the block at the given height in the main chain.
+// It resolves the hash of the block at the height and builds the same locator as GetBlockLocator,
+// for callers that only know the height.
+//
+// Parameters:
+//   - ctx: Context for the operation with timeout and cancellation support
+//   - blockHeaderHeight: Height of the starting block header in the main chain
+//
+// Returns:
+//   - []*chainhash.Hash: Array of block hashes forming the block locator
+//   - error: Any error encountered during locator generation, including when no block exists at the height
+func (c *Client) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	resp, err := c.client.GetBlockLocatorByHeight(ctx, &blockchain_api.GetBlockLocatorByHeightRequest{
+		Height: blockHeaderHeight,
+	})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	return blockLocatorFromResponse(resp)
+}
+
+func blockLocatorFromResponse(resp *blockchain_api.GetBlockLocatorResponse) ([]*chainhash.Hash, error) {
 	locator := make([]*chainhash.Hash, 0, len(resp.Locator))
 
 	for _, hash := range resp.Locator {
@@ -1925,6 +2232,49 @@ func (c *Client) GetBestHeightAndTime(ctx context.Context) (uint32, uint32, erro
 	return resp.Height, resp.Time, nil
 }
 
+// GetMedianTimeForHeight retrieves the median time past of the block at the given height in the main chain.
+// The median is calculated over the timestamps of the block and up to 10 of its ancestors, using fewer
+// blocks for heights below 10.
+//
+// Parameters:
+//   - ctx: Context for the operation with timeout and cancellation support
+//   - height: Height of the block in the main chain
+//
+// Returns:
+//   - uint32: The median timestamp as a Unix timestamp
+//   - error: Any error encountered, including when no block exists at the height
+func (c *Client) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	resp, err := c.client.GetMedianTimeForHeight(ctx, &blockchain_api.GetMedianTimeForHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return 0, errors.UnwrapGRPC(err)
+	}
+
+	return resp.Time, nil
+}
+
+// GetBlockSubsidy retrieves the block subsidy at the given height and the height of the next subsidy reduction.
+//
+// Parameters:
+//   - ctx: Context for the operation with timeout and cancellation support
+//   - height: Block height, the block does not have to exist
+//
+// Returns:
+//   - uint64: The block subsidy in satoshis
+//   - uint32: The height of the next subsidy reduction, 0 when the subsidy is not reduced any further
+//   - error: Any error encountered during the retrieval
+func (c *Client) GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error) {
+	resp, err := c.client.GetBlockSubsidy(ctx, &blockchain_api.GetBlockSubsidyRequest{
+		Height: height,
+	})
+	if err != nil {
+		return 0, 0, errors.UnwrapGRPC(err)
+	}
+
+	return resp.Subsidy, resp.NextReductionHeight, nil
+}
+
 // log2FloorMasks defines the masks to use when quickly calculating
 // floor(log2(x)) in a constant log2(32) = 5 steps, where x is a uint32, using
 // shifts.  They are derived from (2^(2^x) - 1) * (2^(2^x)), for x in 4..0.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"math/big"
+	"time"
 
 	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/model"
@@ -33,6 +34,30 @@ type Difficulty struct {
 	lastComputednBits *model.NBit
 }
 
+// DifficultyInfo describes the difficulty state at the tip of the best chain.
+type DifficultyInfo struct {
+	BlockHash *chainhash.Hash // Hash of the best block
+	Height    uint32          // Height of the best block
+	Bits      model.NBit      // Difficulty bits of the best block
+	Target    *big.Int        // Target expanded from the difficulty bits
+	ChainWork *big.Int        // Accumulated chainwork up to and including the best block
+	// AdjustmentWindow is the number of blocks the difficulty adjustment is computed over
+	AdjustmentWindow uint32
+	// NextRetargetHeight is the height of the next block that gets an adjusted difficulty,
+	// or 0 when the network does not adjust the difficulty
+	NextRetargetHeight uint32
+}
+
+// MiningCandidateContext describes the chain state a block template is built on. All values are
+// derived from the same best block, so they are consistent even when the tip changes concurrently.
+type MiningCandidateContext struct {
+	PreviousBlockHash *chainhash.Hash // Hash of the best block, the parent of the new block
+	Height            uint32          // Height of the new block
+	Bits              model.NBit      // Difficulty bits required for the new block
+	MinTime           uint32          // Minimum timestamp of the new block, the median time past of the best block + 1
+	CurTime           uint32          // Current time, raised to MinTime when the clock is behind the median time past
+}
+
 // NewDifficulty creates a new Difficulty instance with the provided dependencies.
 func NewDifficulty(store blockchain_store.Store, logger ulogger.Logger, tSettings *settings.Settings) (*Difficulty, error) {
 	d := &Difficulty{}
@@ -399,3 +424,109 @@ func (d *Difficulty) ResetCache() {
 	d.lastBlockHash = nil
 	d.lastComputednBits = nil
 }
+
+// AdjustmentWindow returns the number of blocks the difficulty adjustment is computed over.
+func (d *Difficulty) AdjustmentWindow() uint32 {
+	return DifficultyAdjustmentWindow
+}
+
+// NextRetargetHeight returns the height of the next block that gets an adjusted difficulty when the
+// best block is at tipHeight. The difficulty is adjusted for every block once the chain is longer than
+// the adjustment window, before that the pow limit is used.
+// Returns 0 when the network does not adjust the difficulty.
+func (d *Difficulty) NextRetargetHeight(tipHeight uint32) uint32 {
+	if d.settings.ChainCfgParams.NoDifficultyAdjustment {
+		return 0
+	}
+
+	// CalcNextWorkRequired adjusts the difficulty for the child of a block at height DifficultyAdjustmentWindow+4 or above
+	firstRetargetHeight := uint32(DifficultyAdjustmentWindow) + 5
+
+	if tipHeight+1 < firstRetargetHeight {
+		return firstRetargetHeight
+	}
+
+	return tipHeight + 1
+}
+
+// DifficultyInfo returns the difficulty state at the tip of the best chain.
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns the difficulty info of the best block.
+func (d *Difficulty) DifficultyInfo(ctx context.Context) (*DifficultyInfo, error) {
+	bestBlockHeader, bestBlockMeta, err := d.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		return nil, errors.NewStorageError("[Difficulty] error getting best block header", err)
+	}
+
+	return &DifficultyInfo{
+		BlockHash:          bestBlockHeader.Hash(),
+		Height:             bestBlockMeta.Height,
+		Bits:               bestBlockHeader.Bits,
+		Target:             bestBlockHeader.Bits.CalculateTarget(),
+		ChainWork:          new(big.Int).SetBytes(bestBlockMeta.ChainWork),
+		AdjustmentWindow:   d.AdjustmentWindow(),
+		NextRetargetHeight: d.NextRetargetHeight(bestBlockMeta.Height),
+	}, nil
+}
+
+// MiningCandidateContext returns the chain state a block template is built on top of the best block.
+// The difficulty bits are calculated for a block with the timestamp CurTime.
+// Parameters:
+//   - ctx: Context for the operation
+//   - now: The current time
+//
+// Returns the mining candidate context of the best block.
+func (d *Difficulty) MiningCandidateContext(ctx context.Context, now time.Time) (*MiningCandidateContext, error) {
+	bestBlockHeader, bestBlockMeta, err := d.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		return nil, errors.NewStorageError("[Difficulty] error getting best block header", err)
+	}
+
+	bestBlockHash := bestBlockHeader.Hash()
+
+	// get the median block time of the best block and up to 10 of its ancestors
+	headers, _, err := d.store.GetBlockHeaders(ctx, bestBlockHash, 11)
+	if err != nil {
+		return nil, errors.NewStorageError("[Difficulty] error getting block headers of %s", bestBlockHash, err)
+	}
+
+	timestamps := make([]time.Time, 0, len(headers))
+	for _, header := range headers {
+		timestamps = append(timestamps, time.Unix(int64(header.Timestamp), 0))
+	}
+
+	medianTimestamp, err := model.CalculateMedianTimestamp(timestamps)
+	if err != nil {
+		return nil, errors.NewProcessingError("[Difficulty] could not calculate median block time of %s", bestBlockHash, err)
+	}
+
+	medianTime, err := safeconversion.TimeToUint32(*medianTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	curTime, err := safeconversion.TimeToUint32(now)
+	if err != nil {
+		return nil, err
+	}
+
+	minTime := medianTime + 1
+	if curTime < minTime {
+		curTime = minTime
+	}
+
+	nBits, err := d.CalcNextWorkRequired(ctx, bestBlockHeader, bestBlockMeta.Height, int64(curTime))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MiningCandidateContext{
+		PreviousBlockHash: bestBlockHash,
+		Height:            bestBlockMeta.Height + 1,
+		Bits:              *nBits,
+		MinTime:           minTime,
+		CurTime:           curTime,
+	}, nil
+}
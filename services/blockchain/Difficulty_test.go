@@ -71,6 +71,34 @@ func TestCalcNextRequiredDifficulty(t *testing.T) {
 	require.Equal(t, *expectedNbits, *nbits)
 }
 
+func TestNextRetargetHeight(t *testing.T) {
+	t.Run("mainnet", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.ChainCfgParams = &chaincfg.MainNetParams
+		d, err := NewDifficulty(nil, ulogger.TestLogger{}, tSettings)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(DifficultyAdjustmentWindow), d.AdjustmentWindow())
+
+		// the pow limit is used until the chain is longer than the adjustment window
+		assert.Equal(t, uint32(DifficultyAdjustmentWindow)+5, d.NextRetargetHeight(0))
+		assert.Equal(t, uint32(DifficultyAdjustmentWindow)+5, d.NextRetargetHeight(DifficultyAdjustmentWindow+3))
+
+		// after that the difficulty is adjusted for every block
+		assert.Equal(t, uint32(DifficultyAdjustmentWindow)+5, d.NextRetargetHeight(DifficultyAdjustmentWindow+4))
+		assert.Equal(t, uint32(826224), d.NextRetargetHeight(826223))
+	})
+
+	t.Run("no difficulty adjustment", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.ChainCfgParams = &chaincfg.RegressionNetParams
+		d, err := NewDifficulty(nil, ulogger.TestLogger{}, tSettings)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(0), d.NextRetargetHeight(826223))
+	})
+}
+
 // TestBlock910479Fix tests the fix for issue #3772 where block 910479 was mined with incorrect difficulty
 // The block had nBits 0x1818cd40 but should have had 0x181800c2
 // This test uses a simplified scenario that demonstrates the fix
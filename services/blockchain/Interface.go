@@ -136,6 +136,23 @@ type ClientI interface {
 	// - Error if the block retrieval fails or if no block exists at that height
 	GetBlockByHeight(ctx context.Context, height uint32) (*model.Block, error)
 
+	// GetBlocksByHeightRange retrieves the blocks in an inclusive height range.
+	//
+	// This method fetches all blocks from startHeight up to and including endHeight in a
+	// single call, which is considerably faster than calling GetBlockByHeight for each height
+	// when scanning large parts of the chain. The number of blocks in the range is capped by
+	// the blockchain_maxBlocksByHeightRange setting of the blockchain service.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - startHeight: Height of the first block to retrieve
+	// - endHeight: Height of the last block to retrieve
+	//
+	// Returns:
+	// - The blocks in ascending height order
+	// - Error if the range is invalid, exceeds the maximum number of blocks, or a block is missing
+	GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error)
+
 	// GetBlockByID retrieves a block by its ID.
 	//
 	// This method fetches a Bitcoin block using its internal database ID. The ID is a
@@ -183,20 +200,23 @@ type ClientI interface {
 
 	// GetLastNBlocks retrieves the most recent N blocks.
 	//
-	// This method fetches the most recent blocks in the blockchain, with options to include
-	// orphaned blocks and start from a specific height. This is useful for displaying
-	// recent blockchain activity or for analysis of recent blocks.
+	// This method fetches the most recent blocks in the blockchain, with options to select
+	// the main chain, orphaned blocks or both, and start from a specific height. This is useful
+	// for displaying recent blockchain activity or for analysis of recent blocks.
+	//
+	// The blocks are ordered by height descending and then by hash, so repeated calls return
+	// orphaned blocks and main chain blocks at the same height in the same order.
 	//
 	// Parameters:
 	// - ctx: Context for the operation with timeout and cancellation support
 	// - n: Number of blocks to retrieve
-	// - includeOrphans: Whether to include orphaned/invalid blocks in the results
+	// - selection: Whether to return main chain blocks, orphaned blocks or both
 	// - fromHeight: Starting height to retrieve blocks from (0 means from the tip)
 	//
 	// Returns:
 	// - Array of BlockInfo structures with summarized block information
 	// - Error if block retrieval fails
-	GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error)
+	GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error)
 
 	// GetLastNInvalidBlocks retrieves the most recent N blocks that have been marked as invalid.
 	//
@@ -259,6 +279,37 @@ type ClientI interface {
 	// - Error if the calculation fails
 	GetNextWorkRequired(ctx context.Context, hash *chainhash.Hash, currentBlockTime int64) (*model.NBit, error)
 
+	// GetDifficultyInfo retrieves the difficulty state at the tip of the best chain.
+	//
+	// This method reports the difficulty bits and the target they expand to, the
+	// accumulated chainwork of the best block, the number of blocks the difficulty
+	// adjustment is computed over and the height of the next block that gets an
+	// adjusted difficulty. It allows miners and tooling to display the difficulty
+	// without deriving it from the block headers.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	//
+	// Returns:
+	// - DifficultyInfo of the best block, NextRetargetHeight is 0 when the network does not adjust the difficulty
+	// - Error if the best block header cannot be retrieved
+	GetDifficultyInfo(ctx context.Context) (*DifficultyInfo, error)
+
+	// GetMiningCandidateContext retrieves the chain state a block template is built on.
+	//
+	// This method returns the hash of the best block, the height of the new block, the
+	// difficulty bits required for it and its minimum and current timestamp in one call.
+	// All values are derived from the same best block on the server, so a miner cannot
+	// combine values of different tips when the tip changes between separate calls.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	//
+	// Returns:
+	// - MiningCandidateContext of the best block, MinTime is the median time past + 1 and CurTime is at least MinTime
+	// - Error if the best block header cannot be retrieved or the difficulty cannot be calculated
+	GetMiningCandidateContext(ctx context.Context) (*MiningCandidateContext, error)
+
 	// GetBlockExists checks if a block exists in the blockchain.
 	//
 	// This method performs a lightweight existence check for a block with the specified hash,
@@ -305,19 +356,54 @@ type ClientI interface {
 	// - Error if the header retrieval fails
 	GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error)
 
+	// GetBlockHeaderByHeight retrieves the block header at a specific height in the current chain.
+	//
+	// This is the header-only counterpart of GetBlockByHeight, for callers that do not need the
+	// coinbase and subtrees of the block, like difficulty calculation and the miner.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - height: Height of the block whose header should be retrieved
+	//
+	// Returns:
+	// - BlockHeader containing the header data
+	// - BlockHeaderMeta containing additional metadata about the header
+	// - Error if no block exists at the height in the current chain or the retrieval fails
+	GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error)
+
+	// GetBlockHeadersByHashes retrieves the headers of a list of blocks.
+	//
+	// This method fetches the headers of specific, known block hashes in a single call, for
+	// instance the hashes of a block locator, instead of calling GetBlockHeader for every hash.
+	// Blocks that are not found do not fail the request.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - blockHashes: Hashes of the blocks
+	//
+	// Returns:
+	// - Array of BlockHeader objects in the order of blockHashes, nil for blocks that are not found
+	// - Array of corresponding BlockHeaderMeta objects, nil for blocks that are not found
+	// - Error if the header retrieval fails
+	GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
+
 	// GetBlockHeaders retrieves multiple block headers.
 	//
 	// This method fetches a sequence of block headers starting from the specified hash,
 	// retrieving up to the requested number of headers. This is useful for efficiently
 	// validating portions of the blockchain without transferring full block data.
 	//
+	// The headers are retrieved by walking backward from the specified hash, following the
+	// parent of each block, so passing a chain tip returns the N most recent headers of that
+	// chain. Fewer headers are returned when genesis is reached before numberOfHeaders.
+	//
 	// Parameters:
 	// - ctx: Context for the operation with timeout and cancellation support
-	// - blockHash: Hash of the starting block
+	// - blockHash: Hash of the starting block, which is the highest block returned
 	// - numberOfHeaders: Maximum number of headers to retrieve
 	//
 	// Returns:
-	// - Array of BlockHeader objects in chain order
+	// - Array of BlockHeader objects in descending height order, starting with blockHash
 	// - Array of corresponding BlockHeaderMeta objects with additional metadata
 	// - Error if the header retrieval fails
 	GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
@@ -453,10 +539,27 @@ type ClientI interface {
 	// Parameters:
 	// - ctx: Context for the operation with timeout and cancellation support
 	// - blockHash: Hash of the block to mark as invalid
+	// - opts: Optional settings, WithCheckMaxReorgDepth refuses to invalidate a block on the best chain
+	//   with more than blockchain_maxReorgDepth confirmations, for invalidations requested by an operator
 	//
 	// Returns:
 	// - Error if the invalidation fails, nil on success
-	InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error)
+	InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, opts ...options.InvalidateBlockOption) ([]chainhash.Hash, error)
+
+	// InvalidateBlockDryRun returns the blocks that InvalidateBlock would invalidate, without invalidating them.
+	//
+	// This allows operators to assess the impact of an invalidation before performing it,
+	// such as how many blocks would be affected and whether the current best chain would
+	// be reorganized.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - blockHash: Hash of the block that would be marked as invalid
+	//
+	// Returns:
+	// - The block and all its descendants, ordered by height, with their height and best chain status
+	// - Error if the block does not exist or the lookup fails
+	InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error)
 
 	// RevalidateBlock restores a previously invalidated block.
 	//
@@ -498,11 +601,12 @@ type ClientI interface {
 	// Parameters:
 	// - ctx: Context for the operation with timeout and cancellation support
 	// - source: Identifier for the subscribing client (for logging and tracking)
+	// - opts: Optional subscribe options, options.WithNotificationTypes limits the notification types sent
 	//
 	// Returns:
 	// - Channel of Notification objects that will receive blockchain events
 	// - Error if the subscription creation fails
-	Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error)
+	Subscribe(ctx context.Context, source string, opts ...options.SubscribeOption) (chan *blockchain_api.Notification, error)
 
 	// GetState retrieves state data by key.
 	//
@@ -535,6 +639,23 @@ type ClientI interface {
 	// - Error if the state storage fails, nil on success
 	SetState(ctx context.Context, key string, data []byte) error
 
+	// CompareAndSetState atomically replaces state data, only if the current data matches the expected data.
+	//
+	// The comparison and the write are done in a single conditional update in the state database,
+	// so concurrent writers of the same key cannot overwrite each other. An empty expectedData means
+	// the key must not exist yet, in which case it is created.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - key: String identifier under which to store the data
+	// - expectedData: Byte array the key must currently hold, empty if the key must not exist
+	// - newData: Byte array containing the state data to store
+	//
+	// Returns:
+	// - Boolean indicating whether the data was swapped
+	// - Error if the state storage fails
+	CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error)
+
 	// SetBlockMinedSet marks a block as mined.
 	//
 	// This method updates the blockchain database to indicate that a specific block has
@@ -636,6 +757,54 @@ type ClientI interface {
 	// - Error if the retrieval fails
 	GetBestHeightAndTime(ctx context.Context) (uint32, uint32, error)
 
+	// GetMedianTimeForHeight retrieves the median time past of the block at a height in the main chain.
+	//
+	// This method calculates the median timestamp of the block at the given height and
+	// up to 10 of its ancestors, as used for lock time validation. For heights below 10
+	// the median is calculated over the blocks that are available.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - height: Height of the block in the main chain
+	//
+	// Returns:
+	// - The median timestamp as a uint32 (Unix time)
+	// - Error if no block exists at the height or the calculation fails
+	GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error)
+
+	// GetBlockSubsidy retrieves the block subsidy at a height and the halving schedule.
+	//
+	// This method returns the subsidy of the chain parameters of the node for a block at the
+	// given height, which does not have to exist yet, and the height of the first block with
+	// a lower subsidy.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - height: Block height
+	//
+	// Returns:
+	// - The block subsidy in satoshis
+	// - The height of the next subsidy reduction, 0 when the subsidy is not reduced any further
+	// - Error if the chain parameters have no subsidy reduction interval or the retrieval fails
+	GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error)
+
+	// WaitForBlockHeight waits until the best block reaches a height.
+	//
+	// This method blocks until the height of the best block is equal to or above the given
+	// height, re-checking the best block on every new block instead of polling, and returns
+	// the block header at the height in the main chain. This is mainly meant for tests
+	// and tooling that need to wait for the chain to progress.
+	//
+	// Parameters:
+	// - ctx: Context for the operation, the wait ends when the context is done
+	// - height: Height the best block should reach
+	//
+	// Returns:
+	// - The block header at the height
+	// - Metadata of the block at the height
+	// - Error if the context is done before the height is reached or the retrieval fails
+	WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error)
+
 	// CheckBlockIsInCurrentChain checks if blocks are in the current chain.
 	//
 	// This method determines whether blocks with the specified IDs are part of the
@@ -663,6 +832,9 @@ type ClientI interface {
 	// status indicating their validation state ("valid-fork", "valid-headers",
 	// "headers-only", or "invalid").
 	//
+	// The tips are ordered by height descending and then by hash, so repeated calls return
+	// them in the same order.
+	//
 	// Parameters:
 	// - ctx: Context for the operation with timeout and cancellation support
 	//
@@ -701,6 +873,22 @@ type ClientI interface {
 	// - Error if the state check fails
 	IsFSMCurrentState(ctx context.Context, state FSMStateType) (bool, error)
 
+	// IsCurrent reports whether the node is synced with the network.
+	//
+	// This method gives a single authoritative answer computed by the blockchain service, instead
+	// of each service deriving it from the best block itself. The node is current when the best
+	// block is at or above the last checkpoint, the best block is not older than the
+	// blockchain_maxTipAge setting, and the FSM is in the RUNNING state.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	//
+	// Returns:
+	// - Boolean indicating whether the node is current
+	// - The reasons the node is not current, empty when it is current
+	// - Error if the check fails
+	IsCurrent(ctx context.Context) (bool, []NotCurrentReason, error)
+
 	// WaitForFSMtoTransitionToGivenState blocks until the FSM transitions to the specified state.
 	//
 	// This method waits synchronously until the blockchain FSM reaches the specified state.
@@ -737,6 +925,21 @@ type ClientI interface {
 	// - Error if the wait operation fails or times out
 	WaitUntilFSMTransitionFromIdleState(ctx context.Context) error
 
+	// SubscribeFSMState creates a subscription to the state transitions of the blockchain FSM.
+	//
+	// The first state change received on the channel contains the current state of the FSM as
+	// both the old and the new state, followed by a state change for every transition of the FSM.
+	// This allows services to react to FSM transitions without polling GetFSMCurrentState.
+	//
+	// Parameters:
+	// - ctx: Context for the operation, the subscription ends when the context is cancelled
+	// - source: Identifier for the subscribing client (for logging and tracking)
+	//
+	// Returns:
+	// - Channel of FSMStateChange objects, which is closed when the subscription ends
+	// - Error if the subscription creation fails
+	SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error)
+
 	// IsFullyReady checks if the blockchain service is fully operational.
 	//
 	// This method verifies that the blockchain service is ready for normal operations,
@@ -853,6 +1056,21 @@ type ClientI interface {
 	// - Error if the locator generation fails
 	GetBlockLocator(ctx context.Context, blockHeaderHash *chainhash.Hash, blockHeaderHeight uint32) ([]*chainhash.Hash, error)
 
+	// GetBlockLocatorByHeight retrieves a block locator for the block at a height in the main chain.
+	//
+	// This method resolves the hash of the block at the given height in the main chain
+	// and generates the same block locator as GetBlockLocator, for callers that only
+	// know the height of the starting block.
+	//
+	// Parameters:
+	// - ctx: Context for the operation with timeout and cancellation support
+	// - blockHeaderHeight: Height of the starting block header in the main chain
+	//
+	// Returns:
+	// - Array of hashes forming the block locator sequence
+	// - Error if no block exists at the height or the locator generation fails
+	GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error)
+
 	// LocateBlockHeaders locates block headers using a block locator.
 	//
 	// This method uses a block locator to efficiently find where two blockchain states
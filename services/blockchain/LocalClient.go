@@ -3,6 +3,7 @@ package blockchain
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -31,7 +32,13 @@ type LocalClient struct {
 
 	// Subscription management
 	subscribersMu sync.RWMutex
-	subscribers   map[string]chan *blockchain_api.Notification
+	subscribers   map[string]localClientSubscriber
+}
+
+// localClientSubscriber represents a subscriber to the notifications of the local client.
+type localClientSubscriber struct {
+	ch                chan *blockchain_api.Notification // Channel for receiving notifications
+	notificationTypes uint64                            // Bitmask of the notification types to send, 0 means all types
 }
 
 // NewLocalClient creates a new LocalClient instance with the provided dependencies.
@@ -42,7 +49,7 @@ func NewLocalClient(logger ulogger.Logger, tSettings *settings.Settings, store b
 		store:        store,
 		subtreeStore: subtreeStore,
 		utxoStore:    utxoStore,
-		subscribers:  make(map[string]chan *blockchain_api.Notification),
+		subscribers:  make(map[string]localClientSubscriber),
 	}, nil
 }
 
@@ -123,9 +130,13 @@ func (c *LocalClient) AddBlock(ctx context.Context, block *model.Block, peerID s
 		return nil
 	}
 
-	for source, ch := range c.subscribers {
+	for source, sub := range c.subscribers {
+		if !matchesNotificationTypeFilter(sub.notificationTypes, notification.Type) {
+			continue
+		}
+
 		select {
-		case ch <- notification:
+		case sub.ch <- notification:
 			c.logger.Debugf("[Blockchain LocalClient] sent block notification to subscriber %s", source)
 		default:
 			c.logger.Warnf("[Blockchain LocalClient] failed to send notification to subscriber %s (channel full)", source)
@@ -161,6 +172,10 @@ func (c *LocalClient) GetBlockByHeight(ctx context.Context, height uint32) (*mod
 	return c.store.GetBlockByHeight(ctx, height)
 }
 
+func (c *LocalClient) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	return getBlocksByHeightRange(ctx, c.store, startHeight, endHeight, c.settings.BlockChain.MaxBlocksByHeightRange)
+}
+
 func (c *LocalClient) GetBlockByID(ctx context.Context, id uint64) (*model.Block, error) {
 	return c.store.GetBlockByID(ctx, id)
 }
@@ -177,8 +192,8 @@ func (c *LocalClient) GetBlockGraphData(ctx context.Context, periodMillis uint64
 	return c.store.GetBlockGraphData(ctx, periodMillis)
 }
 
-func (c *LocalClient) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
-	return c.store.GetLastNBlocks(ctx, n, includeOrphans, fromHeight)
+func (c *LocalClient) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
+	return c.store.GetLastNBlocks(ctx, n, selection, fromHeight)
 }
 
 func (c *LocalClient) GetLastNInvalidBlocks(ctx context.Context, n int64) ([]*model.BlockInfo, error) {
@@ -205,6 +220,24 @@ func (c *LocalClient) GetNextWorkRequired(ctx context.Context, blockHash *chainh
 	return difficulty.CalcNextWorkRequired(ctx, blockHeader, meta.Height, currentBlockTime)
 }
 
+func (c *LocalClient) GetDifficultyInfo(ctx context.Context) (*DifficultyInfo, error) {
+	difficulty, err := NewDifficulty(c.store, c.logger, c.settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return difficulty.DifficultyInfo(ctx)
+}
+
+func (c *LocalClient) GetMiningCandidateContext(ctx context.Context) (*MiningCandidateContext, error) {
+	difficulty, err := NewDifficulty(c.store, c.logger, c.settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return difficulty.MiningCandidateContext(ctx, time.Now())
+}
+
 func (c *LocalClient) GetBlockExists(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	exists, err := c.store.GetBlockExists(ctx, blockHash)
 	if err != nil {
@@ -222,6 +255,14 @@ func (c *LocalClient) GetBlockHeader(ctx context.Context, blockHash *chainhash.H
 	return c.store.GetBlockHeader(ctx, blockHash)
 }
 
+func (c *LocalClient) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	return c.store.GetBlockHeaderByHeight(ctx, height)
+}
+
+func (c *LocalClient) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	return c.store.GetBlockHeadersByHashes(ctx, blockHashes)
+}
+
 func (c *LocalClient) GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	return c.store.GetBlockHeaders(ctx, blockHash, numberOfHeaders)
 }
@@ -262,10 +303,14 @@ func (c *LocalClient) GetBlockHeadersByHeight(ctx context.Context, startHeight,
 	return c.store.GetBlockHeadersByHeight(ctx, startHeight, endHeight)
 }
 
-func (c *LocalClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+func (c *LocalClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, _ ...options.InvalidateBlockOption) ([]chainhash.Hash, error) {
 	return c.store.InvalidateBlock(ctx, blockHash)
 }
 
+func (c *LocalClient) InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	return c.store.GetBlocksAffectedByInvalidation(ctx, blockHash)
+}
+
 func (c *LocalClient) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
 	return c.store.RevalidateBlock(ctx, blockHash)
 }
@@ -284,9 +329,13 @@ func (c *LocalClient) SendNotification(ctx context.Context, notification *blockc
 		return nil
 	}
 
-	for source, ch := range c.subscribers {
+	for source, sub := range c.subscribers {
+		if !matchesNotificationTypeFilter(sub.notificationTypes, notification.Type) {
+			continue
+		}
+
 		select {
-		case ch <- notification:
+		case sub.ch <- notification:
 			c.logger.Debugf("[LocalClient] sent notification to subscriber %s", source)
 		default:
 			c.logger.Warnf("[LocalClient] failed to send notification to subscriber %s (channel full)", source)
@@ -296,20 +345,28 @@ func (c *LocalClient) SendNotification(ctx context.Context, notification *blockc
 	return nil
 }
 
-func (c *LocalClient) Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error) {
+func (c *LocalClient) Subscribe(ctx context.Context, source string, opts ...options.SubscribeOption) (chan *blockchain_api.Notification, error) {
+	subscribeOptions := options.ProcessSubscribeOptions(opts...)
+
 	// Return a buffered channel to prevent blocking
 	ch := make(chan *blockchain_api.Notification, 10)
+	notificationTypes := newNotificationTypeFilter(subscribeOptions.NotificationTypes)
 
 	// Register the subscriber
 	c.subscribersMu.Lock()
 	if c.subscribers == nil {
-		c.subscribers = make(map[string]chan *blockchain_api.Notification)
+		c.subscribers = make(map[string]localClientSubscriber)
 	}
-	c.subscribers[source] = ch
+	c.subscribers[source] = localClientSubscriber{ch: ch, notificationTypes: notificationTypes}
 	c.subscribersMu.Unlock()
 
 	c.logger.Infof("[LocalClient] Registered subscriber %s", source)
 
+	// the initial notification is a block notification, which the subscriber might not be interested in
+	if !matchesNotificationTypeFilter(notificationTypes, model.NotificationType_Block) {
+		return ch, nil
+	}
+
 	// initial notification to let subscribers know the current state
 	initialNotification := &blockchain_api.Notification{
 		Type: model.NotificationType_Block,
@@ -353,6 +410,10 @@ func (c *LocalClient) SetState(ctx context.Context, key string, data []byte) err
 	return c.store.SetState(ctx, key, data)
 }
 
+func (c *LocalClient) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	return c.store.CompareAndSetState(ctx, key, expectedData, newData)
+}
+
 func (c *LocalClient) GetBlockIsMined(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	return c.store.GetBlockIsMined(ctx, blockHash)
 }
@@ -387,6 +448,18 @@ func (c *LocalClient) IsFSMCurrentState(_ context.Context, state FSMStateType) (
 	return state == FSMStateRUNNING, nil
 }
 
+func (c *LocalClient) IsCurrent(ctx context.Context) (bool, []NotCurrentReason, error) {
+	_, bestBlockHeaderMeta, err := c.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+
+	state, _ := c.GetFSMCurrentState(ctx)
+	reasons := notCurrentReasons(bestBlockHeaderMeta, *state, c.settings)
+
+	return len(reasons) == 0, reasons, nil
+}
+
 func (c *LocalClient) WaitForFSMtoTransitionToGivenState(_ context.Context, _ FSMStateType) error {
 	return nil
 }
@@ -395,6 +468,22 @@ func (c *LocalClient) WaitUntilFSMTransitionFromIdleState(_ context.Context) err
 	return nil
 }
 
+func (c *LocalClient) SubscribeFSMState(ctx context.Context, _ string) (<-chan *blockchain_api.FSMStateChange, error) {
+	// the local client has no FSM, it is always running, see GetFSMCurrentState
+	ch := make(chan *blockchain_api.FSMStateChange, 1)
+	ch <- &blockchain_api.FSMStateChange{
+		OldState: FSMStateRUNNING,
+		NewState: FSMStateRUNNING,
+	}
+
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
 func (c *LocalClient) IsFullyReady(_ context.Context) (bool, error) {
 	// LocalClient is always ready since it doesn't depend on remote services
 	// and has direct store access without subscription infrastructure
@@ -438,6 +527,10 @@ func (c *LocalClient) GetBlockLocator(ctx context.Context, blockHeaderHash *chai
 	return getBlockLocator(ctx, c.store, blockHeaderHash, blockHeaderHeight)
 }
 
+func (c *LocalClient) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	return getBlockLocatorByHeight(ctx, c.store, blockHeaderHeight)
+}
+
 func (c *LocalClient) GetChainTips(ctx context.Context) ([]*model.ChainTip, error) {
 	return c.store.GetChainTips(ctx)
 }
@@ -502,3 +595,33 @@ func (c *LocalClient) GetBestHeightAndTime(ctx context.Context) (uint32, uint32,
 
 	return meta.Height, medianTimestampUint32, nil
 }
+
+func (c *LocalClient) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	return getMedianTimeForHeight(ctx, c.store, height)
+}
+
+func (c *LocalClient) GetBlockSubsidy(_ context.Context, height uint32) (uint64, uint32, error) {
+	return getBlockSubsidy(c.settings.ChainCfgParams, height)
+}
+
+// WaitForBlockHeight waits until the best block reaches the given height and returns the block header at the height,
+// the best block is checked again on every block notification of the local client.
+func (c *LocalClient) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	ch := make(chan *blockchain_api.Notification, 10)
+	source := fmt.Sprintf("WaitForBlockHeight-%p", ch)
+
+	c.subscribersMu.Lock()
+	if c.subscribers == nil {
+		c.subscribers = make(map[string]localClientSubscriber)
+	}
+	c.subscribers[source] = localClientSubscriber{ch: ch}
+	c.subscribersMu.Unlock()
+
+	defer func() {
+		c.subscribersMu.Lock()
+		delete(c.subscribers, source)
+		c.subscribersMu.Unlock()
+	}()
+
+	return waitForBlockHeight(ctx, c.store, height, ch)
+}
@@ -226,6 +226,47 @@ func TestLocalClient_GetBlockLocator(t *testing.T) {
 	_, _ = client.GetBlockLocator(ctx, &blockHeaderHash, blockHeaderHeight)
 }
 
+func TestLocalClient_WaitForBlockHeight(t *testing.T) {
+	ctx := setup(t)
+
+	client, err := NewLocalClient(ulogger.NewErrorTestLogger(t), ctx.server.settings, ctx.server.store, nil, nil)
+	require.NoError(t, err)
+
+	type result struct {
+		header *model.BlockHeader
+		err    error
+	}
+
+	resultCh := make(chan result, 1)
+
+	go func() {
+		header, _, err := client.WaitForBlockHeight(context.Background(), 2)
+		resultCh <- result{header, err}
+	}()
+
+	// the blocks are stored without notification, the waiter only checks again on the notification
+	blocks := storeTestChain(t, ctx, 2)
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, client.SendNotification(context.Background(), &blockchain_api.Notification{
+			Type: model.NotificationType_Block,
+			Hash: blocks[1].Hash().CloneBytes(),
+		}))
+
+		select {
+		case res := <-resultCh:
+			require.NoError(t, res.err)
+			assert.Equal(t, blocks[1].Hash(), res.header.Hash())
+
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Empty(t, client.(*LocalClient).subscribers)
+}
+
 // Test GetBlockHeadersToCommonAncestor - will panic with nil store
 func TestLocalClient_GetBlockHeadersToCommonAncestor(t *testing.T) {
 	ctx := context.Background()
@@ -316,6 +357,36 @@ func TestLocalClient_NilStorePanics(t *testing.T) {
 				_, _ = client.GetBlockByHeight(ctx, 100)
 			},
 		},
+		{
+			name: "GetBlocksByHeightRange",
+			fn: func() {
+				_, _ = client.GetBlocksByHeightRange(ctx, 100, 101)
+			},
+		},
+		{
+			name: "IsCurrent",
+			fn: func() {
+				_, _, _ = client.IsCurrent(ctx)
+			},
+		},
+		{
+			name: "GetBlockHeadersByHashes",
+			fn: func() {
+				_, _, _ = client.GetBlockHeadersByHashes(ctx, []*chainhash.Hash{&blockHash})
+			},
+		},
+		{
+			name: "GetDifficultyInfo",
+			fn: func() {
+				_, _ = client.GetDifficultyInfo(ctx)
+			},
+		},
+		{
+			name: "GetMiningCandidateContext",
+			fn: func() {
+				_, _ = client.GetMiningCandidateContext(ctx)
+			},
+		},
 		{
 			name: "GetBlockByID",
 			fn: func() {
@@ -352,6 +423,12 @@ func TestLocalClient_NilStorePanics(t *testing.T) {
 				_, _ = client.InvalidateBlock(ctx, &blockHash)
 			},
 		},
+		{
+			name: "InvalidateBlockDryRun",
+			fn: func() {
+				_, _ = client.InvalidateBlockDryRun(ctx, &blockHash)
+			},
+		},
 		{
 			name: "RevalidateBlock",
 			fn: func() {
@@ -406,12 +483,30 @@ func TestLocalClient_NilStorePanics(t *testing.T) {
 				_, _ = client.GetChainTips(ctx)
 			},
 		},
+		{
+			name: "GetBlockLocatorByHeight",
+			fn: func() {
+				_, _ = client.GetBlockLocatorByHeight(ctx, 100)
+			},
+		},
 		{
 			name: "GetBestHeightAndTime",
 			fn: func() {
 				_, _, _ = client.GetBestHeightAndTime(ctx)
 			},
 		},
+		{
+			name: "GetMedianTimeForHeight",
+			fn: func() {
+				_, _ = client.GetMedianTimeForHeight(ctx, 100)
+			},
+		},
+		{
+			name: "WaitForBlockHeight",
+			fn: func() {
+				_, _, _ = client.WaitForBlockHeight(ctx, 100)
+			},
+		},
 		{
 			name: "GetBlockStats",
 			fn: func() {
@@ -427,7 +522,7 @@ func TestLocalClient_NilStorePanics(t *testing.T) {
 		{
 			name: "GetLastNBlocks",
 			fn: func() {
-				_, _ = client.GetLastNBlocks(ctx, 10, true, 100)
+				_, _ = client.GetLastNBlocks(ctx, 10, model.BlockSelection_MainChainAndOrphans, 100)
 			},
 		},
 		{
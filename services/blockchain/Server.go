@@ -20,7 +20,9 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"maps"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -40,6 +42,7 @@ import (
 	"github.com/bitcoin-sv/teranode/util/tracing"
 	"github.com/bsv-blockchain/go-bt/v2"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-chaincfg"
 	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -62,9 +65,44 @@ import (
 // This struct enables the publish-subscribe pattern where multiple services can
 // receive real-time updates about blockchain state changes without polling.
 type subscriber struct {
-	subscription blockchain_api.BlockchainAPI_SubscribeServer // The gRPC subscription server
-	source       string                                       // Source identifier of the subscription
-	done         chan struct{}                                // Channel to signal when subscription is done
+	subscription      blockchain_api.BlockchainAPI_SubscribeServer // The gRPC subscription server
+	source            string                                       // Source identifier of the subscription
+	id                string                                       // Unique identifier of the subscription, several subscriptions can have the same source
+	done              chan struct{}                                // Channel to signal when subscription is done
+	notificationTypes uint64                                       // Bitmask of the notification types to send, 0 means all types
+	notifications     chan *blockchain_api.Notification            // Notifications queued to be sent to the subscriber, in order
+}
+
+// subscriberNotificationBufferSize is the number of notifications that can be queued for a subscriber.
+// A subscriber that falls this far behind is dropped, instead of holding up the other subscribers.
+const subscriberNotificationBufferSize = 1_000
+
+// newNotificationTypeFilter returns the bitmask of the given notification types, as used in subscriber.
+// An empty list of types results in an empty bitmask, which matches all notification types.
+func newNotificationTypeFilter(notificationTypes []model.NotificationType) uint64 {
+	var filter uint64
+
+	for _, notificationType := range notificationTypes {
+		if notificationType >= 0 && notificationType < 64 {
+			filter |= 1 << uint64(notificationType)
+		}
+	}
+
+	return filter
+}
+
+// matchesNotificationTypeFilter returns whether the notification type matches the bitmask of newNotificationTypeFilter.
+func matchesNotificationTypeFilter(filter uint64, notificationType model.NotificationType) bool {
+	if filter == 0 || notificationType < 0 || notificationType >= 64 {
+		return true
+	}
+
+	return filter&(1<<uint64(notificationType)) != 0
+}
+
+// wantsNotification returns whether the notification type matches the notification type filter of the subscriber.
+func (s subscriber) wantsNotification(notificationType model.NotificationType) bool {
+	return matchesNotificationTypeFilter(s.notificationTypes, notificationType)
 }
 
 // Blockchain represents the main blockchain service structure.
@@ -92,7 +130,10 @@ type Blockchain struct {
 	deadSubscriptions             chan subscriber                      // Channel for ended subscriptions
 	subscribers                   map[subscriber]bool                  // Active subscribers map
 	subscribersMu                 sync.RWMutex                         // Mutex for subscribers map
+	subscriberIDs                 atomic.Uint64                        // Last id given to a subscriber, for the per-subscriber metrics
 	notifications                 chan *blockchain_api.Notification    // Channel for notifications
+	addBlockLocks                 map[chainhash.Hash]*blockHashLock    // Locks of the blocks being added, by hash
+	addBlockLocksMu               sync.Mutex                           // Mutex for addBlockLocks map
 	newBlock                      chan struct{}                        // Channel signaling new block events
 	difficulty                    *Difficulty                          // Difficulty calculation instance
 	blocksFinalKafkaAsyncProducer kafka.KafkaAsyncProducerI            // Kafka producer for final blocks
@@ -103,8 +144,33 @@ type Blockchain struct {
 	AppCtx                        context.Context                      // Application context
 	localTestStartState           string                               // Initial state for testing
 	subscriptionManagerReady      atomic.Bool                          // Flag indicating subscription manager is ready
+	fsmSubscribers                fsmSubscriberMap                     // Active FSM state subscribers and their source
+	fsmSubscribersMu              sync.Mutex                           // Mutex for fsmSubscribers map
+	blockWaiters                  map[chan struct{}]struct{}           // Channels of the WaitForBlockHeight calls, signaled on new blocks
+	blockWaitersMu                sync.Mutex                           // Mutex for blockWaiters map
+	pendingSubtreesSet            map[chainhash.Hash]pendingBlock      // Blocks of which the BlockSubtreesSet notification waits for confirmations
+	pendingSubtreesSetMu          sync.Mutex                           // Mutex for pendingSubtreesSet map
+}
+
+// pendingBlock is a block of which the BlockSubtreesSet notification waits for
+// blockchain_subtreesSetNotificationConfirmations confirmations.
+type pendingBlock struct {
+	id     uint32
+	height uint32
+}
+
+// blockHashLock serializes the AddBlock calls for a single block hash.
+type blockHashLock struct {
+	mu   sync.Mutex
+	refs int // Number of AddBlock calls holding or waiting for the lock
 }
 
+// fsmSubscriberMap maps the channels of the FSM state subscribers to their source identifiers.
+type fsmSubscriberMap map[chan *blockchain_api.FSMStateChange]string
+
+// fsmSubscriberBufferSize is the number of FSM state changes that can be queued for an FSM state subscriber.
+const fsmSubscriberBufferSize = 100
+
 // New creates a new Blockchain instance with the provided dependencies.
 //
 // This constructor initializes the core blockchain service with all required components and
@@ -394,6 +460,10 @@ func (b *Blockchain) Start(ctx context.Context, readyCh chan<- struct{}) error {
 
 	go b.startSubscriptions()
 
+	if b.settings.BlockChain.CompactionInterval > 0 {
+		go b.startCompaction(ctx)
+	}
+
 	if err := b.startHTTP(ctx); err != nil {
 		return errors.WrapGRPC(err)
 	}
@@ -459,6 +529,7 @@ func (b *Blockchain) startHTTP(ctx context.Context) error {
 
 	e.GET("/invalidate/:hash", b.invalidateHandler)
 	e.GET("/revalidate/:hash", b.revalidateHandler)
+	e.GET("/compact", b.compactHandler)
 
 	go func() {
 		<-ctx.Done()
@@ -494,14 +565,18 @@ func (b *Blockchain) startHTTP(ctx context.Context) error {
 //
 // The invalidation process helps maintain blockchain integrity by marking blocks that
 // should be excluded from the active chain due to consensus rule violations or other issues.
+// A block deeper than blockchain_maxReorgDepth below the best block is only invalidated
+// when the force query parameter is set, e.g. force=1. When the dryrun query parameter is set,
+// e.g. dryrun=1, nothing is invalidated and the blocks that would be invalidated are returned.
 //
 // Parameters:
 // - c: The echo HTTP context containing the request details and response writer
 //
 // Returns:
-// - HTTP 400 (Bad Request) if the hash parameter is invalid
+// - HTTP 400 (Bad Request) if the hash, force or dryrun parameter is invalid
+// - HTTP 409 (Conflict) if the block is deeper than blockchain_maxReorgDepth and force is not set
 // - HTTP 500 (Internal Server Error) if the invalidation operation fails
-// - HTTP 200 (OK) with success message if the block is successfully invalidated
+// - HTTP 200 (OK) with success message if the block is invalidated, or the affected blocks as JSON for a dry run
 func (b *Blockchain) invalidateHandler(c echo.Context) error {
 	hashStr := c.Param("hash")
 
@@ -510,17 +585,75 @@ func (b *Blockchain) invalidateHandler(c echo.Context) error {
 		return c.String(http.StatusBadRequest, fmt.Sprintf("invalid hash: %v", err))
 	}
 
+	var force bool
+
+	if forceStr := c.QueryParam("force"); forceStr != "" {
+		if force, err = strconv.ParseBool(forceStr); err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("invalid force parameter: %v", err))
+		}
+	}
+
+	var dryRun bool
+
+	if dryRunStr := c.QueryParam("dryrun"); dryRunStr != "" {
+		if dryRun, err = strconv.ParseBool(dryRunStr); err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("invalid dryrun parameter: %v", err))
+		}
+	}
+
+	if dryRun {
+		return b.invalidateDryRunHandler(c, hash)
+	}
+
 	_, err = b.InvalidateBlock(b.AppCtx, &blockchain_api.InvalidateBlockRequest{
 		BlockHash: hash.CloneBytes(),
+		Force:     force,
 	})
 
 	if err != nil {
+		if errors.Is(errors.UnwrapGRPC(err), errors.ErrThresholdExceeded) {
+			return c.String(http.StatusConflict, fmt.Sprintf("error invalidating block: %v", err))
+		}
+
 		return c.String(http.StatusInternalServerError, fmt.Sprintf("error invalidating block: %v", err))
 	}
 
 	return c.String(http.StatusOK, fmt.Sprintf("block invalidated: %s", hashStr))
 }
 
+// invalidateDryRunHandler responds with the blocks that would be invalidated by invalidating the given block,
+// without invalidating them.
+func (b *Blockchain) invalidateDryRunHandler(c echo.Context, hash *chainhash.Hash) error {
+	resp, err := b.InvalidateBlock(b.AppCtx, &blockchain_api.InvalidateBlockRequest{
+		BlockHash: hash.CloneBytes(),
+		DryRun:    true,
+	})
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("error in dry run invalidating block: %v", err))
+	}
+
+	affectedBlocks := make([]*model.AffectedBlock, 0, len(resp.AffectedBlocks))
+
+	for _, affectedBlock := range resp.AffectedBlocks {
+		affectedHash, err := chainhash.NewHash(affectedBlock.Hash)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("error in dry run invalidating block: %v", err))
+		}
+
+		affectedBlocks = append(affectedBlocks, &model.AffectedBlock{
+			Hash:        affectedHash,
+			Height:      affectedBlock.Height,
+			OnBestChain: affectedBlock.OnBestChain,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"dryRun": true,
+		"count":  len(affectedBlocks),
+		"blocks": affectedBlocks,
+	})
+}
+
 // revalidateHandler handles HTTP requests to revalidate a block.
 //
 // This method processes HTTP requests to revalidate a previously invalidated block
@@ -557,6 +690,95 @@ func (b *Blockchain) revalidateHandler(c echo.Context) error {
 	return c.String(http.StatusOK, fmt.Sprintf("block revalidated: %s", hashStr))
 }
 
+// compactHandler handles HTTP requests to compact the blockchain store.
+//
+// This allows an operator to trigger a compaction run on demand, independently of the
+// periodic compaction configured with blockchain_compactionInterval. The configured safety
+// depth and state retention are used, so the same guarantees apply as for periodic runs.
+//
+// Parameters:
+// - c: The echo HTTP context containing the request details and response writer
+//
+// Returns:
+// - HTTP 500 (Internal Server Error) if the compaction fails
+// - HTTP 200 (OK) with a summary of the removed data if the compaction succeeds
+func (b *Blockchain) compactHandler(c echo.Context) error {
+	result, err := b.Compact(b.AppCtx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("error compacting blockchain store: %v", err))
+	}
+
+	return c.String(http.StatusOK, fmt.Sprintf("blockchain store compacted: removed %d blocks and %d state keys, reclaimed %d bytes",
+		len(result.RemovedBlocks), len(result.RemovedStateKeys), result.ReclaimedBytes))
+}
+
+// startCompaction periodically compacts the blockchain store until the context is cancelled.
+//
+// Parameters:
+// - ctx: Context for the operation with cancellation support
+func (b *Blockchain) startCompaction(ctx context.Context) {
+	ticker := time.NewTicker(b.settings.BlockChain.CompactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := b.Compact(ctx); err != nil {
+				b.logger.Errorf("[Blockchain][Compact] error compacting blockchain store: %v", err)
+			}
+		}
+	}
+}
+
+// Compact removes invalid blocks buried below the configured safety depth and stale state keys
+// from the blockchain store.
+//
+// Blocks on the best chain are never removed, and invalid side chains within
+// blockchain_compactionSafetyDepth of the best block are retained, since they could still be
+// revalidated. Only stale state keys starting with one of blockchain_compactionStateKeyPrefixes
+// are removed. Every removed block and state key is logged, and the reclaimed space is
+// exposed through the teranode_blockchain_compact_reclaimed_bytes metric.
+//
+// Parameters:
+// - ctx: Context for the operation with cancellation support
+//
+// Returns:
+// - *model.CompactionResult: Details of the removed data
+// - Error if the compaction fails
+func (b *Blockchain) Compact(ctx context.Context) (*model.CompactionResult, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "Compact",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainCompact),
+		tracing.WithDebugLogMessage(b.logger, "[Compact] called"),
+	)
+	defer deferFn()
+
+	result, err := b.store.Compact(ctx, b.settings.BlockChain.CompactionSafetyDepth, b.settings.BlockChain.CompactionStateRetention,
+		b.settings.BlockChain.CompactionStateKeyPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range result.RemovedBlocks {
+		b.logger.Infof("[Compact] removed invalid block %s", hash.String())
+	}
+
+	for _, key := range result.RemovedStateKeys {
+		b.logger.Infof("[Compact] removed stale state key %s", key)
+	}
+
+	b.logger.Infof("[Compact] removed %d blocks and %d state keys, reclaimed %d bytes",
+		len(result.RemovedBlocks), len(result.RemovedStateKeys), result.ReclaimedBytes)
+
+	prometheusBlockchainCompactRemovedBlocks.Add(float64(len(result.RemovedBlocks)))
+	prometheusBlockchainCompactRemovedStateKeys.Add(float64(len(result.RemovedStateKeys)))
+	prometheusBlockchainCompactReclaimedBytes.Add(float64(result.ReclaimedBytes))
+
+	return result, nil
+}
+
 // startKafka initializes and starts the Kafka producer.
 //
 // This method sets up the asynchronous Kafka messaging infrastructure used for publishing
@@ -589,8 +811,10 @@ func (b *Blockchain) startKafka() {
 // to handle high-throughput notification scenarios with concurrent delivery to
 // multiple subscribers.
 //
-// Each notification is forwarded asynchronously to prevent slow subscribers from
-// impacting overall system performance, with automatic cleanup of failed connections.
+// Each notification is queued on the buffered channel of the subscriber and sent by the
+// sender goroutine of that subscriber, which preserves the order of the notifications per
+// subscriber. Subscribers whose queue overflows are dropped, to prevent slow subscribers
+// from impacting overall system performance, as are subscribers with failed connections.
 //
 // Note: This method must be started as a goroutine unless running in a test environment.
 func (b *Blockchain) startSubscriptions() {
@@ -614,15 +838,21 @@ func (b *Blockchain) startSubscriptions() {
 				b.logger.Debugf("[Blockchain Server] Sending notification: %s", notification)
 
 				for sub := range b.subscribers {
-					b.logger.Debugf("[Blockchain][startSubscriptions] Sending notification to %s in background: %s", sub.source, notification.Stringify())
+					if !sub.wantsNotification(notification.Type) {
+						continue
+					}
 
-					go func(s subscriber) {
-						b.logger.Debugf("[Blockchain][startSubscriptions] Sending notification to %s: %s", s.source, notification.Stringify())
+					b.logger.Debugf("[Blockchain][startSubscriptions] Queueing notification for %s: %s", sub.source, notification.Stringify())
 
-						if err := s.subscription.Send(notification); err != nil {
-							b.deadSubscriptions <- s
-						}
-					}(sub)
+					select {
+					case sub.notifications <- notification:
+						// the gauge is only set here, where removeSubscriber runs, so the series of a removed
+						// subscriber is not created again after it has been deleted
+						prometheusBlockchainSubscriberQueueDepth.WithLabelValues(sub.source, sub.id).Set(float64(len(sub.notifications)))
+					default:
+						b.logger.Warnf("[Blockchain][startSubscriptions] Notification queue of %s is full, dropping subscription", sub.source)
+						b.removeSubscriber(sub)
+					}
 				}
 			}()
 			b.stats.NewStat("channel-subscription.Send", true).AddTime(start)
@@ -632,40 +862,84 @@ func (b *Blockchain) startSubscriptions() {
 			b.subscribers[s] = true
 			b.subscribersMu.Unlock()
 
-			// Send initial notification to let the subscriber know the subscription is ready
-			// and provide the current blockchain state
-			go func(sub subscriber) {
-				chainTip, _, err := b.store.GetBestBlockHeader(context.Background())
-				var initialNotification *blockchain_api.Notification
-				if err != nil {
-					// If no best block exists yet (e.g., empty blockchain), send notification with genesis hash
-					b.logger.Warnf("[Blockchain][startSubscriptions] No best block header available for initial notification to %s: %v", sub.source, err)
-					initialNotification = &blockchain_api.Notification{
-						Type: model.NotificationType_Block,
-						Hash: b.settings.ChainCfgParams.GenesisHash.CloneBytes(),
-					}
-				} else {
-					initialNotification = &blockchain_api.Notification{
-						Type: model.NotificationType_Block,
-						Hash: chainTip.Hash().CloneBytes(),
-					}
-				}
-
-				b.logger.Infof("[Blockchain][startSubscriptions] Sending initial notification to %s", sub.source)
-				if err := sub.subscription.Send(initialNotification); err != nil {
-					b.logger.Errorf("[Blockchain][startSubscriptions] Failed to send initial notification to %s: %v", sub.source, err)
-					b.deadSubscriptions <- sub
-				}
-			}(s)
+			go b.sendNotifications(s)
 
 		case s := <-b.deadSubscriptions:
-			delete(b.subscribers, s)
-			safeClose(s.done)
-			b.logger.Infof("[Blockchain][startSubscriptions] Subscription removed (Total=%d).", len(b.subscribers))
+			b.removeSubscriber(s)
+		}
+	}
+}
+
+// removeSubscriber removes the subscriber from the active subscribers and ends its subscription.
+// It must only be called from the startSubscriptions goroutine.
+func (b *Blockchain) removeSubscriber(s subscriber) {
+	b.subscribersMu.Lock()
+	delete(b.subscribers, s)
+	b.subscribersMu.Unlock()
+
+	safeClose(s.done)
+	prometheusBlockchainSubscriberQueueDepth.DeleteLabelValues(s.source, s.id)
+
+	b.logger.Infof("[Blockchain][startSubscriptions] Subscription removed (Total=%d).", len(b.subscribers))
+}
+
+// sendNotifications sends the notifications queued for the subscriber, in the order they were queued,
+// until the subscription ends. A single goroutine per subscriber sends the notifications, which keeps
+// the number of goroutines bounded when many notifications are sent to many subscribers.
+func (b *Blockchain) sendNotifications(sub subscriber) {
+	// Send initial notification to let the subscriber know the subscription is ready
+	// and provide the current blockchain state, unless the subscriber is not interested in blocks
+	if sub.wantsNotification(model.NotificationType_Block) {
+		chainTip, _, err := b.store.GetBestBlockHeader(context.Background())
+		var initialNotification *blockchain_api.Notification
+		if err != nil {
+			// If no best block exists yet (e.g., empty blockchain), send notification with genesis hash
+			b.logger.Warnf("[Blockchain][startSubscriptions] No best block header available for initial notification to %s: %v", sub.source, err)
+			initialNotification = &blockchain_api.Notification{
+				Type: model.NotificationType_Block,
+				Hash: b.settings.ChainCfgParams.GenesisHash.CloneBytes(),
+			}
+		} else {
+			initialNotification = &blockchain_api.Notification{
+				Type: model.NotificationType_Block,
+				Hash: chainTip.Hash().CloneBytes(),
+			}
+		}
+
+		b.logger.Infof("[Blockchain][startSubscriptions] Sending initial notification to %s", sub.source)
+		if err := sub.subscription.Send(initialNotification); err != nil {
+			b.logger.Errorf("[Blockchain][startSubscriptions] Failed to send initial notification to %s: %v", sub.source, err)
+			b.markSubscriberDead(sub)
+
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-sub.done:
+			return
+		case notification := <-sub.notifications:
+			b.logger.Debugf("[Blockchain][startSubscriptions] Sending notification to %s: %s", sub.source, notification.Stringify())
+
+			if err := sub.subscription.Send(notification); err != nil {
+				b.markSubscriberDead(sub)
+
+				return
+			}
 		}
 	}
 }
 
+// markSubscriberDead hands the subscriber to the startSubscriptions goroutine to be removed.
+func (b *Blockchain) markSubscriberDead(sub subscriber) {
+	select {
+	case b.deadSubscriptions <- sub:
+	case <-sub.done:
+	case <-b.AppCtx.Done():
+	}
+}
+
 // Stop gracefully stops the blockchain service.
 //
 // This method handles the graceful shutdown of the blockchain service, allowing
@@ -697,6 +971,13 @@ func (b *Blockchain) Stop(_ context.Context) error {
 // - Publishes the finalized block to Kafka for downstream services
 // - Notifies subscribers about the new block
 //
+// Adding a block is idempotent: when the block is already stored, its height is returned
+// without storing or announcing it again, so retried requests (e.g. from at-least-once
+// Kafka delivery) do not send duplicate notifications. Only when the request waits for the
+// Kafka delivery is an already stored block published again, so that a retry after a failed
+// delivery still reaches Kafka. Concurrent requests for the same block are serialized, so
+// only one of them stores the block.
+//
 // The method includes performance tracking via tracing and metrics, with detailed logging
 // at key points in the process. Error conditions are carefully handled with appropriate
 // GRPC error wrapping to ensure consistent error reporting across the system.
@@ -706,9 +987,9 @@ func (b *Blockchain) Stop(_ context.Context) error {
 // - request: The AddBlockRequest containing block data and metadata
 //
 // Returns:
-// - Empty response on success
+// - AddBlockResponse with the height of the block, and whether it was already stored
 // - Error if the block addition fails (wrapped for GRPC transmission)
-func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBlockRequest) (*emptypb.Empty, error) {
+func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBlockRequest) (*blockchain_api.AddBlockResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "AddBlock",
 		tracing.WithParentStat(b.stats),
 		tracing.WithHistogram(prometheusBlockchainAddBlock),
@@ -723,6 +1004,49 @@ func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBl
 
 	b.logger.Infof("[Blockchain][AddBlock] AddBlock called: %s", header.Hash().String())
 
+	unlock := b.lockBlockHash(*header.Hash())
+	defer unlock()
+
+	exists, err := b.store.GetBlockExists(ctx, header.Hash())
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	if exists {
+		_, meta, err := b.store.GetBlockHeader(ctx, header.Hash())
+		if err != nil {
+			return nil, errors.WrapGRPC(err)
+		}
+
+		b.logger.Infof("[AddBlock] block %s already stored (ID: %d, height: %d), not adding it again", header.Hash(), meta.ID, meta.Height)
+
+		// a previous call may have stored the block but failed to deliver it to Kafka, so the caller
+		// waiting for the delivery gets the block published again
+		if request.OptionWaitForKafka && b.blocksFinalKafkaAsyncProducer != nil {
+			storedBlock, height, err := b.store.GetBlock(ctx, header.Hash())
+			if err != nil {
+				return nil, errors.WrapGRPC(err)
+			}
+
+			storedBlock.Height = height
+
+			message, err := b.newBlocksFinalMessage(storedBlock)
+			if err != nil {
+				return nil, errors.WrapGRPC(err)
+			}
+
+			if err = b.publishAndWaitForDelivery(ctx, message); err != nil {
+				b.logger.Errorf("[AddBlock] error delivering already stored block %s to Kafka: %v", header.Hash(), err)
+				return nil, errors.WrapGRPC(err)
+			}
+		}
+
+		return &blockchain_api.AddBlockResponse{
+			Height:        meta.Height,
+			AlreadyExists: true,
+		}, nil
+	}
+
 	btCoinbaseTx, err := bt.NewTxFromBytes(request.CoinbaseTx)
 	if err != nil {
 		return nil, errors.WrapGRPC(errors.NewInvalidArgumentError("[Blockchain][AddBlock] can't create the coinbase transaction", err))
@@ -764,6 +1088,12 @@ func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBl
 		storeBlockOptions = append(storeBlockOptions, blockchainoptions.WithID(request.OptionID))
 	}
 
+	// the best block before storing the new block, to detect whether the new block switches the best chain
+	oldBestHeader, oldBestMeta, oldBestErr := b.store.GetBestBlockHeader(ctx)
+	if oldBestErr != nil {
+		b.logger.Errorf("[AddBlock] error getting best block header, not checking for a reorg: %v", oldBestErr)
+	}
+
 	ID, height, err := b.store.StoreBlock(ctx, block, request.PeerId, storeBlockOptions...)
 	if err != nil {
 		return nil, errors.WrapGRPC(err)
@@ -775,37 +1105,27 @@ func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBl
 
 	b.logger.Debugf("[AddBlock] checking for Kafka producer: %v", b.blocksFinalKafkaAsyncProducer != nil)
 
-	if b.blocksFinalKafkaAsyncProducer != nil {
-		key := block.Header.Hash().CloneBytes()
-
-		subtreeHashes := make([][]byte, len(block.Subtrees))
-		for i, subtreeHash := range block.Subtrees {
-			subtreeHashes[i] = subtreeHash.CloneBytes()
-		}
-
-		message := &kafkamessage.KafkaBlocksFinalTopicMessage{
-			Header:           block.Header.Bytes(),
-			TransactionCount: block.TransactionCount,
-			SizeInBytes:      block.SizeInBytes,
-			SubtreeHashes:    subtreeHashes,
-			CoinbaseTx:       block.CoinbaseTx.Bytes(),
-			Height:           block.Height,
-		}
+	// the error of the Kafka delivery, when waiting for it. The block is already stored, so the notifications
+	// are still sent before the error is returned
+	var kafkaErr error
 
-		value, err := proto.Marshal(message)
+	if b.blocksFinalKafkaAsyncProducer != nil {
+		message, err := b.newBlocksFinalMessage(block)
 		if err != nil {
-			b.logger.Errorf("[AddBlock] error creating block bytes: %v", err)
 			return nil, errors.WrapGRPC(err)
 		}
 
-		if len(value) >= 500_000 { // kafka default limit is actually 1MB and we don't ever expecta block to be even close to that
-			b.logger.Warnf("[AddBlock] blocks-final message size %d bytes maybe too large for Kafka, block hash: %s (height: %d)", len(value), block.Header.Hash(), block.Height)
+		if request.OptionWaitForKafka {
+			if kafkaErr = b.publishAndWaitForDelivery(ctx, message); kafkaErr != nil {
+				b.logger.Errorf("[AddBlock] error delivering block %s to Kafka: %v", block.Hash(), kafkaErr)
+			}
+		} else {
+			b.kafkaChan <- message
 		}
+	}
 
-		b.kafkaChan <- &kafka.Message{
-			Key:   key,
-			Value: value,
-		}
+	if oldBestErr == nil {
+		b.sendReorgNotification(ctx, oldBestHeader, oldBestMeta)
 	}
 
 	if _, err = b.SendNotification(ctx, &blockchain_api.Notification{
@@ -815,7 +1135,116 @@ func (b *Blockchain) AddBlock(ctx context.Context, request *blockchain_api.AddBl
 		b.logger.Errorf("[AddBlock] error sending notification for new block %s: %v", block.Hash(), err)
 	}
 
-	return &emptypb.Empty{}, nil
+	b.sendConfirmedSubtreesSetNotifications(ctx)
+
+	if kafkaErr != nil {
+		return nil, errors.WrapGRPC(kafkaErr)
+	}
+
+	return &blockchain_api.AddBlockResponse{
+		Height: height,
+	}, nil
+}
+
+// newBlocksFinalMessage creates the blocks-final Kafka message for the given block, keyed by the block hash.
+func (b *Blockchain) newBlocksFinalMessage(block *model.Block) (*kafka.Message, error) {
+	subtreeHashes := make([][]byte, len(block.Subtrees))
+	for i, subtreeHash := range block.Subtrees {
+		subtreeHashes[i] = subtreeHash.CloneBytes()
+	}
+
+	message := &kafkamessage.KafkaBlocksFinalTopicMessage{
+		Header:           block.Header.Bytes(),
+		TransactionCount: block.TransactionCount,
+		SizeInBytes:      block.SizeInBytes,
+		SubtreeHashes:    subtreeHashes,
+		CoinbaseTx:       block.CoinbaseTx.Bytes(),
+		Height:           block.Height,
+	}
+
+	value, err := proto.Marshal(message)
+	if err != nil {
+		b.logger.Errorf("[AddBlock] error creating block bytes: %v", err)
+		return nil, err
+	}
+
+	if len(value) >= 500_000 { // kafka default limit is actually 1MB and we don't ever expecta block to be even close to that
+		b.logger.Warnf("[AddBlock] blocks-final message size %d bytes maybe too large for Kafka, block hash: %s (height: %d)", len(value), block.Header.Hash(), block.Height)
+	}
+
+	return &kafka.Message{
+		Key:   block.Header.Hash().CloneBytes(),
+		Value: value,
+	}, nil
+}
+
+// publishAndWaitForDelivery sends a message to the blocks-final Kafka producer and waits until the producer
+// reports the delivery of the message, for at most the configured Kafka delivery timeout. A timeout <= 0 waits
+// until the context is done.
+//
+// Returns:
+// - nil when the message was delivered
+// - the delivery error, or a service error when the delivery timed out or the context was done
+func (b *Blockchain) publishAndWaitForDelivery(ctx context.Context, message *kafka.Message) error {
+	deliveredCh := make(chan error, 1)
+
+	message.Delivered = func(err error) {
+		deliveredCh <- err
+	}
+
+	timeoutCtx, cancel := context.WithCancel(ctx)
+	if timeout := b.settings.BlockChain.KafkaDeliveryTimeout; timeout > 0 {
+		timeoutCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	select {
+	case b.kafkaChan <- message:
+	case <-timeoutCtx.Done():
+		return errors.NewServiceError("[AddBlock] timed out queuing message for Kafka", timeoutCtx.Err())
+	}
+
+	select {
+	case err := <-deliveredCh:
+		return err
+	case <-timeoutCtx.Done():
+		return errors.NewServiceError("[AddBlock] timed out waiting for Kafka delivery", timeoutCtx.Err())
+	}
+}
+
+// lockBlockHash locks the given block hash, so that concurrent AddBlock calls for the same block are serialized.
+// The returned function unlocks the hash and must be called when done.
+func (b *Blockchain) lockBlockHash(hash chainhash.Hash) func() {
+	b.addBlockLocksMu.Lock()
+
+	if b.addBlockLocks == nil {
+		b.addBlockLocks = make(map[chainhash.Hash]*blockHashLock)
+	}
+
+	lock, ok := b.addBlockLocks[hash]
+	if !ok {
+		lock = &blockHashLock{}
+		b.addBlockLocks[hash] = lock
+	}
+
+	lock.refs++
+
+	b.addBlockLocksMu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+
+		b.addBlockLocksMu.Lock()
+
+		lock.refs--
+		if lock.refs == 0 {
+			delete(b.addBlockLocks, hash)
+		}
+
+		b.addBlockLocksMu.Unlock()
+	}
 }
 
 // GetBlock retrieves a block by its hash.
@@ -912,6 +1341,63 @@ func (b *Blockchain) GetBlocks(ctx context.Context, req *blockchain_api.GetBlock
 	}, nil
 }
 
+// GetBlocksByHeightRange retrieves the blocks in an inclusive height range of the blockchain.
+// The blocks are returned serialized in ascending height order. The number of blocks in the range
+// is capped by blockchain_maxBlocksByHeightRange, to bound the size of the response.
+func (b *Blockchain) GetBlocksByHeightRange(ctx context.Context, request *blockchain_api.GetBlocksByHeightRangeRequest) (*blockchain_api.GetBlocksResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlocksByHeightRange",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBlock),
+		tracing.WithLogMessage(b.logger, "[GetBlocksByHeightRange] called for %d - %d", request.StartHeight, request.EndHeight),
+	)
+	defer deferFn()
+
+	blocks, err := getBlocksByHeightRange(ctx, b.store, request.StartHeight, request.EndHeight, b.settings.BlockChain.MaxBlocksByHeightRange)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	blockBytes := make([][]byte, len(blocks))
+
+	for i, block := range blocks {
+		if blockBytes[i], err = block.Bytes(); err != nil {
+			return nil, errors.WrapGRPC(err)
+		}
+	}
+
+	return &blockchain_api.GetBlocksResponse{
+		Blocks: blockBytes,
+	}, nil
+}
+
+// getBlocksByHeightRange validates the inclusive height range against maxBlocks and fetches the blocks from the store.
+func getBlocksByHeightRange(ctx context.Context, store blockchain_store.Store, startHeight, endHeight, maxBlocks uint32) ([]*model.Block, error) {
+	if endHeight < startHeight {
+		return nil, errors.NewInvalidArgumentError("end height %d is below start height %d", endHeight, startHeight)
+	}
+
+	if span := uint64(endHeight) - uint64(startHeight) + 1; span > uint64(maxBlocks) {
+		return nil, errors.NewInvalidArgumentError("height range %d - %d contains %d blocks, which exceeds the maximum of %d", startHeight, endHeight, span, maxBlocks)
+	}
+
+	// the span fits in an uint32, since it does not exceed maxBlocks
+	numberOfBlocks := endHeight - startHeight + 1
+	blocks := make([]*model.Block, 0, numberOfBlocks)
+
+	for i := uint32(0); i < numberOfBlocks; i++ {
+		height := startHeight + i
+
+		block, err := store.GetBlockByHeight(ctx, height)
+		if err != nil {
+			return nil, errors.NewBlockNotFoundError("[Blockchain] block not found at height %d", height, err)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
 // GetBlockByHeight retrieves a block at a specific height in the blockchain.
 func (b *Blockchain) GetBlockByHeight(ctx context.Context, request *blockchain_api.GetBlockByHeightRequest) (*blockchain_api.GetBlockResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockByHeight",
@@ -1026,7 +1512,8 @@ func (b *Blockchain) GetBlockGraphData(ctx context.Context, req *blockchain_api.
 	return resp, errors.WrapGRPC(err)
 }
 
-// GetLastNBlocks retrieves the most recent N blocks from the blockchain.
+// GetLastNBlocks retrieves the most recent N blocks from the blockchain, ordered by height descending and
+// then by hash. Requests of clients that only set includeOrphans return the main chain and orphaned blocks.
 func (b *Blockchain) GetLastNBlocks(ctx context.Context, request *blockchain_api.GetLastNBlocksRequest) (*blockchain_api.GetLastNBlocksResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetLastNBlocks",
 		tracing.WithParentStat(b.stats),
@@ -1034,7 +1521,12 @@ func (b *Blockchain) GetLastNBlocks(ctx context.Context, request *blockchain_api
 	)
 	defer deferFn()
 
-	blockInfo, err := b.store.GetLastNBlocks(ctx, request.NumberOfBlocks, request.IncludeOrphans, request.FromHeight)
+	selection := request.Selection
+	if request.IncludeOrphans && selection == model.BlockSelection_MainChain {
+		selection = model.BlockSelection_MainChainAndOrphans
+	}
+
+	blockInfo, err := b.store.GetLastNBlocks(ctx, request.NumberOfBlocks, selection, request.FromHeight)
 	if err != nil {
 		return nil, errors.WrapGRPC(err)
 	}
@@ -1116,26 +1608,73 @@ func (b *Blockchain) GetNextWorkRequired(ctx context.Context, request *blockchai
 	}, nil
 }
 
-// GetHashOfAncestorBlock retrieves the hash of an ancestor block at a specific depth.
-func (b *Blockchain) GetHashOfAncestorBlock(ctx context.Context, request *blockchain_api.GetHashOfAncestorBlockRequest) (*blockchain_api.GetHashOfAncestorBlockResponse, error) {
-	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetHashOfAncestorBlock",
+// GetDifficultyInfo retrieves the difficulty state at the tip of the best chain.
+func (b *Blockchain) GetDifficultyInfo(ctx context.Context, _ *emptypb.Empty) (*blockchain_api.GetDifficultyInfoResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetDifficultyInfo",
 		tracing.WithParentStat(b.stats),
-		tracing.WithHistogram(prometheusBlockchainGetHashOfAncestorBlock),
+		tracing.WithHistogram(prometheusBlockchainGetDifficultyInfo),
 	)
 	defer deferFn()
 
-	hash, err := b.store.GetHashOfAncestorBlock(ctx, (*chainhash.Hash)(request.Hash), int(request.Depth))
+	info, err := b.difficulty.DifficultyInfo(ctx)
 	if err != nil {
 		return nil, errors.WrapGRPC(err)
 	}
 
-	return &blockchain_api.GetHashOfAncestorBlockResponse{
-		Hash: hash[:],
+	return &blockchain_api.GetDifficultyInfoResponse{
+		BlockHash:                  info.BlockHash.CloneBytes(),
+		Height:                     info.Height,
+		Bits:                       info.Bits.CloneBytes(),
+		Target:                     info.Target.String(),
+		ChainWork:                  info.ChainWork.Bytes(),
+		DifficultyAdjustmentWindow: info.AdjustmentWindow,
+		NextRetargetHeight:         info.NextRetargetHeight,
 	}, nil
 }
 
-func (b *Blockchain) GetLatestBlockHeaderFromBlockLocatorRequest(ctx context.Context, request *blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest) (*blockchain_api.GetBlockHeaderResponse, error) {
-	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetLatestBlockHeaderFromBlockLocatorRequest",
+// GetMiningCandidateContext retrieves the chain state a block template is built on, the hash and height
+// of the best block, the difficulty bits and the minimum and current timestamp of the new block.
+func (b *Blockchain) GetMiningCandidateContext(ctx context.Context, _ *emptypb.Empty) (*blockchain_api.GetMiningCandidateContextResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetMiningCandidateContext",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetMiningCandidateContext),
+	)
+	defer deferFn()
+
+	candidateContext, err := b.difficulty.MiningCandidateContext(ctx, time.Now())
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockchain_api.GetMiningCandidateContextResponse{
+		PreviousBlockHash: candidateContext.PreviousBlockHash.CloneBytes(),
+		Height:            candidateContext.Height,
+		Bits:              candidateContext.Bits.CloneBytes(),
+		MinTime:           candidateContext.MinTime,
+		CurTime:           candidateContext.CurTime,
+	}, nil
+}
+
+// GetHashOfAncestorBlock retrieves the hash of an ancestor block at a specific depth.
+func (b *Blockchain) GetHashOfAncestorBlock(ctx context.Context, request *blockchain_api.GetHashOfAncestorBlockRequest) (*blockchain_api.GetHashOfAncestorBlockResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetHashOfAncestorBlock",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetHashOfAncestorBlock),
+	)
+	defer deferFn()
+
+	hash, err := b.store.GetHashOfAncestorBlock(ctx, (*chainhash.Hash)(request.Hash), int(request.Depth))
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockchain_api.GetHashOfAncestorBlockResponse{
+		Hash: hash[:],
+	}, nil
+}
+
+func (b *Blockchain) GetLatestBlockHeaderFromBlockLocatorRequest(ctx context.Context, request *blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest) (*blockchain_api.GetBlockHeaderResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetLatestBlockHeaderFromBlockLocatorRequest",
 		tracing.WithParentStat(b.stats),
 		tracing.WithHistogram(prometheusBlockchainGetLatestBlockHeaderFromBlockLocator),
 	)
@@ -1313,6 +1852,70 @@ func (b *Blockchain) GetBlockHeader(ctx context.Context, req *blockchain_api.Get
 		return nil, errors.WrapGRPC(err)
 	}
 
+	return blockHeaderResponse(blockHeader, meta), nil
+}
+
+// GetBlockHeaderByHeight retrieves the header of the block at a specific height in the current chain.
+func (b *Blockchain) GetBlockHeaderByHeight(ctx context.Context, req *blockchain_api.GetBlockByHeightRequest) (*blockchain_api.GetBlockHeaderResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockHeaderByHeight",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBlockHeaderByHeight),
+	)
+	defer deferFn()
+
+	blockHeader, meta, err := b.store.GetBlockHeaderByHeight(ctx, req.Height)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return blockHeaderResponse(blockHeader, meta), nil
+}
+
+// GetBlockHeadersByHashes retrieves the headers of a list of blocks. The headers are returned in the order
+// of the requested hashes, blocks that are not found are marked in the found flags instead of failing the request.
+func (b *Blockchain) GetBlockHeadersByHashes(ctx context.Context, req *blockchain_api.GetBlockHeadersByHashesRequest) (*blockchain_api.GetBlockHeadersByHashesResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockHeadersByHashes",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBlockHeaders),
+	)
+	defer deferFn()
+
+	blockHashes := make([]*chainhash.Hash, len(req.BlockHashes))
+
+	for i, hashBytes := range req.BlockHashes {
+		hash, err := chainhash.NewHash(hashBytes)
+		if err != nil {
+			return nil, errors.WrapGRPC(errors.NewInvalidArgumentError("[Blockchain][GetBlockHeadersByHashes] request's hash at index %d is not valid", i, err))
+		}
+
+		blockHashes[i] = hash
+	}
+
+	blockHeaders, blockHeaderMetas, err := b.store.GetBlockHeadersByHashes(ctx, blockHashes)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	resp := &blockchain_api.GetBlockHeadersByHashesResponse{
+		BlockHeaders: make([][]byte, len(blockHeaders)),
+		Metas:        make([][]byte, len(blockHeaders)),
+		Found:        make([]bool, len(blockHeaders)),
+	}
+
+	for i, blockHeader := range blockHeaders {
+		if blockHeader == nil {
+			continue
+		}
+
+		resp.BlockHeaders[i] = blockHeader.Bytes()
+		resp.Metas[i] = blockHeaderMetas[i].Bytes()
+		resp.Found[i] = true
+	}
+
+	return resp, nil
+}
+
+func blockHeaderResponse(blockHeader *model.BlockHeader, meta *model.BlockHeaderMeta) *blockchain_api.GetBlockHeaderResponse {
 	return &blockchain_api.GetBlockHeaderResponse{
 		BlockHeader: blockHeader.Bytes(),
 		Id:          meta.ID,
@@ -1326,7 +1929,7 @@ func (b *Blockchain) GetBlockHeader(ctx context.Context, req *blockchain_api.Get
 		MinedSet:    meta.MinedSet,
 		SubtreesSet: meta.SubtreesSet,
 		Invalid:     meta.Invalid,
-	}, nil
+	}
 }
 
 // GetBlockHeaders retrieves multiple block headers starting from a specific hash.
@@ -1557,9 +2160,12 @@ func (b *Blockchain) Subscribe(req *blockchain_api.SubscribeRequest, sub blockch
 
 	b.logger.Infof("[Blockchain] Sending new subscription to handler for source: %s", req.Source)
 	b.newSubscriptions <- subscriber{
-		subscription: sub,
-		done:         ch,
-		source:       req.Source,
+		subscription:      sub,
+		done:              ch,
+		source:            req.Source,
+		id:                strconv.FormatUint(b.subscriberIDs.Add(1), 10),
+		notificationTypes: newNotificationTypeFilter(req.NotificationTypes),
+		notifications:     make(chan *blockchain_api.Notification, subscriberNotificationBufferSize),
 	}
 
 	b.subscribersMu.RLock()
@@ -1685,6 +2291,37 @@ func (b *Blockchain) SetState(ctx context.Context, req *blockchain_api.SetStateR
 	return &emptypb.Empty{}, nil
 }
 
+// CompareAndSetState atomically replaces the value of a key in the blockchain state storage,
+// only if the current value equals the expected value. An empty expected value means the key
+// must not exist yet. The comparison and the write are a single conditional update in the store,
+// so concurrent writers of the same key cannot overwrite each other, which makes the state storage
+// usable for coordination between services, like leader election.
+//
+// Parameters:
+//   - ctx: Context for the operation with timeout and cancellation support
+//   - req: CompareAndSetStateRequest containing the key, the expected data and the data to store
+//
+// Returns:
+//   - *blockchain_api.CompareAndSetStateResponse: Response indicating whether the value was swapped
+//   - error: Any error encountered during state storage
+func (b *Blockchain) CompareAndSetState(ctx context.Context, req *blockchain_api.CompareAndSetStateRequest) (*blockchain_api.CompareAndSetStateResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "CompareAndSetState",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainCompareAndSetState),
+		tracing.WithDebugLogMessage(b.logger, "[CompareAndSetState] called with state %s", req.Key),
+	)
+	defer deferFn()
+
+	swapped, err := b.store.CompareAndSetState(ctx, req.Key, req.ExpectedData, req.Data)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockchain_api.CompareAndSetStateResponse{
+		Swapped: swapped,
+	}, nil
+}
+
 // GetBlockHeaderIDs retrieves block header IDs starting from a specific hash.
 // This method fetches a sequence of lightweight block header identifiers (uint32 IDs)
 // from the blockchain service, beginning with the block identified by the provided
@@ -1781,6 +2418,17 @@ func (b *Blockchain) GetBlockHeaderIDs(ctx context.Context, request *blockchain_
 // The method communicates with the blockchain store to perform the
 // invalidation and triggers any necessary reorganization processes.
 //
+// When DryRun is set in the request, the store is not modified and no
+// notifications are sent. The response then lists the blocks that would be
+// invalidated, with their height and whether they are on the best chain,
+// so operators can assess the impact of an invalidation beforehand.
+//
+// Unless Force is set in the request, a block on the best chain with more
+// than blockchain_maxReorgDepth confirmations is not invalidated, and a
+// threshold exceeded error is returned instead. The client sets Force for
+// the invalidations of block validation and the alert system, only operator
+// requests are limited.
+//
 // Parameters:
 //   - ctx: Context for the operation with timeout and cancellation support
 //   - request: InvalidateBlockRequest containing the hash of the block to invalidate
@@ -1801,6 +2449,22 @@ func (b *Blockchain) InvalidateBlock(ctx context.Context, request *blockchain_ap
 		return nil, errors.WrapGRPC(errors.NewBlockInvalidError("[Blockchain][InvalidateBlock] request's hash is not valid", err))
 	}
 
+	if request.DryRun {
+		return b.invalidateBlockDryRun(ctx, blockHash)
+	}
+
+	if !request.Force {
+		if err = b.checkInvalidateBlockDepth(ctx, blockHash); err != nil {
+			return nil, errors.WrapGRPC(err)
+		}
+	}
+
+	// the best block before invalidating, to detect whether the invalidation switches the best chain
+	oldBestHeader, oldBestMeta, oldBestErr := b.store.GetBestBlockHeader(ctx)
+	if oldBestErr != nil {
+		b.logger.Errorf("[InvalidateBlock] error getting best block header, not checking for a reorg: %v", oldBestErr)
+	}
+
 	// invalidate block will also invalidate all child blocks
 	invalidatedHashes, err := b.store.InvalidateBlock(ctx, blockHash)
 	if err != nil {
@@ -1814,6 +2478,9 @@ func (b *Blockchain) InvalidateBlock(ctx context.Context, request *blockchain_ap
 		b.logger.Infof("[InvalidateBlock] Invalidated block %s", blockHash.String())
 	}
 
+	// the invalidated blocks never get the confirmations for their BlockSubtreesSet notification
+	b.prunePendingSubtreesSet(invalidatedHashes...)
+
 	invalidatedHashBytes := make([][]byte, len(invalidatedHashes))
 
 	for i, hash := range invalidatedHashes {
@@ -1823,6 +2490,10 @@ func (b *Blockchain) InvalidateBlock(ctx context.Context, request *blockchain_ap
 	// Clear any cached difficulty that may depend on the previous best tip
 	b.difficulty.ResetCache()
 
+	if oldBestErr == nil {
+		b.sendReorgNotification(ctx, oldBestHeader, oldBestMeta)
+	}
+
 	// send notifications about the new latest block, so subscribers can update their state
 	bestBlock, _, err := b.store.GetBestBlockHeader(ctx)
 	if err != nil {
@@ -1841,6 +2512,158 @@ func (b *Blockchain) InvalidateBlock(ctx context.Context, request *blockchain_ap
 	}, nil
 }
 
+// sendReorgNotification sends a Reorg notification when the best chain switched away from the given old best
+// block, i.e. when blocks of the old best chain are no longer on the best chain. Nothing is sent when the new
+// best block still builds on the old best block.
+//
+// The notification holds the new best block as hash, and the old tip, new tip, fork point (the common ancestor
+// of both tips) and the depth of the reorg (the number of blocks that were disconnected) in its metadata.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - oldBestHeader: Header of the best block before the best chain was changed
+//   - oldBestMeta: Metadata of the best block before the best chain was changed
+func (b *Blockchain) sendReorgNotification(ctx context.Context, oldBestHeader *model.BlockHeader, oldBestMeta *model.BlockHeaderMeta) {
+	newBestHeader, newBestMeta, err := b.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		b.logger.Errorf("[Reorg] error getting best block header: %v", err)
+		return
+	}
+
+	// the best chain was not changed, or was extended by a single block
+	if newBestHeader.Hash().IsEqual(oldBestHeader.Hash()) || newBestHeader.HashPrevBlock.IsEqual(oldBestHeader.Hash()) {
+		return
+	}
+
+	forkPointHeader, forkPointMeta, err := b.getForkPoint(ctx, oldBestHeader, oldBestMeta, newBestHeader, newBestMeta)
+	if err != nil {
+		b.logger.Errorf("[Reorg] error getting fork point of %s and %s: %v", oldBestHeader.Hash(), newBestHeader.Hash(), err)
+		return
+	}
+
+	// the new best chain extends the old best chain
+	if forkPointHeader.Hash().IsEqual(oldBestHeader.Hash()) {
+		return
+	}
+
+	depth := oldBestMeta.Height - forkPointMeta.Height
+
+	b.logger.Warnf("[Reorg] best chain switched from %s (height %d) to %s (height %d), fork point %s (height %d), depth %d",
+		oldBestHeader.Hash(), oldBestMeta.Height, newBestHeader.Hash(), newBestMeta.Height, forkPointHeader.Hash(), forkPointMeta.Height, depth)
+
+	prometheusBlockchainReorgDepth.Observe(float64(depth))
+
+	// the disconnected blocks no longer get the confirmations for their BlockSubtreesSet notification
+	b.prunePendingSubtreesSetOfReorg(ctx, oldBestHeader, forkPointHeader)
+
+	if _, err = b.SendNotification(ctx, &blockchain_api.Notification{
+		Type: model.NotificationType_Reorg,
+		Hash: newBestHeader.Hash().CloneBytes(),
+		Metadata: &blockchain_api.NotificationMetadata{
+			Metadata: map[string]string{
+				"old_tip":           oldBestHeader.Hash().String(),
+				"old_tip_height":    strconv.FormatUint(uint64(oldBestMeta.Height), 10),
+				"new_tip":           newBestHeader.Hash().String(),
+				"new_tip_height":    strconv.FormatUint(uint64(newBestMeta.Height), 10),
+				"fork_point":        forkPointHeader.Hash().String(),
+				"fork_point_height": strconv.FormatUint(uint64(forkPointMeta.Height), 10),
+				"depth":             strconv.FormatUint(uint64(depth), 10),
+			},
+		},
+	}); err != nil {
+		b.logger.Errorf("[Reorg] error sending reorg notification for best block %s: %v", newBestHeader.Hash(), err)
+	}
+}
+
+// getForkPoint returns the common ancestor of two blocks, by walking back from the highest of the two blocks
+// until both chains meet.
+func (b *Blockchain) getForkPoint(ctx context.Context, header1 *model.BlockHeader, meta1 *model.BlockHeaderMeta,
+	header2 *model.BlockHeader, meta2 *model.BlockHeaderMeta) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	var err error
+
+	for !header1.Hash().IsEqual(header2.Hash()) {
+		if meta1.Height >= meta2.Height {
+			header1, meta1, err = b.store.GetBlockHeader(ctx, header1.HashPrevBlock)
+		} else {
+			header2, meta2, err = b.store.GetBlockHeader(ctx, header2.HashPrevBlock)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return header1, meta1, nil
+}
+
+// checkInvalidateBlockDepth returns a threshold exceeded error when the block is on the best chain and has more than
+// blockchain_maxReorgDepth confirmations, since invalidating it would disconnect all the blocks built on top of it.
+// Blocks that are not on the best chain can always be invalidated.
+func (b *Blockchain) checkInvalidateBlockDepth(ctx context.Context, blockHash *chainhash.Hash) error {
+	maxReorgDepth := b.settings.BlockChain.MaxReorgDepth
+	if maxReorgDepth == 0 {
+		return nil
+	}
+
+	_, blockMeta, err := b.store.GetBlockHeader(ctx, blockHash)
+	if err != nil {
+		return err
+	}
+
+	_, bestMeta, err := b.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		return err
+	}
+
+	if blockMeta.Height > bestMeta.Height {
+		return nil
+	}
+
+	onBestChain, err := b.store.CheckBlockIsInCurrentChain(ctx, []uint32{blockMeta.ID})
+	if err != nil {
+		return err
+	}
+
+	if confirmations := bestMeta.Height - blockMeta.Height + 1; onBestChain && confirmations > maxReorgDepth {
+		return errors.NewThresholdExceededError("[Blockchain][InvalidateBlock] block %s has %d confirmations, more than the maximum reorg depth of %d, use force to invalidate it",
+			blockHash.String(), confirmations, maxReorgDepth)
+	}
+
+	return nil
+}
+
+// invalidateBlockDryRun returns the blocks that would be invalidated by invalidating the given block, without invalidating them.
+func (b *Blockchain) invalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) (*blockchain_api.InvalidateBlockResponse, error) {
+	affectedBlocks, err := b.store.GetBlocksAffectedByInvalidation(ctx, blockHash)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	response := &blockchain_api.InvalidateBlockResponse{
+		InvalidatedBlocks: make([][]byte, len(affectedBlocks)),
+		AffectedBlocks:    make([]*blockchain_api.AffectedBlock, len(affectedBlocks)),
+	}
+
+	onBestChain := 0
+
+	for i, affectedBlock := range affectedBlocks {
+		response.InvalidatedBlocks[i] = affectedBlock.Hash.CloneBytes()
+		response.AffectedBlocks[i] = &blockchain_api.AffectedBlock{
+			Hash:        affectedBlock.Hash.CloneBytes(),
+			Height:      affectedBlock.Height,
+			OnBestChain: affectedBlock.OnBestChain,
+		}
+
+		if affectedBlock.OnBestChain {
+			onBestChain++
+		}
+	}
+
+	b.logger.Infof("[InvalidateBlock] Dry run: invalidating block %s would invalidate %d blocks, %d of them on the best chain", blockHash.String(), len(affectedBlocks), onBestChain)
+
+	return response, nil
+}
+
 // RevalidateBlock restores a previously invalidated block.
 // This method reverses a previous block invalidation, marking a previously
 // invalid block as valid again in the blockchain store. This operation is
@@ -1961,9 +2784,75 @@ func (b *Blockchain) SendNotification(ctx context.Context, req *blockchain_api.N
 
 	b.notifications <- req
 
+	if req.Type == model.NotificationType_Block {
+		b.signalBlockWaiters()
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// WaitForBlockHeight waits until the best block reaches the given height, or the context is done,
+// and returns the header of the block at the height in the main chain.
+//
+// The best block is checked again on every block notification, instead of polling the store.
+func (b *Blockchain) WaitForBlockHeight(ctx context.Context, req *blockchain_api.WaitForBlockHeightRequest) (*blockchain_api.GetBlockHeaderResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "WaitForBlockHeight",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainWaitForBlockHeight),
+		tracing.WithDebugLogMessage(b.logger, "[WaitForBlockHeight] called with height %d", req.Height),
+	)
+	defer deferFn()
+
+	// register before checking the best block, so no block notification is missed
+	ch := b.addBlockWaiter()
+	defer b.removeBlockWaiter(ch)
+
+	blockHeader, meta, err := waitForBlockHeight(ctx, b.store, req.Height, ch)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return blockHeaderResponse(blockHeader, meta), nil
+}
+
+// addBlockWaiter registers a channel that is signaled on every block notification.
+func (b *Blockchain) addBlockWaiter() chan struct{} {
+	// a single pending signal is enough, the waiter checks the best block when it wakes up
+	ch := make(chan struct{}, 1)
+
+	b.blockWaitersMu.Lock()
+	defer b.blockWaitersMu.Unlock()
+
+	if b.blockWaiters == nil {
+		b.blockWaiters = make(map[chan struct{}]struct{})
+	}
+
+	b.blockWaiters[ch] = struct{}{}
+
+	return ch
+}
+
+// removeBlockWaiter removes the channel registered with addBlockWaiter.
+func (b *Blockchain) removeBlockWaiter(ch chan struct{}) {
+	b.blockWaitersMu.Lock()
+	defer b.blockWaitersMu.Unlock()
+
+	delete(b.blockWaiters, ch)
+}
+
+// signalBlockWaiters signals all the block waiters, without blocking on waiters that have a signal pending.
+func (b *Blockchain) signalBlockWaiters() {
+	b.blockWaitersMu.Lock()
+	defer b.blockWaitersMu.Unlock()
+
+	for ch := range b.blockWaiters {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
 // GetBlockIsMined checks if a block has been mined in the blockchain.
 func (b *Blockchain) GetBlockIsMined(ctx context.Context, req *blockchain_api.GetBlockIsMinedRequest) (*blockchain_api.GetBlockIsMinedResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockIsMined",
@@ -2047,14 +2936,142 @@ func (b *Blockchain) SetBlockSubtreesSet(ctx context.Context, req *blockchain_ap
 		return nil, errors.WrapGRPC(err)
 	}
 
-	_, _ = b.SendNotification(ctx, &blockchain_api.Notification{
-		Type: model.NotificationType_BlockSubtreesSet,
-		Hash: blockHash.CloneBytes(),
-	})
+	if b.settings.BlockChain.SubtreesSetNotificationConfirmations == 0 {
+		_, _ = b.SendNotification(ctx, &blockchain_api.Notification{
+			Type: model.NotificationType_BlockSubtreesSet,
+			Hash: blockHash.CloneBytes(),
+		})
+
+		return &emptypb.Empty{}, nil
+	}
+
+	_, blockMeta, err := b.store.GetBlockHeader(ctx, &blockHash)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	b.pendingSubtreesSetMu.Lock()
+	if b.pendingSubtreesSet == nil {
+		b.pendingSubtreesSet = make(map[chainhash.Hash]pendingBlock)
+	}
+
+	b.pendingSubtreesSet[blockHash] = pendingBlock{id: blockMeta.ID, height: blockMeta.Height}
+	b.pendingSubtreesSetMu.Unlock()
+
+	// the block may already be deep enough, for instance when the subtrees are set during catchup
+	b.sendConfirmedSubtreesSetNotifications(ctx)
 
 	return &emptypb.Empty{}, nil
 }
 
+// pendingSubtreesSetExpiryDepth is the number of blocks past blockchain_subtreesSetNotificationConfirmations after
+// which a pending block that could not be checked against the best chain is dropped without a notification.
+const pendingSubtreesSetExpiryDepth = 100
+
+// sendConfirmedSubtreesSetNotifications sends the BlockSubtreesSet notifications of the pending blocks that have
+// blockchain_subtreesSetNotificationConfirmations confirmations on the best chain. Pending blocks that are not on
+// the best chain once they would have the confirmations were reorged away and are dropped without a notification.
+// The store is queried without holding pendingSubtreesSetMu, on a copy of the pending blocks.
+func (b *Blockchain) sendConfirmedSubtreesSetNotifications(ctx context.Context) {
+	b.pendingSubtreesSetMu.Lock()
+	pendingBlocks := maps.Clone(b.pendingSubtreesSet)
+	b.pendingSubtreesSetMu.Unlock()
+
+	if len(pendingBlocks) == 0 {
+		return
+	}
+
+	_, bestMeta, err := b.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		b.logger.Errorf("[sendConfirmedSubtreesSetNotifications] error getting best block header: %v", err)
+		return
+	}
+
+	confirmations := b.settings.BlockChain.SubtreesSetNotificationConfirmations
+
+	for blockHash, pending := range pendingBlocks {
+		if pending.height > bestMeta.Height || bestMeta.Height-pending.height+1 < confirmations {
+			continue
+		}
+
+		onBestChain, err := b.store.CheckBlockIsInCurrentChain(ctx, []uint32{pending.id})
+		if err != nil {
+			b.logger.Errorf("[sendConfirmedSubtreesSetNotifications][%s] error checking whether block is on the best chain: %v", blockHash.String(), err)
+
+			if bestMeta.Height-pending.height+1 >= confirmations+pendingSubtreesSetExpiryDepth {
+				b.logger.Warnf("[sendConfirmedSubtreesSetNotifications][%s] block expired, dropping the BlockSubtreesSet notification", blockHash.String())
+				b.prunePendingSubtreesSet(blockHash)
+			}
+
+			continue
+		}
+
+		// the block was already handled by a concurrent call, or was pruned in the meantime
+		if !b.prunePendingSubtreesSet(blockHash) {
+			continue
+		}
+
+		if !onBestChain {
+			b.logger.Infof("[sendConfirmedSubtreesSetNotifications][%s] block is not on the best chain, dropping the BlockSubtreesSet notification", blockHash.String())
+			continue
+		}
+
+		_, _ = b.SendNotification(ctx, &blockchain_api.Notification{
+			Type: model.NotificationType_BlockSubtreesSet,
+			Hash: blockHash.CloneBytes(),
+		})
+	}
+}
+
+// prunePendingSubtreesSet drops the given blocks from the blocks waiting for their BlockSubtreesSet notification.
+// Returns whether any of the blocks was pending.
+func (b *Blockchain) prunePendingSubtreesSet(blockHashes ...chainhash.Hash) bool {
+	b.pendingSubtreesSetMu.Lock()
+	defer b.pendingSubtreesSetMu.Unlock()
+
+	pruned := false
+
+	for _, blockHash := range blockHashes {
+		if _, ok := b.pendingSubtreesSet[blockHash]; ok {
+			delete(b.pendingSubtreesSet, blockHash)
+
+			pruned = true
+		}
+	}
+
+	return pruned
+}
+
+// prunePendingSubtreesSetOfReorg drops the blocks disconnected by a reorg, from the old best block down to the
+// fork point, from the blocks waiting for their BlockSubtreesSet notification.
+func (b *Blockchain) prunePendingSubtreesSetOfReorg(ctx context.Context, oldBestHeader *model.BlockHeader, forkPointHeader *model.BlockHeader) {
+	b.pendingSubtreesSetMu.Lock()
+	pendingCount := len(b.pendingSubtreesSet)
+	b.pendingSubtreesSetMu.Unlock()
+
+	if pendingCount == 0 {
+		return
+	}
+
+	var disconnected []chainhash.Hash
+
+	header := oldBestHeader
+
+	for !header.Hash().IsEqual(forkPointHeader.Hash()) {
+		disconnected = append(disconnected, *header.Hash())
+
+		prevHeader, _, err := b.store.GetBlockHeader(ctx, header.HashPrevBlock)
+		if err != nil {
+			b.logger.Errorf("[Reorg] error getting block header %s of the disconnected blocks: %v", header.HashPrevBlock, err)
+			break
+		}
+
+		header = prevHeader
+	}
+
+	b.prunePendingSubtreesSet(disconnected...)
+}
+
 // GetBlocksSubtreesNotSet retrieves blocks whose subtrees haven't been set.
 func (b *Blockchain) GetBlocksSubtreesNotSet(ctx context.Context, _ *emptypb.Empty) (*blockchain_api.GetBlocksSubtreesNotSetResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlocksSubtreesNotSet",
@@ -2159,6 +3176,34 @@ func (b *Blockchain) GetFSMCurrentState(_ context.Context, _ *emptypb.Empty) (*b
 	}, nil
 }
 
+// IsCurrent reports whether the node is synced with the network. The node is current when the best block
+// is at or above the last checkpoint, is not older than blockchain_maxTipAge, and the FSM is running.
+// The reasons the node is not current are returned, so that callers can tell the conditions apart.
+func (b *Blockchain) IsCurrent(ctx context.Context, _ *emptypb.Empty) (*blockchain_api.IsCurrentResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "IsCurrent",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBestBlockHeader),
+	)
+	defer deferFn()
+
+	fsmState, err := b.GetFSMCurrentState(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	_, bestBlockHeaderMeta, err := b.store.GetBestBlockHeader(ctx)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	reasons := notCurrentReasons(bestBlockHeaderMeta, fsmState.State, b.settings)
+
+	return &blockchain_api.IsCurrentResponse{
+		Current: len(reasons) == 0,
+		Reasons: reasons,
+	}, nil
+}
+
 // WaitForFSMtoTransitionToGivenState waits for the FSM to reach a specific state.
 func (b *Blockchain) WaitForFSMtoTransitionToGivenState(ctx context.Context, targetState blockchain_api.FSMStateType) error {
 	for b.finiteStateMachine.Current() != targetState.String() {
@@ -2201,6 +3246,103 @@ func (b *Blockchain) WaitUntilFSMTransitionFromIdleState(ctx context.Context, _
 	return &emptypb.Empty{}, nil
 }
 
+// SubscribeFSMState streams the transitions of the blockchain FSM to the subscriber.
+//
+// The current state is sent as soon as the subscription is registered, so subscribers are
+// consistent with the FSM regardless of when they subscribe, followed by a message for every
+// transition of the FSM, containing the old state, the new state and the event of the transition.
+// A subscriber that does not keep up with the transitions is disconnected, it should subscribe
+// again to get the current state.
+//
+// Parameters:
+//   - req: SubscribeFSMStateRequest containing the source identifier of the subscriber
+//   - stream: gRPC stream for sending the FSM state changes to the subscriber
+//
+// Returns:
+//   - error: nil when the subscriber disconnected, otherwise the error that ended the subscription
+func (b *Blockchain) SubscribeFSMState(req *blockchain_api.SubscribeFSMStateRequest, stream blockchain_api.BlockchainAPI_SubscribeFSMStateServer) error {
+	ctx := stream.Context()
+
+	// register before getting the current state, so no transition is missed
+	ch := b.addFSMSubscriber(req.Source)
+	defer b.removeFSMSubscriber(ch)
+
+	currentState, err := b.GetFSMCurrentState(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	if err = stream.Send(&blockchain_api.FSMStateChange{
+		OldState: currentState.State,
+		NewState: currentState.State,
+	}); err != nil {
+		return errors.WrapGRPC(errors.NewServiceError("[SubscribeFSMState] failed to send current state to %s", req.Source, err))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.logger.Infof("[Blockchain] FSM state subscriber disconnected: %s", req.Source)
+			return nil
+		case <-b.AppCtx.Done():
+			return nil
+		case stateChange, ok := <-ch:
+			if !ok {
+				return errors.WrapGRPC(errors.NewServiceError("[SubscribeFSMState] subscriber %s did not keep up with the FSM state changes", req.Source))
+			}
+
+			if err = stream.Send(stateChange); err != nil {
+				return errors.WrapGRPC(errors.NewServiceError("[SubscribeFSMState] failed to send FSM state change to %s", req.Source, err))
+			}
+		}
+	}
+}
+
+// addFSMSubscriber registers a new FSM state subscriber and returns the channel the state changes are sent on.
+func (b *Blockchain) addFSMSubscriber(source string) chan *blockchain_api.FSMStateChange {
+	ch := make(chan *blockchain_api.FSMStateChange, fsmSubscriberBufferSize)
+
+	b.fsmSubscribersMu.Lock()
+	defer b.fsmSubscribersMu.Unlock()
+
+	if b.fsmSubscribers == nil {
+		b.fsmSubscribers = make(fsmSubscriberMap)
+	}
+
+	b.fsmSubscribers[ch] = source
+
+	return ch
+}
+
+// removeFSMSubscriber removes the FSM state subscriber, if it was not already dropped.
+func (b *Blockchain) removeFSMSubscriber(ch chan *blockchain_api.FSMStateChange) {
+	b.fsmSubscribersMu.Lock()
+	defer b.fsmSubscribersMu.Unlock()
+
+	if _, ok := b.fsmSubscribers[ch]; ok {
+		delete(b.fsmSubscribers, ch)
+		close(ch)
+	}
+}
+
+// broadcastFSMStateChange sends the FSM state change to all FSM state subscribers. Subscribers whose
+// buffer is full are dropped, instead of blocking the FSM transition.
+func (b *Blockchain) broadcastFSMStateChange(stateChange *blockchain_api.FSMStateChange) {
+	b.fsmSubscribersMu.Lock()
+	defer b.fsmSubscribersMu.Unlock()
+
+	for ch, source := range b.fsmSubscribers {
+		select {
+		case ch <- stateChange:
+		default:
+			b.logger.Warnf("[Blockchain] FSM state subscriber %s is not keeping up, dropping subscription", source)
+
+			delete(b.fsmSubscribers, ch)
+			close(ch)
+		}
+	}
+}
+
 // IsFullyReady checks if the blockchain service is fully operational.
 // This includes both FSM being in a non-IDLE state and subscription infrastructure being ready.
 // Services should use this method to determine if they can safely proceed with blockchain operations.
@@ -2401,6 +3543,28 @@ func (b *Blockchain) GetBlockLocator(ctx context.Context, req *blockchain_api.Ge
 	return &blockchain_api.GetBlockLocatorResponse{Locator: locator}, nil
 }
 
+// GetBlockLocatorByHeight retrieves a block locator starting at the block at the given height in the main chain.
+func (b *Blockchain) GetBlockLocatorByHeight(ctx context.Context, req *blockchain_api.GetBlockLocatorByHeightRequest) (*blockchain_api.GetBlockLocatorResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockLocatorByHeight",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBlockLocatorByHeight),
+		tracing.WithDebugLogMessage(b.logger, "[GetBlockLocatorByHeight] called with height %d", req.Height),
+	)
+	defer deferFn()
+
+	locatorHashes, err := getBlockLocatorByHeight(ctx, b.store, req.Height)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	locator := make([][]byte, len(locatorHashes))
+	for i, hash := range locatorHashes {
+		locator[i] = hash.CloneBytes()
+	}
+
+	return &blockchain_api.GetBlockLocatorResponse{Locator: locator}, nil
+}
+
 // LocateBlockHeaders finds block headers using a locator.
 func (b *Blockchain) LocateBlockHeaders(ctx context.Context, request *blockchain_api.LocateBlockHeadersRequest) (*blockchain_api.LocateBlockHeadersResponse, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "LocateBlockHeaders",
@@ -2467,6 +3631,43 @@ func (b *Blockchain) GetBestHeightAndTime(ctx context.Context, _ *emptypb.Empty)
 	}, nil
 }
 
+// GetMedianTimeForHeight retrieves the median time past of the block at the given height in the main chain.
+func (b *Blockchain) GetMedianTimeForHeight(ctx context.Context, req *blockchain_api.GetMedianTimeForHeightRequest) (*blockchain_api.GetMedianTimeForHeightResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetMedianTimeForHeight",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetMedianTimeForHeight),
+		tracing.WithDebugLogMessage(b.logger, "[GetMedianTimeForHeight] called with height %d", req.Height),
+	)
+	defer deferFn()
+
+	medianTime, err := getMedianTimeForHeight(ctx, b.store, req.Height)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockchain_api.GetMedianTimeForHeightResponse{Time: medianTime}, nil
+}
+
+// GetBlockSubsidy retrieves the block subsidy at the given height and the height of the next subsidy reduction.
+func (b *Blockchain) GetBlockSubsidy(ctx context.Context, req *blockchain_api.GetBlockSubsidyRequest) (*blockchain_api.GetBlockSubsidyResponse, error) {
+	_, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "GetBlockSubsidy",
+		tracing.WithParentStat(b.stats),
+		tracing.WithHistogram(prometheusBlockchainGetBlockSubsidy),
+		tracing.WithDebugLogMessage(b.logger, "[GetBlockSubsidy] called with height %d", req.Height),
+	)
+	defer deferFn()
+
+	subsidy, nextReductionHeight, err := getBlockSubsidy(b.settings.ChainCfgParams, req.Height)
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockchain_api.GetBlockSubsidyResponse{
+		Subsidy:             subsidy,
+		NextReductionHeight: nextReductionHeight,
+	}, nil
+}
+
 // safeClose safely closes a channel without panicking if it's already closed.
 func safeClose[T any](ch chan T) {
 	defer func() {
@@ -2541,6 +3742,78 @@ func getBlockLocator(ctx context.Context, store blockchain_store.Store, blockHea
 	return locator, nil
 }
 
+// getBlockLocatorByHeight creates a block locator for the block at the given height in the main chain.
+func getBlockLocatorByHeight(ctx context.Context, store blockchain_store.Store, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	block, err := store.GetBlockByHeight(ctx, blockHeaderHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return getBlockLocator(ctx, store, block.Header.Hash(), blockHeaderHeight)
+}
+
+// getMedianTimeForHeight calculates the median timestamp of the block at the given height in the main chain
+// and up to 10 of its ancestors, fewer blocks are used near genesis.
+func getMedianTimeForHeight(ctx context.Context, store blockchain_store.Store, height uint32) (uint32, error) {
+	block, err := store.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return 0, err
+	}
+
+	headers, _, err := store.GetBlockHeaders(ctx, block.Header.Hash(), 11)
+	if err != nil {
+		return 0, err
+	}
+
+	timestamps := make([]time.Time, 0, len(headers))
+	for _, header := range headers {
+		timestamps = append(timestamps, time.Unix(int64(header.Timestamp), 0))
+	}
+
+	medianTimestamp, err := model.CalculateMedianTimestamp(timestamps)
+	if err != nil {
+		return 0, errors.NewProcessingError("[Blockchain][GetMedianTimeForHeight] could not calculate median block time for height %d", height, err)
+	}
+
+	return safeconversion.TimeToUint32(*medianTimestamp)
+}
+
+// getBlockSubsidy returns the block subsidy at the given height and the height of the next subsidy reduction
+// for the chain parameters, the next reduction height is 0 when the subsidy is not reduced any further.
+func getBlockSubsidy(params *chaincfg.Params, height uint32) (uint64, uint32, error) {
+	if params == nil || params.SubsidyReductionInterval == 0 {
+		return 0, 0, errors.NewConfigurationError("[Blockchain][GetBlockSubsidy] chain parameters have no subsidy reduction interval")
+	}
+
+	return util.GetBlockSubsidyForHeight(height, params), util.GetNextSubsidyReductionHeight(height, params), nil
+}
+
+// waitForBlockHeight waits until the best block reaches the given height and returns the header of the block
+// at the height in the main chain. The best block is checked again every time a block notification is received.
+func waitForBlockHeight[T any](ctx context.Context, store blockchain_store.Store, height uint32, blockNotifications <-chan T) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	for {
+		_, bestBlockMeta, err := store.GetBestBlockHeader(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if bestBlockMeta.Height >= height {
+			block, err := store.GetBlockByHeight(ctx, height)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return store.GetBlockHeader(ctx, block.Header.Hash())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, errors.NewContextCanceledError("[WaitForBlockHeight] context done while waiting for height %d, best height is %d", height, bestBlockMeta.Height, ctx.Err())
+		case <-blockNotifications:
+		}
+	}
+}
+
 func getBlockHeadersToCommonAncestor(ctx context.Context, store blockchain_store.Store, hashTarget *chainhash.Hash, blockLocatorHashes []*chainhash.Hash, maxHeaders uint32) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	const (
 		numberOfHeaders = 1_000
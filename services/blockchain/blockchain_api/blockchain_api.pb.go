@@ -132,6 +132,56 @@ func (FSMStateType) EnumDescriptor() ([]byte, []int) {
 	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{1}
 }
 
+// NotCurrentReason defines the reasons for the node not being current.
+type NotCurrentReason int32
+
+const (
+	NotCurrentReason_BELOW_CHECKPOINT NotCurrentReason = 0 // Best block is below the last checkpoint
+	NotCurrentReason_TIP_TOO_OLD      NotCurrentReason = 1 // Best block is older than the maximum tip age
+	NotCurrentReason_FSM_NOT_RUNNING  NotCurrentReason = 2 // FSM is not in the running state
+)
+
+// Enum value maps for NotCurrentReason.
+var (
+	NotCurrentReason_name = map[int32]string{
+		0: "BELOW_CHECKPOINT",
+		1: "TIP_TOO_OLD",
+		2: "FSM_NOT_RUNNING",
+	}
+	NotCurrentReason_value = map[string]int32{
+		"BELOW_CHECKPOINT": 0,
+		"TIP_TOO_OLD":      1,
+		"FSM_NOT_RUNNING":  2,
+	}
+)
+
+func (x NotCurrentReason) Enum() *NotCurrentReason {
+	p := new(NotCurrentReason)
+	*p = x
+	return p
+}
+
+func (x NotCurrentReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotCurrentReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_enumTypes[2].Descriptor()
+}
+
+func (NotCurrentReason) Type() protoreflect.EnumType {
+	return &file_services_blockchain_blockchain_api_blockchain_api_proto_enumTypes[2]
+}
+
+func (x NotCurrentReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotCurrentReason.Descriptor instead.
+func (NotCurrentReason) EnumDescriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{2}
+}
+
 // HealthResponse represents the health status of the blockchain service.
 type HealthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -195,20 +245,21 @@ func (x *HealthResponse) GetTimestamp() *timestamppb.Timestamp {
 
 // AddBlockRequest contains data for adding a new block to the blockchain.
 type AddBlockRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Header            []byte                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`                                              // Block header
-	SubtreeHashes     [][]byte               `protobuf:"bytes,2,rep,name=subtree_hashes,json=subtreeHashes,proto3" json:"subtree_hashes,omitempty"`           // Merkle tree hashes
-	CoinbaseTx        []byte                 `protobuf:"bytes,3,opt,name=coinbase_tx,json=coinbaseTx,proto3" json:"coinbase_tx,omitempty"`                    // Coinbase transaction
-	TransactionCount  uint64                 `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"` // Number of transactions
-	SizeInBytes       uint64                 `protobuf:"varint,5,opt,name=size_in_bytes,json=sizeInBytes,proto3" json:"size_in_bytes,omitempty"`              // Block size
-	External          bool                   `protobuf:"varint,6,opt,name=external,proto3" json:"external,omitempty"`                                         // External block flag
-	PeerId            string                 `protobuf:"bytes,7,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                                // Peer identifier
-	OptionMinedSet    bool                   `protobuf:"varint,8,opt,name=optionMinedSet,proto3" json:"optionMinedSet,omitempty"`                             // Option to mark block as mined
-	OptionSubtreesSet bool                   `protobuf:"varint,9,opt,name=optionSubtreesSet,proto3" json:"optionSubtreesSet,omitempty"`                       // Option to mark subtrees as set
-	OptionInvalid     bool                   `protobuf:"varint,10,opt,name=optionInvalid,proto3" json:"optionInvalid,omitempty"`                              // Option to invalidate block when adding
-	OptionID          uint64                 `protobuf:"varint,11,opt,name=optionID,proto3" json:"optionID,omitempty"`                                        // Optional block ID
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Header             []byte                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`                                              // Block header
+	SubtreeHashes      [][]byte               `protobuf:"bytes,2,rep,name=subtree_hashes,json=subtreeHashes,proto3" json:"subtree_hashes,omitempty"`           // Merkle tree hashes
+	CoinbaseTx         []byte                 `protobuf:"bytes,3,opt,name=coinbase_tx,json=coinbaseTx,proto3" json:"coinbase_tx,omitempty"`                    // Coinbase transaction
+	TransactionCount   uint64                 `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"` // Number of transactions
+	SizeInBytes        uint64                 `protobuf:"varint,5,opt,name=size_in_bytes,json=sizeInBytes,proto3" json:"size_in_bytes,omitempty"`              // Block size
+	External           bool                   `protobuf:"varint,6,opt,name=external,proto3" json:"external,omitempty"`                                         // External block flag
+	PeerId             string                 `protobuf:"bytes,7,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                                // Peer identifier
+	OptionMinedSet     bool                   `protobuf:"varint,8,opt,name=optionMinedSet,proto3" json:"optionMinedSet,omitempty"`                             // Option to mark block as mined
+	OptionSubtreesSet  bool                   `protobuf:"varint,9,opt,name=optionSubtreesSet,proto3" json:"optionSubtreesSet,omitempty"`                       // Option to mark subtrees as set
+	OptionInvalid      bool                   `protobuf:"varint,10,opt,name=optionInvalid,proto3" json:"optionInvalid,omitempty"`                              // Option to invalidate block when adding
+	OptionID           uint64                 `protobuf:"varint,11,opt,name=optionID,proto3" json:"optionID,omitempty"`                                        // Optional block ID
+	OptionWaitForKafka bool                   `protobuf:"varint,12,opt,name=optionWaitForKafka,proto3" json:"optionWaitForKafka,omitempty"`                    // Option to wait until the block is delivered to Kafka
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *AddBlockRequest) Reset() {
@@ -318,6 +369,66 @@ func (x *AddBlockRequest) GetOptionID() uint64 {
 	return 0
 }
 
+func (x *AddBlockRequest) GetOptionWaitForKafka() bool {
+	if x != nil {
+		return x.OptionWaitForKafka
+	}
+	return false
+}
+
+// AddBlockResponse contains the result of adding a block to the blockchain.
+type AddBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint32                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`               // Height of the block
+	AlreadyExists bool                   `protobuf:"varint,2,opt,name=alreadyExists,proto3" json:"alreadyExists,omitempty"` // True when the block was already stored and was not added again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlockResponse) Reset() {
+	*x = AddBlockResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlockResponse) ProtoMessage() {}
+
+func (x *AddBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlockResponse.ProtoReflect.Descriptor instead.
+func (*AddBlockResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddBlockResponse) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AddBlockResponse) GetAlreadyExists() bool {
+	if x != nil {
+		return x.AlreadyExists
+	}
+	return false
+}
+
 // GetBlockRequest represents a request to retrieve a block by its hash.
 type GetBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -328,7 +439,7 @@ type GetBlockRequest struct {
 
 func (x *GetBlockRequest) Reset() {
 	*x = GetBlockRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[2]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +451,7 @@ func (x *GetBlockRequest) String() string {
 func (*GetBlockRequest) ProtoMessage() {}
 
 func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[2]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +464,7 @@ func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{2}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetBlockRequest) GetHash() []byte {
@@ -374,7 +485,7 @@ type GetBlocksRequest struct {
 
 func (x *GetBlocksRequest) Reset() {
 	*x = GetBlocksRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[3]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +497,7 @@ func (x *GetBlocksRequest) String() string {
 func (*GetBlocksRequest) ProtoMessage() {}
 
 func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[3]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +510,7 @@ func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{3}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetBlocksRequest) GetHash() []byte {
@@ -426,7 +537,7 @@ type GetBlocksResponse struct {
 
 func (x *GetBlocksResponse) Reset() {
 	*x = GetBlocksResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[4]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +549,7 @@ func (x *GetBlocksResponse) String() string {
 func (*GetBlocksResponse) ProtoMessage() {}
 
 func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[4]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +562,7 @@ func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{4}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetBlocksResponse) GetBlocks() [][]byte {
@@ -471,7 +582,7 @@ type GetBlockByHeightRequest struct {
 
 func (x *GetBlockByHeightRequest) Reset() {
 	*x = GetBlockByHeightRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[5]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +594,7 @@ func (x *GetBlockByHeightRequest) String() string {
 func (*GetBlockByHeightRequest) ProtoMessage() {}
 
 func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[5]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +607,7 @@ func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHeightRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{5}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBlockByHeightRequest) GetHeight() uint32 {
@@ -506,6 +617,59 @@ func (x *GetBlockByHeightRequest) GetHeight() uint32 {
 	return 0
 }
 
+// GetBlocksByHeightRangeRequest represents a request to retrieve the blocks in an inclusive height range.
+type GetBlocksByHeightRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartHeight   uint32                 `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"` // Height of the first block to retrieve
+	EndHeight     uint32                 `protobuf:"varint,2,opt,name=endHeight,proto3" json:"endHeight,omitempty"`     // Height of the last block to retrieve
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlocksByHeightRangeRequest) Reset() {
+	*x = GetBlocksByHeightRangeRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlocksByHeightRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlocksByHeightRangeRequest) ProtoMessage() {}
+
+func (x *GetBlocksByHeightRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlocksByHeightRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetBlocksByHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBlocksByHeightRangeRequest) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetBlocksByHeightRangeRequest) GetEndHeight() uint32 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
 // GetBlockByIDRequest represents a request to retrieve a block by its ID.
 type GetBlockByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -516,7 +680,7 @@ type GetBlockByIDRequest struct {
 
 func (x *GetBlockByIDRequest) Reset() {
 	*x = GetBlockByIDRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[6]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +692,7 @@ func (x *GetBlockByIDRequest) String() string {
 func (*GetBlockByIDRequest) ProtoMessage() {}
 
 func (x *GetBlockByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[6]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +705,7 @@ func (x *GetBlockByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByIDRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{6}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetBlockByIDRequest) GetId() uint64 {
@@ -561,7 +725,7 @@ type GetNextBlockIDResponse struct {
 
 func (x *GetNextBlockIDResponse) Reset() {
 	*x = GetNextBlockIDResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[7]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +737,7 @@ func (x *GetNextBlockIDResponse) String() string {
 func (*GetNextBlockIDResponse) ProtoMessage() {}
 
 func (x *GetNextBlockIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[7]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +750,7 @@ func (x *GetNextBlockIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextBlockIDResponse.ProtoReflect.Descriptor instead.
 func (*GetNextBlockIDResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{7}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetNextBlockIDResponse) GetNextBlockId() uint64 {
@@ -607,7 +771,7 @@ type GetBlockInChainByHeightHashRequest struct {
 
 func (x *GetBlockInChainByHeightHashRequest) Reset() {
 	*x = GetBlockInChainByHeightHashRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[8]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +783,7 @@ func (x *GetBlockInChainByHeightHashRequest) String() string {
 func (*GetBlockInChainByHeightHashRequest) ProtoMessage() {}
 
 func (x *GetBlockInChainByHeightHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[8]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +796,7 @@ func (x *GetBlockInChainByHeightHashRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetBlockInChainByHeightHashRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockInChainByHeightHashRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{8}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetBlockInChainByHeightHashRequest) GetHeight() uint32 {
@@ -665,7 +829,7 @@ type GetBlockResponse struct {
 
 func (x *GetBlockResponse) Reset() {
 	*x = GetBlockResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[9]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +841,7 @@ func (x *GetBlockResponse) String() string {
 func (*GetBlockResponse) ProtoMessage() {}
 
 func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[9]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +854,7 @@ func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{9}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetBlockResponse) GetHeader() []byte {
@@ -752,7 +916,7 @@ type GetFullBlockResponse struct {
 
 func (x *GetFullBlockResponse) Reset() {
 	*x = GetFullBlockResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[10]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -764,7 +928,7 @@ func (x *GetFullBlockResponse) String() string {
 func (*GetFullBlockResponse) ProtoMessage() {}
 
 func (x *GetFullBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[10]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +941,7 @@ func (x *GetFullBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFullBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetFullBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{10}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetFullBlockResponse) GetFullBlockBytes() []byte {
@@ -797,7 +961,7 @@ type GetBlockGraphDataRequest struct {
 
 func (x *GetBlockGraphDataRequest) Reset() {
 	*x = GetBlockGraphDataRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[11]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +973,7 @@ func (x *GetBlockGraphDataRequest) String() string {
 func (*GetBlockGraphDataRequest) ProtoMessage() {}
 
 func (x *GetBlockGraphDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[11]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +986,7 @@ func (x *GetBlockGraphDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockGraphDataRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockGraphDataRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{11}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBlockGraphDataRequest) GetPeriodMillis() uint64 {
@@ -842,7 +1006,7 @@ type GetBlockExistsResponse struct {
 
 func (x *GetBlockExistsResponse) Reset() {
 	*x = GetBlockExistsResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[12]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1018,7 @@ func (x *GetBlockExistsResponse) String() string {
 func (*GetBlockExistsResponse) ProtoMessage() {}
 
 func (x *GetBlockExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[12]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1031,7 @@ func (x *GetBlockExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockExistsResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockExistsResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{12}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetBlockExistsResponse) GetExists() bool {
@@ -887,7 +1051,7 @@ type GetMedianTimeRequest struct {
 
 func (x *GetMedianTimeRequest) Reset() {
 	*x = GetMedianTimeRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[13]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +1063,7 @@ func (x *GetMedianTimeRequest) String() string {
 func (*GetMedianTimeRequest) ProtoMessage() {}
 
 func (x *GetMedianTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[13]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1076,7 @@ func (x *GetMedianTimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMedianTimeRequest.ProtoReflect.Descriptor instead.
 func (*GetMedianTimeRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{13}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetMedianTimeRequest) GetBlockHash() []byte {
@@ -933,7 +1097,7 @@ type GetBlockHeadersRequest struct {
 
 func (x *GetBlockHeadersRequest) Reset() {
 	*x = GetBlockHeadersRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[14]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1109,7 @@ func (x *GetBlockHeadersRequest) String() string {
 func (*GetBlockHeadersRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[14]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1122,7 @@ func (x *GetBlockHeadersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{14}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetBlockHeadersRequest) GetStartHash() []byte {
@@ -987,7 +1151,7 @@ type GetBlockHeadersToCommonAncestorRequest struct {
 
 func (x *GetBlockHeadersToCommonAncestorRequest) Reset() {
 	*x = GetBlockHeadersToCommonAncestorRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[15]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -999,7 +1163,7 @@ func (x *GetBlockHeadersToCommonAncestorRequest) String() string {
 func (*GetBlockHeadersToCommonAncestorRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersToCommonAncestorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[15]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1176,7 @@ func (x *GetBlockHeadersToCommonAncestorRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetBlockHeadersToCommonAncestorRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersToCommonAncestorRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{15}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetBlockHeadersToCommonAncestorRequest) GetTargetHash() []byte {
@@ -1048,7 +1212,7 @@ type GetBlockHeadersFromCommonAncestorRequest struct {
 
 func (x *GetBlockHeadersFromCommonAncestorRequest) Reset() {
 	*x = GetBlockHeadersFromCommonAncestorRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[16]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1060,7 +1224,7 @@ func (x *GetBlockHeadersFromCommonAncestorRequest) String() string {
 func (*GetBlockHeadersFromCommonAncestorRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersFromCommonAncestorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[16]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,7 +1237,7 @@ func (x *GetBlockHeadersFromCommonAncestorRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetBlockHeadersFromCommonAncestorRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersFromCommonAncestorRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{16}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetBlockHeadersFromCommonAncestorRequest) GetTargetHash() []byte {
@@ -1108,7 +1272,7 @@ type GetBlockHeadersResponse struct {
 
 func (x *GetBlockHeadersResponse) Reset() {
 	*x = GetBlockHeadersResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[17]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1120,7 +1284,7 @@ func (x *GetBlockHeadersResponse) String() string {
 func (*GetBlockHeadersResponse) ProtoMessage() {}
 
 func (x *GetBlockHeadersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[17]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,7 +1297,7 @@ func (x *GetBlockHeadersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{17}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetBlockHeadersResponse) GetBlockHeaders() [][]byte {
@@ -1161,7 +1325,7 @@ type GetBlockHeadersFromTillRequest struct {
 
 func (x *GetBlockHeadersFromTillRequest) Reset() {
 	*x = GetBlockHeadersFromTillRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[18]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1173,7 +1337,7 @@ func (x *GetBlockHeadersFromTillRequest) String() string {
 func (*GetBlockHeadersFromTillRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersFromTillRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[18]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,7 +1350,7 @@ func (x *GetBlockHeadersFromTillRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersFromTillRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersFromTillRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{18}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetBlockHeadersFromTillRequest) GetStartHash() []byte {
@@ -1214,7 +1378,7 @@ type GetBlockHeadersFromHeightRequest struct {
 
 func (x *GetBlockHeadersFromHeightRequest) Reset() {
 	*x = GetBlockHeadersFromHeightRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[19]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1226,7 +1390,7 @@ func (x *GetBlockHeadersFromHeightRequest) String() string {
 func (*GetBlockHeadersFromHeightRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersFromHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[19]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1403,7 @@ func (x *GetBlockHeadersFromHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersFromHeightRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersFromHeightRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{19}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetBlockHeadersFromHeightRequest) GetStartHeight() uint32 {
@@ -1267,7 +1431,7 @@ type GetBlockHeadersFromHeightResponse struct {
 
 func (x *GetBlockHeadersFromHeightResponse) Reset() {
 	*x = GetBlockHeadersFromHeightResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[20]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1443,7 @@ func (x *GetBlockHeadersFromHeightResponse) String() string {
 func (*GetBlockHeadersFromHeightResponse) ProtoMessage() {}
 
 func (x *GetBlockHeadersFromHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[20]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1456,7 @@ func (x *GetBlockHeadersFromHeightResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetBlockHeadersFromHeightResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersFromHeightResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{20}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetBlockHeadersFromHeightResponse) GetBlockHeaders() [][]byte {
@@ -1320,7 +1484,7 @@ type GetBlockHeadersByHeightRequest struct {
 
 func (x *GetBlockHeadersByHeightRequest) Reset() {
 	*x = GetBlockHeadersByHeightRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[21]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1496,7 @@ func (x *GetBlockHeadersByHeightRequest) String() string {
 func (*GetBlockHeadersByHeightRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersByHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[21]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1509,7 @@ func (x *GetBlockHeadersByHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersByHeightRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersByHeightRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{21}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetBlockHeadersByHeightRequest) GetStartHeight() uint32 {
@@ -1373,7 +1537,7 @@ type GetBlockHeadersByHeightResponse struct {
 
 func (x *GetBlockHeadersByHeightResponse) Reset() {
 	*x = GetBlockHeadersByHeightResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[22]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1385,7 +1549,7 @@ func (x *GetBlockHeadersByHeightResponse) String() string {
 func (*GetBlockHeadersByHeightResponse) ProtoMessage() {}
 
 func (x *GetBlockHeadersByHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[22]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1562,7 @@ func (x *GetBlockHeadersByHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersByHeightResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersByHeightResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{22}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetBlockHeadersByHeightResponse) GetBlockHeaders() [][]byte {
@@ -1425,7 +1589,7 @@ type GetBlockHeaderIDsResponse struct {
 
 func (x *GetBlockHeaderIDsResponse) Reset() {
 	*x = GetBlockHeaderIDsResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[23]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1437,7 +1601,7 @@ func (x *GetBlockHeaderIDsResponse) String() string {
 func (*GetBlockHeaderIDsResponse) ProtoMessage() {}
 
 func (x *GetBlockHeaderIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[23]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,7 +1614,7 @@ func (x *GetBlockHeaderIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeaderIDsResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeaderIDsResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{23}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetBlockHeaderIDsResponse) GetIds() []uint32 {
@@ -1470,7 +1634,7 @@ type GetMedianTimeResponse struct {
 
 func (x *GetMedianTimeResponse) Reset() {
 	*x = GetMedianTimeResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[24]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1482,7 +1646,7 @@ func (x *GetMedianTimeResponse) String() string {
 func (*GetMedianTimeResponse) ProtoMessage() {}
 
 func (x *GetMedianTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[24]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,7 +1659,7 @@ func (x *GetMedianTimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMedianTimeResponse.ProtoReflect.Descriptor instead.
 func (*GetMedianTimeResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{24}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetMedianTimeResponse) GetBlockHeaderTime() []uint32 {
@@ -1515,7 +1679,7 @@ type GetBlockHeaderRequest struct {
 
 func (x *GetBlockHeaderRequest) Reset() {
 	*x = GetBlockHeaderRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[25]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1527,7 +1691,7 @@ func (x *GetBlockHeaderRequest) String() string {
 func (*GetBlockHeaderRequest) ProtoMessage() {}
 
 func (x *GetBlockHeaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[25]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +1704,7 @@ func (x *GetBlockHeaderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeaderRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeaderRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{25}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetBlockHeaderRequest) GetBlockHash() []byte {
@@ -1550,6 +1714,113 @@ func (x *GetBlockHeaderRequest) GetBlockHash() []byte {
 	return nil
 }
 
+// GetBlockHeadersByHashesRequest requests the headers of a list of blocks.
+type GetBlockHeadersByHashesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHashes   [][]byte               `protobuf:"bytes,1,rep,name=blockHashes,proto3" json:"blockHashes,omitempty"` // Hashes of the blocks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockHeadersByHashesRequest) Reset() {
+	*x = GetBlockHeadersByHashesRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockHeadersByHashesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockHeadersByHashesRequest) ProtoMessage() {}
+
+func (x *GetBlockHeadersByHashesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockHeadersByHashesRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockHeadersByHashesRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBlockHeadersByHashesRequest) GetBlockHashes() [][]byte {
+	if x != nil {
+		return x.BlockHashes
+	}
+	return nil
+}
+
+// GetBlockHeadersByHashesResponse contains the headers in the order of the requested hashes.
+// The header and meta of a block that was not found are empty, and its found flag is false.
+type GetBlockHeadersByHashesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHeaders  [][]byte               `protobuf:"bytes,1,rep,name=blockHeaders,proto3" json:"blockHeaders,omitempty"` // List of serialized block headers
+	Metas         [][]byte               `protobuf:"bytes,2,rep,name=metas,proto3" json:"metas,omitempty"`               // List of serialized metadata
+	Found         []bool                 `protobuf:"varint,3,rep,packed,name=found,proto3" json:"found,omitempty"`       // Whether the block of each requested hash was found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockHeadersByHashesResponse) Reset() {
+	*x = GetBlockHeadersByHashesResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockHeadersByHashesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockHeadersByHashesResponse) ProtoMessage() {}
+
+func (x *GetBlockHeadersByHashesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockHeadersByHashesResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockHeadersByHashesResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBlockHeadersByHashesResponse) GetBlockHeaders() [][]byte {
+	if x != nil {
+		return x.BlockHeaders
+	}
+	return nil
+}
+
+func (x *GetBlockHeadersByHashesResponse) GetMetas() [][]byte {
+	if x != nil {
+		return x.Metas
+	}
+	return nil
+}
+
+func (x *GetBlockHeadersByHashesResponse) GetFound() []bool {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
 // CheckBlockIsCurrentChainRequest checks if blocks are in the main chain.
 type CheckBlockIsCurrentChainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1560,7 +1831,7 @@ type CheckBlockIsCurrentChainRequest struct {
 
 func (x *CheckBlockIsCurrentChainRequest) Reset() {
 	*x = CheckBlockIsCurrentChainRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[26]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1572,7 +1843,7 @@ func (x *CheckBlockIsCurrentChainRequest) String() string {
 func (*CheckBlockIsCurrentChainRequest) ProtoMessage() {}
 
 func (x *CheckBlockIsCurrentChainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[26]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,7 +1856,7 @@ func (x *CheckBlockIsCurrentChainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckBlockIsCurrentChainRequest.ProtoReflect.Descriptor instead.
 func (*CheckBlockIsCurrentChainRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{26}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CheckBlockIsCurrentChainRequest) GetBlockIDs() []uint32 {
@@ -1599,13 +1870,15 @@ func (x *CheckBlockIsCurrentChainRequest) GetBlockIDs() []uint32 {
 type InvalidateBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Hash of the block to invalidate
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`      // Only compute the blocks that would be invalidated, without invalidating them
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`        // Invalidate the block even when it is deeper than blockchain_maxReorgDepth below the best block
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InvalidateBlockRequest) Reset() {
 	*x = InvalidateBlockRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[27]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1617,7 +1890,7 @@ func (x *InvalidateBlockRequest) String() string {
 func (*InvalidateBlockRequest) ProtoMessage() {}
 
 func (x *InvalidateBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[27]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1630,7 +1903,7 @@ func (x *InvalidateBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateBlockRequest.ProtoReflect.Descriptor instead.
 func (*InvalidateBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{27}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *InvalidateBlockRequest) GetBlockHash() []byte {
@@ -1640,17 +1913,32 @@ func (x *InvalidateBlockRequest) GetBlockHash() []byte {
 	return nil
 }
 
+func (x *InvalidateBlockRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *InvalidateBlockRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // InvalidateBlockResponse contains the result of block invalidation.
 type InvalidateBlockResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	InvalidatedBlocks [][]byte               `protobuf:"bytes,1,rep,name=invalidatedBlocks,proto3" json:"invalidatedBlocks,omitempty"` // List of invalidated block hashes
+	AffectedBlocks    []*AffectedBlock       `protobuf:"bytes,2,rep,name=affectedBlocks,proto3" json:"affectedBlocks,omitempty"`       // Details of the blocks that would be invalidated, only set for dry runs
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *InvalidateBlockResponse) Reset() {
 	*x = InvalidateBlockResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[28]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +1950,7 @@ func (x *InvalidateBlockResponse) String() string {
 func (*InvalidateBlockResponse) ProtoMessage() {}
 
 func (x *InvalidateBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[28]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +1963,7 @@ func (x *InvalidateBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateBlockResponse.ProtoReflect.Descriptor instead.
 func (*InvalidateBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{28}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InvalidateBlockResponse) GetInvalidatedBlocks() [][]byte {
@@ -1685,6 +1973,74 @@ func (x *InvalidateBlockResponse) GetInvalidatedBlocks() [][]byte {
 	return nil
 }
 
+func (x *InvalidateBlockResponse) GetAffectedBlocks() []*AffectedBlock {
+	if x != nil {
+		return x.AffectedBlocks
+	}
+	return nil
+}
+
+// AffectedBlock describes a block that is affected by a block invalidation.
+type AffectedBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`                // Hash of the block
+	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`           // Height of the block
+	OnBestChain   bool                   `protobuf:"varint,3,opt,name=onBestChain,proto3" json:"onBestChain,omitempty"` // Whether the block is on the current best chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AffectedBlock) Reset() {
+	*x = AffectedBlock{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedBlock) ProtoMessage() {}
+
+func (x *AffectedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedBlock.ProtoReflect.Descriptor instead.
+func (*AffectedBlock) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AffectedBlock) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *AffectedBlock) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AffectedBlock) GetOnBestChain() bool {
+	if x != nil {
+		return x.OnBestChain
+	}
+	return false
+}
+
 // RevalidateBlockRequest requests to revalidate a block.
 type RevalidateBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1695,7 +2051,7 @@ type RevalidateBlockRequest struct {
 
 func (x *RevalidateBlockRequest) Reset() {
 	*x = RevalidateBlockRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[29]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1707,7 +2063,7 @@ func (x *RevalidateBlockRequest) String() string {
 func (*RevalidateBlockRequest) ProtoMessage() {}
 
 func (x *RevalidateBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[29]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +2076,7 @@ func (x *RevalidateBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevalidateBlockRequest.ProtoReflect.Descriptor instead.
 func (*RevalidateBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{29}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RevalidateBlockRequest) GetBlockHash() []byte {
@@ -1752,7 +2108,7 @@ type GetBlockHeaderResponse struct {
 
 func (x *GetBlockHeaderResponse) Reset() {
 	*x = GetBlockHeaderResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[30]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1764,7 +2120,7 @@ func (x *GetBlockHeaderResponse) String() string {
 func (*GetBlockHeaderResponse) ProtoMessage() {}
 
 func (x *GetBlockHeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[30]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +2133,7 @@ func (x *GetBlockHeaderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeaderResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{30}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetBlockHeaderResponse) GetBlockHeader() []byte {
@@ -1881,7 +2237,7 @@ type CheckBlockIsCurrentChainResponse struct {
 
 func (x *CheckBlockIsCurrentChainResponse) Reset() {
 	*x = CheckBlockIsCurrentChainResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[31]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1893,7 +2249,7 @@ func (x *CheckBlockIsCurrentChainResponse) String() string {
 func (*CheckBlockIsCurrentChainResponse) ProtoMessage() {}
 
 func (x *CheckBlockIsCurrentChainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[31]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,7 +2262,7 @@ func (x *CheckBlockIsCurrentChainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckBlockIsCurrentChainResponse.ProtoReflect.Descriptor instead.
 func (*CheckBlockIsCurrentChainResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{31}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CheckBlockIsCurrentChainResponse) GetIsPartOfCurrentChain() bool {
@@ -1918,15 +2274,16 @@ func (x *CheckBlockIsCurrentChainResponse) GetIsPartOfCurrentChain() bool {
 
 // SubscribeRequest initiates a subscription to blockchain events.
 type SubscribeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Source identifier for the subscription
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Source            string                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                                                                                    // Source identifier for the subscription
+	NotificationTypes []model.NotificationType `protobuf:"varint,2,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=model.NotificationType" json:"notification_types,omitempty"` // Only send notifications of these types, all types when empty
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[32]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1938,7 +2295,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[32]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2308,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{32}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SubscribeRequest) GetSource() string {
@@ -1961,6 +2318,13 @@ func (x *SubscribeRequest) GetSource() string {
 	return ""
 }
 
+func (x *SubscribeRequest) GetNotificationTypes() []model.NotificationType {
+	if x != nil {
+		return x.NotificationTypes
+	}
+	return nil
+}
+
 // Notification represents a blockchain event notification.
 type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1974,7 +2338,7 @@ type Notification struct {
 
 func (x *Notification) Reset() {
 	*x = Notification{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[33]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1986,7 +2350,7 @@ func (x *Notification) String() string {
 func (*Notification) ProtoMessage() {}
 
 func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[33]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,7 +2363,7 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notification.ProtoReflect.Descriptor instead.
 func (*Notification) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{33}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Notification) GetType() model.NotificationType {
@@ -2040,7 +2404,7 @@ type NotificationMetadata struct {
 
 func (x *NotificationMetadata) Reset() {
 	*x = NotificationMetadata{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[34]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2052,7 +2416,7 @@ func (x *NotificationMetadata) String() string {
 func (*NotificationMetadata) ProtoMessage() {}
 
 func (x *NotificationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[34]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2429,7 @@ func (x *NotificationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationMetadata.ProtoReflect.Descriptor instead.
 func (*NotificationMetadata) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{34}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *NotificationMetadata) GetMetadata() map[string]string {
@@ -2085,7 +2449,7 @@ type GetStateRequest struct {
 
 func (x *GetStateRequest) Reset() {
 	*x = GetStateRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[35]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2097,7 +2461,7 @@ func (x *GetStateRequest) String() string {
 func (*GetStateRequest) ProtoMessage() {}
 
 func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[35]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,7 +2474,7 @@ func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
 func (*GetStateRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{35}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetStateRequest) GetKey() string {
@@ -2130,7 +2494,7 @@ type StateResponse struct {
 
 func (x *StateResponse) Reset() {
 	*x = StateResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[36]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2142,7 +2506,7 @@ func (x *StateResponse) String() string {
 func (*StateResponse) ProtoMessage() {}
 
 func (x *StateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[36]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,7 +2519,7 @@ func (x *StateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
 func (*StateResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{36}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StateResponse) GetData() []byte {
@@ -2176,7 +2540,7 @@ type SetStateRequest struct {
 
 func (x *SetStateRequest) Reset() {
 	*x = SetStateRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[37]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2188,7 +2552,7 @@ func (x *SetStateRequest) String() string {
 func (*SetStateRequest) ProtoMessage() {}
 
 func (x *SetStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[37]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2565,7 @@ func (x *SetStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetStateRequest.ProtoReflect.Descriptor instead.
 func (*SetStateRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{37}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SetStateRequest) GetKey() string {
@@ -2218,29 +2582,31 @@ func (x *SetStateRequest) GetData() []byte {
 	return nil
 }
 
-// GetBlockIsMinedRequest checks if a block is marked as mined.
-type GetBlockIsMinedRequest struct {
+// CompareAndSetStateRequest replaces the state data of a key if it matches the expected data.
+type CompareAndSetStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Hash of the block
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                       // State key
+	ExpectedData  []byte                 `protobuf:"bytes,2,opt,name=expected_data,json=expectedData,proto3" json:"expected_data,omitempty"` // State data the key must currently hold, empty if the key must not exist
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                     // State data to store
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlockIsMinedRequest) Reset() {
-	*x = GetBlockIsMinedRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[38]
+func (x *CompareAndSetStateRequest) Reset() {
+	*x = CompareAndSetStateRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlockIsMinedRequest) String() string {
+func (x *CompareAndSetStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockIsMinedRequest) ProtoMessage() {}
+func (*CompareAndSetStateRequest) ProtoMessage() {}
 
-func (x *GetBlockIsMinedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[38]
+func (x *CompareAndSetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2251,29 +2617,133 @@ func (x *GetBlockIsMinedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockIsMinedRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockIsMinedRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CompareAndSetStateRequest.ProtoReflect.Descriptor instead.
+func (*CompareAndSetStateRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetBlockIsMinedRequest) GetBlockHash() []byte {
+func (x *CompareAndSetStateRequest) GetKey() string {
 	if x != nil {
-		return x.BlockHash
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CompareAndSetStateRequest) GetExpectedData() []byte {
+	if x != nil {
+		return x.ExpectedData
 	}
 	return nil
 }
 
-// GetBlockIsMinedResponse indicates if a block is marked as mined.
-type GetBlockIsMinedResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsMined       bool                   `protobuf:"varint,1,opt,name=isMined,proto3" json:"isMined,omitempty"` // True if the block is marked as mined
-	unknownFields protoimpl.UnknownFields
+func (x *CompareAndSetStateRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CompareAndSetStateResponse indicates whether the state data was swapped.
+type CompareAndSetStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swapped       bool                   `protobuf:"varint,1,opt,name=swapped,proto3" json:"swapped,omitempty"` // True if the state data matched the expected data and was replaced
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareAndSetStateResponse) Reset() {
+	*x = CompareAndSetStateResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareAndSetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareAndSetStateResponse) ProtoMessage() {}
+
+func (x *CompareAndSetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareAndSetStateResponse.ProtoReflect.Descriptor instead.
+func (*CompareAndSetStateResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CompareAndSetStateResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+// GetBlockIsMinedRequest checks if a block is marked as mined.
+type GetBlockIsMinedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Hash of the block
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockIsMinedRequest) Reset() {
+	*x = GetBlockIsMinedRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockIsMinedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockIsMinedRequest) ProtoMessage() {}
+
+func (x *GetBlockIsMinedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockIsMinedRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockIsMinedRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetBlockIsMinedRequest) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+// GetBlockIsMinedResponse indicates if a block is marked as mined.
+type GetBlockIsMinedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMined       bool                   `protobuf:"varint,1,opt,name=isMined,proto3" json:"isMined,omitempty"` // True if the block is marked as mined
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBlockIsMinedResponse) Reset() {
 	*x = GetBlockIsMinedResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[39]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2285,7 +2755,7 @@ func (x *GetBlockIsMinedResponse) String() string {
 func (*GetBlockIsMinedResponse) ProtoMessage() {}
 
 func (x *GetBlockIsMinedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[39]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2298,7 +2768,7 @@ func (x *GetBlockIsMinedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockIsMinedResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockIsMinedResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{39}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetBlockIsMinedResponse) GetIsMined() bool {
@@ -2311,16 +2781,17 @@ func (x *GetBlockIsMinedResponse) GetIsMined() bool {
 // GetLastNBlocksRequest requests the most recent blocks.
 type GetLastNBlocksRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	NumberOfBlocks int64                  `protobuf:"varint,1,opt,name=numberOfBlocks,proto3" json:"numberOfBlocks,omitempty"` // Number of blocks to retrieve
-	IncludeOrphans bool                   `protobuf:"varint,2,opt,name=includeOrphans,proto3" json:"includeOrphans,omitempty"` // Whether to include orphaned blocks
-	FromHeight     uint32                 `protobuf:"varint,3,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`         // Starting height
+	NumberOfBlocks int64                  `protobuf:"varint,1,opt,name=numberOfBlocks,proto3" json:"numberOfBlocks,omitempty"`                 // Number of blocks to retrieve
+	IncludeOrphans bool                   `protobuf:"varint,2,opt,name=includeOrphans,proto3" json:"includeOrphans,omitempty"`                 // Whether to include orphaned blocks, superseded by selection
+	FromHeight     uint32                 `protobuf:"varint,3,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`                         // Starting height
+	Selection      model.BlockSelection   `protobuf:"varint,4,opt,name=selection,proto3,enum=model.BlockSelection" json:"selection,omitempty"` // Which blocks to return, main chain, orphans or both
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetLastNBlocksRequest) Reset() {
 	*x = GetLastNBlocksRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[40]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2332,7 +2803,7 @@ func (x *GetLastNBlocksRequest) String() string {
 func (*GetLastNBlocksRequest) ProtoMessage() {}
 
 func (x *GetLastNBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[40]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2345,7 +2816,7 @@ func (x *GetLastNBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastNBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetLastNBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{40}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetLastNBlocksRequest) GetNumberOfBlocks() int64 {
@@ -2369,6 +2840,13 @@ func (x *GetLastNBlocksRequest) GetFromHeight() uint32 {
 	return 0
 }
 
+func (x *GetLastNBlocksRequest) GetSelection() model.BlockSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return model.BlockSelection(0)
+}
+
 // GetLastNBlocksResponse contains recent block information.
 type GetLastNBlocksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2379,7 +2857,7 @@ type GetLastNBlocksResponse struct {
 
 func (x *GetLastNBlocksResponse) Reset() {
 	*x = GetLastNBlocksResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[41]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2391,7 +2869,7 @@ func (x *GetLastNBlocksResponse) String() string {
 func (*GetLastNBlocksResponse) ProtoMessage() {}
 
 func (x *GetLastNBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[41]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2404,7 +2882,7 @@ func (x *GetLastNBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastNBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetLastNBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{41}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetLastNBlocksResponse) GetBlocks() []*model.BlockInfo {
@@ -2424,7 +2902,7 @@ type GetLastNInvalidBlocksRequest struct {
 
 func (x *GetLastNInvalidBlocksRequest) Reset() {
 	*x = GetLastNInvalidBlocksRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[42]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2436,7 +2914,7 @@ func (x *GetLastNInvalidBlocksRequest) String() string {
 func (*GetLastNInvalidBlocksRequest) ProtoMessage() {}
 
 func (x *GetLastNInvalidBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[42]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,7 +2927,7 @@ func (x *GetLastNInvalidBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastNInvalidBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetLastNInvalidBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{42}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetLastNInvalidBlocksRequest) GetN() int64 {
@@ -2469,7 +2947,7 @@ type GetLastNInvalidBlocksResponse struct {
 
 func (x *GetLastNInvalidBlocksResponse) Reset() {
 	*x = GetLastNInvalidBlocksResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[43]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2481,7 +2959,7 @@ func (x *GetLastNInvalidBlocksResponse) String() string {
 func (*GetLastNInvalidBlocksResponse) ProtoMessage() {}
 
 func (x *GetLastNInvalidBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[43]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2972,7 @@ func (x *GetLastNInvalidBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastNInvalidBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetLastNInvalidBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{43}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetLastNInvalidBlocksResponse) GetBlocks() []*model.BlockInfo {
@@ -2514,7 +2992,7 @@ type GetSuitableBlockRequest struct {
 
 func (x *GetSuitableBlockRequest) Reset() {
 	*x = GetSuitableBlockRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[44]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2526,7 +3004,7 @@ func (x *GetSuitableBlockRequest) String() string {
 func (*GetSuitableBlockRequest) ProtoMessage() {}
 
 func (x *GetSuitableBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[44]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,7 +3017,7 @@ func (x *GetSuitableBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSuitableBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetSuitableBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{44}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetSuitableBlockRequest) GetHash() []byte {
@@ -2559,7 +3037,7 @@ type GetSuitableBlockResponse struct {
 
 func (x *GetSuitableBlockResponse) Reset() {
 	*x = GetSuitableBlockResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[45]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2571,7 +3049,7 @@ func (x *GetSuitableBlockResponse) String() string {
 func (*GetSuitableBlockResponse) ProtoMessage() {}
 
 func (x *GetSuitableBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[45]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2584,7 +3062,7 @@ func (x *GetSuitableBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSuitableBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetSuitableBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{45}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetSuitableBlockResponse) GetBlock() *model.SuitableBlock {
@@ -2605,7 +3083,7 @@ type GetHashOfAncestorBlockRequest struct {
 
 func (x *GetHashOfAncestorBlockRequest) Reset() {
 	*x = GetHashOfAncestorBlockRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[46]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2617,7 +3095,7 @@ func (x *GetHashOfAncestorBlockRequest) String() string {
 func (*GetHashOfAncestorBlockRequest) ProtoMessage() {}
 
 func (x *GetHashOfAncestorBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[46]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +3108,7 @@ func (x *GetHashOfAncestorBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHashOfAncestorBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetHashOfAncestorBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{46}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetHashOfAncestorBlockRequest) GetHash() []byte {
@@ -2658,7 +3136,7 @@ type GetLatestBlockHeaderFromBlockLocatorRequest struct {
 
 func (x *GetLatestBlockHeaderFromBlockLocatorRequest) Reset() {
 	*x = GetLatestBlockHeaderFromBlockLocatorRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[47]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2670,7 +3148,7 @@ func (x *GetLatestBlockHeaderFromBlockLocatorRequest) String() string {
 func (*GetLatestBlockHeaderFromBlockLocatorRequest) ProtoMessage() {}
 
 func (x *GetLatestBlockHeaderFromBlockLocatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[47]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,7 +3161,7 @@ func (x *GetLatestBlockHeaderFromBlockLocatorRequest) ProtoReflect() protoreflec
 
 // Deprecated: Use GetLatestBlockHeaderFromBlockLocatorRequest.ProtoReflect.Descriptor instead.
 func (*GetLatestBlockHeaderFromBlockLocatorRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{47}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetLatestBlockHeaderFromBlockLocatorRequest) GetBestBlockHash() []byte {
@@ -2712,7 +3190,7 @@ type GetBlockHeadersFromOldestRequest struct {
 
 func (x *GetBlockHeadersFromOldestRequest) Reset() {
 	*x = GetBlockHeadersFromOldestRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[48]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2724,7 +3202,7 @@ func (x *GetBlockHeadersFromOldestRequest) String() string {
 func (*GetBlockHeadersFromOldestRequest) ProtoMessage() {}
 
 func (x *GetBlockHeadersFromOldestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[48]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2737,7 +3215,7 @@ func (x *GetBlockHeadersFromOldestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockHeadersFromOldestRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockHeadersFromOldestRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{48}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetBlockHeadersFromOldestRequest) GetChainTipHash() []byte {
@@ -2771,7 +3249,7 @@ type GetHashOfAncestorBlockResponse struct {
 
 func (x *GetHashOfAncestorBlockResponse) Reset() {
 	*x = GetHashOfAncestorBlockResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[49]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2783,7 +3261,7 @@ func (x *GetHashOfAncestorBlockResponse) String() string {
 func (*GetHashOfAncestorBlockResponse) ProtoMessage() {}
 
 func (x *GetHashOfAncestorBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[49]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,7 +3274,7 @@ func (x *GetHashOfAncestorBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHashOfAncestorBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetHashOfAncestorBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{49}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetHashOfAncestorBlockResponse) GetHash() []byte {
@@ -2817,7 +3295,7 @@ type GetNextWorkRequiredRequest struct {
 
 func (x *GetNextWorkRequiredRequest) Reset() {
 	*x = GetNextWorkRequiredRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[50]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2829,7 +3307,7 @@ func (x *GetNextWorkRequiredRequest) String() string {
 func (*GetNextWorkRequiredRequest) ProtoMessage() {}
 
 func (x *GetNextWorkRequiredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[50]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2842,7 +3320,7 @@ func (x *GetNextWorkRequiredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextWorkRequiredRequest.ProtoReflect.Descriptor instead.
 func (*GetNextWorkRequiredRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{50}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetNextWorkRequiredRequest) GetPreviousBlockHash() []byte {
@@ -2869,7 +3347,7 @@ type GetNextWorkRequiredResponse struct {
 
 func (x *GetNextWorkRequiredResponse) Reset() {
 	*x = GetNextWorkRequiredResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[51]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2881,7 +3359,7 @@ func (x *GetNextWorkRequiredResponse) String() string {
 func (*GetNextWorkRequiredResponse) ProtoMessage() {}
 
 func (x *GetNextWorkRequiredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[51]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2894,7 +3372,7 @@ func (x *GetNextWorkRequiredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextWorkRequiredResponse.ProtoReflect.Descriptor instead.
 func (*GetNextWorkRequiredResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{51}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetNextWorkRequiredResponse) GetBits() []byte {
@@ -2904,29 +3382,35 @@ func (x *GetNextWorkRequiredResponse) GetBits() []byte {
 	return nil
 }
 
-// SetBlockMinedSetRequest marks a block as mined.
-type SetBlockMinedSetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Hash of the mined block
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetDifficultyInfoResponse contains the difficulty state of the best block.
+type GetDifficultyInfoResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash                  []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`                                    // Hash of the best block
+	Height                     uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                                         // Height of the best block
+	Bits                       []byte                 `protobuf:"bytes,3,opt,name=bits,proto3" json:"bits,omitempty"`                                              // Difficulty bits of the best block
+	Target                     string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`                                          // Target of the best block, as a decimal integer
+	ChainWork                  []byte                 `protobuf:"bytes,5,opt,name=chainWork,proto3" json:"chainWork,omitempty"`                                    // Accumulated chainwork of the best block
+	DifficultyAdjustmentWindow uint32                 `protobuf:"varint,6,opt,name=difficultyAdjustmentWindow,proto3" json:"difficultyAdjustmentWindow,omitempty"` // Number of blocks the difficulty adjustment is computed over
+	NextRetargetHeight         uint32                 `protobuf:"varint,7,opt,name=nextRetargetHeight,proto3" json:"nextRetargetHeight,omitempty"`                 // Height of the next block with an adjusted difficulty, 0 when the network does not adjust the difficulty
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *SetBlockMinedSetRequest) Reset() {
-	*x = SetBlockMinedSetRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[52]
+func (x *GetDifficultyInfoResponse) Reset() {
+	*x = GetDifficultyInfoResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetBlockMinedSetRequest) String() string {
+func (x *GetDifficultyInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockMinedSetRequest) ProtoMessage() {}
+func (*GetDifficultyInfoResponse) ProtoMessage() {}
 
-func (x *SetBlockMinedSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[52]
+func (x *GetDifficultyInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2937,86 +3421,87 @@ func (x *SetBlockMinedSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockMinedSetRequest.ProtoReflect.Descriptor instead.
-func (*SetBlockMinedSetRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetDifficultyInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDifficultyInfoResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SetBlockMinedSetRequest) GetBlockHash() []byte {
+func (x *GetDifficultyInfoResponse) GetBlockHash() []byte {
 	if x != nil {
 		return x.BlockHash
 	}
 	return nil
 }
 
-// GetBlocksMinedNotSetResponse contains blocks not marked as mined.
-type GetBlocksMinedNotSetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockBytes    [][]byte               `protobuf:"bytes,1,rep,name=blockBytes,proto3" json:"blockBytes,omitempty"` // List of serialized blocks
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetDifficultyInfoResponse) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (x *GetBlocksMinedNotSetResponse) Reset() {
-	*x = GetBlocksMinedNotSetResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[53]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetDifficultyInfoResponse) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
 }
 
-func (x *GetBlocksMinedNotSetResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetDifficultyInfoResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
-func (*GetBlocksMinedNotSetResponse) ProtoMessage() {}
-
-func (x *GetBlocksMinedNotSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[53]
+func (x *GetDifficultyInfoResponse) GetChainWork() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChainWork
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetBlocksMinedNotSetResponse.ProtoReflect.Descriptor instead.
-func (*GetBlocksMinedNotSetResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{53}
+func (x *GetDifficultyInfoResponse) GetDifficultyAdjustmentWindow() uint32 {
+	if x != nil {
+		return x.DifficultyAdjustmentWindow
+	}
+	return 0
 }
 
-func (x *GetBlocksMinedNotSetResponse) GetBlockBytes() [][]byte {
+func (x *GetDifficultyInfoResponse) GetNextRetargetHeight() uint32 {
 	if x != nil {
-		return x.BlockBytes
+		return x.NextRetargetHeight
 	}
-	return nil
+	return 0
 }
 
-// SetBlockSubtreesSetRequest marks block subtrees as set.
-type SetBlockSubtreesSetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Block hash
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetMiningCandidateContextResponse contains the chain state a block template is built on.
+type GetMiningCandidateContextResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PreviousBlockHash []byte                 `protobuf:"bytes,1,opt,name=previousBlockHash,proto3" json:"previousBlockHash,omitempty"` // Hash of the best block
+	Height            uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                      // Height of the new block
+	Bits              []byte                 `protobuf:"bytes,3,opt,name=bits,proto3" json:"bits,omitempty"`                           // Difficulty bits required for the new block
+	MinTime           uint32                 `protobuf:"varint,4,opt,name=minTime,proto3" json:"minTime,omitempty"`                    // Median time past of the best block + 1
+	CurTime           uint32                 `protobuf:"varint,5,opt,name=curTime,proto3" json:"curTime,omitempty"`                    // Current time, at least minTime
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SetBlockSubtreesSetRequest) Reset() {
-	*x = SetBlockSubtreesSetRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[54]
+func (x *GetMiningCandidateContextResponse) Reset() {
+	*x = GetMiningCandidateContextResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetBlockSubtreesSetRequest) String() string {
+func (x *GetMiningCandidateContextResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockSubtreesSetRequest) ProtoMessage() {}
+func (*GetMiningCandidateContextResponse) ProtoMessage() {}
 
-func (x *SetBlockSubtreesSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[54]
+func (x *GetMiningCandidateContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,9 +3512,172 @@ func (x *SetBlockSubtreesSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockSubtreesSetRequest.ProtoReflect.Descriptor instead.
+// Deprecated: Use GetMiningCandidateContextResponse.ProtoReflect.Descriptor instead.
+func (*GetMiningCandidateContextResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetMiningCandidateContextResponse) GetPreviousBlockHash() []byte {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return nil
+}
+
+func (x *GetMiningCandidateContextResponse) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetMiningCandidateContextResponse) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+func (x *GetMiningCandidateContextResponse) GetMinTime() uint32 {
+	if x != nil {
+		return x.MinTime
+	}
+	return 0
+}
+
+func (x *GetMiningCandidateContextResponse) GetCurTime() uint32 {
+	if x != nil {
+		return x.CurTime
+	}
+	return 0
+}
+
+// SetBlockMinedSetRequest marks a block as mined.
+type SetBlockMinedSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Hash of the mined block
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlockMinedSetRequest) Reset() {
+	*x = SetBlockMinedSetRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlockMinedSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlockMinedSetRequest) ProtoMessage() {}
+
+func (x *SetBlockMinedSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlockMinedSetRequest.ProtoReflect.Descriptor instead.
+func (*SetBlockMinedSetRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetBlockMinedSetRequest) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+// GetBlocksMinedNotSetResponse contains blocks not marked as mined.
+type GetBlocksMinedNotSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockBytes    [][]byte               `protobuf:"bytes,1,rep,name=blockBytes,proto3" json:"blockBytes,omitempty"` // List of serialized blocks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlocksMinedNotSetResponse) Reset() {
+	*x = GetBlocksMinedNotSetResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlocksMinedNotSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlocksMinedNotSetResponse) ProtoMessage() {}
+
+func (x *GetBlocksMinedNotSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlocksMinedNotSetResponse.ProtoReflect.Descriptor instead.
+func (*GetBlocksMinedNotSetResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetBlocksMinedNotSetResponse) GetBlockBytes() [][]byte {
+	if x != nil {
+		return x.BlockBytes
+	}
+	return nil
+}
+
+// SetBlockSubtreesSetRequest marks block subtrees as set.
+type SetBlockSubtreesSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     []byte                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"` // Block hash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlockSubtreesSetRequest) Reset() {
+	*x = SetBlockSubtreesSetRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlockSubtreesSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlockSubtreesSetRequest) ProtoMessage() {}
+
+func (x *SetBlockSubtreesSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlockSubtreesSetRequest.ProtoReflect.Descriptor instead.
 func (*SetBlockSubtreesSetRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{54}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *SetBlockSubtreesSetRequest) GetBlockHash() []byte {
@@ -3049,7 +3697,7 @@ type GetBlocksSubtreesNotSetResponse struct {
 
 func (x *GetBlocksSubtreesNotSetResponse) Reset() {
 	*x = GetBlocksSubtreesNotSetResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[55]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3061,7 +3709,7 @@ func (x *GetBlocksSubtreesNotSetResponse) String() string {
 func (*GetBlocksSubtreesNotSetResponse) ProtoMessage() {}
 
 func (x *GetBlocksSubtreesNotSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[55]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3074,7 +3722,7 @@ func (x *GetBlocksSubtreesNotSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksSubtreesNotSetResponse.ProtoReflect.Descriptor instead.
 func (*GetBlocksSubtreesNotSetResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{55}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetBlocksSubtreesNotSetResponse) GetBlockBytes() [][]byte {
@@ -3098,7 +3746,7 @@ type SetBlockProcessedAtRequest struct {
 
 func (x *SetBlockProcessedAtRequest) Reset() {
 	*x = SetBlockProcessedAtRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[56]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3110,7 +3758,7 @@ func (x *SetBlockProcessedAtRequest) String() string {
 func (*SetBlockProcessedAtRequest) ProtoMessage() {}
 
 func (x *SetBlockProcessedAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[56]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,7 +3771,7 @@ func (x *SetBlockProcessedAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBlockProcessedAtRequest.ProtoReflect.Descriptor instead.
 func (*SetBlockProcessedAtRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{56}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SetBlockProcessedAtRequest) GetBlockHash() []byte {
@@ -3150,7 +3798,7 @@ type GetFSMStateResponse struct {
 
 func (x *GetFSMStateResponse) Reset() {
 	*x = GetFSMStateResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[57]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3162,7 +3810,7 @@ func (x *GetFSMStateResponse) String() string {
 func (*GetFSMStateResponse) ProtoMessage() {}
 
 func (x *GetFSMStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[57]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3175,7 +3823,7 @@ func (x *GetFSMStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFSMStateResponse.ProtoReflect.Descriptor instead.
 func (*GetFSMStateResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{57}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *GetFSMStateResponse) GetState() FSMStateType {
@@ -3185,6 +3833,59 @@ func (x *GetFSMStateResponse) GetState() FSMStateType {
 	return FSMStateType_IDLE
 }
 
+// IsCurrentResponse reports whether the node is synced with the network.
+type IsCurrentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       bool                   `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                                             // Whether the node is current
+	Reasons       []NotCurrentReason     `protobuf:"varint,2,rep,packed,name=reasons,proto3,enum=blockchain_api.NotCurrentReason" json:"reasons,omitempty"` // Reasons the node is not current, empty when it is current
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsCurrentResponse) Reset() {
+	*x = IsCurrentResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsCurrentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsCurrentResponse) ProtoMessage() {}
+
+func (x *IsCurrentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsCurrentResponse.ProtoReflect.Descriptor instead.
+func (*IsCurrentResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *IsCurrentResponse) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *IsCurrentResponse) GetReasons() []NotCurrentReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
 // WaitFSMToTransitionRequest specifies target FSM state.
 type WaitFSMToTransitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3195,7 +3896,7 @@ type WaitFSMToTransitionRequest struct {
 
 func (x *WaitFSMToTransitionRequest) Reset() {
 	*x = WaitFSMToTransitionRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[58]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3207,7 +3908,7 @@ func (x *WaitFSMToTransitionRequest) String() string {
 func (*WaitFSMToTransitionRequest) ProtoMessage() {}
 
 func (x *WaitFSMToTransitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[58]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3220,7 +3921,7 @@ func (x *WaitFSMToTransitionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitFSMToTransitionRequest.ProtoReflect.Descriptor instead.
 func (*WaitFSMToTransitionRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{58}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *WaitFSMToTransitionRequest) GetState() FSMStateType {
@@ -3230,6 +3931,113 @@ func (x *WaitFSMToTransitionRequest) GetState() FSMStateType {
 	return FSMStateType_IDLE
 }
 
+// SubscribeFSMStateRequest initiates a subscription to FSM state transitions.
+type SubscribeFSMStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Source identifier for the subscription
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeFSMStateRequest) Reset() {
+	*x = SubscribeFSMStateRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeFSMStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFSMStateRequest) ProtoMessage() {}
+
+func (x *SubscribeFSMStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFSMStateRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFSMStateRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubscribeFSMStateRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// FSMStateChange describes a transition of the FSM. The first message of a subscription
+// contains the current state as both the old and the new state, without an event.
+type FSMStateChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldState      FSMStateType           `protobuf:"varint,1,opt,name=old_state,json=oldState,proto3,enum=blockchain_api.FSMStateType" json:"old_state,omitempty"` // State before the transition
+	NewState      FSMStateType           `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=blockchain_api.FSMStateType" json:"new_state,omitempty"` // State after the transition
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                                                         // FSM event that caused the transition
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FSMStateChange) Reset() {
+	*x = FSMStateChange{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FSMStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FSMStateChange) ProtoMessage() {}
+
+func (x *FSMStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FSMStateChange.ProtoReflect.Descriptor instead.
+func (*FSMStateChange) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FSMStateChange) GetOldState() FSMStateType {
+	if x != nil {
+		return x.OldState
+	}
+	return FSMStateType_IDLE
+}
+
+func (x *FSMStateChange) GetNewState() FSMStateType {
+	if x != nil {
+		return x.NewState
+	}
+	return FSMStateType_IDLE
+}
+
+func (x *FSMStateChange) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
 // SendFSMEventRequest triggers an FSM event.
 type SendFSMEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3240,7 +4048,7 @@ type SendFSMEventRequest struct {
 
 func (x *SendFSMEventRequest) Reset() {
 	*x = SendFSMEventRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[59]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3252,7 +4060,7 @@ func (x *SendFSMEventRequest) String() string {
 func (*SendFSMEventRequest) ProtoMessage() {}
 
 func (x *SendFSMEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[59]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3265,7 +4073,7 @@ func (x *SendFSMEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendFSMEventRequest.ProtoReflect.Descriptor instead.
 func (*SendFSMEventRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{59}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SendFSMEventRequest) GetEvent() FSMEventType {
@@ -3286,7 +4094,7 @@ type GetBlockLocatorRequest struct {
 
 func (x *GetBlockLocatorRequest) Reset() {
 	*x = GetBlockLocatorRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[60]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3298,7 +4106,7 @@ func (x *GetBlockLocatorRequest) String() string {
 func (*GetBlockLocatorRequest) ProtoMessage() {}
 
 func (x *GetBlockLocatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[60]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,7 +4119,7 @@ func (x *GetBlockLocatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockLocatorRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockLocatorRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{60}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetBlockLocatorRequest) GetHash() []byte {
@@ -3328,6 +4136,51 @@ func (x *GetBlockLocatorRequest) GetHeight() uint32 {
 	return 0
 }
 
+// GetBlockLocatorByHeightRequest requests a block locator for the block at a height in the main chain.
+type GetBlockLocatorByHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint32                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // Reference block height
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockLocatorByHeightRequest) Reset() {
+	*x = GetBlockLocatorByHeightRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockLocatorByHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockLocatorByHeightRequest) ProtoMessage() {}
+
+func (x *GetBlockLocatorByHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockLocatorByHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockLocatorByHeightRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetBlockLocatorByHeightRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // GetBlockLocatorResponse contains block locator data.
 type GetBlockLocatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3338,7 +4191,7 @@ type GetBlockLocatorResponse struct {
 
 func (x *GetBlockLocatorResponse) Reset() {
 	*x = GetBlockLocatorResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[61]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3350,7 +4203,7 @@ func (x *GetBlockLocatorResponse) String() string {
 func (*GetBlockLocatorResponse) ProtoMessage() {}
 
 func (x *GetBlockLocatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[61]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3363,7 +4216,7 @@ func (x *GetBlockLocatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockLocatorResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockLocatorResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{61}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetBlockLocatorResponse) GetLocator() [][]byte {
@@ -3385,7 +4238,7 @@ type LocateBlockHeadersRequest struct {
 
 func (x *LocateBlockHeadersRequest) Reset() {
 	*x = LocateBlockHeadersRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[62]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3397,7 +4250,7 @@ func (x *LocateBlockHeadersRequest) String() string {
 func (*LocateBlockHeadersRequest) ProtoMessage() {}
 
 func (x *LocateBlockHeadersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[62]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +4263,7 @@ func (x *LocateBlockHeadersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocateBlockHeadersRequest.ProtoReflect.Descriptor instead.
 func (*LocateBlockHeadersRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{62}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *LocateBlockHeadersRequest) GetLocator() [][]byte {
@@ -3444,7 +4297,7 @@ type LocateBlockHeadersResponse struct {
 
 func (x *LocateBlockHeadersResponse) Reset() {
 	*x = LocateBlockHeadersResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[63]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3456,7 +4309,7 @@ func (x *LocateBlockHeadersResponse) String() string {
 func (*LocateBlockHeadersResponse) ProtoMessage() {}
 
 func (x *LocateBlockHeadersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[63]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3469,7 +4322,7 @@ func (x *LocateBlockHeadersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocateBlockHeadersResponse.ProtoReflect.Descriptor instead.
 func (*LocateBlockHeadersResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{63}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *LocateBlockHeadersResponse) GetBlockHeaders() [][]byte {
@@ -3490,7 +4343,7 @@ type GetBestHeightAndTimeResponse struct {
 
 func (x *GetBestHeightAndTimeResponse) Reset() {
 	*x = GetBestHeightAndTimeResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[64]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3502,7 +4355,7 @@ func (x *GetBestHeightAndTimeResponse) String() string {
 func (*GetBestHeightAndTimeResponse) ProtoMessage() {}
 
 func (x *GetBestHeightAndTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[64]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3515,7 +4368,7 @@ func (x *GetBestHeightAndTimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBestHeightAndTimeResponse.ProtoReflect.Descriptor instead.
 func (*GetBestHeightAndTimeResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{64}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetBestHeightAndTimeResponse) GetHeight() uint32 {
@@ -3532,6 +4385,239 @@ func (x *GetBestHeightAndTimeResponse) GetTime() uint32 {
 	return 0
 }
 
+// GetMedianTimeForHeightRequest requests the median time past of the block at a height in the main chain.
+type GetMedianTimeForHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint32                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // Block height
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMedianTimeForHeightRequest) Reset() {
+	*x = GetMedianTimeForHeightRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMedianTimeForHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedianTimeForHeightRequest) ProtoMessage() {}
+
+func (x *GetMedianTimeForHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedianTimeForHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetMedianTimeForHeightRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetMedianTimeForHeightRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GetMedianTimeForHeightResponse contains the median time past of a block.
+type GetMedianTimeForHeightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          uint32                 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // Median time of the block and up to 10 of its ancestors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMedianTimeForHeightResponse) Reset() {
+	*x = GetMedianTimeForHeightResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMedianTimeForHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedianTimeForHeightResponse) ProtoMessage() {}
+
+func (x *GetMedianTimeForHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedianTimeForHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetMedianTimeForHeightResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetMedianTimeForHeightResponse) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// GetBlockSubsidyRequest requests the block subsidy at a height.
+type GetBlockSubsidyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint32                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // Block height
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockSubsidyRequest) Reset() {
+	*x = GetBlockSubsidyRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockSubsidyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockSubsidyRequest) ProtoMessage() {}
+
+func (x *GetBlockSubsidyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockSubsidyRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockSubsidyRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetBlockSubsidyRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GetBlockSubsidyResponse contains the block subsidy at a height and the halving schedule.
+type GetBlockSubsidyResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Subsidy             uint64                 `protobuf:"varint,1,opt,name=subsidy,proto3" json:"subsidy,omitempty"`                                                      // Block subsidy in satoshis
+	NextReductionHeight uint32                 `protobuf:"varint,2,opt,name=next_reduction_height,json=nextReductionHeight,proto3" json:"next_reduction_height,omitempty"` // Height of the first block with a lower subsidy, 0 when the subsidy is not reduced any further
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetBlockSubsidyResponse) Reset() {
+	*x = GetBlockSubsidyResponse{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockSubsidyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockSubsidyResponse) ProtoMessage() {}
+
+func (x *GetBlockSubsidyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockSubsidyResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockSubsidyResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetBlockSubsidyResponse) GetSubsidy() uint64 {
+	if x != nil {
+		return x.Subsidy
+	}
+	return 0
+}
+
+func (x *GetBlockSubsidyResponse) GetNextReductionHeight() uint32 {
+	if x != nil {
+		return x.NextReductionHeight
+	}
+	return 0
+}
+
+// WaitForBlockHeightRequest requests to wait until the best block reaches a height.
+type WaitForBlockHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint32                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // Block height to wait for
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForBlockHeightRequest) Reset() {
+	*x = WaitForBlockHeightRequest{}
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForBlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForBlockHeightRequest) ProtoMessage() {}
+
+func (x *WaitForBlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForBlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*WaitForBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *WaitForBlockHeightRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // GetChainTipsResponse contains information about all known tips in the block tree.
 type GetChainTipsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3542,7 +4628,7 @@ type GetChainTipsResponse struct {
 
 func (x *GetChainTipsResponse) Reset() {
 	*x = GetChainTipsResponse{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[65]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3554,7 +4640,7 @@ func (x *GetChainTipsResponse) String() string {
 func (*GetChainTipsResponse) ProtoMessage() {}
 
 func (x *GetChainTipsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[65]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3567,7 +4653,7 @@ func (x *GetChainTipsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChainTipsResponse.ProtoReflect.Descriptor instead.
 func (*GetChainTipsResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{65}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetChainTipsResponse) GetTips() []*model.ChainTip {
@@ -3590,7 +4676,7 @@ type ReportPeerFailureRequest struct {
 
 func (x *ReportPeerFailureRequest) Reset() {
 	*x = ReportPeerFailureRequest{}
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[66]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3602,7 +4688,7 @@ func (x *ReportPeerFailureRequest) String() string {
 func (*ReportPeerFailureRequest) ProtoMessage() {}
 
 func (x *ReportPeerFailureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[66]
+	mi := &file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3615,7 +4701,7 @@ func (x *ReportPeerFailureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportPeerFailureRequest.ProtoReflect.Descriptor instead.
 func (*ReportPeerFailureRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{66}
+	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ReportPeerFailureRequest) GetHash() []byte {
@@ -3654,7 +4740,7 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x0eHealthResponse\x12\x0e\n" +
 	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
 	"\adetails\x18\x02 \x01(\tR\adetails\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8f\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xbf\x03\n" +
 	"\x0fAddBlockRequest\x12\x16\n" +
 	"\x06header\x18\x01 \x01(\fR\x06header\x12%\n" +
 	"\x0esubtree_hashes\x18\x02 \x03(\fR\rsubtreeHashes\x12\x1f\n" +
@@ -3668,7 +4754,11 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x11optionSubtreesSet\x18\t \x01(\bR\x11optionSubtreesSet\x12$\n" +
 	"\roptionInvalid\x18\n" +
 	" \x01(\bR\roptionInvalid\x12\x1a\n" +
-	"\boptionID\x18\v \x01(\x04R\boptionID\"%\n" +
+	"\boptionID\x18\v \x01(\x04R\boptionID\x12.\n" +
+	"\x12optionWaitForKafka\x18\f \x01(\bR\x12optionWaitForKafka\"P\n" +
+	"\x10AddBlockResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\x12$\n" +
+	"\ralreadyExists\x18\x02 \x01(\bR\ralreadyExists\"%\n" +
 	"\x0fGetBlockRequest\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\fR\x04hash\"<\n" +
 	"\x10GetBlocksRequest\x12\x12\n" +
@@ -3677,7 +4767,10 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x11GetBlocksResponse\x12\x16\n" +
 	"\x06blocks\x18\x01 \x03(\fR\x06blocks\"1\n" +
 	"\x17GetBlockByHeightRequest\x12\x16\n" +
-	"\x06height\x18\x01 \x01(\rR\x06height\"%\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\"_\n" +
+	"\x1dGetBlocksByHeightRangeRequest\x12 \n" +
+	"\vstartHeight\x18\x01 \x01(\rR\vstartHeight\x12\x1c\n" +
+	"\tendHeight\x18\x02 \x01(\rR\tendHeight\"%\n" +
 	"\x13GetBlockByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"<\n" +
 	"\x16GetNextBlockIDResponse\x12\"\n" +
@@ -3745,13 +4838,26 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x15GetMedianTimeResponse\x12*\n" +
 	"\x11block_header_time\x18\x01 \x03(\rR\x0fblockHeaderTime\"5\n" +
 	"\x15GetBlockHeaderRequest\x12\x1c\n" +
-	"\tblockHash\x18\x01 \x01(\fR\tblockHash\"=\n" +
+	"\tblockHash\x18\x01 \x01(\fR\tblockHash\"B\n" +
+	"\x1eGetBlockHeadersByHashesRequest\x12 \n" +
+	"\vblockHashes\x18\x01 \x03(\fR\vblockHashes\"q\n" +
+	"\x1fGetBlockHeadersByHashesResponse\x12\"\n" +
+	"\fblockHeaders\x18\x01 \x03(\fR\fblockHeaders\x12\x14\n" +
+	"\x05metas\x18\x02 \x03(\fR\x05metas\x12\x14\n" +
+	"\x05found\x18\x03 \x03(\bR\x05found\"=\n" +
 	"\x1fCheckBlockIsCurrentChainRequest\x12\x1a\n" +
-	"\bblockIDs\x18\x01 \x03(\rR\bblockIDs\"6\n" +
+	"\bblockIDs\x18\x01 \x03(\rR\bblockIDs\"d\n" +
 	"\x16InvalidateBlockRequest\x12\x1c\n" +
-	"\tblockHash\x18\x01 \x01(\fR\tblockHash\"G\n" +
+	"\tblockHash\x18\x01 \x01(\fR\tblockHash\x12\x16\n" +
+	"\x06dryRun\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x8e\x01\n" +
 	"\x17InvalidateBlockResponse\x12,\n" +
-	"\x11invalidatedBlocks\x18\x01 \x03(\fR\x11invalidatedBlocks\"6\n" +
+	"\x11invalidatedBlocks\x18\x01 \x03(\fR\x11invalidatedBlocks\x12E\n" +
+	"\x0eaffectedBlocks\x18\x02 \x03(\v2\x1d.blockchain_api.AffectedBlockR\x0eaffectedBlocks\"]\n" +
+	"\rAffectedBlock\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\x12 \n" +
+	"\vonBestChain\x18\x03 \x01(\bR\vonBestChain\"6\n" +
 	"\x16RevalidateBlockRequest\x12\x1c\n" +
 	"\tblockHash\x18\x01 \x01(\fR\tblockHash\"\x86\x03\n" +
 	"\x16GetBlockHeaderResponse\x12 \n" +
@@ -3772,9 +4878,10 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\fsubtrees_set\x18\f \x01(\bR\vsubtreesSet\x12\x18\n" +
 	"\ainvalid\x18\r \x01(\bR\ainvalid\"V\n" +
 	" CheckBlockIsCurrentChainResponse\x122\n" +
-	"\x14isPartOfCurrentChain\x18\x01 \x01(\bR\x14isPartOfCurrentChain\"*\n" +
+	"\x14isPartOfCurrentChain\x18\x01 \x01(\bR\x14isPartOfCurrentChain\"r\n" +
 	"\x10SubscribeRequest\x12\x16\n" +
-	"\x06source\x18\x01 \x01(\tR\x06source\"\xac\x01\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12F\n" +
+	"\x12notification_types\x18\x02 \x03(\x0e2\x17.model.NotificationTypeR\x11notificationTypes\"\xac\x01\n" +
 	"\fNotification\x12+\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x17.model.NotificationTypeR\x04type\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x19\n" +
@@ -3791,17 +4898,24 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x04data\x18\x01 \x01(\fR\x04data\"7\n" +
 	"\x0fSetStateRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\"6\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"f\n" +
+	"\x19CompareAndSetStateRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
+	"\rexpected_data\x18\x02 \x01(\fR\fexpectedData\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"6\n" +
+	"\x1aCompareAndSetStateResponse\x12\x18\n" +
+	"\aswapped\x18\x01 \x01(\bR\aswapped\"6\n" +
 	"\x16GetBlockIsMinedRequest\x12\x1c\n" +
 	"\tblockHash\x18\x01 \x01(\fR\tblockHash\"3\n" +
 	"\x17GetBlockIsMinedResponse\x12\x18\n" +
-	"\aisMined\x18\x01 \x01(\bR\aisMined\"\x87\x01\n" +
+	"\aisMined\x18\x01 \x01(\bR\aisMined\"\xbc\x01\n" +
 	"\x15GetLastNBlocksRequest\x12&\n" +
 	"\x0enumberOfBlocks\x18\x01 \x01(\x03R\x0enumberOfBlocks\x12&\n" +
 	"\x0eincludeOrphans\x18\x02 \x01(\bR\x0eincludeOrphans\x12\x1e\n" +
 	"\n" +
 	"fromHeight\x18\x03 \x01(\rR\n" +
-	"fromHeight\"B\n" +
+	"fromHeight\x123\n" +
+	"\tselection\x18\x04 \x01(\x0e2\x15.model.BlockSelectionR\tselection\"B\n" +
 	"\x16GetLastNBlocksResponse\x12(\n" +
 	"\x06blocks\x18\x01 \x03(\v2\x10.model.BlockInfoR\x06blocks\",\n" +
 	"\x1cGetLastNInvalidBlocksRequest\x12\f\n" +
@@ -3830,7 +4944,21 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x11previousBlockHash\x18\x01 \x01(\fR\x11previousBlockHash\x12*\n" +
 	"\x10currentBlockTime\x18\x02 \x01(\x03R\x10currentBlockTime\"1\n" +
 	"\x1bGetNextWorkRequiredResponse\x12\x12\n" +
-	"\x04bits\x18\x01 \x01(\fR\x04bits\"7\n" +
+	"\x04bits\x18\x01 \x01(\fR\x04bits\"\x8b\x02\n" +
+	"\x19GetDifficultyInfoResponse\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\fR\tblockHash\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\x12\x12\n" +
+	"\x04bits\x18\x03 \x01(\fR\x04bits\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x1c\n" +
+	"\tchainWork\x18\x05 \x01(\fR\tchainWork\x12>\n" +
+	"\x1adifficultyAdjustmentWindow\x18\x06 \x01(\rR\x1adifficultyAdjustmentWindow\x12.\n" +
+	"\x12nextRetargetHeight\x18\a \x01(\rR\x12nextRetargetHeight\"\xb1\x01\n" +
+	"!GetMiningCandidateContextResponse\x12,\n" +
+	"\x11previousBlockHash\x18\x01 \x01(\fR\x11previousBlockHash\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\x12\x12\n" +
+	"\x04bits\x18\x03 \x01(\fR\x04bits\x12\x18\n" +
+	"\aminTime\x18\x04 \x01(\rR\aminTime\x12\x18\n" +
+	"\acurTime\x18\x05 \x01(\rR\acurTime\"7\n" +
 	"\x17SetBlockMinedSetRequest\x12\x1c\n" +
 	"\tblockHash\x18\x01 \x01(\fR\tblockHash\">\n" +
 	"\x1cGetBlocksMinedNotSetResponse\x12\x1e\n" +
@@ -3848,14 +4976,25 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"block_hash\x18\x01 \x01(\fR\tblockHash\x12\x14\n" +
 	"\x05clear\x18\x02 \x01(\bR\x05clear\"I\n" +
 	"\x13GetFSMStateResponse\x122\n" +
-	"\x05state\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\x05state\"P\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\x05state\"i\n" +
+	"\x11IsCurrentResponse\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\bR\acurrent\x12:\n" +
+	"\areasons\x18\x02 \x03(\x0e2 .blockchain_api.NotCurrentReasonR\areasons\"P\n" +
 	"\x1aWaitFSMToTransitionRequest\x122\n" +
-	"\x05state\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\x05state\"I\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\x05state\"2\n" +
+	"\x18SubscribeFSMStateRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\"\x9c\x01\n" +
+	"\x0eFSMStateChange\x129\n" +
+	"\told_state\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\boldState\x129\n" +
+	"\tnew_state\x18\x02 \x01(\x0e2\x1c.blockchain_api.FSMStateTypeR\bnewState\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\"I\n" +
 	"\x13SendFSMEventRequest\x122\n" +
 	"\x05event\x18\x01 \x01(\x0e2\x1c.blockchain_api.FSMEventTypeR\x05event\"D\n" +
 	"\x16GetBlockLocatorRequest\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x16\n" +
-	"\x06height\x18\x02 \x01(\rR\x06height\"3\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\"8\n" +
+	"\x1eGetBlockLocatorByHeightRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\"3\n" +
 	"\x17GetBlockLocatorResponse\x12\x18\n" +
 	"\alocator\x18\x01 \x03(\fR\alocator\"q\n" +
 	"\x19LocateBlockHeadersRequest\x12\x18\n" +
@@ -3867,7 +5006,18 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\rblock_headers\x18\x01 \x03(\fR\fblockHeaders\"J\n" +
 	"\x1cGetBestHeightAndTimeResponse\x12\x16\n" +
 	"\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n" +
-	"\x04time\x18\x02 \x01(\rR\x04time\";\n" +
+	"\x04time\x18\x02 \x01(\rR\x04time\"7\n" +
+	"\x1dGetMedianTimeForHeightRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\"4\n" +
+	"\x1eGetMedianTimeForHeightResponse\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\rR\x04time\"0\n" +
+	"\x16GetBlockSubsidyRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\"g\n" +
+	"\x17GetBlockSubsidyResponse\x12\x18\n" +
+	"\asubsidy\x18\x01 \x01(\x04R\asubsidy\x122\n" +
+	"\x15next_reduction_height\x18\x02 \x01(\rR\x13nextReductionHeight\"3\n" +
+	"\x19WaitForBlockHeightRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\rR\x06height\";\n" +
 	"\x14GetChainTipsResponse\x12#\n" +
 	"\x04tips\x18\x01 \x03(\v2\x0f.model.ChainTipR\x04tips\"\x82\x01\n" +
 	"\x18ReportPeerFailureRequest\x12\x12\n" +
@@ -3885,14 +5035,19 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x04IDLE\x10\x00\x12\v\n" +
 	"\aRUNNING\x10\x01\x12\x12\n" +
 	"\x0eCATCHINGBLOCKS\x10\x02\x12\x11\n" +
-	"\rLEGACYSYNCING\x10\x032\x8b'\n" +
+	"\rLEGACYSYNCING\x10\x03*N\n" +
+	"\x10NotCurrentReason\x12\x14\n" +
+	"\x10BELOW_CHECKPOINT\x10\x00\x12\x0f\n" +
+	"\vTIP_TOO_OLD\x10\x01\x12\x13\n" +
+	"\x0fFSM_NOT_RUNNING\x10\x022\x901\n" +
 	"\rBlockchainAPI\x12F\n" +
 	"\n" +
-	"HealthGRPC\x12\x16.google.protobuf.Empty\x1a\x1e.blockchain_api.HealthResponse\"\x00\x12E\n" +
-	"\bAddBlock\x12\x1f.blockchain_api.AddBlockRequest\x1a\x16.google.protobuf.Empty\"\x00\x12O\n" +
+	"HealthGRPC\x12\x16.google.protobuf.Empty\x1a\x1e.blockchain_api.HealthResponse\"\x00\x12O\n" +
+	"\bAddBlock\x12\x1f.blockchain_api.AddBlockRequest\x1a .blockchain_api.AddBlockResponse\"\x00\x12O\n" +
 	"\bGetBlock\x12\x1f.blockchain_api.GetBlockRequest\x1a .blockchain_api.GetBlockResponse\"\x00\x12R\n" +
 	"\tGetBlocks\x12 .blockchain_api.GetBlocksRequest\x1a!.blockchain_api.GetBlocksResponse\"\x00\x12_\n" +
-	"\x10GetBlockByHeight\x12'.blockchain_api.GetBlockByHeightRequest\x1a .blockchain_api.GetBlockResponse\"\x00\x12W\n" +
+	"\x10GetBlockByHeight\x12'.blockchain_api.GetBlockByHeightRequest\x1a .blockchain_api.GetBlockResponse\"\x00\x12l\n" +
+	"\x16GetBlocksByHeightRange\x12-.blockchain_api.GetBlocksByHeightRangeRequest\x1a!.blockchain_api.GetBlocksResponse\"\x00\x12W\n" +
 	"\fGetBlockByID\x12#.blockchain_api.GetBlockByIDRequest\x1a .blockchain_api.GetBlockResponse\"\x00\x12R\n" +
 	"\x0eGetNextBlockID\x12\x16.google.protobuf.Empty\x1a&.blockchain_api.GetNextBlockIDResponse\"\x00\x12<\n" +
 	"\rGetBlockStats\x12\x16.google.protobuf.Empty\x1a\x11.model.BlockStats\"\x00\x12W\n" +
@@ -3903,7 +5058,9 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x16GetHashOfAncestorBlock\x12-.blockchain_api.GetHashOfAncestorBlockRequest\x1a..blockchain_api.GetHashOfAncestorBlockResponse\"\x00\x12\x8d\x01\n" +
 	"$GetLatestBlockHeaderFromBlockLocator\x12;.blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00\x12x\n" +
 	"\x19GetBlockHeadersFromOldest\x120.blockchain_api.GetBlockHeadersFromOldestRequest\x1a'.blockchain_api.GetBlockHeadersResponse\"\x00\x12p\n" +
-	"\x13GetNextWorkRequired\x12*.blockchain_api.GetNextWorkRequiredRequest\x1a+.blockchain_api.GetNextWorkRequiredResponse\"\x00\x12[\n" +
+	"\x13GetNextWorkRequired\x12*.blockchain_api.GetNextWorkRequiredRequest\x1a+.blockchain_api.GetNextWorkRequiredResponse\"\x00\x12X\n" +
+	"\x11GetDifficultyInfo\x12\x16.google.protobuf.Empty\x1a).blockchain_api.GetDifficultyInfoResponse\"\x00\x12h\n" +
+	"\x19GetMiningCandidateContext\x12\x16.google.protobuf.Empty\x1a1.blockchain_api.GetMiningCandidateContextResponse\"\x00\x12[\n" +
 	"\x0eGetBlockExists\x12\x1f.blockchain_api.GetBlockRequest\x1a&.blockchain_api.GetBlockExistsResponse\"\x00\x12d\n" +
 	"\x0fGetBlockHeaders\x12&.blockchain_api.GetBlockHeadersRequest\x1a'.blockchain_api.GetBlockHeadersResponse\"\x00\x12\x84\x01\n" +
 	"\x1fGetBlockHeadersToCommonAncestor\x126.blockchain_api.GetBlockHeadersToCommonAncestorRequest\x1a'.blockchain_api.GetBlockHeadersResponse\"\x00\x12\x88\x01\n" +
@@ -3915,13 +5072,16 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x12GetBestBlockHeader\x12\x16.google.protobuf.Empty\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00\x12\x81\x01\n" +
 	"\x1aCheckBlockIsInCurrentChain\x12/.blockchain_api.CheckBlockIsCurrentChainRequest\x1a0.blockchain_api.CheckBlockIsCurrentChainResponse\"\x00\x12N\n" +
 	"\fGetChainTips\x12\x16.google.protobuf.Empty\x1a$.blockchain_api.GetChainTipsResponse\"\x00\x12a\n" +
-	"\x0eGetBlockHeader\x12%.blockchain_api.GetBlockHeaderRequest\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00\x12d\n" +
+	"\x0eGetBlockHeader\x12%.blockchain_api.GetBlockHeaderRequest\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00\x12k\n" +
+	"\x16GetBlockHeaderByHeight\x12'.blockchain_api.GetBlockByHeightRequest\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00\x12|\n" +
+	"\x17GetBlockHeadersByHashes\x12..blockchain_api.GetBlockHeadersByHashesRequest\x1a/.blockchain_api.GetBlockHeadersByHashesResponse\"\x00\x12d\n" +
 	"\x0fInvalidateBlock\x12&.blockchain_api.InvalidateBlockRequest\x1a'.blockchain_api.InvalidateBlockResponse\"\x00\x12S\n" +
 	"\x0fRevalidateBlock\x12&.blockchain_api.RevalidateBlockRequest\x1a\x16.google.protobuf.Empty\"\x00\x12O\n" +
 	"\tSubscribe\x12 .blockchain_api.SubscribeRequest\x1a\x1c.blockchain_api.Notification\"\x000\x01\x12J\n" +
 	"\x10SendNotification\x12\x1c.blockchain_api.Notification\x1a\x16.google.protobuf.Empty\"\x00\x12L\n" +
 	"\bGetState\x12\x1f.blockchain_api.GetStateRequest\x1a\x1d.blockchain_api.StateResponse\"\x00\x12E\n" +
-	"\bSetState\x12\x1f.blockchain_api.SetStateRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
+	"\bSetState\x12\x1f.blockchain_api.SetStateRequest\x1a\x16.google.protobuf.Empty\"\x00\x12m\n" +
+	"\x12CompareAndSetState\x12).blockchain_api.CompareAndSetStateRequest\x1a*.blockchain_api.CompareAndSetStateResponse\"\x00\x12d\n" +
 	"\x0fGetBlockIsMined\x12&.blockchain_api.GetBlockIsMinedRequest\x1a'.blockchain_api.GetBlockIsMinedResponse\"\x00\x12U\n" +
 	"\x10SetBlockMinedSet\x12'.blockchain_api.SetBlockMinedSetRequest\x1a\x16.google.protobuf.Empty\"\x00\x12^\n" +
 	"\x14GetBlocksMinedNotSet\x12\x16.google.protobuf.Empty\x1a,.blockchain_api.GetBlocksMinedNotSetResponse\"\x00\x12[\n" +
@@ -3929,18 +5089,24 @@ const file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc = ""
 	"\x17GetBlocksSubtreesNotSet\x12\x16.google.protobuf.Empty\x1a/.blockchain_api.GetBlocksSubtreesNotSetResponse\"\x00\x12[\n" +
 	"\x13SetBlockProcessedAt\x12*.blockchain_api.SetBlockProcessedAtRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Z\n" +
 	"\fSendFSMEvent\x12#.blockchain_api.SendFSMEventRequest\x1a#.blockchain_api.GetFSMStateResponse\"\x00\x12S\n" +
-	"\x12GetFSMCurrentState\x12\x16.google.protobuf.Empty\x1a#.blockchain_api.GetFSMStateResponse\"\x00\x12g\n" +
+	"\x12GetFSMCurrentState\x12\x16.google.protobuf.Empty\x1a#.blockchain_api.GetFSMStateResponse\"\x00\x12H\n" +
+	"\tIsCurrent\x12\x16.google.protobuf.Empty\x1a!.blockchain_api.IsCurrentResponse\"\x00\x12g\n" +
 	"\x1fWaitFSMToTransitionToGivenState\x12*.blockchain_api.WaitFSMToTransitionRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
-	"#WaitUntilFSMTransitionFromIdleState\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x127\n" +
+	"#WaitUntilFSMTransitionFromIdleState\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12a\n" +
+	"\x11SubscribeFSMState\x12(.blockchain_api.SubscribeFSMStateRequest\x1a\x1e.blockchain_api.FSMStateChange\"\x000\x01\x127\n" +
 	"\x03Run\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12A\n" +
 	"\rCatchUpBlocks\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12>\n" +
 	"\n" +
 	"LegacySync\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x128\n" +
 	"\x04Idle\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
 	"\x11ReportPeerFailure\x12(.blockchain_api.ReportPeerFailureRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
-	"\x0fGetBlockLocator\x12&.blockchain_api.GetBlockLocatorRequest\x1a'.blockchain_api.GetBlockLocatorResponse\"\x00\x12m\n" +
+	"\x0fGetBlockLocator\x12&.blockchain_api.GetBlockLocatorRequest\x1a'.blockchain_api.GetBlockLocatorResponse\"\x00\x12t\n" +
+	"\x17GetBlockLocatorByHeight\x12..blockchain_api.GetBlockLocatorByHeightRequest\x1a'.blockchain_api.GetBlockLocatorResponse\"\x00\x12m\n" +
 	"\x12LocateBlockHeaders\x12).blockchain_api.LocateBlockHeadersRequest\x1a*.blockchain_api.LocateBlockHeadersResponse\"\x00\x12^\n" +
-	"\x14GetBestHeightAndTime\x12\x16.google.protobuf.Empty\x1a,.blockchain_api.GetBestHeightAndTimeResponse\"\x00B\x13Z\x11./;blockchain_apib\x06proto3"
+	"\x14GetBestHeightAndTime\x12\x16.google.protobuf.Empty\x1a,.blockchain_api.GetBestHeightAndTimeResponse\"\x00\x12y\n" +
+	"\x16GetMedianTimeForHeight\x12-.blockchain_api.GetMedianTimeForHeightRequest\x1a..blockchain_api.GetMedianTimeForHeightResponse\"\x00\x12d\n" +
+	"\x0fGetBlockSubsidy\x12&.blockchain_api.GetBlockSubsidyRequest\x1a'.blockchain_api.GetBlockSubsidyResponse\"\x00\x12i\n" +
+	"\x12WaitForBlockHeight\x12).blockchain_api.WaitForBlockHeightRequest\x1a&.blockchain_api.GetBlockHeaderResponse\"\x00B\x13Z\x11./;blockchain_apib\x06proto3"
 
 var (
 	file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescOnce sync.Once
@@ -3954,209 +5120,259 @@ func file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescGZIP()
 	return file_services_blockchain_blockchain_api_blockchain_api_proto_rawDescData
 }
 
-var file_services_blockchain_blockchain_api_blockchain_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_services_blockchain_blockchain_api_blockchain_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_services_blockchain_blockchain_api_blockchain_api_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_services_blockchain_blockchain_api_blockchain_api_proto_goTypes = []any{
 	(FSMEventType)(0),                                   // 0: blockchain_api.FSMEventType
 	(FSMStateType)(0),                                   // 1: blockchain_api.FSMStateType
-	(*HealthResponse)(nil),                              // 2: blockchain_api.HealthResponse
-	(*AddBlockRequest)(nil),                             // 3: blockchain_api.AddBlockRequest
-	(*GetBlockRequest)(nil),                             // 4: blockchain_api.GetBlockRequest
-	(*GetBlocksRequest)(nil),                            // 5: blockchain_api.GetBlocksRequest
-	(*GetBlocksResponse)(nil),                           // 6: blockchain_api.GetBlocksResponse
-	(*GetBlockByHeightRequest)(nil),                     // 7: blockchain_api.GetBlockByHeightRequest
-	(*GetBlockByIDRequest)(nil),                         // 8: blockchain_api.GetBlockByIDRequest
-	(*GetNextBlockIDResponse)(nil),                      // 9: blockchain_api.GetNextBlockIDResponse
-	(*GetBlockInChainByHeightHashRequest)(nil),          // 10: blockchain_api.GetBlockInChainByHeightHashRequest
-	(*GetBlockResponse)(nil),                            // 11: blockchain_api.GetBlockResponse
-	(*GetFullBlockResponse)(nil),                        // 12: blockchain_api.GetFullBlockResponse
-	(*GetBlockGraphDataRequest)(nil),                    // 13: blockchain_api.GetBlockGraphDataRequest
-	(*GetBlockExistsResponse)(nil),                      // 14: blockchain_api.GetBlockExistsResponse
-	(*GetMedianTimeRequest)(nil),                        // 15: blockchain_api.GetMedianTimeRequest
-	(*GetBlockHeadersRequest)(nil),                      // 16: blockchain_api.GetBlockHeadersRequest
-	(*GetBlockHeadersToCommonAncestorRequest)(nil),      // 17: blockchain_api.GetBlockHeadersToCommonAncestorRequest
-	(*GetBlockHeadersFromCommonAncestorRequest)(nil),    // 18: blockchain_api.GetBlockHeadersFromCommonAncestorRequest
-	(*GetBlockHeadersResponse)(nil),                     // 19: blockchain_api.GetBlockHeadersResponse
-	(*GetBlockHeadersFromTillRequest)(nil),              // 20: blockchain_api.GetBlockHeadersFromTillRequest
-	(*GetBlockHeadersFromHeightRequest)(nil),            // 21: blockchain_api.GetBlockHeadersFromHeightRequest
-	(*GetBlockHeadersFromHeightResponse)(nil),           // 22: blockchain_api.GetBlockHeadersFromHeightResponse
-	(*GetBlockHeadersByHeightRequest)(nil),              // 23: blockchain_api.GetBlockHeadersByHeightRequest
-	(*GetBlockHeadersByHeightResponse)(nil),             // 24: blockchain_api.GetBlockHeadersByHeightResponse
-	(*GetBlockHeaderIDsResponse)(nil),                   // 25: blockchain_api.GetBlockHeaderIDsResponse
-	(*GetMedianTimeResponse)(nil),                       // 26: blockchain_api.GetMedianTimeResponse
-	(*GetBlockHeaderRequest)(nil),                       // 27: blockchain_api.GetBlockHeaderRequest
-	(*CheckBlockIsCurrentChainRequest)(nil),             // 28: blockchain_api.CheckBlockIsCurrentChainRequest
-	(*InvalidateBlockRequest)(nil),                      // 29: blockchain_api.InvalidateBlockRequest
-	(*InvalidateBlockResponse)(nil),                     // 30: blockchain_api.InvalidateBlockResponse
-	(*RevalidateBlockRequest)(nil),                      // 31: blockchain_api.RevalidateBlockRequest
-	(*GetBlockHeaderResponse)(nil),                      // 32: blockchain_api.GetBlockHeaderResponse
-	(*CheckBlockIsCurrentChainResponse)(nil),            // 33: blockchain_api.CheckBlockIsCurrentChainResponse
-	(*SubscribeRequest)(nil),                            // 34: blockchain_api.SubscribeRequest
-	(*Notification)(nil),                                // 35: blockchain_api.Notification
-	(*NotificationMetadata)(nil),                        // 36: blockchain_api.NotificationMetadata
-	(*GetStateRequest)(nil),                             // 37: blockchain_api.GetStateRequest
-	(*StateResponse)(nil),                               // 38: blockchain_api.StateResponse
-	(*SetStateRequest)(nil),                             // 39: blockchain_api.SetStateRequest
-	(*GetBlockIsMinedRequest)(nil),                      // 40: blockchain_api.GetBlockIsMinedRequest
-	(*GetBlockIsMinedResponse)(nil),                     // 41: blockchain_api.GetBlockIsMinedResponse
-	(*GetLastNBlocksRequest)(nil),                       // 42: blockchain_api.GetLastNBlocksRequest
-	(*GetLastNBlocksResponse)(nil),                      // 43: blockchain_api.GetLastNBlocksResponse
-	(*GetLastNInvalidBlocksRequest)(nil),                // 44: blockchain_api.GetLastNInvalidBlocksRequest
-	(*GetLastNInvalidBlocksResponse)(nil),               // 45: blockchain_api.GetLastNInvalidBlocksResponse
-	(*GetSuitableBlockRequest)(nil),                     // 46: blockchain_api.GetSuitableBlockRequest
-	(*GetSuitableBlockResponse)(nil),                    // 47: blockchain_api.GetSuitableBlockResponse
-	(*GetHashOfAncestorBlockRequest)(nil),               // 48: blockchain_api.GetHashOfAncestorBlockRequest
-	(*GetLatestBlockHeaderFromBlockLocatorRequest)(nil), // 49: blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest
-	(*GetBlockHeadersFromOldestRequest)(nil),            // 50: blockchain_api.GetBlockHeadersFromOldestRequest
-	(*GetHashOfAncestorBlockResponse)(nil),              // 51: blockchain_api.GetHashOfAncestorBlockResponse
-	(*GetNextWorkRequiredRequest)(nil),                  // 52: blockchain_api.GetNextWorkRequiredRequest
-	(*GetNextWorkRequiredResponse)(nil),                 // 53: blockchain_api.GetNextWorkRequiredResponse
-	(*SetBlockMinedSetRequest)(nil),                     // 54: blockchain_api.SetBlockMinedSetRequest
-	(*GetBlocksMinedNotSetResponse)(nil),                // 55: blockchain_api.GetBlocksMinedNotSetResponse
-	(*SetBlockSubtreesSetRequest)(nil),                  // 56: blockchain_api.SetBlockSubtreesSetRequest
-	(*GetBlocksSubtreesNotSetResponse)(nil),             // 57: blockchain_api.GetBlocksSubtreesNotSetResponse
-	(*SetBlockProcessedAtRequest)(nil),                  // 58: blockchain_api.SetBlockProcessedAtRequest
-	(*GetFSMStateResponse)(nil),                         // 59: blockchain_api.GetFSMStateResponse
-	(*WaitFSMToTransitionRequest)(nil),                  // 60: blockchain_api.WaitFSMToTransitionRequest
-	(*SendFSMEventRequest)(nil),                         // 61: blockchain_api.SendFSMEventRequest
-	(*GetBlockLocatorRequest)(nil),                      // 62: blockchain_api.GetBlockLocatorRequest
-	(*GetBlockLocatorResponse)(nil),                     // 63: blockchain_api.GetBlockLocatorResponse
-	(*LocateBlockHeadersRequest)(nil),                   // 64: blockchain_api.LocateBlockHeadersRequest
-	(*LocateBlockHeadersResponse)(nil),                  // 65: blockchain_api.LocateBlockHeadersResponse
-	(*GetBestHeightAndTimeResponse)(nil),                // 66: blockchain_api.GetBestHeightAndTimeResponse
-	(*GetChainTipsResponse)(nil),                        // 67: blockchain_api.GetChainTipsResponse
-	(*ReportPeerFailureRequest)(nil),                    // 68: blockchain_api.ReportPeerFailureRequest
-	nil,                                                 // 69: blockchain_api.NotificationMetadata.MetadataEntry
-	(*timestamppb.Timestamp)(nil),                       // 70: google.protobuf.Timestamp
-	(model.NotificationType)(0),                         // 71: model.NotificationType
-	(*model.BlockInfo)(nil),                             // 72: model.BlockInfo
-	(*model.SuitableBlock)(nil),                         // 73: model.SuitableBlock
-	(*model.ChainTip)(nil),                              // 74: model.ChainTip
-	(*emptypb.Empty)(nil),                               // 75: google.protobuf.Empty
-	(*model.BlockStats)(nil),                            // 76: model.BlockStats
-	(*model.BlockDataPoints)(nil),                       // 77: model.BlockDataPoints
+	(NotCurrentReason)(0),                               // 2: blockchain_api.NotCurrentReason
+	(*HealthResponse)(nil),                              // 3: blockchain_api.HealthResponse
+	(*AddBlockRequest)(nil),                             // 4: blockchain_api.AddBlockRequest
+	(*AddBlockResponse)(nil),                            // 5: blockchain_api.AddBlockResponse
+	(*GetBlockRequest)(nil),                             // 6: blockchain_api.GetBlockRequest
+	(*GetBlocksRequest)(nil),                            // 7: blockchain_api.GetBlocksRequest
+	(*GetBlocksResponse)(nil),                           // 8: blockchain_api.GetBlocksResponse
+	(*GetBlockByHeightRequest)(nil),                     // 9: blockchain_api.GetBlockByHeightRequest
+	(*GetBlocksByHeightRangeRequest)(nil),               // 10: blockchain_api.GetBlocksByHeightRangeRequest
+	(*GetBlockByIDRequest)(nil),                         // 11: blockchain_api.GetBlockByIDRequest
+	(*GetNextBlockIDResponse)(nil),                      // 12: blockchain_api.GetNextBlockIDResponse
+	(*GetBlockInChainByHeightHashRequest)(nil),          // 13: blockchain_api.GetBlockInChainByHeightHashRequest
+	(*GetBlockResponse)(nil),                            // 14: blockchain_api.GetBlockResponse
+	(*GetFullBlockResponse)(nil),                        // 15: blockchain_api.GetFullBlockResponse
+	(*GetBlockGraphDataRequest)(nil),                    // 16: blockchain_api.GetBlockGraphDataRequest
+	(*GetBlockExistsResponse)(nil),                      // 17: blockchain_api.GetBlockExistsResponse
+	(*GetMedianTimeRequest)(nil),                        // 18: blockchain_api.GetMedianTimeRequest
+	(*GetBlockHeadersRequest)(nil),                      // 19: blockchain_api.GetBlockHeadersRequest
+	(*GetBlockHeadersToCommonAncestorRequest)(nil),      // 20: blockchain_api.GetBlockHeadersToCommonAncestorRequest
+	(*GetBlockHeadersFromCommonAncestorRequest)(nil),    // 21: blockchain_api.GetBlockHeadersFromCommonAncestorRequest
+	(*GetBlockHeadersResponse)(nil),                     // 22: blockchain_api.GetBlockHeadersResponse
+	(*GetBlockHeadersFromTillRequest)(nil),              // 23: blockchain_api.GetBlockHeadersFromTillRequest
+	(*GetBlockHeadersFromHeightRequest)(nil),            // 24: blockchain_api.GetBlockHeadersFromHeightRequest
+	(*GetBlockHeadersFromHeightResponse)(nil),           // 25: blockchain_api.GetBlockHeadersFromHeightResponse
+	(*GetBlockHeadersByHeightRequest)(nil),              // 26: blockchain_api.GetBlockHeadersByHeightRequest
+	(*GetBlockHeadersByHeightResponse)(nil),             // 27: blockchain_api.GetBlockHeadersByHeightResponse
+	(*GetBlockHeaderIDsResponse)(nil),                   // 28: blockchain_api.GetBlockHeaderIDsResponse
+	(*GetMedianTimeResponse)(nil),                       // 29: blockchain_api.GetMedianTimeResponse
+	(*GetBlockHeaderRequest)(nil),                       // 30: blockchain_api.GetBlockHeaderRequest
+	(*GetBlockHeadersByHashesRequest)(nil),              // 31: blockchain_api.GetBlockHeadersByHashesRequest
+	(*GetBlockHeadersByHashesResponse)(nil),             // 32: blockchain_api.GetBlockHeadersByHashesResponse
+	(*CheckBlockIsCurrentChainRequest)(nil),             // 33: blockchain_api.CheckBlockIsCurrentChainRequest
+	(*InvalidateBlockRequest)(nil),                      // 34: blockchain_api.InvalidateBlockRequest
+	(*InvalidateBlockResponse)(nil),                     // 35: blockchain_api.InvalidateBlockResponse
+	(*AffectedBlock)(nil),                               // 36: blockchain_api.AffectedBlock
+	(*RevalidateBlockRequest)(nil),                      // 37: blockchain_api.RevalidateBlockRequest
+	(*GetBlockHeaderResponse)(nil),                      // 38: blockchain_api.GetBlockHeaderResponse
+	(*CheckBlockIsCurrentChainResponse)(nil),            // 39: blockchain_api.CheckBlockIsCurrentChainResponse
+	(*SubscribeRequest)(nil),                            // 40: blockchain_api.SubscribeRequest
+	(*Notification)(nil),                                // 41: blockchain_api.Notification
+	(*NotificationMetadata)(nil),                        // 42: blockchain_api.NotificationMetadata
+	(*GetStateRequest)(nil),                             // 43: blockchain_api.GetStateRequest
+	(*StateResponse)(nil),                               // 44: blockchain_api.StateResponse
+	(*SetStateRequest)(nil),                             // 45: blockchain_api.SetStateRequest
+	(*CompareAndSetStateRequest)(nil),                   // 46: blockchain_api.CompareAndSetStateRequest
+	(*CompareAndSetStateResponse)(nil),                  // 47: blockchain_api.CompareAndSetStateResponse
+	(*GetBlockIsMinedRequest)(nil),                      // 48: blockchain_api.GetBlockIsMinedRequest
+	(*GetBlockIsMinedResponse)(nil),                     // 49: blockchain_api.GetBlockIsMinedResponse
+	(*GetLastNBlocksRequest)(nil),                       // 50: blockchain_api.GetLastNBlocksRequest
+	(*GetLastNBlocksResponse)(nil),                      // 51: blockchain_api.GetLastNBlocksResponse
+	(*GetLastNInvalidBlocksRequest)(nil),                // 52: blockchain_api.GetLastNInvalidBlocksRequest
+	(*GetLastNInvalidBlocksResponse)(nil),               // 53: blockchain_api.GetLastNInvalidBlocksResponse
+	(*GetSuitableBlockRequest)(nil),                     // 54: blockchain_api.GetSuitableBlockRequest
+	(*GetSuitableBlockResponse)(nil),                    // 55: blockchain_api.GetSuitableBlockResponse
+	(*GetHashOfAncestorBlockRequest)(nil),               // 56: blockchain_api.GetHashOfAncestorBlockRequest
+	(*GetLatestBlockHeaderFromBlockLocatorRequest)(nil), // 57: blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest
+	(*GetBlockHeadersFromOldestRequest)(nil),            // 58: blockchain_api.GetBlockHeadersFromOldestRequest
+	(*GetHashOfAncestorBlockResponse)(nil),              // 59: blockchain_api.GetHashOfAncestorBlockResponse
+	(*GetNextWorkRequiredRequest)(nil),                  // 60: blockchain_api.GetNextWorkRequiredRequest
+	(*GetNextWorkRequiredResponse)(nil),                 // 61: blockchain_api.GetNextWorkRequiredResponse
+	(*GetDifficultyInfoResponse)(nil),                   // 62: blockchain_api.GetDifficultyInfoResponse
+	(*GetMiningCandidateContextResponse)(nil),           // 63: blockchain_api.GetMiningCandidateContextResponse
+	(*SetBlockMinedSetRequest)(nil),                     // 64: blockchain_api.SetBlockMinedSetRequest
+	(*GetBlocksMinedNotSetResponse)(nil),                // 65: blockchain_api.GetBlocksMinedNotSetResponse
+	(*SetBlockSubtreesSetRequest)(nil),                  // 66: blockchain_api.SetBlockSubtreesSetRequest
+	(*GetBlocksSubtreesNotSetResponse)(nil),             // 67: blockchain_api.GetBlocksSubtreesNotSetResponse
+	(*SetBlockProcessedAtRequest)(nil),                  // 68: blockchain_api.SetBlockProcessedAtRequest
+	(*GetFSMStateResponse)(nil),                         // 69: blockchain_api.GetFSMStateResponse
+	(*IsCurrentResponse)(nil),                           // 70: blockchain_api.IsCurrentResponse
+	(*WaitFSMToTransitionRequest)(nil),                  // 71: blockchain_api.WaitFSMToTransitionRequest
+	(*SubscribeFSMStateRequest)(nil),                    // 72: blockchain_api.SubscribeFSMStateRequest
+	(*FSMStateChange)(nil),                              // 73: blockchain_api.FSMStateChange
+	(*SendFSMEventRequest)(nil),                         // 74: blockchain_api.SendFSMEventRequest
+	(*GetBlockLocatorRequest)(nil),                      // 75: blockchain_api.GetBlockLocatorRequest
+	(*GetBlockLocatorByHeightRequest)(nil),              // 76: blockchain_api.GetBlockLocatorByHeightRequest
+	(*GetBlockLocatorResponse)(nil),                     // 77: blockchain_api.GetBlockLocatorResponse
+	(*LocateBlockHeadersRequest)(nil),                   // 78: blockchain_api.LocateBlockHeadersRequest
+	(*LocateBlockHeadersResponse)(nil),                  // 79: blockchain_api.LocateBlockHeadersResponse
+	(*GetBestHeightAndTimeResponse)(nil),                // 80: blockchain_api.GetBestHeightAndTimeResponse
+	(*GetMedianTimeForHeightRequest)(nil),               // 81: blockchain_api.GetMedianTimeForHeightRequest
+	(*GetMedianTimeForHeightResponse)(nil),              // 82: blockchain_api.GetMedianTimeForHeightResponse
+	(*GetBlockSubsidyRequest)(nil),                      // 83: blockchain_api.GetBlockSubsidyRequest
+	(*GetBlockSubsidyResponse)(nil),                     // 84: blockchain_api.GetBlockSubsidyResponse
+	(*WaitForBlockHeightRequest)(nil),                   // 85: blockchain_api.WaitForBlockHeightRequest
+	(*GetChainTipsResponse)(nil),                        // 86: blockchain_api.GetChainTipsResponse
+	(*ReportPeerFailureRequest)(nil),                    // 87: blockchain_api.ReportPeerFailureRequest
+	nil,                                                 // 88: blockchain_api.NotificationMetadata.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                       // 89: google.protobuf.Timestamp
+	(model.NotificationType)(0),                         // 90: model.NotificationType
+	(model.BlockSelection)(0),                           // 91: model.BlockSelection
+	(*model.BlockInfo)(nil),                             // 92: model.BlockInfo
+	(*model.SuitableBlock)(nil),                         // 93: model.SuitableBlock
+	(*model.ChainTip)(nil),                              // 94: model.ChainTip
+	(*emptypb.Empty)(nil),                               // 95: google.protobuf.Empty
+	(*model.BlockStats)(nil),                            // 96: model.BlockStats
+	(*model.BlockDataPoints)(nil),                       // 97: model.BlockDataPoints
 }
 var file_services_blockchain_blockchain_api_blockchain_api_proto_depIdxs = []int32{
-	70, // 0: blockchain_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	71, // 1: blockchain_api.Notification.type:type_name -> model.NotificationType
-	36, // 2: blockchain_api.Notification.metadata:type_name -> blockchain_api.NotificationMetadata
-	69, // 3: blockchain_api.NotificationMetadata.metadata:type_name -> blockchain_api.NotificationMetadata.MetadataEntry
-	72, // 4: blockchain_api.GetLastNBlocksResponse.blocks:type_name -> model.BlockInfo
-	72, // 5: blockchain_api.GetLastNInvalidBlocksResponse.blocks:type_name -> model.BlockInfo
-	73, // 6: blockchain_api.GetSuitableBlockResponse.block:type_name -> model.SuitableBlock
-	1,  // 7: blockchain_api.GetFSMStateResponse.state:type_name -> blockchain_api.FSMStateType
-	1,  // 8: blockchain_api.WaitFSMToTransitionRequest.state:type_name -> blockchain_api.FSMStateType
-	0,  // 9: blockchain_api.SendFSMEventRequest.event:type_name -> blockchain_api.FSMEventType
-	74, // 10: blockchain_api.GetChainTipsResponse.tips:type_name -> model.ChainTip
-	75, // 11: blockchain_api.BlockchainAPI.HealthGRPC:input_type -> google.protobuf.Empty
-	3,  // 12: blockchain_api.BlockchainAPI.AddBlock:input_type -> blockchain_api.AddBlockRequest
-	4,  // 13: blockchain_api.BlockchainAPI.GetBlock:input_type -> blockchain_api.GetBlockRequest
-	5,  // 14: blockchain_api.BlockchainAPI.GetBlocks:input_type -> blockchain_api.GetBlocksRequest
-	7,  // 15: blockchain_api.BlockchainAPI.GetBlockByHeight:input_type -> blockchain_api.GetBlockByHeightRequest
-	8,  // 16: blockchain_api.BlockchainAPI.GetBlockByID:input_type -> blockchain_api.GetBlockByIDRequest
-	75, // 17: blockchain_api.BlockchainAPI.GetNextBlockID:input_type -> google.protobuf.Empty
-	75, // 18: blockchain_api.BlockchainAPI.GetBlockStats:input_type -> google.protobuf.Empty
-	13, // 19: blockchain_api.BlockchainAPI.GetBlockGraphData:input_type -> blockchain_api.GetBlockGraphDataRequest
-	42, // 20: blockchain_api.BlockchainAPI.GetLastNBlocks:input_type -> blockchain_api.GetLastNBlocksRequest
-	44, // 21: blockchain_api.BlockchainAPI.GetLastNInvalidBlocks:input_type -> blockchain_api.GetLastNInvalidBlocksRequest
-	46, // 22: blockchain_api.BlockchainAPI.GetSuitableBlock:input_type -> blockchain_api.GetSuitableBlockRequest
-	48, // 23: blockchain_api.BlockchainAPI.GetHashOfAncestorBlock:input_type -> blockchain_api.GetHashOfAncestorBlockRequest
-	49, // 24: blockchain_api.BlockchainAPI.GetLatestBlockHeaderFromBlockLocator:input_type -> blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest
-	50, // 25: blockchain_api.BlockchainAPI.GetBlockHeadersFromOldest:input_type -> blockchain_api.GetBlockHeadersFromOldestRequest
-	52, // 26: blockchain_api.BlockchainAPI.GetNextWorkRequired:input_type -> blockchain_api.GetNextWorkRequiredRequest
-	4,  // 27: blockchain_api.BlockchainAPI.GetBlockExists:input_type -> blockchain_api.GetBlockRequest
-	16, // 28: blockchain_api.BlockchainAPI.GetBlockHeaders:input_type -> blockchain_api.GetBlockHeadersRequest
-	17, // 29: blockchain_api.BlockchainAPI.GetBlockHeadersToCommonAncestor:input_type -> blockchain_api.GetBlockHeadersToCommonAncestorRequest
-	18, // 30: blockchain_api.BlockchainAPI.GetBlockHeadersFromCommonAncestor:input_type -> blockchain_api.GetBlockHeadersFromCommonAncestorRequest
-	20, // 31: blockchain_api.BlockchainAPI.GetBlockHeadersFromTill:input_type -> blockchain_api.GetBlockHeadersFromTillRequest
-	21, // 32: blockchain_api.BlockchainAPI.GetBlockHeadersFromHeight:input_type -> blockchain_api.GetBlockHeadersFromHeightRequest
-	23, // 33: blockchain_api.BlockchainAPI.GetBlockHeadersByHeight:input_type -> blockchain_api.GetBlockHeadersByHeightRequest
-	16, // 34: blockchain_api.BlockchainAPI.GetBlockHeaderIDs:input_type -> blockchain_api.GetBlockHeadersRequest
-	75, // 35: blockchain_api.BlockchainAPI.GetBestBlockHeader:input_type -> google.protobuf.Empty
-	28, // 36: blockchain_api.BlockchainAPI.CheckBlockIsInCurrentChain:input_type -> blockchain_api.CheckBlockIsCurrentChainRequest
-	75, // 37: blockchain_api.BlockchainAPI.GetChainTips:input_type -> google.protobuf.Empty
-	27, // 38: blockchain_api.BlockchainAPI.GetBlockHeader:input_type -> blockchain_api.GetBlockHeaderRequest
-	29, // 39: blockchain_api.BlockchainAPI.InvalidateBlock:input_type -> blockchain_api.InvalidateBlockRequest
-	31, // 40: blockchain_api.BlockchainAPI.RevalidateBlock:input_type -> blockchain_api.RevalidateBlockRequest
-	34, // 41: blockchain_api.BlockchainAPI.Subscribe:input_type -> blockchain_api.SubscribeRequest
-	35, // 42: blockchain_api.BlockchainAPI.SendNotification:input_type -> blockchain_api.Notification
-	37, // 43: blockchain_api.BlockchainAPI.GetState:input_type -> blockchain_api.GetStateRequest
-	39, // 44: blockchain_api.BlockchainAPI.SetState:input_type -> blockchain_api.SetStateRequest
-	40, // 45: blockchain_api.BlockchainAPI.GetBlockIsMined:input_type -> blockchain_api.GetBlockIsMinedRequest
-	54, // 46: blockchain_api.BlockchainAPI.SetBlockMinedSet:input_type -> blockchain_api.SetBlockMinedSetRequest
-	75, // 47: blockchain_api.BlockchainAPI.GetBlocksMinedNotSet:input_type -> google.protobuf.Empty
-	56, // 48: blockchain_api.BlockchainAPI.SetBlockSubtreesSet:input_type -> blockchain_api.SetBlockSubtreesSetRequest
-	75, // 49: blockchain_api.BlockchainAPI.GetBlocksSubtreesNotSet:input_type -> google.protobuf.Empty
-	58, // 50: blockchain_api.BlockchainAPI.SetBlockProcessedAt:input_type -> blockchain_api.SetBlockProcessedAtRequest
-	61, // 51: blockchain_api.BlockchainAPI.SendFSMEvent:input_type -> blockchain_api.SendFSMEventRequest
-	75, // 52: blockchain_api.BlockchainAPI.GetFSMCurrentState:input_type -> google.protobuf.Empty
-	60, // 53: blockchain_api.BlockchainAPI.WaitFSMToTransitionToGivenState:input_type -> blockchain_api.WaitFSMToTransitionRequest
-	75, // 54: blockchain_api.BlockchainAPI.WaitUntilFSMTransitionFromIdleState:input_type -> google.protobuf.Empty
-	75, // 55: blockchain_api.BlockchainAPI.Run:input_type -> google.protobuf.Empty
-	75, // 56: blockchain_api.BlockchainAPI.CatchUpBlocks:input_type -> google.protobuf.Empty
-	75, // 57: blockchain_api.BlockchainAPI.LegacySync:input_type -> google.protobuf.Empty
-	75, // 58: blockchain_api.BlockchainAPI.Idle:input_type -> google.protobuf.Empty
-	68, // 59: blockchain_api.BlockchainAPI.ReportPeerFailure:input_type -> blockchain_api.ReportPeerFailureRequest
-	62, // 60: blockchain_api.BlockchainAPI.GetBlockLocator:input_type -> blockchain_api.GetBlockLocatorRequest
-	64, // 61: blockchain_api.BlockchainAPI.LocateBlockHeaders:input_type -> blockchain_api.LocateBlockHeadersRequest
-	75, // 62: blockchain_api.BlockchainAPI.GetBestHeightAndTime:input_type -> google.protobuf.Empty
-	2,  // 63: blockchain_api.BlockchainAPI.HealthGRPC:output_type -> blockchain_api.HealthResponse
-	75, // 64: blockchain_api.BlockchainAPI.AddBlock:output_type -> google.protobuf.Empty
-	11, // 65: blockchain_api.BlockchainAPI.GetBlock:output_type -> blockchain_api.GetBlockResponse
-	6,  // 66: blockchain_api.BlockchainAPI.GetBlocks:output_type -> blockchain_api.GetBlocksResponse
-	11, // 67: blockchain_api.BlockchainAPI.GetBlockByHeight:output_type -> blockchain_api.GetBlockResponse
-	11, // 68: blockchain_api.BlockchainAPI.GetBlockByID:output_type -> blockchain_api.GetBlockResponse
-	9,  // 69: blockchain_api.BlockchainAPI.GetNextBlockID:output_type -> blockchain_api.GetNextBlockIDResponse
-	76, // 70: blockchain_api.BlockchainAPI.GetBlockStats:output_type -> model.BlockStats
-	77, // 71: blockchain_api.BlockchainAPI.GetBlockGraphData:output_type -> model.BlockDataPoints
-	43, // 72: blockchain_api.BlockchainAPI.GetLastNBlocks:output_type -> blockchain_api.GetLastNBlocksResponse
-	45, // 73: blockchain_api.BlockchainAPI.GetLastNInvalidBlocks:output_type -> blockchain_api.GetLastNInvalidBlocksResponse
-	47, // 74: blockchain_api.BlockchainAPI.GetSuitableBlock:output_type -> blockchain_api.GetSuitableBlockResponse
-	51, // 75: blockchain_api.BlockchainAPI.GetHashOfAncestorBlock:output_type -> blockchain_api.GetHashOfAncestorBlockResponse
-	32, // 76: blockchain_api.BlockchainAPI.GetLatestBlockHeaderFromBlockLocator:output_type -> blockchain_api.GetBlockHeaderResponse
-	19, // 77: blockchain_api.BlockchainAPI.GetBlockHeadersFromOldest:output_type -> blockchain_api.GetBlockHeadersResponse
-	53, // 78: blockchain_api.BlockchainAPI.GetNextWorkRequired:output_type -> blockchain_api.GetNextWorkRequiredResponse
-	14, // 79: blockchain_api.BlockchainAPI.GetBlockExists:output_type -> blockchain_api.GetBlockExistsResponse
-	19, // 80: blockchain_api.BlockchainAPI.GetBlockHeaders:output_type -> blockchain_api.GetBlockHeadersResponse
-	19, // 81: blockchain_api.BlockchainAPI.GetBlockHeadersToCommonAncestor:output_type -> blockchain_api.GetBlockHeadersResponse
-	19, // 82: blockchain_api.BlockchainAPI.GetBlockHeadersFromCommonAncestor:output_type -> blockchain_api.GetBlockHeadersResponse
-	19, // 83: blockchain_api.BlockchainAPI.GetBlockHeadersFromTill:output_type -> blockchain_api.GetBlockHeadersResponse
-	22, // 84: blockchain_api.BlockchainAPI.GetBlockHeadersFromHeight:output_type -> blockchain_api.GetBlockHeadersFromHeightResponse
-	24, // 85: blockchain_api.BlockchainAPI.GetBlockHeadersByHeight:output_type -> blockchain_api.GetBlockHeadersByHeightResponse
-	25, // 86: blockchain_api.BlockchainAPI.GetBlockHeaderIDs:output_type -> blockchain_api.GetBlockHeaderIDsResponse
-	32, // 87: blockchain_api.BlockchainAPI.GetBestBlockHeader:output_type -> blockchain_api.GetBlockHeaderResponse
-	33, // 88: blockchain_api.BlockchainAPI.CheckBlockIsInCurrentChain:output_type -> blockchain_api.CheckBlockIsCurrentChainResponse
-	67, // 89: blockchain_api.BlockchainAPI.GetChainTips:output_type -> blockchain_api.GetChainTipsResponse
-	32, // 90: blockchain_api.BlockchainAPI.GetBlockHeader:output_type -> blockchain_api.GetBlockHeaderResponse
-	30, // 91: blockchain_api.BlockchainAPI.InvalidateBlock:output_type -> blockchain_api.InvalidateBlockResponse
-	75, // 92: blockchain_api.BlockchainAPI.RevalidateBlock:output_type -> google.protobuf.Empty
-	35, // 93: blockchain_api.BlockchainAPI.Subscribe:output_type -> blockchain_api.Notification
-	75, // 94: blockchain_api.BlockchainAPI.SendNotification:output_type -> google.protobuf.Empty
-	38, // 95: blockchain_api.BlockchainAPI.GetState:output_type -> blockchain_api.StateResponse
-	75, // 96: blockchain_api.BlockchainAPI.SetState:output_type -> google.protobuf.Empty
-	41, // 97: blockchain_api.BlockchainAPI.GetBlockIsMined:output_type -> blockchain_api.GetBlockIsMinedResponse
-	75, // 98: blockchain_api.BlockchainAPI.SetBlockMinedSet:output_type -> google.protobuf.Empty
-	55, // 99: blockchain_api.BlockchainAPI.GetBlocksMinedNotSet:output_type -> blockchain_api.GetBlocksMinedNotSetResponse
-	75, // 100: blockchain_api.BlockchainAPI.SetBlockSubtreesSet:output_type -> google.protobuf.Empty
-	57, // 101: blockchain_api.BlockchainAPI.GetBlocksSubtreesNotSet:output_type -> blockchain_api.GetBlocksSubtreesNotSetResponse
-	75, // 102: blockchain_api.BlockchainAPI.SetBlockProcessedAt:output_type -> google.protobuf.Empty
-	59, // 103: blockchain_api.BlockchainAPI.SendFSMEvent:output_type -> blockchain_api.GetFSMStateResponse
-	59, // 104: blockchain_api.BlockchainAPI.GetFSMCurrentState:output_type -> blockchain_api.GetFSMStateResponse
-	75, // 105: blockchain_api.BlockchainAPI.WaitFSMToTransitionToGivenState:output_type -> google.protobuf.Empty
-	75, // 106: blockchain_api.BlockchainAPI.WaitUntilFSMTransitionFromIdleState:output_type -> google.protobuf.Empty
-	75, // 107: blockchain_api.BlockchainAPI.Run:output_type -> google.protobuf.Empty
-	75, // 108: blockchain_api.BlockchainAPI.CatchUpBlocks:output_type -> google.protobuf.Empty
-	75, // 109: blockchain_api.BlockchainAPI.LegacySync:output_type -> google.protobuf.Empty
-	75, // 110: blockchain_api.BlockchainAPI.Idle:output_type -> google.protobuf.Empty
-	75, // 111: blockchain_api.BlockchainAPI.ReportPeerFailure:output_type -> google.protobuf.Empty
-	63, // 112: blockchain_api.BlockchainAPI.GetBlockLocator:output_type -> blockchain_api.GetBlockLocatorResponse
-	65, // 113: blockchain_api.BlockchainAPI.LocateBlockHeaders:output_type -> blockchain_api.LocateBlockHeadersResponse
-	66, // 114: blockchain_api.BlockchainAPI.GetBestHeightAndTime:output_type -> blockchain_api.GetBestHeightAndTimeResponse
-	63, // [63:115] is the sub-list for method output_type
-	11, // [11:63] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	89, // 0: blockchain_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 1: blockchain_api.InvalidateBlockResponse.affectedBlocks:type_name -> blockchain_api.AffectedBlock
+	90, // 2: blockchain_api.SubscribeRequest.notification_types:type_name -> model.NotificationType
+	90, // 3: blockchain_api.Notification.type:type_name -> model.NotificationType
+	42, // 4: blockchain_api.Notification.metadata:type_name -> blockchain_api.NotificationMetadata
+	88, // 5: blockchain_api.NotificationMetadata.metadata:type_name -> blockchain_api.NotificationMetadata.MetadataEntry
+	91, // 6: blockchain_api.GetLastNBlocksRequest.selection:type_name -> model.BlockSelection
+	92, // 7: blockchain_api.GetLastNBlocksResponse.blocks:type_name -> model.BlockInfo
+	92, // 8: blockchain_api.GetLastNInvalidBlocksResponse.blocks:type_name -> model.BlockInfo
+	93, // 9: blockchain_api.GetSuitableBlockResponse.block:type_name -> model.SuitableBlock
+	1,  // 10: blockchain_api.GetFSMStateResponse.state:type_name -> blockchain_api.FSMStateType
+	2,  // 11: blockchain_api.IsCurrentResponse.reasons:type_name -> blockchain_api.NotCurrentReason
+	1,  // 12: blockchain_api.WaitFSMToTransitionRequest.state:type_name -> blockchain_api.FSMStateType
+	1,  // 13: blockchain_api.FSMStateChange.old_state:type_name -> blockchain_api.FSMStateType
+	1,  // 14: blockchain_api.FSMStateChange.new_state:type_name -> blockchain_api.FSMStateType
+	0,  // 15: blockchain_api.SendFSMEventRequest.event:type_name -> blockchain_api.FSMEventType
+	94, // 16: blockchain_api.GetChainTipsResponse.tips:type_name -> model.ChainTip
+	95, // 17: blockchain_api.BlockchainAPI.HealthGRPC:input_type -> google.protobuf.Empty
+	4,  // 18: blockchain_api.BlockchainAPI.AddBlock:input_type -> blockchain_api.AddBlockRequest
+	6,  // 19: blockchain_api.BlockchainAPI.GetBlock:input_type -> blockchain_api.GetBlockRequest
+	7,  // 20: blockchain_api.BlockchainAPI.GetBlocks:input_type -> blockchain_api.GetBlocksRequest
+	9,  // 21: blockchain_api.BlockchainAPI.GetBlockByHeight:input_type -> blockchain_api.GetBlockByHeightRequest
+	10, // 22: blockchain_api.BlockchainAPI.GetBlocksByHeightRange:input_type -> blockchain_api.GetBlocksByHeightRangeRequest
+	11, // 23: blockchain_api.BlockchainAPI.GetBlockByID:input_type -> blockchain_api.GetBlockByIDRequest
+	95, // 24: blockchain_api.BlockchainAPI.GetNextBlockID:input_type -> google.protobuf.Empty
+	95, // 25: blockchain_api.BlockchainAPI.GetBlockStats:input_type -> google.protobuf.Empty
+	16, // 26: blockchain_api.BlockchainAPI.GetBlockGraphData:input_type -> blockchain_api.GetBlockGraphDataRequest
+	50, // 27: blockchain_api.BlockchainAPI.GetLastNBlocks:input_type -> blockchain_api.GetLastNBlocksRequest
+	52, // 28: blockchain_api.BlockchainAPI.GetLastNInvalidBlocks:input_type -> blockchain_api.GetLastNInvalidBlocksRequest
+	54, // 29: blockchain_api.BlockchainAPI.GetSuitableBlock:input_type -> blockchain_api.GetSuitableBlockRequest
+	56, // 30: blockchain_api.BlockchainAPI.GetHashOfAncestorBlock:input_type -> blockchain_api.GetHashOfAncestorBlockRequest
+	57, // 31: blockchain_api.BlockchainAPI.GetLatestBlockHeaderFromBlockLocator:input_type -> blockchain_api.GetLatestBlockHeaderFromBlockLocatorRequest
+	58, // 32: blockchain_api.BlockchainAPI.GetBlockHeadersFromOldest:input_type -> blockchain_api.GetBlockHeadersFromOldestRequest
+	60, // 33: blockchain_api.BlockchainAPI.GetNextWorkRequired:input_type -> blockchain_api.GetNextWorkRequiredRequest
+	95, // 34: blockchain_api.BlockchainAPI.GetDifficultyInfo:input_type -> google.protobuf.Empty
+	95, // 35: blockchain_api.BlockchainAPI.GetMiningCandidateContext:input_type -> google.protobuf.Empty
+	6,  // 36: blockchain_api.BlockchainAPI.GetBlockExists:input_type -> blockchain_api.GetBlockRequest
+	19, // 37: blockchain_api.BlockchainAPI.GetBlockHeaders:input_type -> blockchain_api.GetBlockHeadersRequest
+	20, // 38: blockchain_api.BlockchainAPI.GetBlockHeadersToCommonAncestor:input_type -> blockchain_api.GetBlockHeadersToCommonAncestorRequest
+	21, // 39: blockchain_api.BlockchainAPI.GetBlockHeadersFromCommonAncestor:input_type -> blockchain_api.GetBlockHeadersFromCommonAncestorRequest
+	23, // 40: blockchain_api.BlockchainAPI.GetBlockHeadersFromTill:input_type -> blockchain_api.GetBlockHeadersFromTillRequest
+	24, // 41: blockchain_api.BlockchainAPI.GetBlockHeadersFromHeight:input_type -> blockchain_api.GetBlockHeadersFromHeightRequest
+	26, // 42: blockchain_api.BlockchainAPI.GetBlockHeadersByHeight:input_type -> blockchain_api.GetBlockHeadersByHeightRequest
+	19, // 43: blockchain_api.BlockchainAPI.GetBlockHeaderIDs:input_type -> blockchain_api.GetBlockHeadersRequest
+	95, // 44: blockchain_api.BlockchainAPI.GetBestBlockHeader:input_type -> google.protobuf.Empty
+	33, // 45: blockchain_api.BlockchainAPI.CheckBlockIsInCurrentChain:input_type -> blockchain_api.CheckBlockIsCurrentChainRequest
+	95, // 46: blockchain_api.BlockchainAPI.GetChainTips:input_type -> google.protobuf.Empty
+	30, // 47: blockchain_api.BlockchainAPI.GetBlockHeader:input_type -> blockchain_api.GetBlockHeaderRequest
+	9,  // 48: blockchain_api.BlockchainAPI.GetBlockHeaderByHeight:input_type -> blockchain_api.GetBlockByHeightRequest
+	31, // 49: blockchain_api.BlockchainAPI.GetBlockHeadersByHashes:input_type -> blockchain_api.GetBlockHeadersByHashesRequest
+	34, // 50: blockchain_api.BlockchainAPI.InvalidateBlock:input_type -> blockchain_api.InvalidateBlockRequest
+	37, // 51: blockchain_api.BlockchainAPI.RevalidateBlock:input_type -> blockchain_api.RevalidateBlockRequest
+	40, // 52: blockchain_api.BlockchainAPI.Subscribe:input_type -> blockchain_api.SubscribeRequest
+	41, // 53: blockchain_api.BlockchainAPI.SendNotification:input_type -> blockchain_api.Notification
+	43, // 54: blockchain_api.BlockchainAPI.GetState:input_type -> blockchain_api.GetStateRequest
+	45, // 55: blockchain_api.BlockchainAPI.SetState:input_type -> blockchain_api.SetStateRequest
+	46, // 56: blockchain_api.BlockchainAPI.CompareAndSetState:input_type -> blockchain_api.CompareAndSetStateRequest
+	48, // 57: blockchain_api.BlockchainAPI.GetBlockIsMined:input_type -> blockchain_api.GetBlockIsMinedRequest
+	64, // 58: blockchain_api.BlockchainAPI.SetBlockMinedSet:input_type -> blockchain_api.SetBlockMinedSetRequest
+	95, // 59: blockchain_api.BlockchainAPI.GetBlocksMinedNotSet:input_type -> google.protobuf.Empty
+	66, // 60: blockchain_api.BlockchainAPI.SetBlockSubtreesSet:input_type -> blockchain_api.SetBlockSubtreesSetRequest
+	95, // 61: blockchain_api.BlockchainAPI.GetBlocksSubtreesNotSet:input_type -> google.protobuf.Empty
+	68, // 62: blockchain_api.BlockchainAPI.SetBlockProcessedAt:input_type -> blockchain_api.SetBlockProcessedAtRequest
+	74, // 63: blockchain_api.BlockchainAPI.SendFSMEvent:input_type -> blockchain_api.SendFSMEventRequest
+	95, // 64: blockchain_api.BlockchainAPI.GetFSMCurrentState:input_type -> google.protobuf.Empty
+	95, // 65: blockchain_api.BlockchainAPI.IsCurrent:input_type -> google.protobuf.Empty
+	71, // 66: blockchain_api.BlockchainAPI.WaitFSMToTransitionToGivenState:input_type -> blockchain_api.WaitFSMToTransitionRequest
+	95, // 67: blockchain_api.BlockchainAPI.WaitUntilFSMTransitionFromIdleState:input_type -> google.protobuf.Empty
+	72, // 68: blockchain_api.BlockchainAPI.SubscribeFSMState:input_type -> blockchain_api.SubscribeFSMStateRequest
+	95, // 69: blockchain_api.BlockchainAPI.Run:input_type -> google.protobuf.Empty
+	95, // 70: blockchain_api.BlockchainAPI.CatchUpBlocks:input_type -> google.protobuf.Empty
+	95, // 71: blockchain_api.BlockchainAPI.LegacySync:input_type -> google.protobuf.Empty
+	95, // 72: blockchain_api.BlockchainAPI.Idle:input_type -> google.protobuf.Empty
+	87, // 73: blockchain_api.BlockchainAPI.ReportPeerFailure:input_type -> blockchain_api.ReportPeerFailureRequest
+	75, // 74: blockchain_api.BlockchainAPI.GetBlockLocator:input_type -> blockchain_api.GetBlockLocatorRequest
+	76, // 75: blockchain_api.BlockchainAPI.GetBlockLocatorByHeight:input_type -> blockchain_api.GetBlockLocatorByHeightRequest
+	78, // 76: blockchain_api.BlockchainAPI.LocateBlockHeaders:input_type -> blockchain_api.LocateBlockHeadersRequest
+	95, // 77: blockchain_api.BlockchainAPI.GetBestHeightAndTime:input_type -> google.protobuf.Empty
+	81, // 78: blockchain_api.BlockchainAPI.GetMedianTimeForHeight:input_type -> blockchain_api.GetMedianTimeForHeightRequest
+	83, // 79: blockchain_api.BlockchainAPI.GetBlockSubsidy:input_type -> blockchain_api.GetBlockSubsidyRequest
+	85, // 80: blockchain_api.BlockchainAPI.WaitForBlockHeight:input_type -> blockchain_api.WaitForBlockHeightRequest
+	3,  // 81: blockchain_api.BlockchainAPI.HealthGRPC:output_type -> blockchain_api.HealthResponse
+	5,  // 82: blockchain_api.BlockchainAPI.AddBlock:output_type -> blockchain_api.AddBlockResponse
+	14, // 83: blockchain_api.BlockchainAPI.GetBlock:output_type -> blockchain_api.GetBlockResponse
+	8,  // 84: blockchain_api.BlockchainAPI.GetBlocks:output_type -> blockchain_api.GetBlocksResponse
+	14, // 85: blockchain_api.BlockchainAPI.GetBlockByHeight:output_type -> blockchain_api.GetBlockResponse
+	8,  // 86: blockchain_api.BlockchainAPI.GetBlocksByHeightRange:output_type -> blockchain_api.GetBlocksResponse
+	14, // 87: blockchain_api.BlockchainAPI.GetBlockByID:output_type -> blockchain_api.GetBlockResponse
+	12, // 88: blockchain_api.BlockchainAPI.GetNextBlockID:output_type -> blockchain_api.GetNextBlockIDResponse
+	96, // 89: blockchain_api.BlockchainAPI.GetBlockStats:output_type -> model.BlockStats
+	97, // 90: blockchain_api.BlockchainAPI.GetBlockGraphData:output_type -> model.BlockDataPoints
+	51, // 91: blockchain_api.BlockchainAPI.GetLastNBlocks:output_type -> blockchain_api.GetLastNBlocksResponse
+	53, // 92: blockchain_api.BlockchainAPI.GetLastNInvalidBlocks:output_type -> blockchain_api.GetLastNInvalidBlocksResponse
+	55, // 93: blockchain_api.BlockchainAPI.GetSuitableBlock:output_type -> blockchain_api.GetSuitableBlockResponse
+	59, // 94: blockchain_api.BlockchainAPI.GetHashOfAncestorBlock:output_type -> blockchain_api.GetHashOfAncestorBlockResponse
+	38, // 95: blockchain_api.BlockchainAPI.GetLatestBlockHeaderFromBlockLocator:output_type -> blockchain_api.GetBlockHeaderResponse
+	22, // 96: blockchain_api.BlockchainAPI.GetBlockHeadersFromOldest:output_type -> blockchain_api.GetBlockHeadersResponse
+	61, // 97: blockchain_api.BlockchainAPI.GetNextWorkRequired:output_type -> blockchain_api.GetNextWorkRequiredResponse
+	62, // 98: blockchain_api.BlockchainAPI.GetDifficultyInfo:output_type -> blockchain_api.GetDifficultyInfoResponse
+	63, // 99: blockchain_api.BlockchainAPI.GetMiningCandidateContext:output_type -> blockchain_api.GetMiningCandidateContextResponse
+	17, // 100: blockchain_api.BlockchainAPI.GetBlockExists:output_type -> blockchain_api.GetBlockExistsResponse
+	22, // 101: blockchain_api.BlockchainAPI.GetBlockHeaders:output_type -> blockchain_api.GetBlockHeadersResponse
+	22, // 102: blockchain_api.BlockchainAPI.GetBlockHeadersToCommonAncestor:output_type -> blockchain_api.GetBlockHeadersResponse
+	22, // 103: blockchain_api.BlockchainAPI.GetBlockHeadersFromCommonAncestor:output_type -> blockchain_api.GetBlockHeadersResponse
+	22, // 104: blockchain_api.BlockchainAPI.GetBlockHeadersFromTill:output_type -> blockchain_api.GetBlockHeadersResponse
+	25, // 105: blockchain_api.BlockchainAPI.GetBlockHeadersFromHeight:output_type -> blockchain_api.GetBlockHeadersFromHeightResponse
+	27, // 106: blockchain_api.BlockchainAPI.GetBlockHeadersByHeight:output_type -> blockchain_api.GetBlockHeadersByHeightResponse
+	28, // 107: blockchain_api.BlockchainAPI.GetBlockHeaderIDs:output_type -> blockchain_api.GetBlockHeaderIDsResponse
+	38, // 108: blockchain_api.BlockchainAPI.GetBestBlockHeader:output_type -> blockchain_api.GetBlockHeaderResponse
+	39, // 109: blockchain_api.BlockchainAPI.CheckBlockIsInCurrentChain:output_type -> blockchain_api.CheckBlockIsCurrentChainResponse
+	86, // 110: blockchain_api.BlockchainAPI.GetChainTips:output_type -> blockchain_api.GetChainTipsResponse
+	38, // 111: blockchain_api.BlockchainAPI.GetBlockHeader:output_type -> blockchain_api.GetBlockHeaderResponse
+	38, // 112: blockchain_api.BlockchainAPI.GetBlockHeaderByHeight:output_type -> blockchain_api.GetBlockHeaderResponse
+	32, // 113: blockchain_api.BlockchainAPI.GetBlockHeadersByHashes:output_type -> blockchain_api.GetBlockHeadersByHashesResponse
+	35, // 114: blockchain_api.BlockchainAPI.InvalidateBlock:output_type -> blockchain_api.InvalidateBlockResponse
+	95, // 115: blockchain_api.BlockchainAPI.RevalidateBlock:output_type -> google.protobuf.Empty
+	41, // 116: blockchain_api.BlockchainAPI.Subscribe:output_type -> blockchain_api.Notification
+	95, // 117: blockchain_api.BlockchainAPI.SendNotification:output_type -> google.protobuf.Empty
+	44, // 118: blockchain_api.BlockchainAPI.GetState:output_type -> blockchain_api.StateResponse
+	95, // 119: blockchain_api.BlockchainAPI.SetState:output_type -> google.protobuf.Empty
+	47, // 120: blockchain_api.BlockchainAPI.CompareAndSetState:output_type -> blockchain_api.CompareAndSetStateResponse
+	49, // 121: blockchain_api.BlockchainAPI.GetBlockIsMined:output_type -> blockchain_api.GetBlockIsMinedResponse
+	95, // 122: blockchain_api.BlockchainAPI.SetBlockMinedSet:output_type -> google.protobuf.Empty
+	65, // 123: blockchain_api.BlockchainAPI.GetBlocksMinedNotSet:output_type -> blockchain_api.GetBlocksMinedNotSetResponse
+	95, // 124: blockchain_api.BlockchainAPI.SetBlockSubtreesSet:output_type -> google.protobuf.Empty
+	67, // 125: blockchain_api.BlockchainAPI.GetBlocksSubtreesNotSet:output_type -> blockchain_api.GetBlocksSubtreesNotSetResponse
+	95, // 126: blockchain_api.BlockchainAPI.SetBlockProcessedAt:output_type -> google.protobuf.Empty
+	69, // 127: blockchain_api.BlockchainAPI.SendFSMEvent:output_type -> blockchain_api.GetFSMStateResponse
+	69, // 128: blockchain_api.BlockchainAPI.GetFSMCurrentState:output_type -> blockchain_api.GetFSMStateResponse
+	70, // 129: blockchain_api.BlockchainAPI.IsCurrent:output_type -> blockchain_api.IsCurrentResponse
+	95, // 130: blockchain_api.BlockchainAPI.WaitFSMToTransitionToGivenState:output_type -> google.protobuf.Empty
+	95, // 131: blockchain_api.BlockchainAPI.WaitUntilFSMTransitionFromIdleState:output_type -> google.protobuf.Empty
+	73, // 132: blockchain_api.BlockchainAPI.SubscribeFSMState:output_type -> blockchain_api.FSMStateChange
+	95, // 133: blockchain_api.BlockchainAPI.Run:output_type -> google.protobuf.Empty
+	95, // 134: blockchain_api.BlockchainAPI.CatchUpBlocks:output_type -> google.protobuf.Empty
+	95, // 135: blockchain_api.BlockchainAPI.LegacySync:output_type -> google.protobuf.Empty
+	95, // 136: blockchain_api.BlockchainAPI.Idle:output_type -> google.protobuf.Empty
+	95, // 137: blockchain_api.BlockchainAPI.ReportPeerFailure:output_type -> google.protobuf.Empty
+	77, // 138: blockchain_api.BlockchainAPI.GetBlockLocator:output_type -> blockchain_api.GetBlockLocatorResponse
+	77, // 139: blockchain_api.BlockchainAPI.GetBlockLocatorByHeight:output_type -> blockchain_api.GetBlockLocatorResponse
+	79, // 140: blockchain_api.BlockchainAPI.LocateBlockHeaders:output_type -> blockchain_api.LocateBlockHeadersResponse
+	80, // 141: blockchain_api.BlockchainAPI.GetBestHeightAndTime:output_type -> blockchain_api.GetBestHeightAndTimeResponse
+	82, // 142: blockchain_api.BlockchainAPI.GetMedianTimeForHeight:output_type -> blockchain_api.GetMedianTimeForHeightResponse
+	84, // 143: blockchain_api.BlockchainAPI.GetBlockSubsidy:output_type -> blockchain_api.GetBlockSubsidyResponse
+	38, // 144: blockchain_api.BlockchainAPI.WaitForBlockHeight:output_type -> blockchain_api.GetBlockHeaderResponse
+	81, // [81:145] is the sub-list for method output_type
+	17, // [17:81] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_services_blockchain_blockchain_api_blockchain_api_proto_init() }
@@ -4169,8 +5385,8 @@ func file_services_blockchain_blockchain_api_blockchain_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc), len(file_services_blockchain_blockchain_api_blockchain_api_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   68,
+			NumEnums:      3,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,7 @@ const (
 	BlockchainAPI_GetBlock_FullMethodName                             = "/blockchain_api.BlockchainAPI/GetBlock"
 	BlockchainAPI_GetBlocks_FullMethodName                            = "/blockchain_api.BlockchainAPI/GetBlocks"
 	BlockchainAPI_GetBlockByHeight_FullMethodName                     = "/blockchain_api.BlockchainAPI/GetBlockByHeight"
+	BlockchainAPI_GetBlocksByHeightRange_FullMethodName               = "/blockchain_api.BlockchainAPI/GetBlocksByHeightRange"
 	BlockchainAPI_GetBlockByID_FullMethodName                         = "/blockchain_api.BlockchainAPI/GetBlockByID"
 	BlockchainAPI_GetNextBlockID_FullMethodName                       = "/blockchain_api.BlockchainAPI/GetNextBlockID"
 	BlockchainAPI_GetBlockStats_FullMethodName                        = "/blockchain_api.BlockchainAPI/GetBlockStats"
@@ -39,6 +40,8 @@ const (
 	BlockchainAPI_GetLatestBlockHeaderFromBlockLocator_FullMethodName = "/blockchain_api.BlockchainAPI/GetLatestBlockHeaderFromBlockLocator"
 	BlockchainAPI_GetBlockHeadersFromOldest_FullMethodName            = "/blockchain_api.BlockchainAPI/GetBlockHeadersFromOldest"
 	BlockchainAPI_GetNextWorkRequired_FullMethodName                  = "/blockchain_api.BlockchainAPI/GetNextWorkRequired"
+	BlockchainAPI_GetDifficultyInfo_FullMethodName                    = "/blockchain_api.BlockchainAPI/GetDifficultyInfo"
+	BlockchainAPI_GetMiningCandidateContext_FullMethodName            = "/blockchain_api.BlockchainAPI/GetMiningCandidateContext"
 	BlockchainAPI_GetBlockExists_FullMethodName                       = "/blockchain_api.BlockchainAPI/GetBlockExists"
 	BlockchainAPI_GetBlockHeaders_FullMethodName                      = "/blockchain_api.BlockchainAPI/GetBlockHeaders"
 	BlockchainAPI_GetBlockHeadersToCommonAncestor_FullMethodName      = "/blockchain_api.BlockchainAPI/GetBlockHeadersToCommonAncestor"
@@ -51,12 +54,15 @@ const (
 	BlockchainAPI_CheckBlockIsInCurrentChain_FullMethodName           = "/blockchain_api.BlockchainAPI/CheckBlockIsInCurrentChain"
 	BlockchainAPI_GetChainTips_FullMethodName                         = "/blockchain_api.BlockchainAPI/GetChainTips"
 	BlockchainAPI_GetBlockHeader_FullMethodName                       = "/blockchain_api.BlockchainAPI/GetBlockHeader"
+	BlockchainAPI_GetBlockHeaderByHeight_FullMethodName               = "/blockchain_api.BlockchainAPI/GetBlockHeaderByHeight"
+	BlockchainAPI_GetBlockHeadersByHashes_FullMethodName              = "/blockchain_api.BlockchainAPI/GetBlockHeadersByHashes"
 	BlockchainAPI_InvalidateBlock_FullMethodName                      = "/blockchain_api.BlockchainAPI/InvalidateBlock"
 	BlockchainAPI_RevalidateBlock_FullMethodName                      = "/blockchain_api.BlockchainAPI/RevalidateBlock"
 	BlockchainAPI_Subscribe_FullMethodName                            = "/blockchain_api.BlockchainAPI/Subscribe"
 	BlockchainAPI_SendNotification_FullMethodName                     = "/blockchain_api.BlockchainAPI/SendNotification"
 	BlockchainAPI_GetState_FullMethodName                             = "/blockchain_api.BlockchainAPI/GetState"
 	BlockchainAPI_SetState_FullMethodName                             = "/blockchain_api.BlockchainAPI/SetState"
+	BlockchainAPI_CompareAndSetState_FullMethodName                   = "/blockchain_api.BlockchainAPI/CompareAndSetState"
 	BlockchainAPI_GetBlockIsMined_FullMethodName                      = "/blockchain_api.BlockchainAPI/GetBlockIsMined"
 	BlockchainAPI_SetBlockMinedSet_FullMethodName                     = "/blockchain_api.BlockchainAPI/SetBlockMinedSet"
 	BlockchainAPI_GetBlocksMinedNotSet_FullMethodName                 = "/blockchain_api.BlockchainAPI/GetBlocksMinedNotSet"
@@ -65,16 +71,22 @@ const (
 	BlockchainAPI_SetBlockProcessedAt_FullMethodName                  = "/blockchain_api.BlockchainAPI/SetBlockProcessedAt"
 	BlockchainAPI_SendFSMEvent_FullMethodName                         = "/blockchain_api.BlockchainAPI/SendFSMEvent"
 	BlockchainAPI_GetFSMCurrentState_FullMethodName                   = "/blockchain_api.BlockchainAPI/GetFSMCurrentState"
+	BlockchainAPI_IsCurrent_FullMethodName                            = "/blockchain_api.BlockchainAPI/IsCurrent"
 	BlockchainAPI_WaitFSMToTransitionToGivenState_FullMethodName      = "/blockchain_api.BlockchainAPI/WaitFSMToTransitionToGivenState"
 	BlockchainAPI_WaitUntilFSMTransitionFromIdleState_FullMethodName  = "/blockchain_api.BlockchainAPI/WaitUntilFSMTransitionFromIdleState"
+	BlockchainAPI_SubscribeFSMState_FullMethodName                    = "/blockchain_api.BlockchainAPI/SubscribeFSMState"
 	BlockchainAPI_Run_FullMethodName                                  = "/blockchain_api.BlockchainAPI/Run"
 	BlockchainAPI_CatchUpBlocks_FullMethodName                        = "/blockchain_api.BlockchainAPI/CatchUpBlocks"
 	BlockchainAPI_LegacySync_FullMethodName                           = "/blockchain_api.BlockchainAPI/LegacySync"
 	BlockchainAPI_Idle_FullMethodName                                 = "/blockchain_api.BlockchainAPI/Idle"
 	BlockchainAPI_ReportPeerFailure_FullMethodName                    = "/blockchain_api.BlockchainAPI/ReportPeerFailure"
 	BlockchainAPI_GetBlockLocator_FullMethodName                      = "/blockchain_api.BlockchainAPI/GetBlockLocator"
+	BlockchainAPI_GetBlockLocatorByHeight_FullMethodName              = "/blockchain_api.BlockchainAPI/GetBlockLocatorByHeight"
 	BlockchainAPI_LocateBlockHeaders_FullMethodName                   = "/blockchain_api.BlockchainAPI/LocateBlockHeaders"
 	BlockchainAPI_GetBestHeightAndTime_FullMethodName                 = "/blockchain_api.BlockchainAPI/GetBestHeightAndTime"
+	BlockchainAPI_GetMedianTimeForHeight_FullMethodName               = "/blockchain_api.BlockchainAPI/GetMedianTimeForHeight"
+	BlockchainAPI_GetBlockSubsidy_FullMethodName                      = "/blockchain_api.BlockchainAPI/GetBlockSubsidy"
+	BlockchainAPI_WaitForBlockHeight_FullMethodName                   = "/blockchain_api.BlockchainAPI/WaitForBlockHeight"
 )
 
 // BlockchainAPIClient is the client API for BlockchainAPI service.
@@ -87,13 +99,16 @@ type BlockchainAPIClient interface {
 	HealthGRPC(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	// AddBlock adds a new block to the blockchain.
 	// Called by BlockValidator to add validated blocks.
-	AddBlock(ctx context.Context, in *AddBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Adding a block that is already stored returns its height without storing or announcing it again.
+	AddBlock(ctx context.Context, in *AddBlockRequest, opts ...grpc.CallOption) (*AddBlockResponse, error)
 	// GetBlock retrieves a block by its hash.
 	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
 	// GetBlocks retrieves multiple blocks starting from a specific hash.
 	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error)
 	// GetBlockByHeight retrieves a block at a specific height.
 	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	// GetBlocksByHeightRange retrieves the blocks in an inclusive height range.
+	GetBlocksByHeightRange(ctx context.Context, in *GetBlocksByHeightRangeRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error)
 	// GetBlockByID retrieves a block by its id.
 	GetBlockByID(ctx context.Context, in *GetBlockByIDRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
 	// GetNextBlockID retrieves the next available block ID.
@@ -116,6 +131,10 @@ type BlockchainAPIClient interface {
 	GetBlockHeadersFromOldest(ctx context.Context, in *GetBlockHeadersFromOldestRequest, opts ...grpc.CallOption) (*GetBlockHeadersResponse, error)
 	// GetNextWorkRequired calculates the required proof of work for the next block.
 	GetNextWorkRequired(ctx context.Context, in *GetNextWorkRequiredRequest, opts ...grpc.CallOption) (*GetNextWorkRequiredResponse, error)
+	// GetDifficultyInfo retrieves the difficulty state of the best block.
+	GetDifficultyInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDifficultyInfoResponse, error)
+	// GetMiningCandidateContext retrieves the chain state a block template is built on.
+	GetMiningCandidateContext(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMiningCandidateContextResponse, error)
 	// GetBlockExists checks if a block exists in the blockchain.
 	GetBlockExists(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockExistsResponse, error)
 	// GetBlockHeaders retrieves headers for multiple blocks.
@@ -140,6 +159,10 @@ type BlockchainAPIClient interface {
 	GetChainTips(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetChainTipsResponse, error)
 	// GetBlockHeader retrieves the header of a specific block.
 	GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
+	// GetBlockHeaderByHeight retrieves the header of the block at a specific height in the current chain.
+	GetBlockHeaderByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
+	// GetBlockHeadersByHashes retrieves the headers of a list of blocks.
+	GetBlockHeadersByHashes(ctx context.Context, in *GetBlockHeadersByHashesRequest, opts ...grpc.CallOption) (*GetBlockHeadersByHashesResponse, error)
 	// InvalidateBlock marks a block as invalid in the blockchain.
 	InvalidateBlock(ctx context.Context, in *InvalidateBlockRequest, opts ...grpc.CallOption) (*InvalidateBlockResponse, error)
 	// RevalidateBlock restores a previously invalidated block.
@@ -152,6 +175,8 @@ type BlockchainAPIClient interface {
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*StateResponse, error)
 	// SetState stores state data with a key.
 	SetState(ctx context.Context, in *SetStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CompareAndSetState atomically replaces state data, only if the current data matches the expected data.
+	CompareAndSetState(ctx context.Context, in *CompareAndSetStateRequest, opts ...grpc.CallOption) (*CompareAndSetStateResponse, error)
 	// GetBlockIsMined checks if a block is marked as mined.
 	GetBlockIsMined(ctx context.Context, in *GetBlockIsMinedRequest, opts ...grpc.CallOption) (*GetBlockIsMinedResponse, error)
 	// SetBlockMinedSet marks a block as mined.
@@ -168,10 +193,14 @@ type BlockchainAPIClient interface {
 	SendFSMEvent(ctx context.Context, in *SendFSMEventRequest, opts ...grpc.CallOption) (*GetFSMStateResponse, error)
 	// GetFSMCurrentState retrieves the current state of the FSM.
 	GetFSMCurrentState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFSMStateResponse, error)
+	// IsCurrent reports whether the node is synced with the network, and the reasons when it is not.
+	IsCurrent(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IsCurrentResponse, error)
 	// WaitFSMToTransitionToGivenState waits for FSM to reach a specific state.
 	WaitFSMToTransitionToGivenState(ctx context.Context, in *WaitFSMToTransitionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// WaitUntilFSMTransitionFromIdleState waits for FSM to transition from IDLE state.
 	WaitUntilFSMTransitionFromIdleState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SubscribeFSMState streams the FSM state transitions, starting with the current state.
+	SubscribeFSMState(ctx context.Context, in *SubscribeFSMStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FSMStateChange], error)
 	// Run transitions the blockchain service to running state.
 	Run(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CatchUpBlocks initiates block catch-up process.
@@ -184,10 +213,18 @@ type BlockchainAPIClient interface {
 	ReportPeerFailure(ctx context.Context, in *ReportPeerFailureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetBlockLocator retrieves a block locator for chain synchronization.
 	GetBlockLocator(ctx context.Context, in *GetBlockLocatorRequest, opts ...grpc.CallOption) (*GetBlockLocatorResponse, error)
+	// GetBlockLocatorByHeight retrieves a block locator starting at the block at the given height in the main chain.
+	GetBlockLocatorByHeight(ctx context.Context, in *GetBlockLocatorByHeightRequest, opts ...grpc.CallOption) (*GetBlockLocatorResponse, error)
 	// LocateBlockHeaders finds block headers using a locator.
 	LocateBlockHeaders(ctx context.Context, in *LocateBlockHeadersRequest, opts ...grpc.CallOption) (*LocateBlockHeadersResponse, error)
 	// GetBestHeightAndTime retrieves the current best height and median time.
 	GetBestHeightAndTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBestHeightAndTimeResponse, error)
+	// GetMedianTimeForHeight retrieves the median time past of the block at a height in the main chain.
+	GetMedianTimeForHeight(ctx context.Context, in *GetMedianTimeForHeightRequest, opts ...grpc.CallOption) (*GetMedianTimeForHeightResponse, error)
+	// GetBlockSubsidy retrieves the block subsidy at a height and the height of the next subsidy reduction.
+	GetBlockSubsidy(ctx context.Context, in *GetBlockSubsidyRequest, opts ...grpc.CallOption) (*GetBlockSubsidyResponse, error)
+	// WaitForBlockHeight waits until the best block reaches the given height and returns the block header at the height.
+	WaitForBlockHeight(ctx context.Context, in *WaitForBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
 }
 
 type blockchainAPIClient struct {
@@ -208,9 +245,9 @@ func (c *blockchainAPIClient) HealthGRPC(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
-func (c *blockchainAPIClient) AddBlock(ctx context.Context, in *AddBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *blockchainAPIClient) AddBlock(ctx context.Context, in *AddBlockRequest, opts ...grpc.CallOption) (*AddBlockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(AddBlockResponse)
 	err := c.cc.Invoke(ctx, BlockchainAPI_AddBlock_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -248,6 +285,16 @@ func (c *blockchainAPIClient) GetBlockByHeight(ctx context.Context, in *GetBlock
 	return out, nil
 }
 
+func (c *blockchainAPIClient) GetBlocksByHeightRange(ctx context.Context, in *GetBlocksByHeightRangeRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlocksResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetBlocksByHeightRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) GetBlockByID(ctx context.Context, in *GetBlockByIDRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBlockResponse)
@@ -358,6 +405,26 @@ func (c *blockchainAPIClient) GetNextWorkRequired(ctx context.Context, in *GetNe
 	return out, nil
 }
 
+func (c *blockchainAPIClient) GetDifficultyInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDifficultyInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDifficultyInfoResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetDifficultyInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainAPIClient) GetMiningCandidateContext(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMiningCandidateContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMiningCandidateContextResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetMiningCandidateContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) GetBlockExists(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockExistsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBlockExistsResponse)
@@ -478,6 +545,26 @@ func (c *blockchainAPIClient) GetBlockHeader(ctx context.Context, in *GetBlockHe
 	return out, nil
 }
 
+func (c *blockchainAPIClient) GetBlockHeaderByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetBlockHeaderByHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainAPIClient) GetBlockHeadersByHashes(ctx context.Context, in *GetBlockHeadersByHashesRequest, opts ...grpc.CallOption) (*GetBlockHeadersByHashesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockHeadersByHashesResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetBlockHeadersByHashes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) InvalidateBlock(ctx context.Context, in *InvalidateBlockRequest, opts ...grpc.CallOption) (*InvalidateBlockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InvalidateBlockResponse)
@@ -547,6 +634,16 @@ func (c *blockchainAPIClient) SetState(ctx context.Context, in *SetStateRequest,
 	return out, nil
 }
 
+func (c *blockchainAPIClient) CompareAndSetState(ctx context.Context, in *CompareAndSetStateRequest, opts ...grpc.CallOption) (*CompareAndSetStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareAndSetStateResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_CompareAndSetState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) GetBlockIsMined(ctx context.Context, in *GetBlockIsMinedRequest, opts ...grpc.CallOption) (*GetBlockIsMinedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBlockIsMinedResponse)
@@ -627,6 +724,16 @@ func (c *blockchainAPIClient) GetFSMCurrentState(ctx context.Context, in *emptyp
 	return out, nil
 }
 
+func (c *blockchainAPIClient) IsCurrent(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IsCurrentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsCurrentResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_IsCurrent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) WaitFSMToTransitionToGivenState(ctx context.Context, in *WaitFSMToTransitionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -647,6 +754,25 @@ func (c *blockchainAPIClient) WaitUntilFSMTransitionFromIdleState(ctx context.Co
 	return out, nil
 }
 
+func (c *blockchainAPIClient) SubscribeFSMState(ctx context.Context, in *SubscribeFSMStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FSMStateChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BlockchainAPI_ServiceDesc.Streams[1], BlockchainAPI_SubscribeFSMState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeFSMStateRequest, FSMStateChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlockchainAPI_SubscribeFSMStateClient = grpc.ServerStreamingClient[FSMStateChange]
+
 func (c *blockchainAPIClient) Run(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -707,6 +833,16 @@ func (c *blockchainAPIClient) GetBlockLocator(ctx context.Context, in *GetBlockL
 	return out, nil
 }
 
+func (c *blockchainAPIClient) GetBlockLocatorByHeight(ctx context.Context, in *GetBlockLocatorByHeightRequest, opts ...grpc.CallOption) (*GetBlockLocatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockLocatorResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetBlockLocatorByHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainAPIClient) LocateBlockHeaders(ctx context.Context, in *LocateBlockHeadersRequest, opts ...grpc.CallOption) (*LocateBlockHeadersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LocateBlockHeadersResponse)
@@ -727,6 +863,36 @@ func (c *blockchainAPIClient) GetBestHeightAndTime(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *blockchainAPIClient) GetMedianTimeForHeight(ctx context.Context, in *GetMedianTimeForHeightRequest, opts ...grpc.CallOption) (*GetMedianTimeForHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMedianTimeForHeightResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetMedianTimeForHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainAPIClient) GetBlockSubsidy(ctx context.Context, in *GetBlockSubsidyRequest, opts ...grpc.CallOption) (*GetBlockSubsidyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockSubsidyResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_GetBlockSubsidy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainAPIClient) WaitForBlockHeight(ctx context.Context, in *WaitForBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, BlockchainAPI_WaitForBlockHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockchainAPIServer is the server API for BlockchainAPI service.
 // All implementations must embed UnimplementedBlockchainAPIServer
 // for forward compatibility.
@@ -737,13 +903,16 @@ type BlockchainAPIServer interface {
 	HealthGRPC(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	// AddBlock adds a new block to the blockchain.
 	// Called by BlockValidator to add validated blocks.
-	AddBlock(context.Context, *AddBlockRequest) (*emptypb.Empty, error)
+	// Adding a block that is already stored returns its height without storing or announcing it again.
+	AddBlock(context.Context, *AddBlockRequest) (*AddBlockResponse, error)
 	// GetBlock retrieves a block by its hash.
 	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
 	// GetBlocks retrieves multiple blocks starting from a specific hash.
 	GetBlocks(context.Context, *GetBlocksRequest) (*GetBlocksResponse, error)
 	// GetBlockByHeight retrieves a block at a specific height.
 	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockResponse, error)
+	// GetBlocksByHeightRange retrieves the blocks in an inclusive height range.
+	GetBlocksByHeightRange(context.Context, *GetBlocksByHeightRangeRequest) (*GetBlocksResponse, error)
 	// GetBlockByID retrieves a block by its id.
 	GetBlockByID(context.Context, *GetBlockByIDRequest) (*GetBlockResponse, error)
 	// GetNextBlockID retrieves the next available block ID.
@@ -766,6 +935,10 @@ type BlockchainAPIServer interface {
 	GetBlockHeadersFromOldest(context.Context, *GetBlockHeadersFromOldestRequest) (*GetBlockHeadersResponse, error)
 	// GetNextWorkRequired calculates the required proof of work for the next block.
 	GetNextWorkRequired(context.Context, *GetNextWorkRequiredRequest) (*GetNextWorkRequiredResponse, error)
+	// GetDifficultyInfo retrieves the difficulty state of the best block.
+	GetDifficultyInfo(context.Context, *emptypb.Empty) (*GetDifficultyInfoResponse, error)
+	// GetMiningCandidateContext retrieves the chain state a block template is built on.
+	GetMiningCandidateContext(context.Context, *emptypb.Empty) (*GetMiningCandidateContextResponse, error)
 	// GetBlockExists checks if a block exists in the blockchain.
 	GetBlockExists(context.Context, *GetBlockRequest) (*GetBlockExistsResponse, error)
 	// GetBlockHeaders retrieves headers for multiple blocks.
@@ -790,6 +963,10 @@ type BlockchainAPIServer interface {
 	GetChainTips(context.Context, *emptypb.Empty) (*GetChainTipsResponse, error)
 	// GetBlockHeader retrieves the header of a specific block.
 	GetBlockHeader(context.Context, *GetBlockHeaderRequest) (*GetBlockHeaderResponse, error)
+	// GetBlockHeaderByHeight retrieves the header of the block at a specific height in the current chain.
+	GetBlockHeaderByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockHeaderResponse, error)
+	// GetBlockHeadersByHashes retrieves the headers of a list of blocks.
+	GetBlockHeadersByHashes(context.Context, *GetBlockHeadersByHashesRequest) (*GetBlockHeadersByHashesResponse, error)
 	// InvalidateBlock marks a block as invalid in the blockchain.
 	InvalidateBlock(context.Context, *InvalidateBlockRequest) (*InvalidateBlockResponse, error)
 	// RevalidateBlock restores a previously invalidated block.
@@ -802,6 +979,8 @@ type BlockchainAPIServer interface {
 	GetState(context.Context, *GetStateRequest) (*StateResponse, error)
 	// SetState stores state data with a key.
 	SetState(context.Context, *SetStateRequest) (*emptypb.Empty, error)
+	// CompareAndSetState atomically replaces state data, only if the current data matches the expected data.
+	CompareAndSetState(context.Context, *CompareAndSetStateRequest) (*CompareAndSetStateResponse, error)
 	// GetBlockIsMined checks if a block is marked as mined.
 	GetBlockIsMined(context.Context, *GetBlockIsMinedRequest) (*GetBlockIsMinedResponse, error)
 	// SetBlockMinedSet marks a block as mined.
@@ -818,10 +997,14 @@ type BlockchainAPIServer interface {
 	SendFSMEvent(context.Context, *SendFSMEventRequest) (*GetFSMStateResponse, error)
 	// GetFSMCurrentState retrieves the current state of the FSM.
 	GetFSMCurrentState(context.Context, *emptypb.Empty) (*GetFSMStateResponse, error)
+	// IsCurrent reports whether the node is synced with the network, and the reasons when it is not.
+	IsCurrent(context.Context, *emptypb.Empty) (*IsCurrentResponse, error)
 	// WaitFSMToTransitionToGivenState waits for FSM to reach a specific state.
 	WaitFSMToTransitionToGivenState(context.Context, *WaitFSMToTransitionRequest) (*emptypb.Empty, error)
 	// WaitUntilFSMTransitionFromIdleState waits for FSM to transition from IDLE state.
 	WaitUntilFSMTransitionFromIdleState(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// SubscribeFSMState streams the FSM state transitions, starting with the current state.
+	SubscribeFSMState(*SubscribeFSMStateRequest, grpc.ServerStreamingServer[FSMStateChange]) error
 	// Run transitions the blockchain service to running state.
 	Run(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// CatchUpBlocks initiates block catch-up process.
@@ -834,10 +1017,18 @@ type BlockchainAPIServer interface {
 	ReportPeerFailure(context.Context, *ReportPeerFailureRequest) (*emptypb.Empty, error)
 	// GetBlockLocator retrieves a block locator for chain synchronization.
 	GetBlockLocator(context.Context, *GetBlockLocatorRequest) (*GetBlockLocatorResponse, error)
+	// GetBlockLocatorByHeight retrieves a block locator starting at the block at the given height in the main chain.
+	GetBlockLocatorByHeight(context.Context, *GetBlockLocatorByHeightRequest) (*GetBlockLocatorResponse, error)
 	// LocateBlockHeaders finds block headers using a locator.
 	LocateBlockHeaders(context.Context, *LocateBlockHeadersRequest) (*LocateBlockHeadersResponse, error)
 	// GetBestHeightAndTime retrieves the current best height and median time.
 	GetBestHeightAndTime(context.Context, *emptypb.Empty) (*GetBestHeightAndTimeResponse, error)
+	// GetMedianTimeForHeight retrieves the median time past of the block at a height in the main chain.
+	GetMedianTimeForHeight(context.Context, *GetMedianTimeForHeightRequest) (*GetMedianTimeForHeightResponse, error)
+	// GetBlockSubsidy retrieves the block subsidy at a height and the height of the next subsidy reduction.
+	GetBlockSubsidy(context.Context, *GetBlockSubsidyRequest) (*GetBlockSubsidyResponse, error)
+	// WaitForBlockHeight waits until the best block reaches the given height and returns the block header at the height.
+	WaitForBlockHeight(context.Context, *WaitForBlockHeightRequest) (*GetBlockHeaderResponse, error)
 	mustEmbedUnimplementedBlockchainAPIServer()
 }
 
@@ -851,7 +1042,7 @@ type UnimplementedBlockchainAPIServer struct{}
 func (UnimplementedBlockchainAPIServer) HealthGRPC(context.Context, *emptypb.Empty) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthGRPC not implemented")
 }
-func (UnimplementedBlockchainAPIServer) AddBlock(context.Context, *AddBlockRequest) (*emptypb.Empty, error) {
+func (UnimplementedBlockchainAPIServer) AddBlock(context.Context, *AddBlockRequest) (*AddBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlock not implemented")
 }
 func (UnimplementedBlockchainAPIServer) GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error) {
@@ -863,6 +1054,9 @@ func (UnimplementedBlockchainAPIServer) GetBlocks(context.Context, *GetBlocksReq
 func (UnimplementedBlockchainAPIServer) GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
 }
+func (UnimplementedBlockchainAPIServer) GetBlocksByHeightRange(context.Context, *GetBlocksByHeightRangeRequest) (*GetBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocksByHeightRange not implemented")
+}
 func (UnimplementedBlockchainAPIServer) GetBlockByID(context.Context, *GetBlockByIDRequest) (*GetBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByID not implemented")
 }
@@ -896,6 +1090,12 @@ func (UnimplementedBlockchainAPIServer) GetBlockHeadersFromOldest(context.Contex
 func (UnimplementedBlockchainAPIServer) GetNextWorkRequired(context.Context, *GetNextWorkRequiredRequest) (*GetNextWorkRequiredResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNextWorkRequired not implemented")
 }
+func (UnimplementedBlockchainAPIServer) GetDifficultyInfo(context.Context, *emptypb.Empty) (*GetDifficultyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDifficultyInfo not implemented")
+}
+func (UnimplementedBlockchainAPIServer) GetMiningCandidateContext(context.Context, *emptypb.Empty) (*GetMiningCandidateContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMiningCandidateContext not implemented")
+}
 func (UnimplementedBlockchainAPIServer) GetBlockExists(context.Context, *GetBlockRequest) (*GetBlockExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockExists not implemented")
 }
@@ -932,6 +1132,12 @@ func (UnimplementedBlockchainAPIServer) GetChainTips(context.Context, *emptypb.E
 func (UnimplementedBlockchainAPIServer) GetBlockHeader(context.Context, *GetBlockHeaderRequest) (*GetBlockHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (UnimplementedBlockchainAPIServer) GetBlockHeaderByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHeight not implemented")
+}
+func (UnimplementedBlockchainAPIServer) GetBlockHeadersByHashes(context.Context, *GetBlockHeadersByHashesRequest) (*GetBlockHeadersByHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeadersByHashes not implemented")
+}
 func (UnimplementedBlockchainAPIServer) InvalidateBlock(context.Context, *InvalidateBlockRequest) (*InvalidateBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateBlock not implemented")
 }
@@ -950,6 +1156,9 @@ func (UnimplementedBlockchainAPIServer) GetState(context.Context, *GetStateReque
 func (UnimplementedBlockchainAPIServer) SetState(context.Context, *SetStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetState not implemented")
 }
+func (UnimplementedBlockchainAPIServer) CompareAndSetState(context.Context, *CompareAndSetStateRequest) (*CompareAndSetStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSetState not implemented")
+}
 func (UnimplementedBlockchainAPIServer) GetBlockIsMined(context.Context, *GetBlockIsMinedRequest) (*GetBlockIsMinedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockIsMined not implemented")
 }
@@ -974,12 +1183,18 @@ func (UnimplementedBlockchainAPIServer) SendFSMEvent(context.Context, *SendFSMEv
 func (UnimplementedBlockchainAPIServer) GetFSMCurrentState(context.Context, *emptypb.Empty) (*GetFSMStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFSMCurrentState not implemented")
 }
+func (UnimplementedBlockchainAPIServer) IsCurrent(context.Context, *emptypb.Empty) (*IsCurrentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCurrent not implemented")
+}
 func (UnimplementedBlockchainAPIServer) WaitFSMToTransitionToGivenState(context.Context, *WaitFSMToTransitionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitFSMToTransitionToGivenState not implemented")
 }
 func (UnimplementedBlockchainAPIServer) WaitUntilFSMTransitionFromIdleState(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitUntilFSMTransitionFromIdleState not implemented")
 }
+func (UnimplementedBlockchainAPIServer) SubscribeFSMState(*SubscribeFSMStateRequest, grpc.ServerStreamingServer[FSMStateChange]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFSMState not implemented")
+}
 func (UnimplementedBlockchainAPIServer) Run(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
 }
@@ -998,12 +1213,24 @@ func (UnimplementedBlockchainAPIServer) ReportPeerFailure(context.Context, *Repo
 func (UnimplementedBlockchainAPIServer) GetBlockLocator(context.Context, *GetBlockLocatorRequest) (*GetBlockLocatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockLocator not implemented")
 }
+func (UnimplementedBlockchainAPIServer) GetBlockLocatorByHeight(context.Context, *GetBlockLocatorByHeightRequest) (*GetBlockLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockLocatorByHeight not implemented")
+}
 func (UnimplementedBlockchainAPIServer) LocateBlockHeaders(context.Context, *LocateBlockHeadersRequest) (*LocateBlockHeadersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LocateBlockHeaders not implemented")
 }
 func (UnimplementedBlockchainAPIServer) GetBestHeightAndTime(context.Context, *emptypb.Empty) (*GetBestHeightAndTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBestHeightAndTime not implemented")
 }
+func (UnimplementedBlockchainAPIServer) GetMedianTimeForHeight(context.Context, *GetMedianTimeForHeightRequest) (*GetMedianTimeForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedianTimeForHeight not implemented")
+}
+func (UnimplementedBlockchainAPIServer) GetBlockSubsidy(context.Context, *GetBlockSubsidyRequest) (*GetBlockSubsidyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockSubsidy not implemented")
+}
+func (UnimplementedBlockchainAPIServer) WaitForBlockHeight(context.Context, *WaitForBlockHeightRequest) (*GetBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForBlockHeight not implemented")
+}
 func (UnimplementedBlockchainAPIServer) mustEmbedUnimplementedBlockchainAPIServer() {}
 func (UnimplementedBlockchainAPIServer) testEmbeddedByValue()                       {}
 
@@ -1115,6 +1342,24 @@ func _BlockchainAPI_GetBlockByHeight_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_GetBlocksByHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlocksByHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetBlocksByHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetBlocksByHeightRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetBlocksByHeightRange(ctx, req.(*GetBlocksByHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_GetBlockByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockByIDRequest)
 	if err := dec(in); err != nil {
@@ -1313,6 +1558,42 @@ func _BlockchainAPI_GetNextWorkRequired_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_GetDifficultyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetDifficultyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetDifficultyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetDifficultyInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockchainAPI_GetMiningCandidateContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetMiningCandidateContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetMiningCandidateContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetMiningCandidateContext(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_GetBlockExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockRequest)
 	if err := dec(in); err != nil {
@@ -1529,6 +1810,42 @@ func _BlockchainAPI_GetBlockHeader_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_GetBlockHeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetBlockHeaderByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetBlockHeaderByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetBlockHeaderByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockchainAPI_GetBlockHeadersByHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeadersByHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetBlockHeadersByHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetBlockHeadersByHashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetBlockHeadersByHashes(ctx, req.(*GetBlockHeadersByHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_InvalidateBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvalidateBlockRequest)
 	if err := dec(in); err != nil {
@@ -1630,6 +1947,24 @@ func _BlockchainAPI_SetState_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_CompareAndSetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).CompareAndSetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_CompareAndSetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).CompareAndSetState(ctx, req.(*CompareAndSetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_GetBlockIsMined_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockIsMinedRequest)
 	if err := dec(in); err != nil {
@@ -1774,6 +2109,24 @@ func _BlockchainAPI_GetFSMCurrentState_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_IsCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).IsCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_IsCurrent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).IsCurrent(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_WaitFSMToTransitionToGivenState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitFSMToTransitionRequest)
 	if err := dec(in); err != nil {
@@ -1810,6 +2163,17 @@ func _BlockchainAPI_WaitUntilFSMTransitionFromIdleState_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_SubscribeFSMState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFSMStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainAPIServer).SubscribeFSMState(m, &grpc.GenericServerStream[SubscribeFSMStateRequest, FSMStateChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlockchainAPI_SubscribeFSMStateServer = grpc.ServerStreamingServer[FSMStateChange]
+
 func _BlockchainAPI_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1918,6 +2282,24 @@ func _BlockchainAPI_GetBlockLocator_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_GetBlockLocatorByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockLocatorByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetBlockLocatorByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetBlockLocatorByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetBlockLocatorByHeight(ctx, req.(*GetBlockLocatorByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainAPI_LocateBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LocateBlockHeadersRequest)
 	if err := dec(in); err != nil {
@@ -1954,6 +2336,60 @@ func _BlockchainAPI_GetBestHeightAndTime_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainAPI_GetMedianTimeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedianTimeForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetMedianTimeForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetMedianTimeForHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetMedianTimeForHeight(ctx, req.(*GetMedianTimeForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockchainAPI_GetBlockSubsidy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockSubsidyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).GetBlockSubsidy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_GetBlockSubsidy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).GetBlockSubsidy(ctx, req.(*GetBlockSubsidyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockchainAPI_WaitForBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainAPIServer).WaitForBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockchainAPI_WaitForBlockHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainAPIServer).WaitForBlockHeight(ctx, req.(*WaitForBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlockchainAPI_ServiceDesc is the grpc.ServiceDesc for BlockchainAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1981,6 +2417,10 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByHeight",
 			Handler:    _BlockchainAPI_GetBlockByHeight_Handler,
 		},
+		{
+			MethodName: "GetBlocksByHeightRange",
+			Handler:    _BlockchainAPI_GetBlocksByHeightRange_Handler,
+		},
 		{
 			MethodName: "GetBlockByID",
 			Handler:    _BlockchainAPI_GetBlockByID_Handler,
@@ -2025,6 +2465,14 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNextWorkRequired",
 			Handler:    _BlockchainAPI_GetNextWorkRequired_Handler,
 		},
+		{
+			MethodName: "GetDifficultyInfo",
+			Handler:    _BlockchainAPI_GetDifficultyInfo_Handler,
+		},
+		{
+			MethodName: "GetMiningCandidateContext",
+			Handler:    _BlockchainAPI_GetMiningCandidateContext_Handler,
+		},
 		{
 			MethodName: "GetBlockExists",
 			Handler:    _BlockchainAPI_GetBlockExists_Handler,
@@ -2073,6 +2521,14 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeader",
 			Handler:    _BlockchainAPI_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetBlockHeaderByHeight",
+			Handler:    _BlockchainAPI_GetBlockHeaderByHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockHeadersByHashes",
+			Handler:    _BlockchainAPI_GetBlockHeadersByHashes_Handler,
+		},
 		{
 			MethodName: "InvalidateBlock",
 			Handler:    _BlockchainAPI_InvalidateBlock_Handler,
@@ -2093,6 +2549,10 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetState",
 			Handler:    _BlockchainAPI_SetState_Handler,
 		},
+		{
+			MethodName: "CompareAndSetState",
+			Handler:    _BlockchainAPI_CompareAndSetState_Handler,
+		},
 		{
 			MethodName: "GetBlockIsMined",
 			Handler:    _BlockchainAPI_GetBlockIsMined_Handler,
@@ -2125,6 +2585,10 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFSMCurrentState",
 			Handler:    _BlockchainAPI_GetFSMCurrentState_Handler,
 		},
+		{
+			MethodName: "IsCurrent",
+			Handler:    _BlockchainAPI_IsCurrent_Handler,
+		},
 		{
 			MethodName: "WaitFSMToTransitionToGivenState",
 			Handler:    _BlockchainAPI_WaitFSMToTransitionToGivenState_Handler,
@@ -2157,6 +2621,10 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockLocator",
 			Handler:    _BlockchainAPI_GetBlockLocator_Handler,
 		},
+		{
+			MethodName: "GetBlockLocatorByHeight",
+			Handler:    _BlockchainAPI_GetBlockLocatorByHeight_Handler,
+		},
 		{
 			MethodName: "LocateBlockHeaders",
 			Handler:    _BlockchainAPI_LocateBlockHeaders_Handler,
@@ -2165,6 +2633,18 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBestHeightAndTime",
 			Handler:    _BlockchainAPI_GetBestHeightAndTime_Handler,
 		},
+		{
+			MethodName: "GetMedianTimeForHeight",
+			Handler:    _BlockchainAPI_GetMedianTimeForHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockSubsidy",
+			Handler:    _BlockchainAPI_GetBlockSubsidy_Handler,
+		},
+		{
+			MethodName: "WaitForBlockHeight",
+			Handler:    _BlockchainAPI_WaitForBlockHeight_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2172,6 +2652,11 @@ var BlockchainAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BlockchainAPI_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeFSMState",
+			Handler:       _BlockchainAPI_SubscribeFSMState_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "services/blockchain/blockchain_api/blockchain_api.proto",
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/services/blockchain/blockchain_api"
 	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
 	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bitcoin-sv/teranode/util/test"
 	"github.com/bsv-blockchain/go-bt/v2"
@@ -993,9 +994,9 @@ func TestClientGetBlockGraphData(t *testing.T) {
 func TestClient_GetLastNBlocks(t *testing.T) {
 	ctx := context.Background()
 	const (
-		n           int64  = 5
-		fromHeight  uint32 = 1234
-		includeOrph        = true
+		n          int64  = 5
+		fromHeight uint32 = 1234
+		selection         = model.BlockSelection_OrphansOnly
 	)
 
 	t.Run("happy path (err == nil)", func(t *testing.T) {
@@ -1011,13 +1012,14 @@ func TestClient_GetLastNBlocks(t *testing.T) {
 		}
 		c := &Client{client: mc}
 
-		blocks, err := c.GetLastNBlocks(ctx, n, includeOrph, fromHeight)
+		blocks, err := c.GetLastNBlocks(ctx, n, selection, fromHeight)
 		require.NoError(t, err)
 		require.Equal(t, expected, blocks)
 
 		require.NotNil(t, mc.lastGetLastNBlocksReq)
 		require.Equal(t, n, mc.lastGetLastNBlocksReq.NumberOfBlocks)
-		require.Equal(t, includeOrph, mc.lastGetLastNBlocksReq.IncludeOrphans)
+		require.True(t, mc.lastGetLastNBlocksReq.IncludeOrphans)
+		require.Equal(t, selection, mc.lastGetLastNBlocksReq.Selection)
 		require.Equal(t, fromHeight, mc.lastGetLastNBlocksReq.FromHeight)
 	})
 
@@ -1030,7 +1032,7 @@ func TestClient_GetLastNBlocks(t *testing.T) {
 		}
 		c := &Client{client: mc}
 
-		blocks, err := c.GetLastNBlocks(ctx, n, false, 0)
+		blocks, err := c.GetLastNBlocks(ctx, n, model.BlockSelection_MainChain, 0)
 		require.Error(t, err)
 		require.Nil(t, blocks)
 	})
@@ -1044,7 +1046,7 @@ func TestClient_GetLastNBlocks(t *testing.T) {
 		}
 		c := &Client{client: mc}
 
-		blocks, err := c.GetLastNBlocks(ctx, n, false, 0)
+		blocks, err := c.GetLastNBlocks(ctx, n, model.BlockSelection_MainChain, 0)
 		require.NoError(t, err)
 		require.NotNil(t, blocks)
 		require.Len(t, blocks, 0)
@@ -1990,6 +1992,143 @@ func TestClientGetNextWorkRequired(t *testing.T) {
 	})
 }
 
+func TestClientGetDifficultyInfo(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHash := &chainhash.Hash{1, 2, 3, 4, 5}
+	bits := model.NBit{0xff, 0xff, 0x00, 0x1d}
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetDifficultyInfo: &blockchain_api.GetDifficultyInfoResponse{
+				BlockHash:                  blockHash.CloneBytes(),
+				Height:                     200,
+				Bits:                       bits.CloneBytes(),
+				Target:                     bits.CalculateTarget().String(),
+				ChainWork:                  []byte{0x01, 0x00},
+				DifficultyAdjustmentWindow: DifficultyAdjustmentWindow,
+				NextRetargetHeight:         201,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		info, err := c.GetDifficultyInfo(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, blockHash, info.BlockHash)
+		assert.Equal(t, uint32(200), info.Height)
+		assert.Equal(t, bits, info.Bits)
+		assert.Equal(t, 0, bits.CalculateTarget().Cmp(info.Target))
+		assert.Equal(t, int64(256), info.ChainWork.Int64())
+		assert.Equal(t, uint32(DifficultyAdjustmentWindow), info.AdjustmentWindow)
+		assert.Equal(t, uint32(201), info.NextRetargetHeight)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetDifficultyInfo: &blockchain_api.GetDifficultyInfoResponse{
+				BlockHash: blockHash.CloneBytes(),
+				Bits:      bits.CloneBytes(),
+				Target:    "not a number",
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		info, err := c.GetDifficultyInfo(ctx)
+		require.Error(t, err)
+		assert.Nil(t, info)
+		assert.Contains(t, err.Error(), "invalid target")
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewServiceError("service unavailable")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		info, err := c.GetDifficultyInfo(ctx)
+		require.Error(t, err)
+		assert.Nil(t, info)
+	})
+}
+
+func TestClientGetMiningCandidateContext(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	previousBlockHash := &chainhash.Hash{1, 2, 3, 4, 5}
+	bits := model.NBit{0xff, 0xff, 0x00, 0x1d}
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetMiningCandidateContext: &blockchain_api.GetMiningCandidateContextResponse{
+				PreviousBlockHash: previousBlockHash.CloneBytes(),
+				Height:            201,
+				Bits:              bits.CloneBytes(),
+				MinTime:           1_700_000_001,
+				CurTime:           1_700_000_600,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		candidateContext, err := c.GetMiningCandidateContext(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, previousBlockHash, candidateContext.PreviousBlockHash)
+		assert.Equal(t, uint32(201), candidateContext.Height)
+		assert.Equal(t, bits, candidateContext.Bits)
+		assert.Equal(t, uint32(1_700_000_001), candidateContext.MinTime)
+		assert.Equal(t, uint32(1_700_000_600), candidateContext.CurTime)
+	})
+
+	t.Run("invalid previous block hash", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetMiningCandidateContext: &blockchain_api.GetMiningCandidateContextResponse{
+				PreviousBlockHash: []byte{1, 2, 3},
+				Bits:              bits.CloneBytes(),
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		candidateContext, err := c.GetMiningCandidateContext(ctx)
+		require.Error(t, err)
+		assert.Nil(t, candidateContext)
+		assert.Contains(t, err.Error(), "invalid previous block hash")
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewServiceError("service unavailable")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		candidateContext, err := c.GetMiningCandidateContext(ctx)
+		require.Error(t, err)
+		assert.Nil(t, candidateContext)
+	})
+}
+
 func TestClientGetBlockExists(t *testing.T) {
 	ctx := context.Background()
 	logger := ulogger.NewErrorTestLogger(t)
@@ -2876,6 +3015,24 @@ func TestClientInvalidateBlock(t *testing.T) {
 		// Validate request
 		require.NotNil(t, mc.lastInvalidateBlockReq)
 		assert.Equal(t, blockHash.CloneBytes(), mc.lastInvalidateBlockReq.BlockHash)
+		assert.True(t, mc.lastInvalidateBlockReq.Force, "the max reorg depth is only checked when requested")
+	})
+
+	t.Run("max reorg depth check", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseInvalidateBlock: &blockchain_api.InvalidateBlockResponse{},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		_, err := c.InvalidateBlock(ctx, &blockHash, options.WithCheckMaxReorgDepth(true))
+		require.NoError(t, err)
+
+		require.NotNil(t, mc.lastInvalidateBlockReq)
+		assert.False(t, mc.lastInvalidateBlockReq.Force)
 	})
 
 	t.Run("grpc client error", func(t *testing.T) {
@@ -2954,6 +3111,285 @@ func TestClientInvalidateBlock(t *testing.T) {
 	})
 }
 
+func TestClientGetBlocksByHeightRange(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	coinbase := bt.NewTx()
+	_ = coinbase.From("0000000000000000000000000000000000000000000000000000000000000000", 0xffffffff, "", 0)
+	_ = coinbase.AddP2PKHOutputFromAddress("mrs6FYWPcb441b4qfcEPyvLvzj64WHtwCU", 5000000000)
+
+	testBlock, err := model.NewBlock(&model.BlockHeader{
+		Version:        1,
+		HashPrevBlock:  &chainhash.Hash{},
+		HashMerkleRoot: &chainhash.Hash{},
+		Timestamp:      uint32(time.Now().Unix()),
+		Bits:           model.NBit{0x1d, 0x00, 0xff, 0xff},
+		Nonce:          123,
+	}, coinbase, []*chainhash.Hash{{1, 2, 3}}, 1, 1000, 5, 5)
+	require.NoError(t, err)
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		blockBytes, err := testBlock.Bytes()
+		require.NoError(t, err)
+
+		mc := &mockBlockClient{
+			responseGetBlocks: &blockchain_api.GetBlocksResponse{
+				Blocks: [][]byte{blockBytes, blockBytes},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		blocks, err := c.GetBlocksByHeightRange(ctx, 5, 6)
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+		assert.Equal(t, testBlock.Hash(), blocks[0].Hash())
+
+		require.NotNil(t, mc.lastGetBlocksByHeightRangeReq)
+		assert.Equal(t, uint32(5), mc.lastGetBlocksByHeightRangeReq.StartHeight)
+		assert.Equal(t, uint32(6), mc.lastGetBlocksByHeightRangeReq.EndHeight)
+	})
+
+	t.Run("grpc client error", func(t *testing.T) {
+		mc := &mockBlockClient{
+			err: errors.NewInvalidArgumentError("range too large"),
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		blocks, err := c.GetBlocksByHeightRange(ctx, 0, 1000)
+		require.Error(t, err)
+		assert.Nil(t, blocks)
+	})
+
+	t.Run("invalid block bytes", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetBlocks: &blockchain_api.GetBlocksResponse{
+				Blocks: [][]byte{{1, 2, 3}},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		blocks, err := c.GetBlocksByHeightRange(ctx, 1, 1)
+		require.Error(t, err)
+		assert.Nil(t, blocks)
+	})
+}
+
+func TestClientGetBlockHeadersByHashes(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	header := &model.BlockHeader{
+		Version:        1,
+		HashPrevBlock:  &chainhash.Hash{},
+		HashMerkleRoot: &chainhash.Hash{},
+		Timestamp:      uint32(time.Now().Unix()),
+		Bits:           model.NBit{0x1d, 0x00, 0xff, 0xff},
+		Nonce:          123,
+	}
+	meta := &model.BlockHeaderMeta{ID: 7, Height: 5, TxCount: 1}
+
+	hashes := []*chainhash.Hash{header.Hash(), {1, 2, 3}}
+
+	t.Run("successful retrieval with unknown block", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetBlockHeadersByHashes: &blockchain_api.GetBlockHeadersByHashesResponse{
+				BlockHeaders: [][]byte{header.Bytes(), nil},
+				Metas:        [][]byte{meta.Bytes(), nil},
+				Found:        []bool{true, false},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		headers, metas, err := c.GetBlockHeadersByHashes(ctx, hashes)
+		require.NoError(t, err)
+		require.Len(t, headers, 2)
+		require.Len(t, metas, 2)
+
+		assert.Equal(t, header.Hash(), headers[0].Hash())
+		assert.Equal(t, uint32(5), metas[0].Height)
+		assert.Nil(t, headers[1])
+		assert.Nil(t, metas[1])
+
+		require.NotNil(t, mc.lastGetBlockHeadersByHashesReq)
+		require.Len(t, mc.lastGetBlockHeadersByHashesReq.BlockHashes, 2)
+		assert.Equal(t, hashes[1][:], mc.lastGetBlockHeadersByHashesReq.BlockHashes[1])
+	})
+
+	t.Run("grpc client error", func(t *testing.T) {
+		mc := &mockBlockClient{
+			err: errors.NewStorageError("db down"),
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		headers, metas, err := c.GetBlockHeadersByHashes(ctx, hashes)
+		require.Error(t, err)
+		assert.Nil(t, headers)
+		assert.Nil(t, metas)
+	})
+
+	t.Run("response length mismatch", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetBlockHeadersByHashes: &blockchain_api.GetBlockHeadersByHashesResponse{
+				BlockHeaders: [][]byte{header.Bytes()},
+				Metas:        [][]byte{meta.Bytes()},
+				Found:        []bool{true},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		_, _, err := c.GetBlockHeadersByHashes(ctx, hashes)
+		require.Error(t, err)
+	})
+}
+
+func TestClientIsCurrent(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	t.Run("not current", func(t *testing.T) {
+		c := &Client{
+			client: &mockBlockClient{
+				responseIsCurrent: &blockchain_api.IsCurrentResponse{
+					Reasons: []blockchain_api.NotCurrentReason{NotCurrentTipTooOld, NotCurrentFSMNotRunning},
+				},
+			},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		current, reasons, err := c.IsCurrent(ctx)
+		require.NoError(t, err)
+		assert.False(t, current)
+		assert.Equal(t, []NotCurrentReason{NotCurrentTipTooOld, NotCurrentFSMNotRunning}, reasons)
+	})
+
+	t.Run("current", func(t *testing.T) {
+		c := &Client{
+			client: &mockBlockClient{
+				responseIsCurrent: &blockchain_api.IsCurrentResponse{Current: true},
+			},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		current, reasons, err := c.IsCurrent(ctx)
+		require.NoError(t, err)
+		assert.True(t, current)
+		assert.Empty(t, reasons)
+	})
+
+	t.Run("grpc client error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewStateInitializationError("FSM is not initialized")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		current, reasons, err := c.IsCurrent(ctx)
+		require.Error(t, err)
+		assert.False(t, current)
+		assert.Nil(t, reasons)
+	})
+}
+
+func TestClientInvalidateBlockDryRun(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHash := chainhash.Hash{1, 2, 3}
+
+	t.Run("successful dry run", func(t *testing.T) {
+		childHash := chainhash.Hash{4, 5, 6}
+
+		mc := &mockBlockClient{
+			responseInvalidateBlock: &blockchain_api.InvalidateBlockResponse{
+				InvalidatedBlocks: [][]byte{blockHash.CloneBytes(), childHash.CloneBytes()},
+				AffectedBlocks: []*blockchain_api.AffectedBlock{
+					{Hash: blockHash.CloneBytes(), Height: 10, OnBestChain: true},
+					{Hash: childHash.CloneBytes(), Height: 11, OnBestChain: false},
+				},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		affectedBlocks, err := c.InvalidateBlockDryRun(ctx, &blockHash)
+		require.NoError(t, err)
+		require.Len(t, affectedBlocks, 2)
+
+		assert.Equal(t, &model.AffectedBlock{Hash: &blockHash, Height: 10, OnBestChain: true}, affectedBlocks[0])
+		assert.Equal(t, &model.AffectedBlock{Hash: &childHash, Height: 11, OnBestChain: false}, affectedBlocks[1])
+
+		require.NotNil(t, mc.lastInvalidateBlockReq)
+		assert.Equal(t, blockHash.CloneBytes(), mc.lastInvalidateBlockReq.BlockHash)
+		assert.True(t, mc.lastInvalidateBlockReq.DryRun)
+	})
+
+	t.Run("grpc client error", func(t *testing.T) {
+		mc := &mockBlockClient{
+			err: errors.NewProcessingError("grpc connection failed"),
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		affectedBlocks, err := c.InvalidateBlockDryRun(ctx, &blockHash)
+		require.Error(t, err)
+		assert.Nil(t, affectedBlocks)
+	})
+
+	t.Run("invalid hash bytes", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseInvalidateBlock: &blockchain_api.InvalidateBlockResponse{
+				AffectedBlocks: []*blockchain_api.AffectedBlock{{Hash: []byte{1, 2}}},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		affectedBlocks, err := c.InvalidateBlockDryRun(ctx, &blockHash)
+		require.Error(t, err)
+		assert.Nil(t, affectedBlocks)
+	})
+}
+
 func TestClientRevalidateBlock(t *testing.T) {
 	ctx := context.Background()
 	logger := ulogger.NewErrorTestLogger(t)
@@ -4247,6 +4683,35 @@ func TestClientSubscribe(t *testing.T) {
 			t.Fatal("Expected to receive last block notification immediately")
 		}
 	})
+
+	t.Run("subscription with notification types", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{},
+			logger:   logger,
+			settings: tSettings,
+			lastBlockNotification: &blockchain_api.Notification{
+				Type: model.NotificationType_Block,
+				Hash: (&chainhash.Hash{1, 2, 3})[:],
+			},
+		}
+
+		ch, err := c.Subscribe(ctx, "test-source", options.WithNotificationTypes(model.NotificationType_Subtree))
+		require.NoError(t, err)
+		require.NotNil(t, ch)
+
+		c.subscribersMu.Lock()
+		require.Len(t, c.subscribers, 1)
+		assert.True(t, matchesNotificationTypeFilter(c.subscribers[0].notificationTypes, model.NotificationType_Subtree))
+		assert.False(t, matchesNotificationTypeFilter(c.subscribers[0].notificationTypes, model.NotificationType_Block))
+		c.subscribersMu.Unlock()
+
+		// Should not receive the last block notification
+		select {
+		case notification := <-ch:
+			t.Fatalf("Expected no notification, got %s", notification.Type)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
 }
 
 // TestClientGetBlockIsMined tests the GetBlockIsMined method
@@ -5085,6 +5550,276 @@ func TestClient_GetBlockLocator(t *testing.T) {
 	})
 }
 
+func TestClient_GetBlockLocatorByHeight(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+	height := uint32(12345)
+
+	t.Run("success", func(t *testing.T) {
+		hash1 := chainhash.HashH([]byte("hash1"))
+		hash2 := chainhash.HashH([]byte("hash2"))
+
+		mc := &mockBlockClient{
+			responseGetBlockLocator: &blockchain_api.GetBlockLocatorResponse{
+				Locator: [][]byte{hash1.CloneBytes(), hash2.CloneBytes()},
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		locator, err := c.GetBlockLocatorByHeight(ctx, height)
+		require.NoError(t, err)
+		require.Len(t, locator, 2)
+		assert.Equal(t, hash1, *locator[0])
+		assert.Equal(t, hash2, *locator[1])
+
+		require.NotNil(t, mc.lastGetBlockLocatorByHeightReq)
+		assert.Equal(t, height, mc.lastGetBlockLocatorByHeightReq.Height)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewBlockNotFoundError("block not found")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		locator, err := c.GetBlockLocatorByHeight(ctx, height)
+		require.Error(t, err)
+		assert.Nil(t, locator)
+	})
+}
+
+func TestClient_GetMedianTimeForHeight(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+	height := uint32(12345)
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetMedianTimeForHeight: &blockchain_api.GetMedianTimeForHeightResponse{
+				Time: 1672531200,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		medianTime, err := c.GetMedianTimeForHeight(ctx, height)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1672531200), medianTime)
+
+		require.NotNil(t, mc.lastGetMedianTimeForHeightReq)
+		assert.Equal(t, height, mc.lastGetMedianTimeForHeightReq.Height)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewBlockNotFoundError("block not found")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		medianTime, err := c.GetMedianTimeForHeight(ctx, height)
+		require.Error(t, err)
+		assert.Equal(t, uint32(0), medianTime)
+	})
+}
+
+func TestClient_GetBlockSubsidy(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+	height := uint32(840_000)
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetBlockSubsidy: &blockchain_api.GetBlockSubsidyResponse{
+				Subsidy:             312_500_000,
+				NextReductionHeight: 1_050_000,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		subsidy, nextReductionHeight, err := c.GetBlockSubsidy(ctx, height)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(312_500_000), subsidy)
+		assert.Equal(t, uint32(1_050_000), nextReductionHeight)
+
+		require.NotNil(t, mc.lastGetBlockSubsidyReq)
+		assert.Equal(t, height, mc.lastGetBlockSubsidyReq.Height)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewConfigurationError("no subsidy reduction interval")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		subsidy, nextReductionHeight, err := c.GetBlockSubsidy(ctx, height)
+		require.Error(t, err)
+		assert.Equal(t, uint64(0), subsidy)
+		assert.Equal(t, uint32(0), nextReductionHeight)
+	})
+}
+
+func TestClient_CompareAndSetState(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseCompareAndSetState: &blockchain_api.CompareAndSetStateResponse{Swapped: true},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		swapped, err := c.CompareAndSetState(ctx, "test-key", []byte("old-data"), []byte("new-data"))
+		require.NoError(t, err)
+		assert.True(t, swapped)
+
+		require.NotNil(t, mc.lastCompareAndSetStateReq)
+		assert.Equal(t, "test-key", mc.lastCompareAndSetStateReq.Key)
+		assert.Equal(t, []byte("old-data"), mc.lastCompareAndSetStateReq.ExpectedData)
+		assert.Equal(t, []byte("new-data"), mc.lastCompareAndSetStateReq.Data)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewStorageError("forced error")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		swapped, err := c.CompareAndSetState(ctx, "test-key", nil, []byte("new-data"))
+		require.Error(t, err)
+		assert.False(t, swapped)
+	})
+}
+
+func TestClient_GetBlockHeaderByHeight(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	blockHeader := &model.BlockHeader{
+		Version:        1,
+		HashPrevBlock:  &chainhash.Hash{},
+		HashMerkleRoot: &chainhash.Hash{},
+		Timestamp:      1234,
+		Bits:           model.NBit{},
+		Nonce:          42,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mc := &mockBlockClient{
+			responseGetBlockHeaderByHeight: &blockchain_api.GetBlockHeaderResponse{
+				BlockHeader: blockHeader.Bytes(),
+				Id:          7,
+				Height:      750,
+				TxCount:     250,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		header, meta, err := c.GetBlockHeaderByHeight(ctx, 750)
+		require.NoError(t, err)
+
+		assert.Equal(t, blockHeader.Hash(), header.Hash())
+		assert.Equal(t, uint32(7), meta.ID)
+		assert.Equal(t, uint32(750), meta.Height)
+		assert.Equal(t, uint64(250), meta.TxCount)
+
+		require.NotNil(t, mc.lastGetBlockHeaderByHeightReq)
+		assert.Equal(t, uint32(750), mc.lastGetBlockHeaderByHeightReq.Height)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewStorageError("forced error")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		header, meta, err := c.GetBlockHeaderByHeight(ctx, 750)
+		require.Error(t, err)
+		assert.Nil(t, header)
+		assert.Nil(t, meta)
+	})
+}
+
+func TestClient_WaitForBlockHeight(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	t.Run("success", func(t *testing.T) {
+		header := &model.BlockHeader{
+			Version:        1,
+			HashPrevBlock:  &chainhash.Hash{},
+			HashMerkleRoot: &chainhash.Hash{},
+			Timestamp:      1672531200,
+			Bits:           model.NBit{0xff, 0xff, 0x00, 0x1d},
+			Nonce:          1,
+		}
+
+		mc := &mockBlockClient{
+			responseGetBlockHeader: &blockchain_api.GetBlockHeaderResponse{
+				BlockHeader: header.Bytes(),
+				Id:          5,
+				Height:      100,
+			},
+		}
+		c := &Client{
+			client:   mc,
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		blockHeader, meta, err := c.WaitForBlockHeight(ctx, 100)
+		require.NoError(t, err)
+		assert.Equal(t, header.Hash(), blockHeader.Hash())
+		assert.Equal(t, uint32(100), meta.Height)
+		assert.Equal(t, uint32(5), meta.ID)
+
+		require.NotNil(t, mc.lastWaitForBlockHeightReq)
+		assert.Equal(t, uint32(100), mc.lastWaitForBlockHeightReq.Height)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		c := &Client{
+			client:   &mockBlockClient{err: errors.NewContextCanceledError("context done")},
+			logger:   logger,
+			settings: tSettings,
+		}
+
+		blockHeader, meta, err := c.WaitForBlockHeight(ctx, 100)
+		require.Error(t, err)
+		assert.Nil(t, blockHeader)
+		assert.Nil(t, meta)
+	})
+}
+
 // Test LocateBlockHeaders
 func TestClient_LocateBlockHeaders(t *testing.T) {
 	ctx := context.Background()
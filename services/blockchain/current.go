@@ -0,0 +1,61 @@
+package blockchain
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/services/blockchain/blockchain_api"
+	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bsv-blockchain/go-chaincfg"
+	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// NotCurrentReason is an alias for blockchain_api.NotCurrentReason
+type NotCurrentReason = blockchain_api.NotCurrentReason
+
+const (
+	NotCurrentBelowCheckpoint = blockchain_api.NotCurrentReason_BELOW_CHECKPOINT
+	NotCurrentTipTooOld       = blockchain_api.NotCurrentReason_TIP_TOO_OLD
+	NotCurrentFSMNotRunning   = blockchain_api.NotCurrentReason_FSM_NOT_RUNNING
+)
+
+// TipNotCurrentReasons returns the reasons the best block does not make the node current, based on the
+// best block alone. The best block must be at or above the last checkpoint of the chain, when the chain
+// has checkpoints, and must not be older than maxTipAge.
+//
+// Parameters:
+//   - bestBlockHeaderMeta: Metadata of the best block
+//   - chainParams: Parameters of the chain, for the checkpoints
+//   - maxTipAge: Maximum age of the best block
+//   - now: Time to compare the block time of the best block with
+//
+// Returns:
+//   - []NotCurrentReason: The reasons the node is not current, empty when it is current
+func TipNotCurrentReasons(bestBlockHeaderMeta *model.BlockHeaderMeta, chainParams *chaincfg.Params, maxTipAge time.Duration, now time.Time) []NotCurrentReason {
+	reasons := make([]NotCurrentReason, 0, 3)
+
+	if len(chainParams.Checkpoints) > 0 {
+		checkpoint := chainParams.Checkpoints[len(chainParams.Checkpoints)-1]
+		if checkpointHeight, err := safeconversion.Int32ToUint32(checkpoint.Height); err == nil && bestBlockHeaderMeta.Height < checkpointHeight {
+			reasons = append(reasons, NotCurrentBelowCheckpoint)
+		}
+	}
+
+	if int64(bestBlockHeaderMeta.BlockTime) < now.Add(-maxTipAge).Unix() {
+		reasons = append(reasons, NotCurrentTipTooOld)
+	}
+
+	return reasons
+}
+
+// notCurrentReasons returns the reasons the node is not current, based on the best block and the FSM state,
+// which must be running for the node to be current.
+func notCurrentReasons(bestBlockHeaderMeta *model.BlockHeaderMeta, fsmState FSMStateType, tSettings *settings.Settings) []NotCurrentReason {
+	reasons := TipNotCurrentReasons(bestBlockHeaderMeta, tSettings.ChainCfgParams, tSettings.BlockChain.MaxTipAge, time.Now())
+
+	if fsmState != FSMStateRUNNING {
+		reasons = append(reasons, NotCurrentFSMNotRunning)
+	}
+
+	return reasons
+}
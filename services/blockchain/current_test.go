@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bsv-blockchain/go-chaincfg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTipNotCurrentReasons(t *testing.T) {
+	now := time.Now()
+	recent := uint32(now.Add(-time.Hour).Unix())
+	old := uint32(now.Add(-25 * time.Hour).Unix())
+
+	checkpoint := chaincfg.MainNetParams.Checkpoints[len(chaincfg.MainNetParams.Checkpoints)-1]
+	checkpointHeight := uint32(checkpoint.Height) // nolint:gosec
+
+	tests := []struct {
+		name        string
+		meta        *model.BlockHeaderMeta
+		chainParams *chaincfg.Params
+		expected    []NotCurrentReason
+	}{
+		{
+			name:        "current",
+			meta:        &model.BlockHeaderMeta{Height: checkpointHeight, BlockTime: recent},
+			chainParams: &chaincfg.MainNetParams,
+			expected:    []NotCurrentReason{},
+		},
+		{
+			name:        "below checkpoint",
+			meta:        &model.BlockHeaderMeta{Height: checkpointHeight - 1, BlockTime: recent},
+			chainParams: &chaincfg.MainNetParams,
+			expected:    []NotCurrentReason{NotCurrentBelowCheckpoint},
+		},
+		{
+			name:        "tip too old",
+			meta:        &model.BlockHeaderMeta{Height: checkpointHeight + 1, BlockTime: old},
+			chainParams: &chaincfg.MainNetParams,
+			expected:    []NotCurrentReason{NotCurrentTipTooOld},
+		},
+		{
+			name:        "below checkpoint and tip too old",
+			meta:        &model.BlockHeaderMeta{Height: 1, BlockTime: old},
+			chainParams: &chaincfg.MainNetParams,
+			expected:    []NotCurrentReason{NotCurrentBelowCheckpoint, NotCurrentTipTooOld},
+		},
+		{
+			name:        "chain without checkpoints",
+			meta:        &model.BlockHeaderMeta{Height: 1, BlockTime: recent},
+			chainParams: &chaincfg.RegressionNetParams,
+			expected:    []NotCurrentReason{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TipNotCurrentReasons(tt.meta, tt.chainParams, 24*time.Hour, now))
+		})
+	}
+}
@@ -36,6 +36,12 @@ func (b *Blockchain) NewFiniteStateMachine(opts ...func(*fsm.FSM)) *fsm.FSM {
 			}
 
 			prometheusBlockchainFSMCurrentState.Set(float64(blockchain_api.FSMStateType_value[e.Dst]))
+
+			b.broadcastFSMStateChange(&blockchain_api.FSMStateChange{
+				OldState: blockchain_api.FSMStateType(blockchain_api.FSMStateType_value[e.Src]),
+				NewState: blockchain_api.FSMStateType(blockchain_api.FSMStateType_value[e.Dst]),
+				Event:    e.Event,
+			})
 		},
 	}
 
@@ -34,17 +34,21 @@ var (
 	prometheusBlockchainGetLatestBlockHeaderFromBlockLocator prometheus.Histogram
 	prometheusBlockchainGetBlockHeadersFromOldest            prometheus.Histogram
 	prometheusBlockchainGetNextWorkRequired                  prometheus.Histogram
+	prometheusBlockchainGetDifficultyInfo                    prometheus.Histogram
+	prometheusBlockchainGetMiningCandidateContext            prometheus.Histogram
 	prometheusBlockchainGetBlockExists                       prometheus.Histogram
 	prometheusBlockchainGetBestBlockHeader                   prometheus.Histogram
 	prometheusBlockchainCheckBlockIsInCurrentChain           prometheus.Histogram
 	prometheusBlockchainGetChainTips                         prometheus.Histogram
 	prometheusBlockchainGetBlockHeader                       prometheus.Histogram
+	prometheusBlockchainGetBlockHeaderByHeight               prometheus.Histogram
 	prometheusBlockchainGetBlockHeaders                      prometheus.Histogram
 	prometheusBlockchainGetBlockHeadersFromHeight            prometheus.Histogram
 	prometheusBlockchainGetBlockHeadersByHeight              prometheus.Histogram
 	prometheusBlockchainSubscribe                            prometheus.Histogram
 	prometheusBlockchainGetState                             prometheus.Histogram
 	prometheusBlockchainSetState                             prometheus.Histogram
+	prometheusBlockchainCompareAndSetState                   prometheus.Histogram
 	prometheusBlockchainGetBlockHeaderIDs                    prometheus.Histogram
 	prometheusBlockchainInvalidateBlock                      prometheus.Histogram
 	prometheusBlockchainRevalidateBlock                      prometheus.Histogram
@@ -57,7 +61,17 @@ var (
 	prometheusBlockchainFSMCurrentState                      prometheus.Gauge
 	prometheusBlockchainGetFSMCurrentState                   prometheus.Histogram
 	prometheusBlockchainGetBlockLocator                      prometheus.Histogram
+	prometheusBlockchainGetBlockLocatorByHeight              prometheus.Histogram
 	prometheusBlockchainLocateBlockHeaders                   prometheus.Histogram
+	prometheusBlockchainGetMedianTimeForHeight               prometheus.Histogram
+	prometheusBlockchainGetBlockSubsidy                      prometheus.Histogram
+	prometheusBlockchainWaitForBlockHeight                   prometheus.Histogram
+	prometheusBlockchainCompact                              prometheus.Histogram
+	prometheusBlockchainCompactReclaimedBytes                prometheus.Counter
+	prometheusBlockchainCompactRemovedBlocks                 prometheus.Counter
+	prometheusBlockchainCompactRemovedStateKeys              prometheus.Counter
+	prometheusBlockchainSubscriberQueueDepth                 *prometheus.GaugeVec
+	prometheusBlockchainReorgDepth                           prometheus.Histogram
 	// prometheusExportBlockDb                        prometheus.Histogram
 )
 
@@ -178,6 +192,24 @@ func _initPrometheusMetrics() {
 			Buckets:   util.MetricsBucketsMilliSeconds,
 		},
 	)
+	prometheusBlockchainGetDifficultyInfo = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_difficulty_info",
+			Help:      "Histogram of GetDifficultyInfo calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+	prometheusBlockchainGetMiningCandidateContext = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_mining_candidate_context",
+			Help:      "Histogram of GetMiningCandidateContext calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
 
 	prometheusBlockchainGetBlockExists = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -229,6 +261,16 @@ func _initPrometheusMetrics() {
 		},
 	)
 
+	prometheusBlockchainGetBlockHeaderByHeight = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_block_header_by_height",
+			Help:      "Histogram of GetBlockHeaderByHeight calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
 	prometheusBlockchainGetBlockHeaders = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "teranode",
@@ -289,6 +331,16 @@ func _initPrometheusMetrics() {
 		},
 	)
 
+	prometheusBlockchainCompareAndSetState = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "compare_and_set_state",
+			Help:      "Histogram of CompareAndSetState calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
 	prometheusBlockchainGetBlockHeaderIDs = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "teranode",
@@ -408,6 +460,46 @@ func _initPrometheusMetrics() {
 		},
 	)
 
+	prometheusBlockchainGetBlockLocatorByHeight = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_block_locator_by_height",
+			Help:      "Histogram of GetBlockLocatorByHeight calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
+	prometheusBlockchainGetMedianTimeForHeight = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_median_time_for_height",
+			Help:      "Histogram of GetMedianTimeForHeight calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
+	prometheusBlockchainGetBlockSubsidy = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "get_block_subsidy",
+			Help:      "Histogram of GetBlockSubsidy calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
+	prometheusBlockchainWaitForBlockHeight = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "wait_for_block_height",
+			Help:      "Histogram of WaitForBlockHeight calls to the blockchain service",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
 	prometheusBlockchainLocateBlockHeaders = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "teranode",
@@ -417,6 +509,63 @@ func _initPrometheusMetrics() {
 			Buckets:   util.MetricsBucketsMilliSeconds,
 		},
 	)
+
+	prometheusBlockchainCompact = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "compact",
+			Help:      "Histogram of compaction runs of the blockchain store",
+			Buckets:   util.MetricsBucketsMilliSeconds,
+		},
+	)
+
+	prometheusBlockchainCompactReclaimedBytes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "compact_reclaimed_bytes",
+			Help:      "Number of bytes reclaimed by compaction of the blockchain store",
+		},
+	)
+
+	prometheusBlockchainCompactRemovedBlocks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "compact_removed_blocks",
+			Help:      "Number of invalid blocks removed by compaction of the blockchain store",
+		},
+	)
+
+	prometheusBlockchainCompactRemovedStateKeys = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "compact_removed_state_keys",
+			Help:      "Number of stale state keys removed by compaction of the blockchain store",
+		},
+	)
+
+	prometheusBlockchainSubscriberQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "subscriber_queue_depth",
+			Help:      "Number of notifications queued for a subscriber that have not been sent yet, as of the last queued notification",
+		},
+		[]string{"source", "subscriber"},
+	)
+
+	prometheusBlockchainReorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "teranode",
+			Subsystem: "blockchain",
+			Name:      "reorg_depth",
+			Help:      "Number of blocks disconnected from the best chain by a reorg",
+			Buckets:   []float64{1, 2, 3, 5, 10, 20, 50, 100, 1000},
+		},
+	)
 }
 
 // prometheusExportBlockDb = promauto.NewHistogram(
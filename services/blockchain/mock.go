@@ -56,6 +56,17 @@ func (m *Mock) GetBlock(ctx context.Context, blockHash *chainhash.Hash) (*model.
 	return args.Get(0).(*model.Block), args.Error(1)
 }
 
+// GetBlocksByHeightRange mocks the GetBlocksByHeightRange method
+func (m *Mock) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	args := m.Called(ctx, startHeight, endHeight)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*model.Block), args.Error(1)
+}
+
 // GetBlocks mocks the GetBlocks method
 func (m *Mock) GetBlocks(ctx context.Context, blockHash *chainhash.Hash, numberOfBlocks uint32) ([]*model.Block, error) {
 	args := m.Called(ctx, blockHash, numberOfBlocks)
@@ -123,8 +134,8 @@ func (m *Mock) GetBlockGraphData(ctx context.Context, periodMillis uint64) (*mod
 }
 
 // GetLastNBlocks mocks the GetLastNBlocks method
-func (m *Mock) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
-	args := m.Called(ctx, n, includeOrphans, fromHeight)
+func (m *Mock) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
+	args := m.Called(ctx, n, selection, fromHeight)
 
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
@@ -166,6 +177,28 @@ func (m *Mock) GetNextWorkRequired(ctx context.Context, hash *chainhash.Hash, cu
 	return args.Get(0).(*model.NBit), args.Error(1)
 }
 
+// GetDifficultyInfo mocks the GetDifficultyInfo method
+func (m *Mock) GetDifficultyInfo(ctx context.Context) (*DifficultyInfo, error) {
+	args := m.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DifficultyInfo), args.Error(1)
+}
+
+// GetMiningCandidateContext mocks the GetMiningCandidateContext method
+func (m *Mock) GetMiningCandidateContext(ctx context.Context) (*MiningCandidateContext, error) {
+	args := m.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*MiningCandidateContext), args.Error(1)
+}
+
 // GetBlockExists mocks the GetBlockExists method
 func (m *Mock) GetBlockExists(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	args := m.Called(ctx, blockHash)
@@ -194,6 +227,28 @@ func (m *Mock) GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash) (*
 	return args.Get(0).(*model.BlockHeader), args.Get(1).(*model.BlockHeaderMeta), args.Error(2)
 }
 
+// GetBlockHeaderByHeight mocks the GetBlockHeaderByHeight method
+func (m *Mock) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, height)
+
+	if args.Error(2) != nil {
+		return nil, nil, args.Error(2)
+	}
+
+	return args.Get(0).(*model.BlockHeader), args.Get(1).(*model.BlockHeaderMeta), args.Error(2)
+}
+
+// GetBlockHeadersByHashes mocks the GetBlockHeadersByHashes method
+func (m *Mock) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, blockHashes)
+
+	if args.Error(2) != nil {
+		return nil, nil, args.Error(2)
+	}
+
+	return args.Get(0).([]*model.BlockHeader), args.Get(1).([]*model.BlockHeaderMeta), args.Error(2)
+}
+
 // GetBlockHeaders mocks the GetBlockHeaders method
 func (m *Mock) GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	args := m.Called(ctx, blockHash, numberOfHeaders)
@@ -283,7 +338,7 @@ func (m *Mock) GetBlockHeadersByHeight(ctx context.Context, startHeight, endHeig
 }
 
 // InvalidateBlock mocks the InvalidateBlock method
-func (m *Mock) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+func (m *Mock) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, _ ...options.InvalidateBlockOption) ([]chainhash.Hash, error) {
 	args := m.Called(ctx, blockHash)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
@@ -291,6 +346,15 @@ func (m *Mock) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) (
 	return args.Get(0).([]chainhash.Hash), args.Error(1)
 }
 
+// InvalidateBlockDryRun mocks the InvalidateBlockDryRun method
+func (m *Mock) InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	args := m.Called(ctx, blockHash)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.AffectedBlock), args.Error(1)
+}
+
 // RevalidateBlock mocks the RevalidateBlock method
 func (m *Mock) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
 	args := m.Called(ctx, blockHash)
@@ -309,7 +373,7 @@ func (m *Mock) GetBlockHeaderIDs(ctx context.Context, blockHash *chainhash.Hash,
 }
 
 // Subscribe mocks the Subscribe method
-func (m *Mock) Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error) {
+func (m *Mock) Subscribe(ctx context.Context, source string, _ ...options.SubscribeOption) (chan *blockchain_api.Notification, error) {
 	args := m.Called(ctx, source)
 
 	if args.Error(1) != nil {
@@ -336,6 +400,12 @@ func (m *Mock) SetState(ctx context.Context, key string, data []byte) error {
 	return args.Error(0)
 }
 
+// CompareAndSetState mocks the CompareAndSetState method
+func (m *Mock) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	args := m.Called(ctx, key, expectedData, newData)
+	return args.Bool(0), args.Error(1)
+}
+
 // SetBlockMinedSet mocks the SetBlockMinedSet method
 func (m *Mock) SetBlockMinedSet(ctx context.Context, blockHash *chainhash.Hash) error {
 	args := m.Called(ctx, blockHash)
@@ -394,6 +464,39 @@ func (m *Mock) GetBestHeightAndTime(ctx context.Context) (uint32, uint32, error)
 	return uint32(args.Int(0)), uint32(args.Int(1)), args.Error(2)
 }
 
+// GetMedianTimeForHeight mocks the GetMedianTimeForHeight method
+func (m *Mock) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	args := m.Called(ctx, height)
+
+	if args.Error(1) != nil {
+		return 0, args.Error(1)
+	}
+
+	return args.Get(0).(uint32), args.Error(1)
+}
+
+// GetBlockSubsidy mocks the GetBlockSubsidy method
+func (m *Mock) GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error) {
+	args := m.Called(ctx, height)
+
+	if args.Error(2) != nil {
+		return 0, 0, args.Error(2)
+	}
+
+	return args.Get(0).(uint64), args.Get(1).(uint32), args.Error(2)
+}
+
+// WaitForBlockHeight mocks the WaitForBlockHeight method
+func (m *Mock) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, height)
+
+	if args.Error(2) != nil {
+		return nil, nil, args.Error(2)
+	}
+
+	return args.Get(0).(*model.BlockHeader), args.Get(1).(*model.BlockHeaderMeta), args.Error(2)
+}
+
 // CheckBlockIsInCurrentChain mocks the CheckBlockIsInCurrentChain method
 func (m *Mock) CheckBlockIsInCurrentChain(ctx context.Context, blockIDs []uint32) (bool, error) {
 	args := m.Called(ctx, blockIDs)
@@ -423,6 +526,17 @@ func (m *Mock) IsFSMCurrentState(ctx context.Context, state FSMStateType) (bool,
 	return args.Bool(0), args.Error(1)
 }
 
+// IsCurrent mocks the IsCurrent method
+func (m *Mock) IsCurrent(ctx context.Context) (bool, []NotCurrentReason, error) {
+	args := m.Called(ctx)
+
+	if args.Error(2) != nil {
+		return false, nil, args.Error(2)
+	}
+
+	return args.Bool(0), args.Get(1).([]NotCurrentReason), args.Error(2)
+}
+
 // WaitForFSMtoTransitionToGivenState mocks the WaitForFSMtoTransitionToGivenState method
 func (m *Mock) WaitForFSMtoTransitionToGivenState(ctx context.Context, state FSMStateType) error {
 	args := m.Called(ctx, state)
@@ -447,6 +561,17 @@ func (m *Mock) GetFSMCurrentStateForE2ETestMode() FSMStateType {
 	return args.Get(0).(FSMStateType)
 }
 
+// SubscribeFSMState mocks the SubscribeFSMState method
+func (m *Mock) SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error) {
+	args := m.Called(ctx, source)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(<-chan *blockchain_api.FSMStateChange), args.Error(1)
+}
+
 // Run mocks the Run method
 func (m *Mock) Run(ctx context.Context, source string) error {
 	args := m.Called(ctx, source)
@@ -495,6 +620,17 @@ func (m *Mock) GetBlockLocator(ctx context.Context, blockHeaderHash *chainhash.H
 	return args.Get(0).([]*chainhash.Hash), args.Error(1)
 }
 
+// GetBlockLocatorByHeight mocks the GetBlockLocatorByHeight method
+func (m *Mock) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	args := m.Called(ctx, blockHeaderHeight)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*chainhash.Hash), args.Error(1)
+}
+
 // LocateBlockHeaders mocks the LocateBlockHeaders method
 func (m *Mock) LocateBlockHeaders(ctx context.Context, locator []*chainhash.Hash, hashStop *chainhash.Hash, maxHashes uint32) ([]*model.BlockHeader, error) {
 	args := m.Called(ctx, locator, hashStop, maxHashes)
@@ -671,6 +807,11 @@ func (m *mockStoreState) SetState(ctx context.Context, key string, data []byte)
 	return args.Error(0)
 }
 
+func (m *mockStoreState) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	args := m.Called(ctx, key, expectedData, newData)
+	return args.Bool(0), args.Error(1)
+}
+
 type mockStoreGetBlockHeaderIDs struct {
 	*blockchain_store.MockStore
 	mock.Mock
@@ -793,6 +934,7 @@ type mockBlockClient struct {
 	blockchain_api.BlockchainAPIClient
 	responseGetBlock                             *blockchain_api.GetBlockResponse
 	responseGetBlocks                            *blockchain_api.GetBlocksResponse
+	lastGetBlocksByHeightRangeReq                *blockchain_api.GetBlocksByHeightRangeRequest
 	responseGetBlockByHeight                     *blockchain_api.GetBlockResponse
 	responseGetBlockByID                         *blockchain_api.GetBlockResponse
 	responseGetNextBlockID                       *blockchain_api.GetNextBlockIDResponse
@@ -813,6 +955,8 @@ type mockBlockClient struct {
 	lastGetBlockHeadersFromOldestReq             *blockchain_api.GetBlockHeadersFromOldestRequest
 	responseGetNextWorkRequired                  *blockchain_api.GetNextWorkRequiredResponse
 	lastGetNextWorkRequiredReq                   *blockchain_api.GetNextWorkRequiredRequest
+	responseGetDifficultyInfo                    *blockchain_api.GetDifficultyInfoResponse
+	responseGetMiningCandidateContext            *blockchain_api.GetMiningCandidateContextResponse
 	responseGetBlockExists                       *blockchain_api.GetBlockExistsResponse
 	lastGetBlockExistsReq                        *blockchain_api.GetBlockRequest
 	responseGetBestBlockHeader                   *blockchain_api.GetBlockHeaderResponse
@@ -821,6 +965,11 @@ type mockBlockClient struct {
 	responseGetChainTips                         *blockchain_api.GetChainTipsResponse
 	responseGetBlockHeader                       *blockchain_api.GetBlockHeaderResponse
 	lastGetBlockHeaderReq                        *blockchain_api.GetBlockHeaderRequest
+	responseGetBlockHeaderByHeight               *blockchain_api.GetBlockHeaderResponse
+	lastGetBlockHeaderByHeightReq                *blockchain_api.GetBlockByHeightRequest
+	responseGetBlockHeadersByHashes              *blockchain_api.GetBlockHeadersByHashesResponse
+	responseIsCurrent                            *blockchain_api.IsCurrentResponse
+	lastGetBlockHeadersByHashesReq               *blockchain_api.GetBlockHeadersByHashesRequest
 	responseGetBlockHeaders                      *blockchain_api.GetBlockHeadersResponse
 	lastGetBlockHeadersReq                       *blockchain_api.GetBlockHeadersRequest
 	responseGetBlockHeadersToCommonAncestor      *blockchain_api.GetBlockHeadersResponse
@@ -845,6 +994,8 @@ type mockBlockClient struct {
 	lastGetStateReq                              *blockchain_api.GetStateRequest
 	responseSetState                             *emptypb.Empty
 	lastSetStateReq                              *blockchain_api.SetStateRequest
+	responseCompareAndSetState                   *blockchain_api.CompareAndSetStateResponse
+	lastCompareAndSetStateReq                    *blockchain_api.CompareAndSetStateRequest
 	responseGetBlockIsMined                      *blockchain_api.GetBlockIsMinedResponse
 	lastGetBlockIsMinedReq                       *blockchain_api.GetBlockIsMinedRequest
 	responseSetBlockMinedSet                     *emptypb.Empty
@@ -860,17 +1011,23 @@ type mockBlockClient struct {
 	lastSendFSMEventReq                          *blockchain_api.SendFSMEventRequest
 	responseGetBlockLocator                      *blockchain_api.GetBlockLocatorResponse
 	lastGetBlockLocatorReq                       *blockchain_api.GetBlockLocatorRequest
+	lastGetBlockLocatorByHeightReq               *blockchain_api.GetBlockLocatorByHeightRequest
 	responseLocateBlockHeaders                   *blockchain_api.LocateBlockHeadersResponse
 	lastLocateBlockHeadersReq                    *blockchain_api.LocateBlockHeadersRequest
 	responseGetBestHeightAndTime                 *blockchain_api.GetBestHeightAndTimeResponse
+	responseGetMedianTimeForHeight               *blockchain_api.GetMedianTimeForHeightResponse
+	lastGetMedianTimeForHeightReq                *blockchain_api.GetMedianTimeForHeightRequest
+	responseGetBlockSubsidy                      *blockchain_api.GetBlockSubsidyResponse
+	lastGetBlockSubsidyReq                       *blockchain_api.GetBlockSubsidyRequest
+	lastWaitForBlockHeightReq                    *blockchain_api.WaitForBlockHeightRequest
 	err                                          error
 }
 
-func (m *mockBlockClient) AddBlock(ctx context.Context, in *blockchain_api.AddBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *mockBlockClient) AddBlock(ctx context.Context, in *blockchain_api.AddBlockRequest, opts ...grpc.CallOption) (*blockchain_api.AddBlockResponse, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
-	return &emptypb.Empty{}, nil
+	return &blockchain_api.AddBlockResponse{}, nil
 }
 
 func (m *mockBlockClient) GetBlock(ctx context.Context, req *blockchain_api.GetBlockRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockResponse, error) {
@@ -887,6 +1044,14 @@ func (m *mockBlockClient) GetBlocks(ctx context.Context, req *blockchain_api.Get
 	return m.responseGetBlocks, nil
 }
 
+func (m *mockBlockClient) GetBlocksByHeightRange(ctx context.Context, req *blockchain_api.GetBlocksByHeightRangeRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlocksResponse, error) {
+	m.lastGetBlocksByHeightRangeReq = req
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.responseGetBlocks, nil
+}
+
 func (m *mockBlockClient) GetBlockByHeight(ctx context.Context, req *blockchain_api.GetBlockByHeightRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockResponse, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -988,6 +1153,22 @@ func (m *mockBlockClient) GetNextWorkRequired(
 	return m.responseGetNextWorkRequired, m.err
 }
 
+func (m *mockBlockClient) GetDifficultyInfo(
+	ctx context.Context,
+	in *emptypb.Empty,
+	opts ...grpc.CallOption,
+) (*blockchain_api.GetDifficultyInfoResponse, error) {
+	return m.responseGetDifficultyInfo, m.err
+}
+
+func (m *mockBlockClient) GetMiningCandidateContext(
+	ctx context.Context,
+	in *emptypb.Empty,
+	opts ...grpc.CallOption,
+) (*blockchain_api.GetMiningCandidateContextResponse, error) {
+	return m.responseGetMiningCandidateContext, m.err
+}
+
 func (m *mockBlockClient) GetBlockExists(
 	ctx context.Context,
 	in *blockchain_api.GetBlockRequest,
@@ -1031,6 +1212,23 @@ func (m *mockBlockClient) GetBlockHeader(
 	return m.responseGetBlockHeader, m.err
 }
 
+func (m *mockBlockClient) GetBlockHeaderByHeight(
+	ctx context.Context,
+	in *blockchain_api.GetBlockByHeightRequest,
+	opts ...grpc.CallOption,
+) (*blockchain_api.GetBlockHeaderResponse, error) {
+	m.lastGetBlockHeaderByHeightReq = in
+	return m.responseGetBlockHeaderByHeight, m.err
+}
+
+func (m *mockBlockClient) GetBlockHeadersByHashes(ctx context.Context, in *blockchain_api.GetBlockHeadersByHashesRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockHeadersByHashesResponse, error) {
+	m.lastGetBlockHeadersByHashesReq = in
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.responseGetBlockHeadersByHashes, nil
+}
+
 func (m *mockBlockClient) GetBlockHeaders(
 	ctx context.Context,
 	in *blockchain_api.GetBlockHeadersRequest,
@@ -1139,6 +1337,15 @@ func (m *mockBlockClient) SetState(
 	return m.responseSetState, m.err
 }
 
+func (m *mockBlockClient) CompareAndSetState(
+	ctx context.Context,
+	in *blockchain_api.CompareAndSetStateRequest,
+	opts ...grpc.CallOption,
+) (*blockchain_api.CompareAndSetStateResponse, error) {
+	m.lastCompareAndSetStateReq = in
+	return m.responseCompareAndSetState, m.err
+}
+
 func (m *mockBlockClient) GetBlockIsMined(
 	ctx context.Context,
 	in *blockchain_api.GetBlockIsMinedRequest,
@@ -1199,6 +1406,13 @@ func (m *mockBlockClient) GetFSMCurrentState(
 	return m.responseGetFSMCurrentState, m.err
 }
 
+func (m *mockBlockClient) IsCurrent(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blockchain_api.IsCurrentResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.responseIsCurrent, nil
+}
+
 func (m *mockBlockClient) SendFSMEvent(
 	ctx context.Context,
 	in *blockchain_api.SendFSMEventRequest,
@@ -1228,6 +1442,10 @@ func (m *mockBlockClient) GetBlockLocator(ctx context.Context, req *blockchain_a
 	m.lastGetBlockLocatorReq = req
 	return m.responseGetBlockLocator, m.err
 }
+func (m *mockBlockClient) GetBlockLocatorByHeight(ctx context.Context, req *blockchain_api.GetBlockLocatorByHeightRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockLocatorResponse, error) {
+	m.lastGetBlockLocatorByHeightReq = req
+	return m.responseGetBlockLocator, m.err
+}
 func (m *mockBlockClient) LocateBlockHeaders(ctx context.Context, req *blockchain_api.LocateBlockHeadersRequest, opts ...grpc.CallOption) (*blockchain_api.LocateBlockHeadersResponse, error) {
 	m.lastLocateBlockHeadersReq = req
 	return m.responseLocateBlockHeaders, m.err
@@ -1235,3 +1453,15 @@ func (m *mockBlockClient) LocateBlockHeaders(ctx context.Context, req *blockchai
 func (m *mockBlockClient) GetBestHeightAndTime(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (*blockchain_api.GetBestHeightAndTimeResponse, error) {
 	return m.responseGetBestHeightAndTime, m.err
 }
+func (m *mockBlockClient) GetMedianTimeForHeight(ctx context.Context, req *blockchain_api.GetMedianTimeForHeightRequest, opts ...grpc.CallOption) (*blockchain_api.GetMedianTimeForHeightResponse, error) {
+	m.lastGetMedianTimeForHeightReq = req
+	return m.responseGetMedianTimeForHeight, m.err
+}
+func (m *mockBlockClient) GetBlockSubsidy(ctx context.Context, req *blockchain_api.GetBlockSubsidyRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockSubsidyResponse, error) {
+	m.lastGetBlockSubsidyReq = req
+	return m.responseGetBlockSubsidy, m.err
+}
+func (m *mockBlockClient) WaitForBlockHeight(ctx context.Context, req *blockchain_api.WaitForBlockHeightRequest, opts ...grpc.CallOption) (*blockchain_api.GetBlockHeaderResponse, error) {
+	m.lastWaitForBlockHeightReq = req
+	return m.responseGetBlockHeader, m.err
+}
@@ -6,10 +6,13 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/json"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +35,7 @@ import (
 	"github.com/bsv-blockchain/go-chaincfg"
 	"github.com/bsv-blockchain/go-subtree"
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -89,6 +93,192 @@ func Test_AddBlock(t *testing.T) {
 	assert.Equal(t, mockBlk.SizeInBytes, addedBlock.SizeInBytes)
 }
 
+// Test_AddBlock_AlreadyStored verifies that adding a block again returns its height without notifying again.
+func Test_AddBlock_AlreadyStored(t *testing.T) {
+	newRequest := func(blk *model.Block) *blockchain_api.AddBlockRequest {
+		subtreeHashes := make([][]byte, len(blk.Subtrees))
+		for i, hash := range blk.Subtrees {
+			subtreeHashes[i] = hash[:]
+		}
+
+		return &blockchain_api.AddBlockRequest{
+			Header:           blk.Header.Bytes(),
+			CoinbaseTx:       blk.CoinbaseTx.Bytes(),
+			SubtreeHashes:    subtreeHashes,
+			TransactionCount: blk.TransactionCount,
+			SizeInBytes:      blk.SizeInBytes,
+			PeerId:           "test-peer",
+		}
+	}
+
+	t.Run("sequential", func(t *testing.T) {
+		ctx := setup(t)
+		request := newRequest(mockBlock(ctx, t))
+
+		first, err := ctx.server.AddBlock(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, first.AlreadyExists)
+
+		second, err := ctx.server.AddBlock(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, second.AlreadyExists)
+		assert.Equal(t, first.Height, second.Height)
+
+		assert.Len(t, ctx.server.notifications, 1)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		ctx := setup(t)
+		request := newRequest(mockBlock(ctx, t))
+
+		var (
+			wg    sync.WaitGroup
+			added atomic.Int32
+		)
+
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				resp, err := ctx.server.AddBlock(context.Background(), request)
+				assert.NoError(t, err)
+
+				if err == nil && !resp.AlreadyExists {
+					added.Add(1)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, int32(1), added.Load())
+		assert.Len(t, ctx.server.notifications, 1)
+		assert.Empty(t, ctx.server.addBlockLocks)
+	})
+}
+
+// Test_AddBlock_WaitForKafka verifies that AddBlock waits for the Kafka delivery when asked to.
+func Test_AddBlock_WaitForKafka(t *testing.T) {
+	// setupKafka replaces the Kafka producer of the server by a channel of which the messages are
+	// delivered with the given error, or not delivered at all when deliver is false
+	setupKafka := func(t *testing.T, deliver bool, deliveryErr error) *testContext {
+		ctx := setup(t)
+		ctx.server.blocksFinalKafkaAsyncProducer = kafka.NewKafkaAsyncProducerMock()
+		ctx.server.kafkaChan = make(chan *kafka.Message, 1)
+
+		go func() {
+			for message := range ctx.server.kafkaChan {
+				if deliver {
+					message.Delivered(deliveryErr)
+				}
+			}
+		}()
+
+		t.Cleanup(func() {
+			close(ctx.server.kafkaChan)
+		})
+
+		return ctx
+	}
+
+	newRequest := func(ctx *testContext) (*blockchain_api.AddBlockRequest, *model.Block) {
+		blk := mockBlock(ctx, t)
+
+		return &blockchain_api.AddBlockRequest{
+			Header:             blk.Header.Bytes(),
+			CoinbaseTx:         blk.CoinbaseTx.Bytes(),
+			TransactionCount:   blk.TransactionCount,
+			SizeInBytes:        blk.SizeInBytes,
+			PeerId:             "test-peer",
+			OptionWaitForKafka: true,
+		}, blk
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		ctx := setupKafka(t, true, nil)
+		request, _ := newRequest(ctx)
+
+		_, err := ctx.server.AddBlock(context.Background(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("delivery error", func(t *testing.T) {
+		ctx := setupKafka(t, true, errors.NewServiceError("broker unavailable"))
+		request, blk := newRequest(ctx)
+
+		_, err := ctx.server.AddBlock(context.Background(), request)
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrServiceError))
+
+		// the block is stored and notified, even though the delivery failed
+		exists, err := ctx.server.store.GetBlockExists(context.Background(), blk.Hash())
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Len(t, ctx.server.notifications, 1)
+	})
+
+	t.Run("retry after delivery error", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.blocksFinalKafkaAsyncProducer = kafka.NewKafkaAsyncProducerMock()
+		ctx.server.kafkaChan = make(chan *kafka.Message, 1)
+
+		// the first message fails to be delivered, the next ones are delivered
+		published := make(chan *kafka.Message, 2)
+
+		go func() {
+			var deliveryErr error = errors.NewServiceError("broker unavailable")
+
+			for message := range ctx.server.kafkaChan {
+				published <- message
+				message.Delivered(deliveryErr)
+				deliveryErr = nil
+			}
+		}()
+
+		t.Cleanup(func() {
+			close(ctx.server.kafkaChan)
+		})
+
+		request, blk := newRequest(ctx)
+
+		_, err := ctx.server.AddBlock(context.Background(), request)
+		require.Error(t, err)
+		require.Len(t, published, 1)
+		<-published
+
+		resp, err := ctx.server.AddBlock(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, resp.AlreadyExists)
+
+		// the retry published the already stored block again, without notifying it again
+		require.Len(t, published, 1)
+		message := <-published
+		assert.Equal(t, blk.Hash().CloneBytes(), message.Key)
+		assert.Len(t, ctx.server.notifications, 1)
+	})
+
+	t.Run("delivery timeout", func(t *testing.T) {
+		ctx := setupKafka(t, false, nil)
+		ctx.server.settings.BlockChain.KafkaDeliveryTimeout = 10 * time.Millisecond
+		request, _ := newRequest(ctx)
+
+		_, err := ctx.server.AddBlock(context.Background(), request)
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrServiceError))
+	})
+
+	t.Run("async by default", func(t *testing.T) {
+		ctx := setupKafka(t, false, nil)
+		request, _ := newRequest(ctx)
+		request.OptionWaitForKafka = false
+
+		_, err := ctx.server.AddBlock(context.Background(), request)
+		require.NoError(t, err)
+	})
+}
+
 // Test_GetBlock verifies the block retrieval functionality.
 func Test_GetBlock(t *testing.T) {
 	ctx := setup(t)
@@ -135,6 +325,39 @@ func Test_GetFSMCurrentState(t *testing.T) {
 	assert.Equal(t, blockchain_api.FSMStateType_IDLE, response.State, "Expected FSM state did not match")
 }
 
+// Test_IsCurrent verifies that the node is only current with a recent best block above the checkpoints and a running FSM.
+func Test_IsCurrent(t *testing.T) {
+	ctx := setup(t)
+
+	resp, err := ctx.server.IsCurrent(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.False(t, resp.Current)
+	assert.Equal(t, []blockchain_api.NotCurrentReason{
+		blockchain_api.NotCurrentReason_BELOW_CHECKPOINT,
+		blockchain_api.NotCurrentReason_TIP_TOO_OLD,
+		blockchain_api.NotCurrentReason_FSM_NOT_RUNNING,
+	}, resp.Reasons)
+
+	// a recent best block on a chain without checkpoints
+	storeTestChain(t, ctx, 1)
+	ctx.server.settings.ChainCfgParams = &chaincfg.RegressionNetParams
+
+	resp, err = ctx.server.IsCurrent(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.False(t, resp.Current)
+	assert.Equal(t, []blockchain_api.NotCurrentReason{blockchain_api.NotCurrentReason_FSM_NOT_RUNNING}, resp.Reasons)
+
+	ctx.server.subscriptionManagerReady.Store(true)
+
+	_, err = ctx.server.SendFSMEvent(context.Background(), &blockchain_api.SendFSMEventRequest{Event: blockchain_api.FSMEventType_RUN})
+	require.NoError(t, err)
+
+	resp, err = ctx.server.IsCurrent(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.True(t, resp.Current)
+	assert.Empty(t, resp.Reasons)
+}
+
 // testContext holds the test environment components
 type testContext struct {
 	server       *Blockchain    // Blockchain server instance
@@ -624,6 +847,8 @@ func Test_GetLastNBlocks(t *testing.T) {
 	tSettings.ChainCfgParams = &chaincfg.MainNetParams
 	prevHash := tSettings.ChainCfgParams.GenesisHash
 
+	var lastBlock *model.Block
+
 	for i := 0; i <= 5; i++ {
 		// Create a unique coinbase transaction for each block
 		coinbase := bt.NewTx()
@@ -662,8 +887,24 @@ func Test_GetLastNBlocks(t *testing.T) {
 
 		// Update prevHash for next block
 		prevHash = block.Hash()
+		lastBlock = block
+	}
+
+	// Store a fork of the last block
+	forkHeader := *lastBlock.Header
+	forkHeader.Nonce++
+
+	forkBlock := &model.Block{
+		Header:           &forkHeader,
+		CoinbaseTx:       lastBlock.CoinbaseTx,
+		Height:           lastBlock.Height,
+		TransactionCount: lastBlock.TransactionCount,
+		SizeInBytes:      lastBlock.SizeInBytes,
 	}
 
+	_, _, err := ctx.server.store.StoreBlock(context.Background(), forkBlock, "peer2")
+	require.NoError(t, err)
+
 	t.Run("get last N blocks", func(t *testing.T) {
 		request := &blockchain_api.GetLastNBlocksRequest{
 			NumberOfBlocks: 3,
@@ -674,6 +915,32 @@ func Test_GetLastNBlocks(t *testing.T) {
 		require.NotNil(t, response)
 		assert.LessOrEqual(t, len(response.Blocks), 3)
 	})
+
+	t.Run("selections", func(t *testing.T) {
+		mainChain, err := ctx.server.GetLastNBlocks(context.Background(), &blockchain_api.GetLastNBlocksRequest{
+			NumberOfBlocks: 10,
+		})
+		require.NoError(t, err)
+
+		// requests of clients that only set includeOrphans return the main chain and orphans
+		all, err := ctx.server.GetLastNBlocks(context.Background(), &blockchain_api.GetLastNBlocksRequest{
+			NumberOfBlocks: 10,
+			IncludeOrphans: true,
+		})
+		require.NoError(t, err)
+		assert.Len(t, all.Blocks, 8) // genesis + 6 blocks + fork
+
+		orphans, err := ctx.server.GetLastNBlocks(context.Background(), &blockchain_api.GetLastNBlocksRequest{
+			NumberOfBlocks: 10,
+			Selection:      model.BlockSelection_OrphansOnly,
+		})
+		require.NoError(t, err)
+		assert.Len(t, orphans.Blocks, len(all.Blocks)-len(mainChain.Blocks))
+
+		for i := 1; i < len(all.Blocks); i++ {
+			assert.GreaterOrEqual(t, all.Blocks[i-1].Height, all.Blocks[i].Height)
+		}
+	})
 }
 
 // Test_GetBlockHeadersFromCommonAncestor verifies the GetBlockHeadersFromCommonAncestor functionality.
@@ -991,418 +1258,1355 @@ func Test_Subscribe(t *testing.T) {
 	}
 }
 
-// mockSubscribeServer implements blockchain_api.BlockchainAPI_SubscribeServer for testing
-type mockSubscribeServer struct {
-	blockchain_api.BlockchainAPI_SubscribeServer
-	context context.Context
-	cancel  context.CancelFunc
-	sent    []*blockchain_api.Notification
-	mu      sync.Mutex
-}
+// Test_Subscribe_NotificationTypes tests that subscribers only receive the notification types they subscribed to
+func Test_Subscribe_NotificationTypes(t *testing.T) {
+	ctx := setup(t)
 
-func (m *mockSubscribeServer) Send(notification *blockchain_api.Notification) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.sent = append(m.sent, notification)
-	return nil
-}
+	if !ctx.server.subscriptionManagerReady.Load() {
+		go ctx.server.startSubscriptions()
 
-func (m *mockSubscribeServer) Context() context.Context {
-	if m.cancel == nil {
-		m.context, m.cancel = context.WithCancel(m.context)
+		require.Eventually(t, ctx.server.subscriptionManagerReady.Load, time.Second, 10*time.Millisecond)
 	}
-	return m.context
-}
 
-func (m *mockSubscribeServer) Cancel() {
-	if m.cancel != nil {
-		m.cancel()
+	mockStream := &mockSubscribeServer{
+		context: context.Background(),
+		sent:    make([]*blockchain_api.Notification, 0),
 	}
-}
 
-// Test_GetBlockLocator tests the GetBlockLocator functionality
-func Test_GetBlockLocator(t *testing.T) {
-	ctx := setup(t)
+	done := make(chan error, 1)
+	go func() {
+		done <- ctx.server.Subscribe(&blockchain_api.SubscribeRequest{
+			Source:            "test-subscriber",
+			NotificationTypes: []model.NotificationType{model.NotificationType_FSMState},
+		}, mockStream)
+	}()
 
-	// Store blocks
-	block := mockBlock(ctx, t)
-	_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
-	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		ctx.server.subscribersMu.RLock()
+		defer ctx.server.subscribersMu.RUnlock()
 
-	t.Run("get block locator", func(t *testing.T) {
-		request := &blockchain_api.GetBlockLocatorRequest{
-			Hash:   block.Hash().CloneBytes(),
-			Height: block.Height,
-		}
+		return len(ctx.server.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
 
-		response, err := ctx.server.GetBlockLocator(context.Background(), request)
-		require.NoError(t, err)
-		require.NotNil(t, response)
-		assert.NotNil(t, response.Locator)
-	})
-}
+	ctx.server.notifications <- &blockchain_api.Notification{Type: model.NotificationType_Block}
+	ctx.server.notifications <- &blockchain_api.Notification{Type: model.NotificationType_FSMState}
 
-func TestBlockchainStart(t *testing.T) {
-	ctx := context.Background()
-	logger := ulogger.NewErrorTestLogger(t)
+	require.Eventually(t, func() bool {
+		mockStream.mu.Lock()
+		defer mockStream.mu.Unlock()
 
-	// settings
-	tSettings := test.CreateBaseTestSettings(t)
-	tSettings.ChainCfgParams = &chaincfg.MainNetParams
-	tSettings.BlockChain.GRPCListenAddress = ""
-	tSettings.BlockChain.HTTPListenAddress = ""
+		return len(mockStream.sent) > 0
+	}, time.Second, 10*time.Millisecond)
 
-	// in-store memory
-	storeURL, err := url.Parse("sqlitememory:///blockchain")
-	require.NoError(t, err)
-	blockchainStore, err := sql.New(logger, storeURL, tSettings)
-	require.NoError(t, err)
+	// give a wrongly forwarded block notification the chance to arrive
+	time.Sleep(50 * time.Millisecond)
 
-	// kafka mock producer
-	mockProducer := kafka.NewKafkaAsyncProducerMock()
+	mockStream.mu.Lock()
+	require.Len(t, mockStream.sent, 1)
+	assert.Equal(t, model.NotificationType_FSMState, mockStream.sent[0].Type)
+	mockStream.mu.Unlock()
 
-	// Instantiate server passing mockProducer
-	server, err := New(ctx, logger, tSettings, blockchainStore, mockProducer)
-	require.NoError(t, err)
-	require.NotNil(t, server)
+	mockStream.Cancel()
 
-	require.NoError(t, server.Init(ctx))
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Subscription didn't end in time")
+	}
+}
 
-	readyCh := make(chan struct{})
-	runCtx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// Test_Subscribe_NotificationOrder tests that notifications are sent to a subscriber in the order they were sent
+func Test_Subscribe_NotificationOrder(t *testing.T) {
+	ctx := setup(t)
+
+	if !ctx.server.subscriptionManagerReady.Load() {
+		go ctx.server.startSubscriptions()
+
+		require.Eventually(t, ctx.server.subscriptionManagerReady.Load, time.Second, 10*time.Millisecond)
+	}
+
+	mockStream := &mockSubscribeServer{
+		context: context.Background(),
+		sent:    make([]*blockchain_api.Notification, 0),
+	}
 
+	done := make(chan error, 1)
 	go func() {
-		_ = server.Start(runCtx, readyCh)
+		done <- ctx.server.Subscribe(&blockchain_api.SubscribeRequest{
+			Source:            "test-subscriber",
+			NotificationTypes: []model.NotificationType{model.NotificationType_Subtree},
+		}, mockStream)
 	}()
 
-	select {
-	case <-readyCh:
+	require.Eventually(t, func() bool {
+		ctx.server.subscribersMu.RLock()
+		defer ctx.server.subscribersMu.RUnlock()
 
-	case <-time.After(time.Second):
-		t.Fatal("timeout waiting for blockchain server to start")
+		return len(ctx.server.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	const nrNotifications = 100
+
+	for i := 0; i < nrNotifications; i++ {
+		ctx.server.notifications <- &blockchain_api.Notification{Type: model.NotificationType_Subtree, Hash: []byte{byte(i)}}
 	}
 
-	cancel()
-}
+	require.Eventually(t, func() bool {
+		mockStream.mu.Lock()
+		defer mockStream.mu.Unlock()
 
-func TestBlockchainStartGRPCError(t *testing.T) {
-	ctx := context.Background()
-	logger := ulogger.NewErrorTestLogger(t)
+		return len(mockStream.sent) == nrNotifications
+	}, time.Second, 10*time.Millisecond)
 
-	tSettings := test.CreateBaseTestSettings(t)
-	tSettings.ChainCfgParams = &chaincfg.MainNetParams
+	mockStream.mu.Lock()
+	for i, notification := range mockStream.sent {
+		assert.Equal(t, []byte{byte(i)}, notification.Hash)
+	}
+	mockStream.mu.Unlock()
 
-	tSettings.BlockChain.HTTPListenAddress = "127.0.0.1:0"
+	mockStream.Cancel()
 
-	tSettings.BlockChain.GRPCListenAddress = "bad:address"
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Subscription didn't end in time")
+	}
+}
 
-	storeURL, err := url.Parse("sqlitememory:///blockchain_error")
-	require.NoError(t, err)
-	blockchainStore, err := sql.New(logger, storeURL, tSettings)
-	require.NoError(t, err)
+// Test_Subscribe_SlowSubscriberDropped tests that a subscriber that does not keep up with the notifications is dropped
+func Test_Subscribe_SlowSubscriberDropped(t *testing.T) {
+	ctx := setup(t)
 
-	mockProducer := kafka.NewKafkaAsyncProducerMock()
+	if !ctx.server.subscriptionManagerReady.Load() {
+		go ctx.server.startSubscriptions()
 
-	server, err := New(ctx, logger, tSettings, blockchainStore, mockProducer)
-	require.NoError(t, err)
+		require.Eventually(t, ctx.server.subscriptionManagerReady.Load, time.Second, 10*time.Millisecond)
+	}
 
-	require.NoError(t, server.Init(ctx))
+	mockStream := &mockSubscribeServer{
+		context: context.Background(),
+		sent:    make([]*blockchain_api.Notification, 0),
+		blockCh: make(chan struct{}),
+	}
+	defer close(mockStream.blockCh)
 
-	readyCh := make(chan struct{})
-	err = server.Start(ctx, readyCh)
+	done := make(chan error, 1)
+	go func() {
+		done <- ctx.server.Subscribe(&blockchain_api.SubscribeRequest{
+			Source:            "slow-subscriber",
+			NotificationTypes: []model.NotificationType{model.NotificationType_Subtree},
+		}, mockStream)
+	}()
 
-	require.Error(t, err)
+	require.Eventually(t, func() bool {
+		ctx.server.subscribersMu.RLock()
+		defer ctx.server.subscribersMu.RUnlock()
 
-	require.Contains(t, err.Error(), "can't start GRPC server")
-}
+		return len(ctx.server.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
 
-func TestInvalidateHandler(t *testing.T) {
-	ctx := context.Background()
+	// one notification is taken by the blocked sender, the rest fill up the queue and overflow it
+	for i := 0; i < subscriberNotificationBufferSize+2; i++ {
+		ctx.server.notifications <- &blockchain_api.Notification{Type: model.NotificationType_Subtree}
+	}
 
-	logger := ulogger.NewErrorTestLogger(t)
-	e := echo.New()
-	tSettings := test.CreateBaseTestSettings(t)
-	tSettings.ChainCfgParams = &chaincfg.MainNetParams
-	tSettings.BlockChain.GRPCListenAddress = ""
-	tSettings.BlockChain.HTTPListenAddress = ""
-	mockProducer := kafka.NewKafkaAsyncProducerMock()
-	storeURL, err := url.Parse("sqlitememory:///blockchain")
-	require.NoError(t, err)
-	blockchainStore, err := sql.New(logger, storeURL, tSettings)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Slow subscription was not dropped")
+	}
+
+	ctx.server.subscribersMu.RLock()
+	assert.Empty(t, ctx.server.subscribers)
+	ctx.server.subscribersMu.RUnlock()
+}
+
+// Test_removeSubscriber_QueueDepthGauge tests that removing a subscriber keeps the queue depth gauge of the other
+// subscribers with the same source
+func Test_removeSubscriber_QueueDepthGauge(t *testing.T) {
+	ctx := setup(t)
+
+	newSub := func() subscriber {
+		return subscriber{
+			source:        "same-source",
+			id:            strconv.FormatUint(ctx.server.subscriberIDs.Add(1), 10),
+			done:          make(chan struct{}),
+			notifications: make(chan *blockchain_api.Notification, 1),
+		}
+	}
+
+	sub1, sub2 := newSub(), newSub()
+
+	for _, sub := range []subscriber{sub1, sub2} {
+		ctx.server.subscribers[sub] = true
+		prometheusBlockchainSubscriberQueueDepth.WithLabelValues(sub.source, sub.id).Set(1)
+	}
+
+	ctx.server.removeSubscriber(sub1)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(prometheusBlockchainSubscriberQueueDepth.WithLabelValues(sub2.source, sub2.id)))
+	assert.False(t, prometheusBlockchainSubscriberQueueDepth.DeleteLabelValues(sub1.source, sub1.id))
+	assert.True(t, prometheusBlockchainSubscriberQueueDepth.DeleteLabelValues(sub2.source, sub2.id))
+}
+
+func Test_subscriber_wantsNotification(t *testing.T) {
+	all := subscriber{notificationTypes: newNotificationTypeFilter(nil)}
+	assert.True(t, all.wantsNotification(model.NotificationType_Block))
+	assert.True(t, all.wantsNotification(model.NotificationType_PeerFailure))
+
+	blocks := subscriber{notificationTypes: newNotificationTypeFilter([]model.NotificationType{
+		model.NotificationType_Block,
+		model.NotificationType_BlockSubtreesSet,
+	})}
+	assert.True(t, blocks.wantsNotification(model.NotificationType_Block))
+	assert.True(t, blocks.wantsNotification(model.NotificationType_BlockSubtreesSet))
+	assert.False(t, blocks.wantsNotification(model.NotificationType_Subtree))
+	assert.False(t, blocks.wantsNotification(model.NotificationType_FSMState))
+}
+
+// Test_SubscribeFSMState tests that FSM state subscribers receive the current state and all following transitions
+func Test_SubscribeFSMState(t *testing.T) {
+	ctx := setup(t)
+	ctx.server.subscriptionManagerReady.Store(true)
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+
+	mockStream := &mockSubscribeFSMStateServer{context: streamCtx}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- ctx.server.SubscribeFSMState(&blockchain_api.SubscribeFSMStateRequest{Source: "test-subscriber"}, mockStream)
+	}()
+
+	// the current state is sent straight away
+	require.Eventually(t, func() bool {
+		return len(mockStream.getSent()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	sent := mockStream.getSent()
+	assert.Equal(t, blockchain_api.FSMStateType_IDLE, sent[0].OldState)
+	assert.Equal(t, blockchain_api.FSMStateType_IDLE, sent[0].NewState)
+
+	_, err := ctx.server.SendFSMEvent(context.Background(), &blockchain_api.SendFSMEventRequest{Event: blockchain_api.FSMEventType_RUN})
 	require.NoError(t, err)
-	t.Run("invalid has detected", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodPost, "/invalidate/notAHash", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues("notAHash")
 
-		server, _ := New(ctx, logger, tSettings, blockchainStore, mockProducer)
-		err := server.invalidateHandler(c)
+	require.Eventually(t, func() bool {
+		return len(mockStream.getSent()) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	sent = mockStream.getSent()
+	assert.Equal(t, blockchain_api.FSMStateType_IDLE, sent[1].OldState)
+	assert.Equal(t, blockchain_api.FSMStateType_RUNNING, sent[1].NewState)
+	assert.Equal(t, blockchain_api.FSMEventType_RUN.String(), sent[1].Event)
+
+	cancel()
+
+	select {
+	case err = <-done:
 		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Subscription didn't end in time")
+	}
 
-		assert.Equal(t, http.StatusBadRequest, rec.Code)
-		assert.Contains(t, rec.Body.String(), "invalid hash")
+	ctx.server.fsmSubscribersMu.Lock()
+	assert.Empty(t, ctx.server.fsmSubscribers)
+	ctx.server.fsmSubscribersMu.Unlock()
+}
+
+// mockSubscribeFSMStateServer implements blockchain_api.BlockchainAPI_SubscribeFSMStateServer for testing
+type mockSubscribeFSMStateServer struct {
+	blockchain_api.BlockchainAPI_SubscribeFSMStateServer
+	context context.Context
+	sent    []*blockchain_api.FSMStateChange
+	mu      sync.Mutex
+}
+
+func (m *mockSubscribeFSMStateServer) Send(stateChange *blockchain_api.FSMStateChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, stateChange)
+	return nil
+}
+
+func (m *mockSubscribeFSMStateServer) Context() context.Context {
+	return m.context
+}
+
+func (m *mockSubscribeFSMStateServer) getSent() []*blockchain_api.FSMStateChange {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*blockchain_api.FSMStateChange{}, m.sent...)
+}
+
+// mockSubscribeServer implements blockchain_api.BlockchainAPI_SubscribeServer for testing
+type mockSubscribeServer struct {
+	blockchain_api.BlockchainAPI_SubscribeServer
+	context context.Context
+	cancel  context.CancelFunc
+	sent    []*blockchain_api.Notification
+	mu      sync.Mutex
+	blockCh chan struct{} // when set, Send blocks until the channel is closed
+}
+
+func (m *mockSubscribeServer) Send(notification *blockchain_api.Notification) error {
+	if m.blockCh != nil {
+		<-m.blockCh
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, notification)
+	return nil
+}
+
+func (m *mockSubscribeServer) Context() context.Context {
+	if m.cancel == nil {
+		m.context, m.cancel = context.WithCancel(m.context)
+	}
+	return m.context
+}
+
+func (m *mockSubscribeServer) Cancel() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// Test_GetBlockLocator tests the GetBlockLocator functionality
+func Test_GetBlockLocator(t *testing.T) {
+	ctx := setup(t)
+
+	// Store blocks
+	block := mockBlock(ctx, t)
+	_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
+	require.NoError(t, err)
+
+	t.Run("get block locator", func(t *testing.T) {
+		request := &blockchain_api.GetBlockLocatorRequest{
+			Hash:   block.Hash().CloneBytes(),
+			Height: block.Height,
+		}
+
+		response, err := ctx.server.GetBlockLocator(context.Background(), request)
+		require.NoError(t, err)
+		require.NotNil(t, response)
+		assert.NotNil(t, response.Locator)
 	})
+}
 
-	t.Run("invalidation fails", func(t *testing.T) {
-		validHash := chainhash.DoubleHashH([]byte("abc")).String()
-		req := httptest.NewRequest(http.MethodPost, "/invalidate/"+validHash, nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues(validHash)
-		server, _ := New(ctx, logger, tSettings, blockchainStore, mockProducer)
-		err := server.invalidateHandler(c)
+func Test_GetBlockLocatorByHeight(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 3)
+
+	t.Run("same locator as by hash", func(t *testing.T) {
+		byHash, err := ctx.server.GetBlockLocator(context.Background(), &blockchain_api.GetBlockLocatorRequest{
+			Hash:   blocks[1].Hash().CloneBytes(),
+			Height: 2,
+		})
 		require.NoError(t, err)
 
-		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "error invalidating block")
+		byHeight, err := ctx.server.GetBlockLocatorByHeight(context.Background(), &blockchain_api.GetBlockLocatorByHeightRequest{
+			Height: 2,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, byHeight.Locator, 3)
+		assert.Equal(t, blocks[1].Hash().CloneBytes(), byHeight.Locator[0])
+		assert.Equal(t, byHash.Locator, byHeight.Locator)
 	})
 
-	t.Run("success", func(t *testing.T) {
-		ctx := setup(t)
-		block := mockBlock(ctx, t)
-		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
+	t.Run("no block at height", func(t *testing.T) {
+		_, err := ctx.server.GetBlockLocatorByHeight(context.Background(), &blockchain_api.GetBlockLocatorByHeightRequest{
+			Height: 4,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrBlockNotFound))
+	})
+}
+
+func Test_GetMedianTimeForHeight(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 13)
+
+	t.Run("median of the last 11 blocks", func(t *testing.T) {
+		response, err := ctx.server.GetMedianTimeForHeight(context.Background(), &blockchain_api.GetMedianTimeForHeightRequest{
+			Height: 12,
+		})
 		require.NoError(t, err)
-		validHash := block.Hash().String()
-		req := httptest.NewRequest(http.MethodPost, "/invalidate/"+validHash, nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues(validHash)
-		err = ctx.server.invalidateHandler(c)
+
+		// blocks 2 to 12 are used, the median is the timestamp of block 7
+		assert.Equal(t, blocks[6].Header.Timestamp, response.Time)
+	})
+
+	t.Run("fewer blocks near genesis", func(t *testing.T) {
+		response, err := ctx.server.GetMedianTimeForHeight(context.Background(), &blockchain_api.GetMedianTimeForHeightRequest{
+			Height: 3,
+		})
 		require.NoError(t, err)
 
-		assert.Equal(t, http.StatusOK, rec.Code)
-		assert.Contains(t, rec.Body.String(), "block invalidated")
+		// genesis and blocks 1 to 3 are used, the median is the timestamp of block 2
+		assert.Equal(t, blocks[1].Header.Timestamp, response.Time)
+	})
+
+	t.Run("no block at height", func(t *testing.T) {
+		_, err := ctx.server.GetMedianTimeForHeight(context.Background(), &blockchain_api.GetMedianTimeForHeightRequest{
+			Height: 14,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrBlockNotFound))
 	})
 }
 
-func TestRevalidateHandler(t *testing.T) {
+func Test_GetBlockSubsidy(t *testing.T) {
 	ctx := setup(t)
-	e := echo.New()
 
-	t.Run("invalid hash returns 400", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodPost, "/revalidate/notAHash", nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues("notAHash")
+	t.Run("subsidy and next reduction height", func(t *testing.T) {
+		response, err := ctx.server.GetBlockSubsidy(context.Background(), &blockchain_api.GetBlockSubsidyRequest{
+			Height: 840_000,
+		})
+		require.NoError(t, err)
 
-		err := ctx.server.revalidateHandler(c)
+		assert.Equal(t, uint64(312_500_000), response.Subsidy)
+		assert.Equal(t, uint32(1_050_000), response.NextReductionHeight)
+	})
+
+	t.Run("no further reduction", func(t *testing.T) {
+		response, err := ctx.server.GetBlockSubsidy(context.Background(), &blockchain_api.GetBlockSubsidyRequest{
+			Height: 6_930_000,
+		})
 		require.NoError(t, err)
 
-		assert.Equal(t, http.StatusBadRequest, rec.Code)
-		assert.Contains(t, rec.Body.String(), "invalid hash")
+		assert.Equal(t, uint64(0), response.Subsidy)
+		assert.Equal(t, uint32(0), response.NextReductionHeight)
 	})
 
-	t.Run("non-existent block returns 500", func(t *testing.T) {
-		nonExistentHash := chainhash.DoubleHashH([]byte("nope")).String()
+	t.Run("no subsidy reduction interval", func(t *testing.T) {
+		ctx.server.settings.ChainCfgParams = &chaincfg.Params{}
 
-		req := httptest.NewRequest(http.MethodPost, "/revalidate/"+nonExistentHash, nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues(nonExistentHash)
+		_, err := ctx.server.GetBlockSubsidy(context.Background(), &blockchain_api.GetBlockSubsidyRequest{
+			Height: 100,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrConfiguration))
+	})
+}
 
-		err := ctx.server.revalidateHandler(c)
+func Test_WaitForBlockHeight(t *testing.T) {
+	t.Run("height already reached", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 3)
+
+		response, err := ctx.server.WaitForBlockHeight(context.Background(), &blockchain_api.WaitForBlockHeightRequest{
+			Height: 2,
+		})
 		require.NoError(t, err)
 
-		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "error revalidating block")
+		assert.Equal(t, blocks[1].Header.Bytes(), response.BlockHeader)
+		assert.Equal(t, uint32(2), response.Height)
 	})
 
-	t.Run("existing block returns 200", func(t *testing.T) {
-		block := mockBlock(ctx, t)
-		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
-		require.NoError(t, err)
+	t.Run("waits for the block notification", func(t *testing.T) {
+		ctx := setup(t)
 
-		validHash := block.Hash().String()
-		req := httptest.NewRequest(http.MethodPost, "/revalidate/"+validHash, nil)
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.SetParamNames("hash")
-		c.SetParamValues(validHash)
+		type result struct {
+			response *blockchain_api.GetBlockHeaderResponse
+			err      error
+		}
 
-		err = ctx.server.revalidateHandler(c)
+		resultCh := make(chan result, 1)
+
+		go func() {
+			response, err := ctx.server.WaitForBlockHeight(context.Background(), &blockchain_api.WaitForBlockHeightRequest{
+				Height: 2,
+			})
+			resultCh <- result{response, err}
+		}()
+
+		select {
+		case <-resultCh:
+			t.Fatal("WaitForBlockHeight returned before the height was reached")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		blocks := storeTestChain(t, ctx, 2)
+
+		_, err := ctx.server.SendNotification(context.Background(), &blockchain_api.Notification{
+			Type: model.NotificationType_Block,
+			Hash: blocks[1].Hash().CloneBytes(),
+		})
 		require.NoError(t, err)
 
-		assert.Equal(t, http.StatusOK, rec.Code)
-		assert.Contains(t, rec.Body.String(), "block revalidated")
+		select {
+		case res := <-resultCh:
+			require.NoError(t, res.err)
+			assert.Equal(t, blocks[1].Header.Bytes(), res.response.BlockHeader)
+		case <-time.After(5 * time.Second):
+			t.Fatal("WaitForBlockHeight did not return after the block notification")
+		}
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx := setup(t)
+		storeTestChain(t, ctx, 1)
+
+		waitCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := ctx.server.WaitForBlockHeight(waitCtx, &blockchain_api.WaitForBlockHeightRequest{
+			Height: 10,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrContextCanceled))
+
+		assert.Empty(t, ctx.server.blockWaiters)
 	})
 }
 
-func TestGetBlocks(t *testing.T) {
-	ctx := setup(t)
+func TestBlockchainStart(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
 
-	t.Run("invalid hash returns error", func(t *testing.T) {
-		req := &blockchain_api.GetBlocksRequest{
-			Hash:  []byte{1, 2, 3}, // not 32 byte
-			Count: 1,
+	// settings
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.ChainCfgParams = &chaincfg.MainNetParams
+	tSettings.BlockChain.GRPCListenAddress = ""
+	tSettings.BlockChain.HTTPListenAddress = ""
+
+	// in-store memory
+	storeURL, err := url.Parse("sqlitememory:///blockchain")
+	require.NoError(t, err)
+	blockchainStore, err := sql.New(logger, storeURL, tSettings)
+	require.NoError(t, err)
+
+	// kafka mock producer
+	mockProducer := kafka.NewKafkaAsyncProducerMock()
+
+	// Instantiate server passing mockProducer
+	server, err := New(ctx, logger, tSettings, blockchainStore, mockProducer)
+	require.NoError(t, err)
+	require.NotNil(t, server)
+
+	require.NoError(t, server.Init(ctx))
+
+	readyCh := make(chan struct{})
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = server.Start(runCtx, readyCh)
+	}()
+
+	select {
+	case <-readyCh:
+
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for blockchain server to start")
+	}
+
+	cancel()
+}
+
+func TestBlockchainStartGRPCError(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.ChainCfgParams = &chaincfg.MainNetParams
+
+	tSettings.BlockChain.HTTPListenAddress = "127.0.0.1:0"
+
+	tSettings.BlockChain.GRPCListenAddress = "bad:address"
+
+	storeURL, err := url.Parse("sqlitememory:///blockchain_error")
+	require.NoError(t, err)
+	blockchainStore, err := sql.New(logger, storeURL, tSettings)
+	require.NoError(t, err)
+
+	mockProducer := kafka.NewKafkaAsyncProducerMock()
+
+	server, err := New(ctx, logger, tSettings, blockchainStore, mockProducer)
+	require.NoError(t, err)
+
+	require.NoError(t, server.Init(ctx))
+
+	readyCh := make(chan struct{})
+	err = server.Start(ctx, readyCh)
+
+	require.Error(t, err)
+
+	require.Contains(t, err.Error(), "can't start GRPC server")
+}
+
+func TestInvalidateHandler(t *testing.T) {
+	ctx := context.Background()
+
+	logger := ulogger.NewErrorTestLogger(t)
+	e := echo.New()
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.ChainCfgParams = &chaincfg.MainNetParams
+	tSettings.BlockChain.GRPCListenAddress = ""
+	tSettings.BlockChain.HTTPListenAddress = ""
+	mockProducer := kafka.NewKafkaAsyncProducerMock()
+	storeURL, err := url.Parse("sqlitememory:///blockchain")
+	require.NoError(t, err)
+	blockchainStore, err := sql.New(logger, storeURL, tSettings)
+	require.NoError(t, err)
+	t.Run("invalid has detected", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/invalidate/notAHash", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues("notAHash")
+
+		server, _ := New(ctx, logger, tSettings, blockchainStore, mockProducer)
+		err := server.invalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid hash")
+	})
+
+	t.Run("invalidation fails", func(t *testing.T) {
+		validHash := chainhash.DoubleHashH([]byte("abc")).String()
+		req := httptest.NewRequest(http.MethodPost, "/invalidate/"+validHash, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues(validHash)
+		server, _ := New(ctx, logger, tSettings, blockchainStore, mockProducer)
+		err := server.invalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "error invalidating block")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ctx := setup(t)
+		block := mockBlock(ctx, t)
+		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
+		require.NoError(t, err)
+		validHash := block.Hash().String()
+		req := httptest.NewRequest(http.MethodPost, "/invalidate/"+validHash, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues(validHash)
+		err = ctx.server.invalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "block invalidated")
+	})
+}
+
+func TestRevalidateHandler(t *testing.T) {
+	ctx := setup(t)
+	e := echo.New()
+
+	t.Run("invalid hash returns 400", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/revalidate/notAHash", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues("notAHash")
+
+		err := ctx.server.revalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid hash")
+	})
+
+	t.Run("non-existent block returns 500", func(t *testing.T) {
+		nonExistentHash := chainhash.DoubleHashH([]byte("nope")).String()
+
+		req := httptest.NewRequest(http.MethodPost, "/revalidate/"+nonExistentHash, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues(nonExistentHash)
+
+		err := ctx.server.revalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "error revalidating block")
+	})
+
+	t.Run("existing block returns 200", func(t *testing.T) {
+		block := mockBlock(ctx, t)
+		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
+		require.NoError(t, err)
+
+		validHash := block.Hash().String()
+		req := httptest.NewRequest(http.MethodPost, "/revalidate/"+validHash, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("hash")
+		c.SetParamValues(validHash)
+
+		err = ctx.server.revalidateHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "block revalidated")
+	})
+}
+
+func TestCompactHandler(t *testing.T) {
+	ctx := setup(t)
+	e := echo.New()
+
+	t.Run("empty store returns 200", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/compact", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := ctx.server.compactHandler(c)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "removed 0 blocks and 0 state keys")
+	})
+}
+
+func TestGetBlocks(t *testing.T) {
+	ctx := setup(t)
+
+	t.Run("invalid hash returns error", func(t *testing.T) {
+		req := &blockchain_api.GetBlocksRequest{
+			Hash:  []byte{1, 2, 3}, // not 32 byte
+			Count: 1,
+		}
+
+		resp, err := ctx.server.GetBlocks(context.Background(), req)
+		require.Error(t, err)
+		require.Nil(t, resp)
+		require.Contains(t, err.Error(), "not valid")
+	})
+
+	t.Run("store returns error", func(t *testing.T) {
+		validHash := chainhash.DoubleHashH([]byte("does-not-exist"))
+
+		// request blocks starting from a non-existent hash
+		req := &blockchain_api.GetBlocksRequest{
+			Hash:  validHash.CloneBytes(),
+			Count: 1,
+		}
+
+		resp, err := ctx.server.GetBlocks(context.Background(), req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Len(t, resp.Blocks, 0, "expected no blocks when hash not found")
+	})
+
+	t.Run("success returns blocks", func(t *testing.T) {
+		block := mockBlock(ctx, t)
+		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
+		require.NoError(t, err)
+
+		req := &blockchain_api.GetBlocksRequest{
+			Hash:  block.Hash().CloneBytes(),
+			Count: 1,
+		}
+
+		resp, err := ctx.server.GetBlocks(context.Background(), req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Blocks, 1)
+		require.NotEmpty(t, resp.Blocks[0])
+	})
+}
+
+func TestGetBestBlockHeader(t *testing.T) {
+	t.Run("store returns error - no best block", func(t *testing.T) {
+		store := blockchain_store.NewMockStore()
+		logger := ulogger.NewErrorTestLogger(t)
+
+		tSettings := test.CreateBaseTestSettings(t)
+		b, err := New(context.Background(), logger, tSettings, store, nil)
+		require.NoError(t, err)
+
+		resp, err := b.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
+		require.Nil(t, resp)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no best block")
+	})
+
+	t.Run("success - best block available", func(t *testing.T) {
+		ctx := setup(t)
+
+		blk := mockBlock(ctx, t)
+		_, _, err := ctx.server.store.StoreBlock(context.Background(), blk, "peer1")
+		require.NoError(t, err)
+
+		resp, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, blk.Height, uint32(resp.Height))
+		assert.Equal(t, blk.TransactionCount, resp.TxCount)
+		assert.Equal(t, blk.SizeInBytes, resp.SizeInBytes)
+		assert.NotEmpty(t, resp.BlockHeader)
+	})
+}
+
+// Test_ServiceInvalidateBlock_ClearsBestAndDifficultyCache ensures that invalidating the
+// current best block via the service updates the best block header and allows
+// difficulty to be recalculated based on the new best tip (i.e., cache does not stay stale).
+func Test_ServiceInvalidateBlock_ClearsBestAndDifficultyCache(t *testing.T) {
+	ctx := setup(t)
+
+	// Build a short chain of 3 blocks (heights 1,2,3) from genesis
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.ChainCfgParams = &chaincfg.MainNetParams
+	prevHash := tSettings.ChainCfgParams.GenesisHash
+
+	var blocks []*model.Block
+	for i := 1; i <= 3; i++ {
+		coinbase := bt.NewTx()
+		err := coinbase.From("0000000000000000000000000000000000000000000000000000000000000000", 0xffffffff, "", 0)
+		require.NoError(t, err)
+
+		arbitraryData := []byte{0x03, byte(i), 0x00, 0x00}
+		coinbase.Inputs[0].UnlockingScript = bscript.NewFromBytes(arbitraryData)
+		coinbase.Inputs[0].SequenceNumber = 0xffffffff
+		err = coinbase.AddP2PKHOutputFromAddress("mrs6FYWPcb441b4qfcEPyvLvzj64WHtwCU", 5000000000)
+		require.NoError(t, err)
+
+		merkleRoot := coinbase.TxIDChainHash()
+
+		blk := &model.Block{
+			Header: &model.BlockHeader{
+				Version:        1,
+				HashPrevBlock:  prevHash,
+				HashMerkleRoot: merkleRoot,
+				Timestamp:      uint32(time.Now().Unix()) + uint32(i),
+				Bits:           model.NBit{0x1d, 0x00, 0xff, 0xff},
+				Nonce:          uint32(i),
+			},
+			CoinbaseTx:       coinbase,
+			TransactionCount: 1,
+			SizeInBytes:      1000,
+			Height:           uint32(i),
+			ID:               uint32(i),
+		}
+
+		_, _, err = ctx.server.store.StoreBlock(context.Background(), blk, "test")
+		require.NoError(t, err)
+
+		blocks = append(blocks, blk)
+		prevHash = blk.Hash()
+	}
+
+	// Warm difficulty cache using the current best (height 3)
+	bestRespBefore, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.NotNil(t, bestRespBefore)
+
+	bestHeaderBefore, err := model.NewBlockHeaderFromBytes(bestRespBefore.BlockHeader)
+	require.NoError(t, err)
+
+	// Call GetNextWorkRequired twice to warm any internal caching
+	req := &blockchain_api.GetNextWorkRequiredRequest{PreviousBlockHash: bestHeaderBefore.Hash().CloneBytes(), CurrentBlockTime: time.Now().Unix()}
+	diff1, err := ctx.server.GetNextWorkRequired(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, diff1)
+
+	diff2, err := ctx.server.GetNextWorkRequired(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, diff2)
+
+	// Invalidate the current best block (height 3)
+	_, err = ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
+		BlockHash: bestHeaderBefore.Hash().CloneBytes(),
+	})
+	require.NoError(t, err)
+
+	// After invalidation, the best should move to height 2
+	bestRespAfter, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.NotNil(t, bestRespAfter)
+
+	bestHeaderAfter, err := model.NewBlockHeaderFromBytes(bestRespAfter.BlockHeader)
+	require.NoError(t, err)
+
+	// Verify that best tip changed from the invalidated block (the store decides the next best)
+	assert.False(t, bestHeaderAfter.Hash().IsEqual(bestHeaderBefore.Hash()))
+
+	// Ensure difficulty can be computed for the new best without using stale cache
+	reqAfter := &blockchain_api.GetNextWorkRequiredRequest{PreviousBlockHash: bestHeaderAfter.Hash().CloneBytes(), CurrentBlockTime: time.Now().Unix()}
+	diffAfter, err := ctx.server.GetNextWorkRequired(context.Background(), reqAfter)
+	require.NoError(t, err)
+	require.NotNil(t, diffAfter)
+}
+
+// storeTestChain stores a chain of n blocks on top of the main net genesis block and returns the blocks.
+func storeTestChain(t *testing.T, ctx *testContext, n int) []*model.Block {
+	prevHash := chaincfg.MainNetParams.GenesisHash
+
+	var blocks []*model.Block
+	for i := 1; i <= n; i++ {
+		coinbase := bt.NewTx()
+		err := coinbase.From("0000000000000000000000000000000000000000000000000000000000000000", 0xffffffff, "", 0)
+		require.NoError(t, err)
+
+		coinbase.Inputs[0].UnlockingScript = bscript.NewFromBytes([]byte{0x03, byte(i), 0x00, 0x00})
+		coinbase.Inputs[0].SequenceNumber = 0xffffffff
+		err = coinbase.AddP2PKHOutputFromAddress("mrs6FYWPcb441b4qfcEPyvLvzj64WHtwCU", 5000000000)
+		require.NoError(t, err)
+
+		blk := &model.Block{
+			Header: &model.BlockHeader{
+				Version:        1,
+				HashPrevBlock:  prevHash,
+				HashMerkleRoot: coinbase.TxIDChainHash(),
+				Timestamp:      uint32(time.Now().Unix()) + uint32(i),
+				Bits:           model.NBit{0xff, 0xff, 0x00, 0x1d},
+				Nonce:          uint32(i),
+			},
+			CoinbaseTx:       coinbase,
+			TransactionCount: 1,
+			SizeInBytes:      1000,
+			Height:           uint32(i),
+			ID:               uint32(i),
+		}
+
+		_, _, err = ctx.server.store.StoreBlock(context.Background(), blk, "test")
+		require.NoError(t, err)
+
+		blocks = append(blocks, blk)
+		prevHash = blk.Hash()
+	}
+
+	return blocks
+}
+
+// Test_ServiceInvalidateBlock_DryRun ensures that a dry run returns the blocks that would be
+// invalidated, without invalidating them.
+func Test_ServiceInvalidateBlock_DryRun(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 3)
+
+	resp, err := ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
+		BlockHash: blocks[1].Hash().CloneBytes(),
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.InvalidatedBlocks, 2)
+	require.Len(t, resp.AffectedBlocks, 2)
+
+	for i, affectedBlock := range resp.AffectedBlocks {
+		assert.Equal(t, blocks[i+1].Hash().CloneBytes(), affectedBlock.Hash)
+		assert.Equal(t, resp.InvalidatedBlocks[i], affectedBlock.Hash)
+		assert.Equal(t, uint32(i+2), affectedBlock.Height)
+		assert.True(t, affectedBlock.OnBestChain)
+	}
+
+	// nothing must have been invalidated
+	bestHeader, _, err := ctx.server.store.GetBestBlockHeader(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, blocks[2].Hash(), bestHeader.Hash())
+}
+
+// newTestBlockOn creates a block with a single coinbase transaction on top of the given block,
+// the nonce makes blocks at the same height on different forks unique.
+func newTestBlockOn(t *testing.T, prevHash *chainhash.Hash, height uint32, nonce uint32) *model.Block {
+	coinbase := bt.NewTx()
+	err := coinbase.From("0000000000000000000000000000000000000000000000000000000000000000", 0xffffffff, "", 0)
+	require.NoError(t, err)
+
+	coinbase.Inputs[0].UnlockingScript = bscript.NewFromBytes([]byte{0x03, byte(height), byte(nonce), 0x00})
+	coinbase.Inputs[0].SequenceNumber = 0xffffffff
+	err = coinbase.AddP2PKHOutputFromAddress("mrs6FYWPcb441b4qfcEPyvLvzj64WHtwCU", 5000000000)
+	require.NoError(t, err)
+
+	return &model.Block{
+		Header: &model.BlockHeader{
+			Version:        1,
+			HashPrevBlock:  prevHash,
+			HashMerkleRoot: coinbase.TxIDChainHash(),
+			Timestamp:      uint32(time.Now().Unix()) + height,
+			Bits:           model.NBit{0xff, 0xff, 0x00, 0x1d},
+			Nonce:          nonce,
+		},
+		CoinbaseTx:       coinbase,
+		TransactionCount: 1,
+		SizeInBytes:      1000,
+	}
+}
+
+// reorgNotifications returns the Reorg notifications queued on the server, discarding all other notifications.
+func reorgNotifications(ctx *testContext) []*blockchain_api.Notification {
+	var reorgs []*blockchain_api.Notification
+
+	for len(ctx.server.notifications) > 0 {
+		if notification := <-ctx.server.notifications; notification.Type == model.NotificationType_Reorg {
+			reorgs = append(reorgs, notification)
+		}
+	}
+
+	return reorgs
+}
+
+func Test_ReorgNotification(t *testing.T) {
+	addBlock := func(t *testing.T, ctx *testContext, block *model.Block) {
+		_, err := ctx.server.AddBlock(context.Background(), &blockchain_api.AddBlockRequest{
+			Header:           block.Header.Bytes(),
+			CoinbaseTx:       block.CoinbaseTx.Bytes(),
+			TransactionCount: block.TransactionCount,
+			SizeInBytes:      block.SizeInBytes,
+			PeerId:           "test-peer",
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("extending the best chain", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 2)
+
+		addBlock(t, ctx, newTestBlockOn(t, blocks[1].Hash(), 3, 1))
+
+		assert.Empty(t, reorgNotifications(ctx))
+	})
+
+	t.Run("side chain overtakes the best chain", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 2)
+
+		// a fork of block 2, with the same chain work as the best chain
+		fork2 := newTestBlockOn(t, blocks[0].Hash(), 2, 100)
+		addBlock(t, ctx, fork2)
+		assert.Empty(t, reorgNotifications(ctx))
+
+		fork3 := newTestBlockOn(t, fork2.Hash(), 3, 100)
+		addBlock(t, ctx, fork3)
+
+		reorgs := reorgNotifications(ctx)
+		require.Len(t, reorgs, 1)
+
+		assert.Equal(t, fork3.Hash().CloneBytes(), reorgs[0].Hash)
+		assert.Equal(t, map[string]string{
+			"old_tip":           blocks[1].Hash().String(),
+			"old_tip_height":    "2",
+			"new_tip":           fork3.Hash().String(),
+			"new_tip_height":    "3",
+			"fork_point":        blocks[0].Hash().String(),
+			"fork_point_height": "1",
+			"depth":             "1",
+		}, reorgs[0].Metadata.Metadata)
+	})
+
+	t.Run("invalidating the best block", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 3)
+
+		_, err := ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
+			BlockHash: blocks[1].Hash().CloneBytes(),
+		})
+		require.NoError(t, err)
+
+		reorgs := reorgNotifications(ctx)
+		require.Len(t, reorgs, 1)
+
+		assert.Equal(t, blocks[0].Hash().CloneBytes(), reorgs[0].Hash)
+		assert.Equal(t, blocks[2].Hash().String(), reorgs[0].Metadata.Metadata["old_tip"])
+		assert.Equal(t, blocks[0].Hash().String(), reorgs[0].Metadata.Metadata["fork_point"])
+		assert.Equal(t, "2", reorgs[0].Metadata.Metadata["depth"])
+	})
+
+	t.Run("invalidating a side chain block", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 2)
+
+		fork2 := newTestBlockOn(t, blocks[0].Hash(), 2, 100)
+		addBlock(t, ctx, fork2)
+		_ = reorgNotifications(ctx)
+
+		_, err := ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
+			BlockHash: fork2.Hash().CloneBytes(),
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, reorgNotifications(ctx))
+	})
+}
+
+func Test_InvalidateBlockMaxReorgDepth(t *testing.T) {
+	invalidate := func(ctx *testContext, hash *chainhash.Hash, force bool) error {
+		_, err := ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
+			BlockHash: hash.CloneBytes(),
+			Force:     force,
+		})
+		if err != nil {
+			return errors.UnwrapGRPC(err)
+		}
+
+		return nil
+	}
+
+	t.Run("block within the max reorg depth", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.settings.BlockChain.MaxReorgDepth = 2
+		blocks := storeTestChain(t, ctx, 3)
+
+		require.NoError(t, invalidate(ctx, blocks[1].Hash(), false))
+	})
+
+	t.Run("block deeper than the max reorg depth", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.settings.BlockChain.MaxReorgDepth = 2
+		blocks := storeTestChain(t, ctx, 3)
+
+		err := invalidate(ctx, blocks[0].Hash(), false)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrThresholdExceeded))
+
+		_, meta, err := ctx.server.store.GetBestBlockHeader(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), meta.Height)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.settings.BlockChain.MaxReorgDepth = 2
+		blocks := storeTestChain(t, ctx, 3)
+
+		require.NoError(t, invalidate(ctx, blocks[0].Hash(), true))
+	})
+
+	t.Run("side chain block", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.settings.BlockChain.MaxReorgDepth = 1
+		blocks := storeTestChain(t, ctx, 3)
+
+		fork2 := newTestBlockOn(t, blocks[0].Hash(), 2, 100)
+		_, _, err := ctx.server.store.StoreBlock(context.Background(), fork2, "test-peer")
+		require.NoError(t, err)
+
+		require.NoError(t, invalidate(ctx, fork2.Hash(), false))
+	})
+
+	t.Run("http route", func(t *testing.T) {
+		ctx := setup(t)
+		ctx.server.settings.BlockChain.MaxReorgDepth = 2
+		blocks := storeTestChain(t, ctx, 3)
+
+		request := func(query string) *httptest.ResponseRecorder {
+			hash := blocks[0].Hash().String()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/invalidate/"+hash+query, nil), rec)
+			c.SetParamNames("hash")
+			c.SetParamValues(hash)
+
+			require.NoError(t, ctx.server.invalidateHandler(c))
+
+			return rec
+		}
+
+		assert.Equal(t, http.StatusConflict, request("").Code)
+		assert.Equal(t, http.StatusBadRequest, request("?force=abc").Code)
+		assert.Equal(t, http.StatusOK, request("?force=1").Code)
+	})
+
+	t.Run("http route dry run", func(t *testing.T) {
+		ctx := setup(t)
+		blocks := storeTestChain(t, ctx, 3)
+
+		store := &invalidateCountingStore{Store: ctx.server.store}
+		ctx.server.store = store
+
+		request := func(query string) *httptest.ResponseRecorder {
+			hash := blocks[1].Hash().String()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/invalidate/"+hash+query, nil), rec)
+			c.SetParamNames("hash")
+			c.SetParamValues(hash)
+
+			require.NoError(t, ctx.server.invalidateHandler(c))
+
+			return rec
 		}
 
-		resp, err := ctx.server.GetBlocks(context.Background(), req)
-		require.Error(t, err)
-		require.Nil(t, resp)
-		require.Contains(t, err.Error(), "not valid")
-	})
+		assert.Equal(t, http.StatusBadRequest, request("?dryrun=abc").Code)
 
-	t.Run("store returns error", func(t *testing.T) {
-		validHash := chainhash.DoubleHashH([]byte("does-not-exist"))
+		rec := request("?dryrun=1")
+		require.Equal(t, http.StatusOK, rec.Code)
 
-		// request blocks starting from a non-existent hash
-		req := &blockchain_api.GetBlocksRequest{
-			Hash:  validHash.CloneBytes(),
-			Count: 1,
+		var response struct {
+			DryRun bool                   `json:"dryRun"`
+			Count  int                    `json:"count"`
+			Blocks []*model.AffectedBlock `json:"blocks"`
 		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
 
-		resp, err := ctx.server.GetBlocks(context.Background(), req)
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 2, response.Count)
+		assert.Len(t, response.Blocks, 2)
+		assert.Zero(t, store.invalidateCalls.Load())
+
+		_, meta, err := ctx.server.store.GetBestBlockHeader(context.Background())
 		require.NoError(t, err)
-		require.NotNil(t, resp)
-		assert.Len(t, resp.Blocks, 0, "expected no blocks when hash not found")
+		assert.Equal(t, uint32(3), meta.Height)
 	})
+}
 
-	t.Run("success returns blocks", func(t *testing.T) {
-		block := mockBlock(ctx, t)
-		_, _, err := ctx.server.store.StoreBlock(context.Background(), block, "peer1")
-		require.NoError(t, err)
+// invalidateCountingStore counts the InvalidateBlock calls on the wrapped store.
+type invalidateCountingStore struct {
+	blockchain_store.Store
+	invalidateCalls atomic.Int32
+}
 
-		req := &blockchain_api.GetBlocksRequest{
-			Hash:  block.Hash().CloneBytes(),
-			Count: 1,
-		}
+func (s *invalidateCountingStore) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+	s.invalidateCalls.Add(1)
 
-		resp, err := ctx.server.GetBlocks(context.Background(), req)
-		require.NoError(t, err)
-		require.NotNil(t, resp)
-		require.Len(t, resp.Blocks, 1)
-		require.NotEmpty(t, resp.Blocks[0])
-	})
+	return s.Store.InvalidateBlock(ctx, blockHash)
 }
 
-func TestGetBestBlockHeader(t *testing.T) {
-	t.Run("store returns error - no best block", func(t *testing.T) {
-		store := blockchain_store.NewMockStore()
-		logger := ulogger.NewErrorTestLogger(t)
+func Test_GetBlocksByHeightRange(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 3)
 
-		tSettings := test.CreateBaseTestSettings(t)
-		b, err := New(context.Background(), logger, tSettings, store, nil)
+	ctx.server.settings.BlockChain.MaxBlocksByHeightRange = 3
+
+	t.Run("range of blocks", func(t *testing.T) {
+		resp, err := ctx.server.GetBlocksByHeightRange(context.Background(), &blockchain_api.GetBlocksByHeightRangeRequest{
+			StartHeight: 1,
+			EndHeight:   3,
+		})
 		require.NoError(t, err)
+		require.Len(t, resp.Blocks, 3)
 
-		resp, err := b.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
-		require.Nil(t, resp)
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "no best block")
-	})
+		for i, blockBytes := range resp.Blocks {
+			block, err := model.NewBlockFromBytes(blockBytes)
+			require.NoError(t, err)
 
-	t.Run("success - best block available", func(t *testing.T) {
-		ctx := setup(t)
+			assert.Equal(t, blocks[i].Hash(), block.Hash())
+			assert.Equal(t, blocks[i].CoinbaseTx.TxID(), block.CoinbaseTx.TxID())
+			assert.Equal(t, blocks[i].TransactionCount, block.TransactionCount)
+		}
+	})
 
-		blk := mockBlock(ctx, t)
-		_, _, err := ctx.server.store.StoreBlock(context.Background(), blk, "peer1")
+	t.Run("single block", func(t *testing.T) {
+		resp, err := ctx.server.GetBlocksByHeightRange(context.Background(), &blockchain_api.GetBlocksByHeightRangeRequest{
+			StartHeight: 2,
+			EndHeight:   2,
+		})
 		require.NoError(t, err)
+		require.Len(t, resp.Blocks, 1)
+	})
 
-		resp, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
-		require.NoError(t, err)
-		require.NotNil(t, resp)
+	t.Run("range exceeds maximum", func(t *testing.T) {
+		_, err := ctx.server.GetBlocksByHeightRange(context.Background(), &blockchain_api.GetBlocksByHeightRangeRequest{
+			StartHeight: 0,
+			EndHeight:   3,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrInvalidArgument))
+		assert.Contains(t, err.Error(), "exceeds the maximum of 3")
+	})
 
-		assert.Equal(t, blk.Height, uint32(resp.Height))
-		assert.Equal(t, blk.TransactionCount, resp.TxCount)
-		assert.Equal(t, blk.SizeInBytes, resp.SizeInBytes)
-		assert.NotEmpty(t, resp.BlockHeader)
+	t.Run("end below start", func(t *testing.T) {
+		_, err := ctx.server.GetBlocksByHeightRange(context.Background(), &blockchain_api.GetBlocksByHeightRangeRequest{
+			StartHeight: 3,
+			EndHeight:   1,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrInvalidArgument))
+	})
+
+	t.Run("missing block", func(t *testing.T) {
+		_, err := ctx.server.GetBlocksByHeightRange(context.Background(), &blockchain_api.GetBlocksByHeightRangeRequest{
+			StartHeight: 2,
+			EndHeight:   4,
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrBlockNotFound))
 	})
 }
 
-// Test_ServiceInvalidateBlock_ClearsBestAndDifficultyCache ensures that invalidating the
-// current best block via the service updates the best block header and allows
-// difficulty to be recalculated based on the new best tip (i.e., cache does not stay stale).
-func Test_ServiceInvalidateBlock_ClearsBestAndDifficultyCache(t *testing.T) {
+func Test_GetBlockHeadersByHashes(t *testing.T) {
 	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 2)
+
+	t.Run("known and unknown blocks", func(t *testing.T) {
+		resp, err := ctx.server.GetBlockHeadersByHashes(context.Background(), &blockchain_api.GetBlockHeadersByHashesRequest{
+			BlockHashes: [][]byte{
+				blocks[1].Hash().CloneBytes(),
+				(&chainhash.Hash{1, 2, 3}).CloneBytes(),
+				blocks[0].Hash().CloneBytes(),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Found, 3)
+		require.Len(t, resp.BlockHeaders, 3)
+		require.Len(t, resp.Metas, 3)
 
-	// Build a short chain of 3 blocks (heights 1,2,3) from genesis
-	tSettings := test.CreateBaseTestSettings(t)
-	tSettings.ChainCfgParams = &chaincfg.MainNetParams
-	prevHash := tSettings.ChainCfgParams.GenesisHash
+		assert.Equal(t, []bool{true, false, true}, resp.Found)
+		assert.Empty(t, resp.BlockHeaders[1])
+		assert.Empty(t, resp.Metas[1])
 
-	var blocks []*model.Block
-	for i := 1; i <= 3; i++ {
-		coinbase := bt.NewTx()
-		err := coinbase.From("0000000000000000000000000000000000000000000000000000000000000000", 0xffffffff, "", 0)
+		header, err := model.NewBlockHeaderFromBytes(resp.BlockHeaders[0])
 		require.NoError(t, err)
+		assert.Equal(t, blocks[1].Hash(), header.Hash())
 
-		arbitraryData := []byte{0x03, byte(i), 0x00, 0x00}
-		coinbase.Inputs[0].UnlockingScript = bscript.NewFromBytes(arbitraryData)
-		coinbase.Inputs[0].SequenceNumber = 0xffffffff
-		err = coinbase.AddP2PKHOutputFromAddress("mrs6FYWPcb441b4qfcEPyvLvzj64WHtwCU", 5000000000)
+		meta, err := model.NewBlockHeaderMetaFromBytes(resp.Metas[0])
 		require.NoError(t, err)
+		assert.Equal(t, uint32(2), meta.Height)
 
-		merkleRoot := coinbase.TxIDChainHash()
-
-		blk := &model.Block{
-			Header: &model.BlockHeader{
-				Version:        1,
-				HashPrevBlock:  prevHash,
-				HashMerkleRoot: merkleRoot,
-				Timestamp:      uint32(time.Now().Unix()) + uint32(i),
-				Bits:           model.NBit{0x1d, 0x00, 0xff, 0xff},
-				Nonce:          uint32(i),
-			},
-			CoinbaseTx:       coinbase,
-			TransactionCount: 1,
-			SizeInBytes:      1000,
-			Height:           uint32(i),
-			ID:               uint32(i),
-		}
-
-		_, _, err = ctx.server.store.StoreBlock(context.Background(), blk, "test")
+		header, err = model.NewBlockHeaderFromBytes(resp.BlockHeaders[2])
 		require.NoError(t, err)
+		assert.Equal(t, blocks[0].Hash(), header.Hash())
+	})
 
-		blocks = append(blocks, blk)
-		prevHash = blk.Hash()
-	}
+	t.Run("invalid hash", func(t *testing.T) {
+		_, err := ctx.server.GetBlockHeadersByHashes(context.Background(), &blockchain_api.GetBlockHeadersByHashesRequest{
+			BlockHashes: [][]byte{{1, 2, 3}},
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrInvalidArgument))
+	})
+}
 
-	// Warm difficulty cache using the current best (height 3)
-	bestRespBefore, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
-	require.NoError(t, err)
-	require.NotNil(t, bestRespBefore)
+func Test_GetDifficultyInfo(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 3)
 
-	bestHeaderBefore, err := model.NewBlockHeaderFromBytes(bestRespBefore.BlockHeader)
+	resp, err := ctx.server.GetDifficultyInfo(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	// Call GetNextWorkRequired twice to warm any internal caching
-	req := &blockchain_api.GetNextWorkRequiredRequest{PreviousBlockHash: bestHeaderBefore.Hash().CloneBytes(), CurrentBlockTime: time.Now().Unix()}
-	diff1, err := ctx.server.GetNextWorkRequired(context.Background(), req)
+	_, bestMeta, err := ctx.server.store.GetBestBlockHeader(context.Background())
 	require.NoError(t, err)
-	require.NotNil(t, diff1)
 
-	diff2, err := ctx.server.GetNextWorkRequired(context.Background(), req)
-	require.NoError(t, err)
-	require.NotNil(t, diff2)
+	assert.Equal(t, blocks[2].Hash().CloneBytes(), resp.BlockHash)
+	assert.Equal(t, uint32(3), resp.Height)
+	assert.Equal(t, blocks[2].Header.Bits.CloneBytes(), resp.Bits)
+	assert.Equal(t, blocks[2].Header.Bits.CalculateTarget().String(), resp.Target)
+	assert.Equal(t, new(big.Int).SetBytes(bestMeta.ChainWork).Bytes(), resp.ChainWork)
+	assert.Equal(t, uint32(DifficultyAdjustmentWindow), resp.DifficultyAdjustmentWindow)
+	assert.Equal(t, uint32(DifficultyAdjustmentWindow)+5, resp.NextRetargetHeight)
+}
 
-	// Invalidate the current best block (height 3)
-	_, err = ctx.server.InvalidateBlock(context.Background(), &blockchain_api.InvalidateBlockRequest{
-		BlockHash: bestHeaderBefore.Hash().CloneBytes(),
-	})
-	require.NoError(t, err)
+func Test_GetMiningCandidateContext(t *testing.T) {
+	ctx := setup(t)
+	blocks := storeTestChain(t, ctx, 3)
 
-	// After invalidation, the best should move to height 2
-	bestRespAfter, err := ctx.server.GetBestBlockHeader(context.Background(), &emptypb.Empty{})
+	resp, err := ctx.server.GetMiningCandidateContext(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
-	require.NotNil(t, bestRespAfter)
 
-	bestHeaderAfter, err := model.NewBlockHeaderFromBytes(bestRespAfter.BlockHeader)
+	medianTime, err := getMedianTimeForHeight(context.Background(), ctx.server.store, 3)
 	require.NoError(t, err)
 
-	// Verify that best tip changed from the invalidated block (the store decides the next best)
-	assert.False(t, bestHeaderAfter.Hash().IsEqual(bestHeaderBefore.Hash()))
-
-	// Ensure difficulty can be computed for the new best without using stale cache
-	reqAfter := &blockchain_api.GetNextWorkRequiredRequest{PreviousBlockHash: bestHeaderAfter.Hash().CloneBytes(), CurrentBlockTime: time.Now().Unix()}
-	diffAfter, err := ctx.server.GetNextWorkRequired(context.Background(), reqAfter)
+	nBits, err := ctx.server.difficulty.CalcNextWorkRequired(context.Background(), blocks[2].Header, 3, int64(resp.CurTime))
 	require.NoError(t, err)
-	require.NotNil(t, diffAfter)
+
+	assert.Equal(t, blocks[2].Hash().CloneBytes(), resp.PreviousBlockHash)
+	assert.Equal(t, uint32(4), resp.Height)
+	assert.Equal(t, nBits.CloneBytes(), resp.Bits)
+	assert.Equal(t, medianTime+1, resp.MinTime)
+	assert.GreaterOrEqual(t, resp.CurTime, resp.MinTime)
 }
 
 func TestGetBlockByID(t *testing.T) {
@@ -2191,6 +3395,118 @@ func TestSetState(t *testing.T) {
 	})
 }
 
+func TestGetBlockHeaderByHeight(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	store := blockchain_store.NewMockStore()
+	block := &model.Block{
+		Height: 5,
+		Header: &model.BlockHeader{
+			Version:        1,
+			HashPrevBlock:  &chainhash.Hash{},
+			HashMerkleRoot: &chainhash.Hash{},
+			Timestamp:      1234,
+			Bits:           model.NBit{},
+			Nonce:          42,
+		},
+	}
+	_, _, err := store.StoreBlock(ctx, block, "")
+	require.NoError(t, err)
+
+	server, err := New(ctx, logger, tSettings, store, nil)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := server.GetBlockHeaderByHeight(ctx, &blockchain_api.GetBlockByHeightRequest{Height: 5})
+		require.NoError(t, err)
+
+		header, err := model.NewBlockHeaderFromBytes(resp.BlockHeader)
+		require.NoError(t, err)
+		assert.Equal(t, block.Hash(), header.Hash())
+		assert.Equal(t, uint32(5), resp.Height)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		_, err := server.GetBlockHeaderByHeight(ctx, &blockchain_api.GetBlockByHeightRequest{Height: 6})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockNotFound))
+	})
+}
+
+func TestCompareAndSetState(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+
+	t.Run("success - state swapped", func(t *testing.T) {
+		mockStore := &mockStoreState{}
+		mockStore.On(
+			"CompareAndSetState",
+			mock.Anything,
+			"test-key",
+			[]byte("old-data"),
+			[]byte("new-data"),
+		).Return(true, nil)
+
+		server, _ := New(ctx, logger, tSettings, mockStore, nil)
+
+		resp, err := server.CompareAndSetState(ctx, &blockchain_api.CompareAndSetStateRequest{
+			Key:          "test-key",
+			ExpectedData: []byte("old-data"),
+			Data:         []byte("new-data"),
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Swapped)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("success - expected data does not match", func(t *testing.T) {
+		mockStore := &mockStoreState{}
+		mockStore.On(
+			"CompareAndSetState",
+			mock.Anything,
+			"test-key",
+			[]byte("old-data"),
+			[]byte("new-data"),
+		).Return(false, nil)
+
+		server, _ := New(ctx, logger, tSettings, mockStore, nil)
+
+		resp, err := server.CompareAndSetState(ctx, &blockchain_api.CompareAndSetStateRequest{
+			Key:          "test-key",
+			ExpectedData: []byte("old-data"),
+			Data:         []byte("new-data"),
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.Swapped)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("error - store returns error", func(t *testing.T) {
+		mockStore := &mockStoreState{}
+		mockStore.On(
+			"CompareAndSetState",
+			mock.Anything,
+			"bad-key",
+			[]byte("old-data"),
+			[]byte("new-data"),
+		).Return(false, errors.NewStorageError("forced error"))
+
+		server, _ := New(ctx, logger, tSettings, mockStore, nil)
+
+		resp, err := server.CompareAndSetState(ctx, &blockchain_api.CompareAndSetStateRequest{
+			Key:          "bad-key",
+			ExpectedData: []byte("old-data"),
+			Data:         []byte("new-data"),
+		})
+		require.Error(t, err)
+		require.Nil(t, resp)
+		mockStore.AssertExpectations(t)
+	})
+}
+
 func TestGetBlockHeaderIDs(t *testing.T) {
 	ctx := context.Background()
 	logger := ulogger.NewErrorTestLogger(t)
@@ -2476,6 +3792,95 @@ func TestSetBlockSubtreesSet(t *testing.T) {
 	})
 }
 
+func TestSetBlockSubtreesSet_Confirmations(t *testing.T) {
+	ctx := context.Background()
+	logger := ulogger.NewErrorTestLogger(t)
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.BlockChain.SubtreesSetNotificationConfirmations = 3
+
+	parent := &model.Block{Header: &model.BlockHeader{HashPrevBlock: &chainhash.Hash{}, HashMerkleRoot: &chainhash.Hash{}, Nonce: 3}, Height: 9}
+	block := &model.Block{Header: &model.BlockHeader{HashPrevBlock: parent.Hash(), HashMerkleRoot: &chainhash.Hash{}, Nonce: 1}, Height: 10}
+	blockHash := *block.Hash()
+
+	setup := func(t *testing.T, bestHeight uint32) (*Blockchain, *blockchain_store.MockStore) {
+		store := blockchain_store.NewMockStore()
+		store.Blocks[*parent.Hash()] = parent
+		store.Blocks[blockHash] = block
+		store.BestBlock = &model.Block{Header: &model.BlockHeader{Nonce: 2}, Height: bestHeight}
+
+		mockStore := &mockStoreGetSetBlockIsMined{MockStore: store}
+		mockStore.On("SetBlockSubtreesSet", mock.Anything, &blockHash).Return(nil)
+
+		server, err := New(ctx, logger, tSettings, mockStore, nil)
+		require.NoError(t, err)
+
+		_, err = server.SetBlockSubtreesSet(ctx, &blockchain_api.SetBlockSubtreesSetRequest{BlockHash: blockHash.CloneBytes()})
+		require.NoError(t, err)
+
+		return server, store
+	}
+
+	t.Run("notification waits for the confirmations", func(t *testing.T) {
+		server, store := setup(t, 11)
+		assert.Empty(t, server.notifications)
+
+		store.BestBlock.Height = 12
+		server.sendConfirmedSubtreesSetNotifications(ctx)
+
+		require.Len(t, server.notifications, 1)
+		notification := <-server.notifications
+		assert.Equal(t, model.NotificationType_BlockSubtreesSet, notification.Type)
+		assert.Equal(t, blockHash.CloneBytes(), notification.Hash)
+
+		// the notification is only sent once
+		store.BestBlock.Height = 13
+		server.sendConfirmedSubtreesSetNotifications(ctx)
+		assert.Empty(t, server.notifications)
+	})
+
+	t.Run("block that is already deep enough is notified immediately", func(t *testing.T) {
+		server, _ := setup(t, 20)
+
+		require.Len(t, server.notifications, 1)
+		assert.Empty(t, server.pendingSubtreesSet)
+	})
+
+	t.Run("invalidated block is pruned", func(t *testing.T) {
+		server, store := setup(t, 11)
+
+		assert.True(t, server.prunePendingSubtreesSet(blockHash))
+		assert.False(t, server.prunePendingSubtreesSet(blockHash))
+
+		store.BestBlock.Height = 12
+		server.sendConfirmedSubtreesSetNotifications(ctx)
+		assert.Empty(t, server.notifications)
+	})
+
+	t.Run("block disconnected by a reorg is pruned", func(t *testing.T) {
+		server, store := setup(t, 11)
+
+		child := &model.Block{Header: &model.BlockHeader{HashPrevBlock: &blockHash, HashMerkleRoot: &chainhash.Hash{}, Nonce: 4}, Height: 11}
+		store.Blocks[*child.Hash()] = child
+
+		server.prunePendingSubtreesSetOfReorg(ctx, child.Header, parent.Header)
+		assert.Empty(t, server.pendingSubtreesSet)
+
+		store.BestBlock.Height = 12
+		server.sendConfirmedSubtreesSetNotifications(ctx)
+		assert.Empty(t, server.notifications)
+	})
+
+	t.Run("reorg that does not disconnect the block keeps it pending", func(t *testing.T) {
+		server, store := setup(t, 11)
+
+		child := &model.Block{Header: &model.BlockHeader{HashPrevBlock: &blockHash, HashMerkleRoot: &chainhash.Hash{}, Nonce: 4}, Height: 11}
+		store.Blocks[*child.Hash()] = child
+
+		server.prunePendingSubtreesSetOfReorg(ctx, child.Header, block.Header)
+		assert.Len(t, server.pendingSubtreesSet, 1)
+	})
+}
+
 func TestGetBlocksSubtreesNotSet(t *testing.T) {
 	ctx := context.Background()
 	logger := ulogger.NewErrorTestLogger(t)
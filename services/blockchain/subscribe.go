@@ -0,0 +1,81 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// blockHeadersChannelSize is the buffer size of the channel returned by SubscribeBlockHeaders.
+const blockHeadersChannelSize = 100
+
+// BlockHeaderNotification is a block notification with the decoded header and metadata of the block.
+type BlockHeaderNotification struct {
+	Header *model.BlockHeader
+	Meta   *model.BlockHeaderMeta
+}
+
+// SubscribeBlockHeaders subscribes to the notifications of the blockchain client and returns a channel
+// that receives the header and metadata of every block notification. Other notification types are
+// ignored. Notifications of which the header cannot be retrieved are logged and skipped.
+//
+// The returned channel is closed when the context is done or the subscription channel is closed.
+//
+// Parameters:
+//   - ctx: Context for the subscription, cancelling it stops the subscription
+//   - client: Blockchain client to subscribe to
+//   - source: Identifier of the subscriber, for logging and tracking
+//   - logger: Logger for the errors of the header lookups
+//
+// Returns:
+//   - <-chan *BlockHeaderNotification: Channel of the decoded block headers
+//   - error: If the subscription could not be created
+func SubscribeBlockHeaders(ctx context.Context, client ClientI, source string, logger ulogger.Logger) (<-chan *BlockHeaderNotification, error) {
+	subscription, err := client.Subscribe(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	headersCh := make(chan *BlockHeaderNotification, blockHeadersChannelSize)
+
+	go func() {
+		defer close(headersCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification, ok := <-subscription:
+				if !ok {
+					return
+				}
+
+				if notification == nil || notification.Type != model.NotificationType_Block {
+					continue
+				}
+
+				hash, err := chainhash.NewHash(notification.Hash)
+				if err != nil {
+					logger.Errorf("[SubscribeBlockHeaders][%s] failed to parse block hash: %v", source, err)
+					continue
+				}
+
+				header, meta, err := client.GetBlockHeader(ctx, hash)
+				if err != nil {
+					logger.Errorf("[SubscribeBlockHeaders][%s] failed to get block header %s: %v", source, hash, err)
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case headersCh <- &BlockHeaderNotification{Header: header, Meta: meta}:
+				}
+			}
+		}
+	}()
+
+	return headersCh, nil
+}
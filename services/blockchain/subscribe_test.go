@@ -0,0 +1,92 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/services/blockchain/blockchain_api"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeBlockHeaders(t *testing.T) {
+	header := model.GenesisBlockHeader
+	meta := &model.BlockHeaderMeta{Height: 0}
+	unknownHash := chainhash.HashH([]byte("unknown"))
+
+	setup := func(t *testing.T) (context.Context, context.CancelFunc, chan *blockchain_api.Notification, <-chan *BlockHeaderNotification) {
+		ctx, cancel := context.WithCancel(context.Background())
+		subscription := make(chan *blockchain_api.Notification, 10)
+
+		client := &Mock{}
+		client.On("Subscribe", mock.Anything, "test").Return(subscription, nil)
+		client.On("GetBlockHeader", mock.Anything, header.Hash()).Return(header, meta, nil)
+		client.On("GetBlockHeader", mock.Anything, &unknownHash).Return(nil, nil, errors.NewBlockNotFoundError("not found"))
+
+		headersCh, err := SubscribeBlockHeaders(ctx, client, "test", ulogger.TestLogger{})
+		require.NoError(t, err)
+
+		return ctx, cancel, subscription, headersCh
+	}
+
+	t.Run("block notifications are decoded", func(t *testing.T) {
+		_, cancel, subscription, headersCh := setup(t)
+		defer cancel()
+
+		subscription <- &blockchain_api.Notification{Type: model.NotificationType_Subtree, Hash: unknownHash[:]}
+		subscription <- nil
+		subscription <- &blockchain_api.Notification{Type: model.NotificationType_Block, Hash: unknownHash[:]}
+		subscription <- &blockchain_api.Notification{Type: model.NotificationType_Block, Hash: header.Hash()[:]}
+
+		select {
+		case notification := <-headersCh:
+			assert.Equal(t, header, notification.Header)
+			assert.Equal(t, meta, notification.Meta)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for block header")
+		}
+
+		assert.Empty(t, headersCh)
+	})
+
+	t.Run("channel is closed when the subscription is closed", func(t *testing.T) {
+		_, cancel, subscription, headersCh := setup(t)
+		defer cancel()
+
+		close(subscription)
+
+		select {
+		case _, ok := <-headersCh:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for channel to be closed")
+		}
+	})
+
+	t.Run("channel is closed when the context is done", func(t *testing.T) {
+		_, cancel, _, headersCh := setup(t)
+
+		cancel()
+
+		select {
+		case _, ok := <-headersCh:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for channel to be closed")
+		}
+	})
+
+	t.Run("subscription error", func(t *testing.T) {
+		client := &Mock{}
+		client.On("Subscribe", mock.Anything, "test").Return(nil, errors.NewServiceError("unavailable"))
+
+		_, err := SubscribeBlockHeaders(context.Background(), client, "test", ulogger.TestLogger{})
+		require.Error(t, err)
+	})
+}
@@ -96,7 +96,7 @@ func TestOneTransaction(t *testing.T) {
 	_ = subtreeStore.Set(ctx, subtrees[0].RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -163,7 +163,7 @@ func TestTwoTransactions(t *testing.T) {
 	_ = subtreeStore.Set(ctx, subtrees[0].RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -252,7 +252,7 @@ func TestMerkleRoot(t *testing.T) {
 	// require.NoError(t, err)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -730,6 +730,12 @@ func (m *MockBlockchainClient) GetBestBlockHeader(ctx context.Context) (*model.B
 }
 
 // GetBlockByHeight implements blockchain.ClientI
+func (m *MockBlockchainClient) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	return nil, nil
+}
+func (m *MockBlockchainClient) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	return nil, nil, nil
+}
 func (m *MockBlockchainClient) GetBlockByHeight(ctx context.Context, height uint32) (*model.Block, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -747,6 +753,11 @@ func (m *MockBlockchainClient) GetBlockByHeight(ctx context.Context, height uint
 	return nil, errors.NewError("block not found at height %d", height)
 }
 
+// SubscribeFSMState implements blockchain.ClientI
+func (m *MockBlockchainClient) SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error) {
+	return make(chan *blockchain_api.FSMStateChange), nil
+}
+
 // WaitUntilFSMTransitionFromIdleState implements blockchain.ClientI
 func (m *MockBlockchainClient) WaitUntilFSMTransitionFromIdleState(ctx context.Context) error {
 	m.mu.Lock()
@@ -797,7 +808,7 @@ func (m *MockBlockchainClient) GetBlockStats(ctx context.Context) (*model.BlockS
 func (m *MockBlockchainClient) GetBlockGraphData(ctx context.Context, periodMillis uint64) (*model.BlockDataPoints, error) {
 	return nil, nil
 }
-func (m *MockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (m *MockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	return nil, nil
 }
 func (m *MockBlockchainClient) GetLastNInvalidBlocks(ctx context.Context, n int64) ([]*model.BlockInfo, error) {
@@ -812,12 +823,21 @@ func (m *MockBlockchainClient) GetHashOfAncestorBlock(ctx context.Context, hash
 func (m *MockBlockchainClient) GetNextWorkRequired(ctx context.Context, hash *chainhash.Hash, currentBlockTime int64) (*model.NBit, error) {
 	return nil, nil
 }
+func (m *MockBlockchainClient) GetDifficultyInfo(ctx context.Context) (*blockchain.DifficultyInfo, error) {
+	return nil, nil
+}
+func (m *MockBlockchainClient) GetMiningCandidateContext(ctx context.Context) (*blockchain.MiningCandidateContext, error) {
+	return nil, nil
+}
 func (m *MockBlockchainClient) GetBlockExists(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	return false, nil
 }
 func (m *MockBlockchainClient) GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
 	return nil, nil, nil
 }
+func (m *MockBlockchainClient) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	return nil, nil, nil
+}
 func (m *MockBlockchainClient) GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	return nil, nil, nil
 }
@@ -842,7 +862,10 @@ func (m *MockBlockchainClient) GetBlockHeadersFromHeight(ctx context.Context, he
 func (m *MockBlockchainClient) GetBlockHeadersByHeight(ctx context.Context, startHeight, endHeight uint32) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	return nil, nil, nil
 }
-func (m *MockBlockchainClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+func (m *MockBlockchainClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, opts ...options.InvalidateBlockOption) ([]chainhash.Hash, error) {
+	return nil, nil
+}
+func (m *MockBlockchainClient) InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
 	return nil, nil
 }
 func (m *MockBlockchainClient) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
@@ -851,7 +874,7 @@ func (m *MockBlockchainClient) RevalidateBlock(ctx context.Context, blockHash *c
 func (m *MockBlockchainClient) GetBlockHeaderIDs(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]uint32, error) {
 	return nil, nil
 }
-func (m *MockBlockchainClient) Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error) {
+func (m *MockBlockchainClient) Subscribe(ctx context.Context, source string, _ ...options.SubscribeOption) (chan *blockchain_api.Notification, error) {
 	return nil, nil
 }
 func (m *MockBlockchainClient) GetState(ctx context.Context, key string) ([]byte, error) {
@@ -860,6 +883,9 @@ func (m *MockBlockchainClient) GetState(ctx context.Context, key string) ([]byte
 func (m *MockBlockchainClient) SetState(ctx context.Context, key string, data []byte) error {
 	return nil
 }
+func (m *MockBlockchainClient) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	return false, nil
+}
 func (m *MockBlockchainClient) SetBlockMinedSet(ctx context.Context, blockHash *chainhash.Hash) error {
 	return nil
 }
@@ -881,6 +907,15 @@ func (m *MockBlockchainClient) GetBlocksSubtreesNotSet(ctx context.Context) ([]*
 func (m *MockBlockchainClient) GetBestHeightAndTime(ctx context.Context) (uint32, uint32, error) {
 	return 0, 0, nil
 }
+func (m *MockBlockchainClient) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	return 0, nil
+}
+func (m *MockBlockchainClient) GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error) {
+	return 0, 0, nil
+}
+func (m *MockBlockchainClient) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	return nil, nil, nil
+}
 func (m *MockBlockchainClient) CheckBlockIsInCurrentChain(ctx context.Context, blockIDs []uint32) (bool, error) {
 	return false, nil
 }
@@ -897,6 +932,9 @@ func (m *MockBlockchainClient) IsFSMCurrentState(ctx context.Context, state bloc
 	defer m.mu.RUnlock()
 	return m.fsmState == state, nil
 }
+func (m *MockBlockchainClient) IsCurrent(ctx context.Context) (bool, []blockchain.NotCurrentReason, error) {
+	return true, nil, nil
+}
 func (m *MockBlockchainClient) WaitForFSMtoTransitionToGivenState(context.Context, blockchain.FSMStateType) error {
 	return nil
 }
@@ -916,6 +954,9 @@ func (m *MockBlockchainClient) SendFSMEvent(ctx context.Context, event blockchai
 func (m *MockBlockchainClient) GetBlockLocator(ctx context.Context, blockHeaderHash *chainhash.Hash, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
 	return nil, nil
 }
+func (m *MockBlockchainClient) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	return nil, nil
+}
 func (m *MockBlockchainClient) LocateBlockHeaders(ctx context.Context, locator []*chainhash.Hash, hashStop *chainhash.Hash, maxHashes uint32) ([]*model.BlockHeader, error) {
 	return nil, nil
 }
@@ -70,6 +70,11 @@ type ValidateBlockOptions struct {
 	// IsRevalidation indicates this is a revalidation of an invalid block.
 	// When true, skips existence check and clears invalid flag after successful validation.
 	IsRevalidation bool
+
+	// SkipRecentBlocksBloomCheck skips the check whether the transactions of the block were already mined in
+	// one of the recent blocks, see model.Block.Valid. This is only set during catchup for blocks that are at
+	// least blockvalidation_catchup_trusted_depth blocks below the catchup target.
+	SkipRecentBlocksBloomCheck bool
 }
 
 // validationResult holds the result of a block validation for sharing between goroutines
@@ -128,7 +133,7 @@ type BlockValidation struct {
 	// recentBlocksBloomFilters maintains bloom filters for recent blocks
 	recentBlocksBloomFilters *txmap.SyncedMap[chainhash.Hash, *model.BlockBloomFilter]
 
-	// bloomFilterRetentionSize defines the number of blocks to keep bloom filters for
+	// bloomFilterRetentionSize defines the number of blocks to keep bloom filters for, see recentBloomWindow
 	bloomFilterRetentionSize uint32
 
 	// subtreeValidationClient manages subtree validation processes
@@ -161,6 +166,9 @@ type BlockValidation struct {
 	// bloomFilterStats collects statistics about bloom filter operations
 	bloomFilterStats *model.BloomStats
 
+	// bloomStatsHistory keeps the bloom filter stats of the last validated blocks
+	bloomStatsHistory *bloomStatsHistory
+
 	// setMinedChan receives block hashes that need to be marked as mined
 	setMinedChan chan *chainhash.Hash
 
@@ -228,7 +236,7 @@ func NewBlockValidation(ctx context.Context, logger ulogger.Logger, tSettings *s
 		utxoStore:                     utxoStore,
 		validatorClient:               validatorClient,
 		recentBlocksBloomFilters:      txmap.NewSyncedMap[chainhash.Hash, *model.BlockBloomFilter](),
-		bloomFilterRetentionSize:      tSettings.GetSubtreeValidationBlockHeightRetention() + 2, // Needs to be larger than global value but not orders of magnitude larger
+		bloomFilterRetentionSize:      recentBloomWindow(tSettings),
 		subtreeValidationClient:       subtreeValidationClient,
 		subtreeDeDuplicator:           NewDeDuplicator(tSettings.GetSubtreeValidationBlockHeightRetention()),
 		lastValidatedBlocks:           expiringmap.New[chainhash.Hash, *model.Block](2 * time.Minute),
@@ -240,11 +248,17 @@ func NewBlockValidation(ctx context.Context, logger ulogger.Logger, tSettings *s
 		blocksCurrentlyValidating:     txmap.NewSyncedMap[chainhash.Hash, *validationResult](),
 		blockBloomFiltersBeingCreated: txmap.NewSwissMap(0),
 		bloomFilterStats:              model.NewBloomStats(),
+		bloomStatsHistory:             newBloomStatsHistory(tSettings.BlockValidation.BloomStatsHistorySize),
 		setMinedChan:                  make(chan *chainhash.Hash, 1000),
 		revalidateBlockChan:           make(chan revalidateBlockData, 2),
 		stats:                         gocore.NewStat("blockvalidation"),
 	}
 
+	if uint64(bv.bloomFilterRetentionSize) < tSettings.BlockValidation.PreviousBlockHeaderCount {
+		logger.Warnf("[BlockValidation] recent bloom window of %d blocks is smaller than the %d previous block headers used for validation, older bloom filters will be loaded from the subtree store",
+			bv.bloomFilterRetentionSize, tSettings.BlockValidation.PreviousBlockHeaderCount)
+	}
+
 	go func() {
 		// update stats for the expiring maps every 5 seconds
 		ticker := time.NewTicker(5 * time.Second)
@@ -713,13 +727,15 @@ func (u *BlockValidation) hasValidSubtrees(block *model.Block) bool {
 		return false
 	}
 
+	subtreeSlices, _ := block.SubtreeSlicesSnapshot()
+
 	// Check if subtrees are loaded and match expected count
-	if len(block.SubtreeSlices) != len(block.Subtrees) || len(block.SubtreeSlices) == 0 {
+	if len(subtreeSlices) != len(block.Subtrees) || len(subtreeSlices) == 0 {
 		return false
 	}
 
 	// Verify all subtrees are non-nil
-	for _, subtree := range block.SubtreeSlices {
+	for _, subtree := range subtreeSlices {
 		if subtree == nil {
 			return false
 		}
@@ -758,17 +774,24 @@ func (u *BlockValidation) setTxMined(ctx context.Context, blockHash *chainhash.H
 		blockHeaderMeta *model.BlockHeaderMeta
 		onLongestChain  bool
 		ids             []uint32
+
+		// cachedGeneration is the generation of the subtrees of the cached block when they were checked, or when
+		// they were replaced by this call
+		cachedGeneration    uint64
+		cachedSubtreeSlices []*subtreepkg.Subtree
 	)
 
 	cachedBlock, blockWasAlreadyCached := u.lastValidatedBlocks.Get(*blockHash)
 
 	if blockWasAlreadyCached && cachedBlock != nil {
 		// Verify the cached block has subtrees loaded
+		cachedSubtreeSlices, cachedGeneration = cachedBlock.SubtreeSlicesSnapshot()
+
 		if u.hasValidSubtrees(cachedBlock) {
-			u.logger.Debugf("[setTxMined][%s] using cached block with %d subtrees", blockHash.String(), len(cachedBlock.SubtreeSlices))
+			u.logger.Debugf("[setTxMined][%s] using cached block with %d subtrees", blockHash.String(), len(cachedSubtreeSlices))
 			block = cachedBlock
 		} else {
-			if len(cachedBlock.SubtreeSlices) != len(cachedBlock.Subtrees) || len(cachedBlock.SubtreeSlices) == 0 {
+			if len(cachedSubtreeSlices) != len(cachedBlock.Subtrees) || len(cachedSubtreeSlices) == 0 {
 				u.logger.Warnf("[setTxMined][%s] cached block missing subtrees, fetching from blockchain", blockHash.String())
 			} else {
 				u.logger.Warnf("[setTxMined][%s] cached block has invalid subtrees, fetching from blockchain", blockHash.String())
@@ -797,7 +820,9 @@ func (u *BlockValidation) setTxMined(ctx context.Context, blockHash *chainhash.H
 	if len(unsetMined) > 0 && unsetMined[0] {
 		u.logger.Warnf("[setTxMined][%s] block is marked as invalid, will attempt to unset tx mined", block.Hash().String())
 
-		block.SubtreeSlices = make([]*subtreepkg.Subtree, len(block.Subtrees))
+		// the block may be cached and read concurrently, so the subtrees are loaded into a new slice which replaces
+		// the subtrees of the block when all are loaded
+		subtreeSlices := make([]*subtreepkg.Subtree, len(block.Subtrees))
 
 		// when the block is invalid, we might not have all the subtrees
 		for subtreeIdx, subtreeHash := range block.Subtrees {
@@ -816,10 +841,12 @@ func (u *BlockValidation) setTxMined(ctx context.Context, blockHash *chainhash.H
 				continue
 			}
 
-			block.SubtreeSlices[subtreeIdx] = subtree
+			subtreeSlices[subtreeIdx] = subtree
 
 			u.logger.Debugf("[setTxMined][%s] loaded subtree %d/%s from store", block.Hash().String(), subtreeIdx, subtreeHash.String())
 		}
+
+		cachedGeneration = block.SetSubtreeSlices(subtreeSlices)
 	} else {
 		// All subtrees should already be available for fully processed blocks
 		_, err = block.GetSubtrees(ctx, u.logger, u.subtreeStore, u.settings.Block.GetAndValidateSubtreesConcurrency)
@@ -863,9 +890,14 @@ func (u *BlockValidation) setTxMined(ctx context.Context, blockHash *chainhash.H
 		return errors.NewProcessingError("[setTxMined][%s] error updating tx mined status", block.Hash().String(), err)
 	}
 
-	// delete the block from the cache, if it was there
+	// delete the block from the cache, if it was there, and release its subtrees, unless they were
+	// replaced in the meantime, in which case they are in use by someone else
 	if blockWasAlreadyCached {
 		u.lastValidatedBlocks.Delete(*blockHash)
+
+		if block.SubtreeSlicesGeneration() == cachedGeneration {
+			block.TakeSubtreeSlices()
+		}
 	}
 
 	// update block mined_set to true
@@ -1202,8 +1234,13 @@ func (u *BlockValidation) ValidateBlockWithOptions(ctx context.Context, block *m
 
 				u.logger.Infof("[ValidateBlock][%s] validating block in background", block.Hash().String())
 
-				// only get the bloom filters for the current chain.
-				bloomFilters, err := u.collectNecessaryBloomFilters(decoupledCtx, block, blockHeaders)
+				// only get the bloom filters for the current chain, they are not needed when the check is skipped
+				var bloomFilters []*model.BlockBloomFilter
+
+				if !opts.SkipRecentBlocksBloomCheck {
+					bloomFilters, err = u.collectNecessaryBloomFilters(decoupledCtx, block, blockHeaders)
+				}
+
 				if err != nil {
 					u.logger.Errorf("[ValidateBlock][%s] failed to collect necessary bloom filters: %s", block.String(), err)
 
@@ -1212,7 +1249,12 @@ func (u *BlockValidation) ValidateBlockWithOptions(ctx context.Context, block *m
 					return
 				}
 
-				if ok, err := block.Valid(decoupledCtx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, bloomStats, u.settings); !ok {
+				blockBloomStats := model.NewBloomStats()
+
+				ok, err := block.Valid(decoupledCtx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, blockBloomStats, opts.SkipRecentBlocksBloomCheck, u.settings)
+				u.recordBloomStats(block, blockBloomStats, bloomStats, ok)
+
+				if !ok {
 					u.logger.Errorf("[ValidateBlock][%s] InvalidateBlock block is not valid in background: %v", block.String(), err)
 
 					if errors.Is(err, errors.ErrBlockInvalid) {
@@ -1268,13 +1310,25 @@ func (u *BlockValidation) ValidateBlockWithOptions(ctx context.Context, block *m
 			// validate the block
 			u.logger.Infof("[ValidateBlock][%s] validating block", block.Hash().String())
 
-			// only get the bloom filters for the current chain
-			bloomFilters, err := u.collectNecessaryBloomFilters(ctx, block, blockHeaders)
+			// only get the bloom filters for the current chain, they are not needed when the check is skipped
+			var bloomFilters []*model.BlockBloomFilter
+
+			if opts.SkipRecentBlocksBloomCheck {
+				u.logger.Debugf("[ValidateBlock][%s] skipping recent blocks bloom filter check for trusted catchup block", block.Hash().String())
+			} else {
+				bloomFilters, err = u.collectNecessaryBloomFilters(ctx, block, blockHeaders)
+			}
+
 			if err != nil {
 				return errors.NewServiceError("[ValidateBlock][%s] failed to collect necessary bloom filters", block.String(), err)
 			}
 
-			if ok, err := block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, bloomStats, u.settings); !ok {
+			blockBloomStats := model.NewBloomStats()
+
+			ok, err := block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, blockBloomStats, opts.SkipRecentBlocksBloomCheck, u.settings)
+			u.recordBloomStats(block, blockBloomStats, bloomStats, ok)
+
+			if !ok {
 				reason := "unknown"
 				if err != nil {
 					reason = err.Error()
@@ -1292,12 +1346,14 @@ func (u *BlockValidation) ValidateBlockWithOptions(ctx context.Context, block *m
 			u.logger.Infof("[ValidateBlock][%s] validating block DONE", block.Hash().String())
 
 			// Cache the block only if subtrees are loaded (they should be from Valid() call)
+			subtreeSlices, _ := block.SubtreeSlicesSnapshot()
+
 			if u.hasValidSubtrees(block) {
-				u.logger.Debugf("[ValidateBlock][%s] caching block with %d subtrees loaded", block.Hash().String(), len(block.SubtreeSlices))
+				u.logger.Debugf("[ValidateBlock][%s] caching block with %d subtrees loaded", block.Hash().String(), len(subtreeSlices))
 				u.lastValidatedBlocks.Set(*block.Hash(), block)
 			} else {
-				if len(block.SubtreeSlices) != len(block.Subtrees) || len(block.SubtreeSlices) == 0 {
-					u.logger.Warnf("[ValidateBlock][%s] not caching block - subtrees not loaded (%d slices, %d hashes)", block.Hash().String(), len(block.SubtreeSlices), len(block.Subtrees))
+				if len(subtreeSlices) != len(block.Subtrees) || len(subtreeSlices) == 0 {
+					u.logger.Warnf("[ValidateBlock][%s] not caching block - subtrees not loaded (%d slices, %d hashes)", block.Hash().String(), len(subtreeSlices), len(block.Subtrees))
 				} else {
 					u.logger.Warnf("[ValidateBlock][%s] not caching block - some subtrees are nil", block.Hash().String())
 				}
@@ -1513,24 +1569,12 @@ func (u *BlockValidation) waitForPreviousBlocksToBeProcessed(ctx context.Context
 }
 
 func (u *BlockValidation) getBloomFilterFromSubtreeStore(ctx context.Context, hash *chainhash.Hash) *model.BlockBloomFilter {
-	// try to get from the subtree store
-	bloomFilterBytes, err := u.subtreeStore.Get(ctx, hash[:], fileformat.FileTypeBloomFilter)
-	if err != nil || len(bloomFilterBytes) == 0 {
-		return nil
-	}
-
-	// we have the bloom filter bytes in subtree store, so we are forming the bloom filter
-	createdBbf := &model.BlockBloomFilter{
-		CreationTime: time.Now(),
-		BlockHash:    hash,
-	}
-
-	err = createdBbf.Deserialize(bloomFilterBytes)
+	bbf, err := model.LoadBlockBloomFilter(ctx, u.subtreeStore, hash)
 	if err != nil {
 		return nil
 	}
 
-	return createdBbf
+	return bbf
 }
 
 // ReValidateBlock queues a block for revalidation after a previous validation failure.
@@ -1635,7 +1679,12 @@ func (u *BlockValidation) reValidateBlock(blockData revalidateBlockData) error {
 
 	oldBlockIDsMap := txmap.NewSyncedMap[chainhash.Hash, []uint32]()
 
-	if ok, err := blockData.block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, u.bloomFilterStats, u.settings); !ok {
+	blockBloomStats := model.NewBloomStats()
+
+	ok, err := blockData.block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, blockBloomStats, false, u.settings)
+	u.recordBloomStats(blockData.block, blockBloomStats, u.bloomFilterStats, ok)
+
+	if !ok {
 		u.logger.Errorf("[ReValidateBlock][%s] InvalidateBlock block is not valid in background: %v", blockData.block.String(), err)
 
 		if errors.Is(err, errors.ErrBlockInvalid) {
@@ -1650,6 +1699,48 @@ func (u *BlockValidation) reValidateBlock(blockData revalidateBlockData) error {
 	return u.checkOldBlockIDs(ctx, oldBlockIDsMap, blockData.block)
 }
 
+// validateBlockReport runs the checks of Block.Valid for a stored block against the current stores and reports
+// the result of each check. Unlike reValidateBlock, the block is neither marked as valid nor invalidated, which
+// makes it safe to use for diagnosing why a block was rejected.
+//
+// Parameters:
+//   - ctx: Context for the operation, validation stops when it is cancelled
+//   - blockHash: Hash of the block to validate
+//
+// Returns the validation report, or an error if the block or the chain it builds on could not be retrieved.
+func (u *BlockValidation) validateBlockReport(ctx context.Context, blockHash *chainhash.Hash) (*model.BlockValidationReport, error) {
+	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "validateBlockReport",
+		tracing.WithParentStat(u.stats),
+		tracing.WithLogMessage(u.logger, "[validateBlockReport][%s] creating validation report", blockHash.String()),
+	)
+	defer deferFn()
+
+	block, err := u.blockchainClient.GetBlock(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeaders, blockHeadersMeta, err := u.blockchainClient.GetBlockHeaders(ctx, block.Header.HashPrevBlock, u.settings.BlockValidation.PreviousBlockHeaderCount)
+	if err != nil {
+		return nil, errors.NewServiceError("[validateBlockReport][%s] failed to get block headers", block.String(), err)
+	}
+
+	blockHeaderIDs := make([]uint32, len(blockHeadersMeta))
+	for i, blockHeaderMeta := range blockHeadersMeta {
+		blockHeaderIDs[i] = blockHeaderMeta.ID
+	}
+
+	bloomFilters, err := u.collectNecessaryBloomFilters(ctx, block, blockHeaders)
+	if err != nil {
+		return nil, errors.NewServiceError("[validateBlockReport][%s] failed to collect necessary bloom filters", block.String(), err)
+	}
+
+	oldBlockIDsMap := txmap.NewSyncedMap[chainhash.Hash, []uint32]()
+
+	// use separate bloom stats, so the report does not skew the stats of the blocks being validated
+	return block.ValidWithReport(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, model.NewBloomStats(), u.settings), nil
+}
+
 // createAppendBloomFilter generates and manages bloom filters for blocks.
 // It handles filter creation, pruning, and concurrent access management.
 //
@@ -1677,32 +1768,13 @@ func (u *BlockValidation) createAppendBloomFilter(ctx context.Context, block *mo
 		_ = u.blockBloomFiltersBeingCreated.Delete(*block.Hash())
 	}()
 
-	var err error
-
-	// create a bloom filter for the block
-	bbf := &model.BlockBloomFilter{
-		CreationTime: time.Now(),
-		BlockHash:    block.Hash(),
-		BlockHeight:  block.Height,
-	}
-
-	bbf.Filter, err = block.NewOptimizedBloomFilter(ctx, u.logger, u.subtreeStore, u.settings.Block.GetAndValidateSubtreesConcurrency)
+	// reuse the bloom filter persisted in the subtree store, only recreating it from the subtrees on a miss
+	bbf, err := block.GetOrCreateBloomFilter(ctx, u.logger, u.subtreeStore, u.settings.Block.GetAndValidateSubtreesConcurrency,
+		options.WithDeleteAt(block.Height+u.bloomFilterRetentionSize))
 	if err != nil {
 		return errors.NewProcessingError("[createAppendBloomFilter][%s] failed to create bloom filter", block.Hash().String(), err)
 	}
 
-	// Serialize the filter
-	filterBytes, err := bbf.Serialize()
-	if err != nil {
-		return errors.NewProcessingError("[createAppendBloomFilter][%s] failed to serialize bloom filter", block.Hash().String(), err)
-	}
-
-	// record the bloom filter in the subtreestore
-	err = u.subtreeStore.Set(ctx, block.Hash()[:], fileformat.FileTypeBloomFilter, filterBytes, options.WithDeleteAt(block.Height+u.bloomFilterRetentionSize))
-	if err != nil {
-		return errors.NewProcessingError("[createAppendBloomFilter][%s] failed to record bloom filter in subtree store", block.Hash().String(), err)
-	}
-
 	u.pruneBloomFilters(ctx, block, bbf)
 
 	return nil
@@ -1739,10 +1811,40 @@ func (u *BlockValidation) pruneBloomFilters(ctx context.Context, block *model.Bl
 
 	remainingCount := u.recentBlocksBloomFilters.Length()
 
+	var retainedBits uint64
+
+	for _, bf := range u.recentBlocksBloomFilters.Range() {
+		if bf.Filter != nil {
+			retainedBits += bf.Filter.NumBits()
+		}
+	}
+
+	prometheusBlockValidationRecentBloomFilters.Set(float64(remainingCount))
+	prometheusBlockValidationRecentBloomFiltersBytes.Set(float64(retainedBits / 8))
+
 	u.logger.Debugf("[pruneBloomFilters][%s] pruned %d filters, %d remaining",
 		block.Hash().String(), len(filtersToPrune), remainingCount)
 }
 
+// recentBloomWindow returns the number of recent blocks to keep bloom filters for.
+//
+// The bloom filters are used to check the transactions of a new block against the blocks
+// in its current chain, which is PreviousBlockHeaderCount headers long. A window that is
+// smaller than the current chain keeps less memory, but filters for the older blocks in the
+// current chain then have to be loaded from the subtree store on every validation. A larger
+// window costs memory for every extra block, without speeding up validation.
+//
+// When block_recentBloomWindow is not set, the window is derived from the subtree validation
+// block height retention, which needs to be larger than the global value but not orders of
+// magnitude larger.
+func recentBloomWindow(tSettings *settings.Settings) uint32 {
+	if tSettings.Block.RecentBloomWindow > 0 {
+		return tSettings.Block.RecentBloomWindow
+	}
+
+	return tSettings.GetSubtreeValidationBlockHeightRetention() + 2
+}
+
 // updateSubtreesDAH manages retention periods for block subtrees.
 // It updates the DAH values and marks subtrees as properly set in the blockchain.
 //
@@ -1832,10 +1934,7 @@ func (u *BlockValidation) checkOldBlockIDs(ctx context.Context, oldBlockIDsMap *
 		return errors.NewServiceError("[Block Validation][checkOldBlockIDs][%s] failed to get block header ids", block.String(), err)
 	}
 
-	currentChainBlockIDsMap := make(map[uint32]struct{}, len(currentChainBlockIDs))
-	for _, blockID := range currentChainBlockIDs {
-		currentChainBlockIDsMap[blockID] = struct{}{}
-	}
+	currentChainBlockIDsMap := model.NewBlockIDSet(currentChainBlockIDs)
 
 	currentChainLookupCache := make(map[string]bool, len(currentChainBlockIDs))
 
@@ -1849,11 +1948,9 @@ func (u *BlockValidation) checkOldBlockIDs(ctx context.Context, oldBlockIDsMap *
 		}
 
 		// check whether the blockIDs are in the current chain we just fetched
-		for _, blockID := range blockIDs {
-			if _, ok := currentChainBlockIDsMap[blockID]; ok {
-				// all good, continue
-				return true
-			}
+		if _, ok := model.FirstBlockIDInSet(blockIDs, currentChainBlockIDsMap); ok {
+			// all good, continue
+			return true
 		}
 
 		slices.Sort(blockIDs)
@@ -3650,3 +3650,19 @@ func TestBlockValidation_InvalidBlock_PublishesToKafka(t *testing.T) {
 	// Use the thread-safe method to check if Publish was called
 	require.True(t, mockKafka.IsPublishCalled(), "Kafka Publish should be called for invalid block (duplicate transaction)")
 }
+
+func TestBlockValidation_recentBloomWindow(t *testing.T) {
+	t.Run("derived from subtree retention", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.RecentBloomWindow = 0
+
+		assert.Equal(t, tSettings.GetSubtreeValidationBlockHeightRetention()+2, recentBloomWindow(tSettings))
+	})
+
+	t.Run("configured window", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.RecentBloomWindow = 42
+
+		assert.Equal(t, uint32(42), recentBloomWindow(tSettings))
+	})
+}
@@ -170,6 +170,35 @@ func (s *Client) ProcessBlock(ctx context.Context, block *model.Block, blockHeig
 	return nil
 }
 
+// ProcessBlockDryRun submits a block for validation at a specified height, without the block being
+// stored or notifications being sent. The subtrees of the block must already be known to the service.
+//
+// Parameters:
+//   - ctx: Context for the processing operation
+//   - block: Complete block data to validate
+//   - blockHeight: Expected chain height for the block, 0 to derive it from the parent block
+//
+// Returns a block invalid error with the reason when the block is not valid, or an error if the validation fails
+func (s *Client) ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error {
+	blockBytes, err := block.Bytes()
+	if err != nil {
+		return err
+	}
+
+	req := &blockvalidation_api.ProcessBlockRequest{
+		Block:  blockBytes,
+		Height: blockHeight,
+		DryRun: true,
+	}
+
+	_, err = s.apiClient.ProcessBlock(ctx, req)
+	if err != nil {
+		return errors.UnwrapGRPC(err)
+	}
+
+	return nil
+}
+
 // ValidateBlock performs comprehensive validation of a block using the validation service.
 // It submits the complete block data for validation including transaction verification,
 // consensus rule checking, and integration with the current blockchain state.
@@ -203,3 +232,72 @@ func (s *Client) ValidateBlock(ctx context.Context, block *model.Block, options
 
 	return nil
 }
+
+// GetCatchupStatus retrieves the progress of the catchup in progress from the validation service.
+// When no catchup is running, the status of the last catchup is returned with IsCatchingUp set to false.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns the catchup status, or an error if service communication fails
+func (s *Client) GetCatchupStatus(ctx context.Context) (*CatchupStatus, error) {
+	resp, err := s.apiClient.GetCatchupStatus(ctx, &blockvalidation_api.EmptyMessage{})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	status := &CatchupStatus{
+		IsCatchingUp:    resp.IsCatchingUp,
+		TargetHeight:    resp.TargetHeight,
+		CurrentHeight:   resp.CurrentHeight,
+		BlocksValidated: resp.BlocksValidated,
+		TotalBlocks:     resp.TotalBlocks,
+		PeerID:          resp.PeerId,
+	}
+
+	if len(resp.TargetHash) > 0 {
+		if status.TargetHash, err = chainhash.NewHash(resp.TargetHash); err != nil {
+			return nil, errors.NewProcessingError("invalid catchup target hash", err)
+		}
+	}
+
+	if resp.StartTime != nil {
+		status.StartTime = resp.StartTime.AsTime()
+	}
+
+	return status, nil
+}
+
+// CancelCatchup asks the validation service to abort the catchup in progress.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns whether a catchup was cancelled, or an error if service communication fails
+func (s *Client) CancelCatchup(ctx context.Context) (bool, error) {
+	resp, err := s.apiClient.CancelCatchup(ctx, &blockvalidation_api.EmptyMessage{})
+	if err != nil {
+		return false, errors.UnwrapGRPC(err)
+	}
+
+	return resp.Cancelled, nil
+}
+
+// GetSubtreeMeta retrieves the serialized subtree meta of a subtree from the validation service.
+// The subtree meta can be deserialized with subtree.NewSubtreeMetaFromBytes, given the subtree itself.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - subtreeHash: Hash of the subtree
+//
+// Returns the serialized subtree meta, or an error if the subtree meta is not found or service communication fails
+func (s *Client) GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error) {
+	resp, err := s.apiClient.GetSubtreeMeta(ctx, &blockvalidation_api.GetSubtreeMetaRequest{
+		Hash: subtreeHash.CloneBytes(),
+	})
+	if err != nil {
+		return nil, errors.UnwrapGRPC(err)
+	}
+
+	return resp.SubtreeMeta, nil
+}
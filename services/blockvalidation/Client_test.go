@@ -1,10 +1,13 @@
 package blockvalidation
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/blockvalidation_api"
 	"github.com/bitcoin-sv/teranode/settings"
@@ -16,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // mockBlockValidationAPIClient is a mock implementation of BlockValidationAPIClient
@@ -39,6 +43,14 @@ func (m *mockBlockValidationAPIClient) BlockFound(ctx context.Context, in *block
 	return args.Get(0).(*blockvalidation_api.EmptyMessage), args.Error(1)
 }
 
+func (m *mockBlockValidationAPIClient) SubtreeFound(ctx context.Context, in *blockvalidation_api.SubtreeFoundRequest, opts ...grpc.CallOption) (*blockvalidation_api.EmptyMessage, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*blockvalidation_api.EmptyMessage), args.Error(1)
+}
+
 func (m *mockBlockValidationAPIClient) ProcessBlock(ctx context.Context, in *blockvalidation_api.ProcessBlockRequest, opts ...grpc.CallOption) (*blockvalidation_api.EmptyMessage, error) {
 	args := m.Called(ctx, in, opts)
 	if args.Get(0) == nil {
@@ -55,6 +67,30 @@ func (m *mockBlockValidationAPIClient) ValidateBlock(ctx context.Context, in *bl
 	return args.Get(0).(*blockvalidation_api.ValidateBlockResponse), args.Error(1)
 }
 
+func (m *mockBlockValidationAPIClient) GetCatchupStatus(ctx context.Context, in *blockvalidation_api.EmptyMessage, opts ...grpc.CallOption) (*blockvalidation_api.CatchupStatusResponse, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*blockvalidation_api.CatchupStatusResponse), args.Error(1)
+}
+
+func (m *mockBlockValidationAPIClient) CancelCatchup(ctx context.Context, in *blockvalidation_api.EmptyMessage, opts ...grpc.CallOption) (*blockvalidation_api.CancelCatchupResponse, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*blockvalidation_api.CancelCatchupResponse), args.Error(1)
+}
+
+func (m *mockBlockValidationAPIClient) GetSubtreeMeta(ctx context.Context, in *blockvalidation_api.GetSubtreeMetaRequest, opts ...grpc.CallOption) (*blockvalidation_api.GetSubtreeMetaResponse, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*blockvalidation_api.GetSubtreeMetaResponse), args.Error(1)
+}
+
 func createTestClient(mockClient *mockBlockValidationAPIClient) *Client {
 	logger := ulogger.TestLogger{}
 	tSettings := &settings.Settings{
@@ -389,3 +425,114 @@ func TestClient_ValidateBlock(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 }
+
+func TestClient_GetCatchupStatus(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mockBlockValidationAPIClient{}
+	client := createTestClient(mockClient)
+
+	t.Run("catchup in progress", func(t *testing.T) {
+		startTime := time.Now().Add(-time.Minute).UTC()
+		targetHash := chainhash.HashH([]byte("target"))
+
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GetCatchupStatus", ctx, mock.Anything, mock.Anything).Return(&blockvalidation_api.CatchupStatusResponse{
+			IsCatchingUp:    true,
+			TargetHash:      targetHash.CloneBytes(),
+			TargetHeight:    200,
+			CurrentHeight:   150,
+			BlocksValidated: 50,
+			TotalBlocks:     100,
+			StartTime:       timestamppb.New(startTime),
+			PeerId:          "peer1",
+		}, nil)
+
+		status, err := client.GetCatchupStatus(ctx)
+		require.NoError(t, err)
+
+		assert.True(t, status.IsCatchingUp)
+		assert.Equal(t, targetHash, *status.TargetHash)
+		assert.Equal(t, uint32(200), status.TargetHeight)
+		assert.Equal(t, uint32(150), status.CurrentHeight)
+		assert.Equal(t, uint32(50), status.BlocksValidated)
+		assert.Equal(t, uint32(100), status.TotalBlocks)
+		assert.True(t, startTime.Equal(status.StartTime))
+		assert.Equal(t, "peer1", status.PeerID)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("no catchup has run", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GetCatchupStatus", ctx, mock.Anything, mock.Anything).Return(&blockvalidation_api.CatchupStatusResponse{}, nil)
+
+		status, err := client.GetCatchupStatus(ctx)
+		require.NoError(t, err)
+
+		assert.False(t, status.IsCatchingUp)
+		assert.Nil(t, status.TargetHash)
+		assert.True(t, status.StartTime.IsZero())
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GetCatchupStatus", ctx, mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+		_, err := client.GetCatchupStatus(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_CancelCatchup(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mockBlockValidationAPIClient{}
+	client := createTestClient(mockClient)
+
+	t.Run("catchup cancelled", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("CancelCatchup", ctx, mock.Anything, mock.Anything).Return(&blockvalidation_api.CancelCatchupResponse{Cancelled: true}, nil)
+
+		cancelled, err := client.CancelCatchup(ctx)
+		require.NoError(t, err)
+		assert.True(t, cancelled)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("grpc error", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("CancelCatchup", ctx, mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+		cancelled, err := client.CancelCatchup(ctx)
+		assert.Error(t, err)
+		assert.False(t, cancelled)
+	})
+}
+
+func TestClient_GetSubtreeMeta(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mockBlockValidationAPIClient{}
+	client := createTestClient(mockClient)
+
+	subtreeHash := &chainhash.Hash{0x01}
+
+	t.Run("subtree meta found", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GetSubtreeMeta", ctx, mock.MatchedBy(func(req *blockvalidation_api.GetSubtreeMetaRequest) bool {
+			return bytes.Equal(req.Hash, subtreeHash.CloneBytes())
+		}), mock.Anything).Return(&blockvalidation_api.GetSubtreeMetaResponse{SubtreeMeta: []byte("subtree-meta")}, nil)
+
+		subtreeMeta, err := client.GetSubtreeMeta(ctx, subtreeHash)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("subtree-meta"), subtreeMeta)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("subtree meta not found", func(t *testing.T) {
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GetSubtreeMeta", ctx, mock.Anything, mock.Anything).Return(nil, errors.WrapGRPC(errors.NewSubtreeNotFoundError("subtree meta not found")))
+
+		subtreeMeta, err := client.GetSubtreeMeta(ctx, subtreeHash)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrSubtreeNotFound))
+		assert.Nil(t, subtreeMeta)
+	})
+}
@@ -38,10 +38,26 @@ type Interface interface {
 	// ProcessBlock validates and processes a complete block at the specified height.
 	ProcessBlock(ctx context.Context, block *model.Block, blockHeight uint32, baseURL string, peerID string) error
 
+	// ProcessBlockDryRun validates a complete block at the specified height against the current stores,
+	// without storing it or sending notifications. Returns a block invalid error with the reason when the block is not valid.
+	ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error
+
 	// ValidateBlock validates a block using the provided request, but does not update any state or database tables.
 	// This is useful for validating blocks without committing them to the database.
 	// The options parameter allows control over validation behavior, including revalidation of invalid blocks.
 	ValidateBlock(ctx context.Context, block *model.Block, options *ValidateBlockOptions) error
+
+	// GetCatchupStatus returns the progress of the catchup in progress.
+	// When no catchup is running, it returns the status of the last catchup with IsCatchingUp set to false.
+	GetCatchupStatus(ctx context.Context) (*CatchupStatus, error)
+
+	// CancelCatchup aborts the catchup in progress without stopping the service.
+	// Returns false when no catchup was in progress.
+	CancelCatchup(ctx context.Context) (bool, error)
+
+	// GetSubtreeMeta returns the serialized subtree meta of the given subtree, holding the parent tx hashes
+	// of its transactions. Returns a subtree not found error when no subtree meta is stored.
+	GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error)
 }
 
 var _ Interface = &MockBlockValidation{}
@@ -60,6 +76,22 @@ func (mv *MockBlockValidation) ProcessBlock(ctx context.Context, block *model.Bl
 	return nil
 }
 
+func (mv *MockBlockValidation) ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error {
+	return nil
+}
+
 func (mv *MockBlockValidation) ValidateBlock(ctx context.Context, block *model.Block, options *ValidateBlockOptions) error {
 	return nil
 }
+
+func (mv *MockBlockValidation) GetCatchupStatus(ctx context.Context) (*CatchupStatus, error) {
+	return &CatchupStatus{}, nil
+}
+
+func (mv *MockBlockValidation) CancelCatchup(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (mv *MockBlockValidation) GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error) {
+	return nil, nil
+}
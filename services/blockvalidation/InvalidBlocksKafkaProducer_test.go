@@ -5,6 +5,7 @@ import (
 
 	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
+	"github.com/bitcoin-sv/teranode/services/blockchain/blockchain_api"
 	"github.com/bitcoin-sv/teranode/util/kafka"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/stretchr/testify/mock"
@@ -88,6 +89,28 @@ func (m *MockBlockchainClient) GetBestHeightAndTime(ctx context.Context) (uint32
 	return args.Get(0).(uint32), args.Get(1).(uint32), args.Error(2)
 }
 
+// GetMedianTimeForHeight implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	args := m.Called(ctx, height)
+	return args.Get(0).(uint32), args.Error(1)
+}
+
+// GetBlockSubsidy implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error) {
+	args := m.Called(ctx, height)
+	return args.Get(0).(uint64), args.Get(1).(uint32), args.Error(2)
+}
+
+// WaitForBlockHeight implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, height)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+
+	return args.Get(0).(*model.BlockHeader), args.Get(1).(*model.BlockHeaderMeta), args.Error(2)
+}
+
 // GetBlock implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) GetBlock(ctx context.Context, hash *chainhash.Hash) (*model.Block, error) {
 	args := m.Called(ctx, hash)
@@ -98,6 +121,15 @@ func (m *MockBlockchainClient) GetBlock(ctx context.Context, hash *chainhash.Has
 	return args.Get(0).(*model.Block), args.Error(1)
 }
 
+func (m *MockBlockchainClient) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	args := m.Called(ctx, startHeight, endHeight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*model.Block), args.Error(1)
+}
+
 // GetBlockByHeight implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) GetBlockByHeight(ctx context.Context, height uint32) (*model.Block, error) {
 	args := m.Called(ctx, height)
@@ -143,6 +175,31 @@ func (m *MockBlockchainClient) GetBlockHeader(ctx context.Context, hash *chainha
 	return args.Get(0).(*model.BlockHeader), meta, args.Error(2)
 }
 
+// GetBlockHeaderByHeight implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, height)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+
+	var meta *model.BlockHeaderMeta
+	if args.Get(1) != nil {
+		meta = args.Get(1).(*model.BlockHeaderMeta)
+	}
+
+	return args.Get(0).(*model.BlockHeader), meta, args.Error(2)
+}
+
+// GetBlockHeadersByHashes implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	args := m.Called(ctx, blockHashes)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+
+	return args.Get(0).([]*model.BlockHeader), args.Get(1).([]*model.BlockHeaderMeta), args.Error(2)
+}
+
 // GetBlockHeaderIDs implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) GetBlockHeaderIDs(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]uint32, error) {
 	args := m.Called(ctx, blockHash, numberOfHeaders)
@@ -229,6 +286,16 @@ func (m *MockBlockchainClient) GetBlockLocator(ctx context.Context, hash *chainh
 	return args.Get(0).([]*chainhash.Hash), args.Error(1)
 }
 
+// GetBlockLocatorByHeight implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	args := m.Called(ctx, blockHeaderHeight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*chainhash.Hash), args.Error(1)
+}
+
 // GetBlockStats implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) GetBlockStats(ctx context.Context) (*model.BlockStats, error) {
 	args := m.Called(ctx)
@@ -298,8 +365,8 @@ func (m *MockBlockchainClient) GetHashOfAncestorBlock(ctx context.Context, hash
 }
 
 // GetLastNBlocks implements the blockchain.ClientI interface
-func (m *MockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
-	args := m.Called(ctx, n, includeOrphans, fromHeight)
+func (m *MockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
+	args := m.Called(ctx, n, selection, fromHeight)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -327,6 +394,26 @@ func (m *MockBlockchainClient) GetNextWorkRequired(ctx context.Context, hash *ch
 	return args.Get(0).(*model.NBit), args.Error(1)
 }
 
+// GetDifficultyInfo implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetDifficultyInfo(ctx context.Context) (*blockchain.DifficultyInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*blockchain.DifficultyInfo), args.Error(1)
+}
+
+// GetMiningCandidateContext implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) GetMiningCandidateContext(ctx context.Context) (*blockchain.MiningCandidateContext, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*blockchain.MiningCandidateContext), args.Error(1)
+}
+
 // GetState implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) GetState(ctx context.Context, key string) ([]byte, error) {
 	args := m.Called(ctx, key)
@@ -365,6 +452,16 @@ func (m *MockBlockchainClient) IsFSMCurrentState(ctx context.Context, state bloc
 	return args.Bool(0), args.Error(1)
 }
 
+// IsCurrent implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) IsCurrent(ctx context.Context) (bool, []blockchain.NotCurrentReason, error) {
+	args := m.Called(ctx)
+	if args.Get(1) == nil {
+		return args.Bool(0), nil, args.Error(2)
+	}
+
+	return args.Bool(0), args.Get(1).([]blockchain.NotCurrentReason), args.Error(2)
+}
+
 // LegacySync implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) LegacySync(ctx context.Context) error {
 	args := m.Called(ctx)
@@ -429,6 +526,12 @@ func (m *MockBlockchainClient) SetState(ctx context.Context, key string, data []
 	return args.Error(0)
 }
 
+// CompareAndSetState implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	args := m.Called(ctx, key, expectedData, newData)
+	return args.Bool(0), args.Error(1)
+}
+
 // Subscribe implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) Subscribe(ctx context.Context, source string) (chan interface{}, error) {
 	args := m.Called(ctx, source)
@@ -445,6 +548,26 @@ func (m *MockBlockchainClient) WaitForFSMtoTransitionToGivenState(ctx context.Co
 	return args.Error(0)
 }
 
+// InvalidateBlockDryRun implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) InvalidateBlockDryRun(ctx context.Context, hash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	args := m.Called(ctx, hash)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*model.AffectedBlock), args.Error(1)
+}
+
+// SubscribeFSMState implements the blockchain.ClientI interface
+func (m *MockBlockchainClient) SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error) {
+	args := m.Called(ctx, source)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(<-chan *blockchain_api.FSMStateChange), args.Error(1)
+}
+
 // WaitUntilFSMTransitionFromIdleState implements the blockchain.ClientI interface
 func (m *MockBlockchainClient) WaitUntilFSMTransitionFromIdleState(ctx context.Context) error {
 	args := m.Called(ctx)
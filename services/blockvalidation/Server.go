@@ -20,12 +20,15 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
 	"github.com/bitcoin-sv/teranode/services/blockassembly"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/blockvalidation_api"
@@ -45,9 +48,9 @@ import (
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	txmap "github.com/bsv-blockchain/go-tx-map"
 	"github.com/jellydator/ttlcache/v3"
+	"github.com/labstack/echo/v4"
 	"github.com/ordishs/go-utils"
 	"github.com/ordishs/gocore"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -72,6 +75,19 @@ type processBlockFound struct {
 	errCh chan error
 }
 
+// processSubtreeFound contains information about a subtree announced by a peer, which is
+// validated ahead of the block containing it.
+type processSubtreeFound struct {
+	// hash uniquely identifies the announced subtree
+	hash *chainhash.Hash
+
+	// baseURL specifies the peer URL from which the subtree and its transactions can be retrieved
+	baseURL string
+
+	// peerID is the P2P peer identifier used for peerMetrics tracking
+	peerID string
+}
+
 // processBlockCatchup contains information needed to process a block during chain catchup
 // operations when the node has fallen behind the current chain tip.
 type processBlockCatchup struct {
@@ -127,10 +143,27 @@ type Server struct {
 	// that need validation. This channel buffers requests when high load occurs.
 	blockFoundCh chan processBlockFound
 
+	// subtreeFoundCh receives subtrees announced by peers, which are validated by
+	// SubtreeFoundChConcurrency workers before the block containing them arrives.
+	subtreeFoundCh chan processSubtreeFound
+
 	// catchupCh handles blocks that need processing during chain catchup operations.
 	// This channel is used when the node falls behind the chain tip.
 	catchupCh chan processBlockCatchup
 
+	// stopCh is closed by Stop to signal the block processing loop to process the queued
+	// blocks and exit. It is created in Init, together with blockLoopDone.
+	stopCh chan struct{}
+
+	// blockLoopDone is closed when the block processing loop has exited
+	blockLoopDone chan struct{}
+
+	// stopping is set when Stop is called, after which no new blocks are accepted
+	stopping atomic.Bool
+
+	// stopOnce ensures the block processing loop is only signalled to stop once
+	stopOnce sync.Once
+
 	// blockValidation contains the core validation logic and state
 	blockValidation *BlockValidation
 
@@ -141,10 +174,23 @@ type Server struct {
 	// Kafka messages for distributed coordination
 	kafkaConsumerClient kafka.KafkaConsumerGroupI
 
+	// httpServer serves the debug endpoints of the service, it is only started when
+	// blockvalidation_httpListenAddress is set
+	httpServer *echo.Echo
+
 	// processSubtreeNotify caches subtree processing state to prevent duplicate
-	// processing of the same subtree from multiple miners
+	// processing of the same subtree from multiple miners, entries expire after processSubtreeNotifyTTL
 	processSubtreeNotify *ttlcache.Cache[chainhash.Hash, bool]
 
+	// catchupInFlight holds the target blocks of queued and running catchups, so that the same
+	// block announced by several peers only triggers a single catchup. The peers announcing the block
+	// while the catchup is in flight are kept, a failed catchup is retried from the next of them.
+	// Entries are removed when the catchup finishes and expire after the catchup operation timeout as a safeguard.
+	catchupInFlight *ttlcache.Cache[chainhash.Hash, *catchupInFlightEntry]
+
+	// catchupInFlightMu guards the lookups and updates of the catchupInFlight entries
+	catchupInFlightMu sync.Mutex
+
 	// stats tracks operational metrics for monitoring and troubleshooting
 	stats *gocore.Stat
 
@@ -155,6 +201,10 @@ type Server struct {
 	// peerMetrics tracks performance and reputation metrics for each peer
 	peerMetrics *catchup.CatchupMetrics
 
+	// knownPeers tracks the peers that recently announced blocks, catchup requests headers from
+	// these peers in addition to the catchup peer when the header peer fan-out is above 1
+	knownPeers *catchup.KnownPeers
+
 	// headerChainCache provides efficient access to block headers during catchup
 	// with proper chain validation to avoid redundant fetches during block validation
 	headerChainCache *catchup.HeaderChainCache
@@ -189,6 +239,22 @@ type Server struct {
 	// The success rate can be calculated as: catchupSuccesses / catchupAttempts.
 	// The value persists for the lifetime of the server and is never reset.
 	catchupSuccesses atomic.Int64
+
+	// catchupStatus tracks the progress of the current or last catchup, exposed through GetCatchupStatus.
+	// Protected by catchupStatsMu for thread-safe access.
+	catchupStatus CatchupStatus
+
+	// cancelCatchupFn cancels the context of the catchup in progress, nil when no catchup is running.
+	// Protected by catchupStatsMu for thread-safe access.
+	cancelCatchupFn context.CancelFunc
+
+	// catchupCancelled indicates whether the catchup in progress was cancelled through CancelCatchup.
+	// Protected by catchupStatsMu for thread-safe access.
+	catchupCancelled bool
+
+	// catchupRate tracks the validation rate of the catchup in progress, for the catchup rate metric.
+	// Protected by catchupStatsMu for thread-safe access.
+	catchupRate catchupRate
 }
 
 // New creates a new block validation server with the provided dependencies.
@@ -215,10 +281,6 @@ func New(
 ) *Server {
 	initPrometheusMetrics()
 
-	// TEMP limit to 1, to prevent multiple subtrees processing at the same time
-	subtreeGroup := errgroup.Group{}
-	util.SafeSetLimit(&subtreeGroup, tSettings.BlockValidation.SubtreeGroupConcurrency)
-
 	// Initialize circuit breakers for peer management
 	cbConfig := &catchup.CircuitBreakerConfig{
 		FailureThreshold:    tSettings.BlockValidation.CircuitBreakerFailureThreshold,
@@ -227,6 +289,9 @@ func New(
 		MaxHalfOpenRequests: 1,
 	}
 
+	// a catchup that never finished must not block catchups to its target block forever
+	catchupInFlightTTL := time.Duration(tSettings.BlockValidation.CatchupOperationTimeout) * time.Second
+
 	bVal := &Server{
 		logger:               logger,
 		settings:             tSettings,
@@ -238,13 +303,16 @@ func New(
 		blockAssemblyClient:  blockAssemblyClient,
 		blockFoundCh:         make(chan processBlockFound, tSettings.BlockValidation.BlockFoundChBufferSize),
 		catchupCh:            make(chan processBlockCatchup, tSettings.BlockValidation.CatchupChBufferSize),
-		processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](),
+		subtreeFoundCh:       make(chan processSubtreeFound, tSettings.BlockValidation.SubtreeFoundChConcurrency),
+		processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](ttlcache.WithTTL[chainhash.Hash, bool](processSubtreeNotifyTTL)),
+		catchupInFlight:      ttlcache.New[chainhash.Hash, *catchupInFlightEntry](ttlcache.WithTTL[chainhash.Hash, *catchupInFlightEntry](catchupInFlightTTL)),
 		stats:                gocore.NewStat("blockvalidation"),
 		kafkaConsumerClient:  kafkaConsumerClient,
 		peerCircuitBreakers:  catchup.NewPeerCircuitBreakers(*cbConfig),
 		peerMetrics: &catchup.CatchupMetrics{
 			PeerMetrics: make(map[string]*catchup.PeerCatchupMetrics),
 		},
+		knownPeers:       catchup.NewKnownPeers(maxKnownPeers),
 		headerChainCache: catchup.NewHeaderChainCache(logger), // Chain-aware cache for efficient catchup
 	}
 
@@ -297,6 +365,19 @@ func (u *Server) Health(ctx context.Context, checkLiveness bool) (int, string, e
 		})
 	}
 
+	// Check if the debug HTTP server is actually listening and accepting requests
+	if u.settings.BlockValidation.HTTPListenAddress != "" {
+		addr := u.settings.BlockValidation.HTTPListenAddress
+		if strings.HasPrefix(addr, ":") {
+			addr = "localhost" + addr
+		}
+
+		checks = append(checks, health.Check{
+			Name:  "HTTP Server",
+			Check: health.CheckHTTPServer(fmt.Sprintf("http://%s", addr), "/health"),
+		})
+	}
+
 	// Only check Kafka if we have a consumer client configured
 	if u.kafkaConsumerClient != nil {
 		checks = append(checks, health.Check{Name: "Kafka", Check: kafka.HealthChecker(ctx, brokersURL)})
@@ -335,6 +416,7 @@ func (u *Server) Health(ctx context.Context, checkLiveness bool) (int, string, e
 			u.catchupStatsMu.RLock()
 			lastTime := u.lastCatchupTime
 			lastResult := u.lastCatchupResult
+			catchupStatus := u.catchupStatus
 			u.catchupStatsMu.RUnlock()
 
 			// Format time safely
@@ -343,13 +425,15 @@ func (u *Server) Health(ctx context.Context, checkLiveness bool) (int, string, e
 				timeStr = lastTime.Format(time.RFC3339)
 			}
 
-			status := fmt.Sprintf("active=%v, last_time=%s, last_success=%v, attempts=%d, successes=%d, rate=%.2f",
+			status := fmt.Sprintf("active=%v, last_time=%s, last_success=%v, attempts=%d, successes=%d, rate=%.2f, validated=%d/%d",
 				u.isCatchingUp.Load(),
 				timeStr,
 				lastResult,
 				attempts,
 				successes,
 				successRate,
+				catchupStatus.BlocksValidated,
+				catchupStatus.TotalBlocks,
 			)
 
 			return http.StatusOK, status, nil
@@ -399,6 +483,15 @@ func (u *Server) Init(ctx context.Context) (err error) {
 		return errors.NewConfigurationError("could not get utxostore URL", err)
 	}
 
+	// fail early on a typo in the error codes, rather than on the first kafka message that fails
+	if _, err = kafkaErrorCodes(u.settings.BlockValidation.KafkaRecoverableErrors); err != nil {
+		return errors.NewConfigurationError("[Init] invalid blockvalidation_kafka_recoverable_errors setting", err)
+	}
+
+	if _, err = kafkaErrorCodes(u.settings.BlockValidation.KafkaNonRecoverableErrors); err != nil {
+		return errors.NewConfigurationError("[Init] invalid blockvalidation_kafka_non_recoverable_errors setting", err)
+	}
+
 	// Only create a new BlockValidation if one wasn't already set (for testing)
 	if u.blockValidation == nil {
 		u.blockValidation = NewBlockValidation(ctx, u.logger, u.settings, u.blockchainClient, u.subtreeStore, u.txStore, u.utxoStore, u.validatorClient, subtreeValidationClient)
@@ -419,73 +512,50 @@ func (u *Server) Init(ctx context.Context) (err error) {
 
 	go u.processSubtreeNotify.Start()
 
+	if u.catchupInFlight != nil {
+		go u.catchupInFlight.Start()
+	}
+
+	// validate announced subtrees ahead of their blocks
+	for i := 0; i < max(1, u.settings.BlockValidation.SubtreeFoundChConcurrency); i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case subtreeFound := <-u.subtreeFoundCh:
+					if err := u.processSubtreeFound(ctx, subtreeFound); err != nil {
+						u.logger.Warnf("[SubtreeFound][%s] failed to validate subtree from %s: %v", subtreeFound.hash.String(), subtreeFound.baseURL, err)
+					}
+				}
+			}
+		}()
+	}
+
+	u.stopCh = make(chan struct{})
+	u.blockLoopDone = make(chan struct{})
+
 	// process blocks found from channel
 	go func() {
+		defer close(u.blockLoopDone)
+
 		for {
 			select {
 			case <-ctx.Done():
 				u.logger.Infof("[Init] closing block found channel")
 				return
 
-			case c := <-u.catchupCh:
-				{
-					if u.peerMetrics != nil && c.peerID != "" {
-						peerMetric := u.peerMetrics.GetOrCreatePeerMetrics(c.peerID)
-						if peerMetric != nil {
-							if peerMetric.IsBad() || peerMetric.IsMalicious() {
-								u.logger.Warnf("[catchup][%s] peer %s (%s) is marked as bad (score: %0.0f) or malicious (attempts: %d), skipping", c.block.Hash().String(), c.peerID, c.baseURL, peerMetric.GetReputation(), peerMetric.GetMaliciousAttempts())
-								continue
-							}
-						}
-					}
+			case <-u.stopCh:
+				u.logger.Infof("[Init] stopping, processing the queued blocks")
+				u.processQueuedBlocks(ctx)
 
-					if err := u.catchup(ctx, c.block, c.baseURL, c.peerID); err != nil {
-						var (
-							peerMetric        *catchup.PeerCatchupMetrics
-							reputationScore   float64
-							maliciousAttempts int64
-						)
-
-						// this should be moved into the catchup directly...
-						if u.peerMetrics != nil && c.peerID != "" {
-							peerMetric = u.peerMetrics.GetOrCreatePeerMetrics(c.peerID)
-							if peerMetric != nil {
-								peerMetric.RecordFailure()
-								reputationScore = peerMetric.ReputationScore
-								maliciousAttempts = peerMetric.MaliciousAttempts
-
-								if !peerMetric.IsTrusted() {
-									u.logger.Warnf("[catchup][%s] peer %s has low reputation score: %.2f, malicious attempts: %d", c.block.Hash().String(), c.peerID, reputationScore, maliciousAttempts)
-								}
-							}
-						}
-
-						u.logger.Errorf("[Init] failed to process catchup signal for block [%s], peer reputation: %.2f, malicious attempts: %d, [%v]", c.block.Hash().String(), reputationScore, maliciousAttempts, err)
-
-						// Report peer failure to blockchain service (which notifies P2P to switch peers)
-						if reportErr := u.blockchainClient.ReportPeerFailure(ctx, c.block.Hash(), c.peerID, "catchup", err.Error()); reportErr != nil {
-							u.logger.Errorf("[Init] failed to report peer failure: %v", reportErr)
-						}
-					}
-				}
+				return
+
+			case c := <-u.catchupCh:
+				u.processCatchupChannel(ctx, c)
 
 			case blockFound := <-u.blockFoundCh:
-				{
-					if err := u.processBlockFoundChannel(ctx, blockFound); err != nil {
-						if u.peerMetrics != nil && blockFound.peerID != "" {
-							peerMetric := u.peerMetrics.GetOrCreatePeerMetrics(blockFound.peerID)
-							if peerMetric != nil {
-								peerMetric.RecordFailure()
-							}
-
-							if !peerMetric.IsTrusted() {
-								u.logger.Warnf("[catchup][%s] peer %s has low reputation score: %.2f, malicious attempts: %d", blockFound.hash.String(), blockFound.peerID, peerMetric.ReputationScore, peerMetric.MaliciousAttempts)
-							}
-						}
-
-						u.logger.Errorf("[Init] failed to process block found [%s] [%v]", blockFound.hash.String(), err)
-					}
-				}
+				u.processBlockFoundChannelItem(ctx, blockFound)
 			}
 		}
 	}()
@@ -493,6 +563,107 @@ func (u *Server) Init(ctx context.Context) (err error) {
 	return nil
 }
 
+// processQueuedBlocks processes the blocks and catchups that are still queued when the service
+// is stopping, returning once both channels are empty or the context is cancelled.
+//
+// Parameters:
+//   - ctx: Context for processing operations and cancellation
+func (u *Server) processQueuedBlocks(ctx context.Context) {
+	for ctx.Err() == nil {
+		select {
+		case c := <-u.catchupCh:
+			u.processCatchupChannel(ctx, c)
+
+		case blockFound := <-u.blockFoundCh:
+			u.processBlockFoundChannelItem(ctx, blockFound)
+
+		default:
+			return
+		}
+	}
+}
+
+// processCatchupChannel runs a catchup received on the catchup channel, skipping peers that are
+// marked as bad or malicious, and reports the peer when the catchup fails.
+//
+// Parameters:
+//   - ctx: Context for processing operations and cancellation
+//   - c: Catchup request containing the target block and the peer to catch up from
+func (u *Server) processCatchupChannel(ctx context.Context, c processBlockCatchup) {
+	if u.peerMetrics != nil && c.peerID != "" {
+		peerMetric := u.peerMetrics.GetOrCreatePeerMetrics(c.peerID)
+		if peerMetric != nil {
+			if peerMetric.IsBad() || peerMetric.IsMalicious() {
+				u.logger.Warnf("[catchup][%s] peer %s (%s) is marked as bad (score: %0.0f) or malicious (attempts: %d), skipping", c.block.Hash().String(), c.peerID, c.baseURL, peerMetric.GetReputation(), peerMetric.GetMaliciousAttempts())
+				u.retryCatchupFromNextPeer(c)
+
+				return
+			}
+		}
+	}
+
+	err := u.catchup(ctx, c.block, c.baseURL, c.peerID)
+	if err == nil {
+		// another peer may announce the block again, which should trigger a new catchup
+		u.releaseCatchupInFlight(c.block.Hash())
+
+		return
+	}
+
+	var (
+		peerMetric        *catchup.PeerCatchupMetrics
+		reputationScore   float64
+		maliciousAttempts int64
+	)
+
+	// this should be moved into the catchup directly...
+	if u.peerMetrics != nil && c.peerID != "" {
+		peerMetric = u.peerMetrics.GetOrCreatePeerMetrics(c.peerID)
+		if peerMetric != nil {
+			peerMetric.RecordFailure()
+			reputationScore = peerMetric.ReputationScore
+			maliciousAttempts = peerMetric.MaliciousAttempts
+
+			if !peerMetric.IsTrusted() {
+				u.logger.Warnf("[catchup][%s] peer %s has low reputation score: %.2f, malicious attempts: %d", c.block.Hash().String(), c.peerID, reputationScore, maliciousAttempts)
+			}
+		}
+	}
+
+	u.logger.Errorf("[Init] failed to process catchup signal for block [%s], peer reputation: %.2f, malicious attempts: %d, [%v]", c.block.Hash().String(), reputationScore, maliciousAttempts, err)
+
+	// Report peer failure to blockchain service (which notifies P2P to switch peers)
+	if reportErr := u.blockchainClient.ReportPeerFailure(ctx, c.block.Hash(), c.peerID, "catchup", err.Error()); reportErr != nil {
+		u.logger.Errorf("[Init] failed to report peer failure: %v", reportErr)
+	}
+
+	// the peers that announced the block while the catchup was in flight were not queued, retry from the next of them
+	u.retryCatchupFromNextPeer(c)
+}
+
+// processBlockFoundChannelItem processes a block received on the block found channel, recording
+// a failure for the announcing peer when the block could not be processed.
+//
+// Parameters:
+//   - ctx: Context for processing operations and cancellation
+//   - blockFound: Block found request containing hash and processing metadata
+func (u *Server) processBlockFoundChannelItem(ctx context.Context, blockFound processBlockFound) {
+	if err := u.processBlockFoundChannel(ctx, blockFound); err != nil {
+		if u.peerMetrics != nil && blockFound.peerID != "" {
+			peerMetric := u.peerMetrics.GetOrCreatePeerMetrics(blockFound.peerID)
+			if peerMetric != nil {
+				peerMetric.RecordFailure()
+			}
+
+			if !peerMetric.IsTrusted() {
+				u.logger.Warnf("[catchup][%s] peer %s has low reputation score: %.2f, malicious attempts: %d", blockFound.hash.String(), blockFound.peerID, peerMetric.ReputationScore, peerMetric.MaliciousAttempts)
+			}
+		}
+
+		u.logger.Errorf("[Init] failed to process block found [%s] [%v]", blockFound.hash.String(), err)
+	}
+}
+
 func (u *Server) consumerMessageHandler(ctx context.Context) func(msg *kafka.KafkaMessage) error {
 	return func(msg *kafka.KafkaMessage) error {
 		if msg == nil {
@@ -520,7 +691,7 @@ func (u *Server) consumerMessageHandler(ctx context.Context) func(msg *kafka.Kaf
 			// if error is not nil, check if the error is a recoverable error.
 			// If the error is a recoverable error, then return the error, so that it kafka message is not marked as committed.
 			// So the message will be consumed again.
-			if errors.Is(err, errors.ErrServiceError) || errors.Is(err, errors.ErrStorageError) || errors.Is(err, errors.ErrThresholdExceeded) || errors.Is(err, errors.ErrContextCanceled) || errors.Is(err, errors.ErrExternal) {
+			if u.isRecoverableKafkaError(err) {
 				u.logger.Errorf("Recoverable error (%v) processing kafka message %v for handling block, returning error, thus not marking Kafka message as complete.\n", msg, err)
 				return err
 			}
@@ -542,6 +713,56 @@ func (u *Server) consumerMessageHandler(ctx context.Context) func(msg *kafka.Kaf
 	}
 }
 
+// isRecoverableKafkaError reports whether processing a kafka block message should be retried after the given error,
+// in which case the message is not committed and will be consumed again.
+//
+// An error is recoverable when it matches one of the error codes in blockvalidation_kafka_recoverable_errors,
+// unless it also matches one of the error codes in blockvalidation_kafka_non_recoverable_errors, which takes precedence.
+// This allows operators to stop the redelivery of a message that keeps failing with a specific error.
+func (u *Server) isRecoverableKafkaError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// the error codes are validated in Init
+	nonRecoverable, _ := kafkaErrorCodes(u.settings.BlockValidation.KafkaNonRecoverableErrors)
+	for _, target := range nonRecoverable {
+		if errors.Is(err, target) {
+			return false
+		}
+	}
+
+	recoverable, _ := kafkaErrorCodes(u.settings.BlockValidation.KafkaRecoverableErrors)
+	for _, target := range recoverable {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kafkaErrorCodes converts error code names, e.g. SERVICE_ERROR, into errors that can be matched with errors.Is.
+func kafkaErrorCodes(names []string) ([]error, error) {
+	targets := make([]error, 0, len(names))
+
+	for _, name := range names {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		code, ok := errors.ERR_value[name]
+		if !ok {
+			return nil, errors.NewInvalidArgumentError("unknown error code %q", name)
+		}
+
+		targets = append(targets, errors.New(errors.ERR(code), name))
+	}
+
+	return targets, nil
+}
+
 func (u *Server) blockHandler(kafkaMsg *kafkamessage.KafkaBlockTopicMessage) error {
 	hash, err := chainhash.NewHashFromStr(kafkaMsg.Hash)
 	if err != nil {
@@ -549,6 +770,10 @@ func (u *Server) blockHandler(kafkaMsg *kafkamessage.KafkaBlockTopicMessage) err
 		return err
 	}
 
+	if u.stopping.Load() {
+		return errors.NewServiceUnavailableError("[BlockFound][%s] service is stopping, not accepting new blocks", hash.String())
+	}
+
 	baseURL, err := url.Parse(kafkaMsg.URL)
 	if err != nil {
 		u.logger.Errorf("Failed to parse block base url from message: %v", err)
@@ -615,6 +840,104 @@ func (u *Server) blockHandler(kafkaMsg *kafkamessage.KafkaBlockTopicMessage) err
 	return nil
 }
 
+// catchupPeer identifies a peer to catch up from.
+type catchupPeer struct {
+	baseURL string
+	peerID  string
+}
+
+// catchupInFlightEntry holds the peer a catchup is queued or running from, and the other peers that
+// announced the target block in the meantime.
+type catchupInFlightEntry struct {
+	peer    catchupPeer
+	waiting []catchupPeer
+}
+
+// markCatchupInFlight records that a catchup to the given block from the given peer is queued or running.
+// Returns false when a catchup to the block is already in flight, in which case no new catchup should be started,
+// the peer is then kept to retry the catchup from if the catchup in flight fails.
+func (u *Server) markCatchupInFlight(hash *chainhash.Hash, baseURL string, peerID string) bool {
+	if u.catchupInFlight == nil {
+		return true
+	}
+
+	peer := catchupPeer{baseURL: baseURL, peerID: peerID}
+
+	u.catchupInFlightMu.Lock()
+	defer u.catchupInFlightMu.Unlock()
+
+	if item := u.catchupInFlight.Get(*hash); item != nil {
+		entry := item.Value()
+		if peer != entry.peer && !slices.Contains(entry.waiting, peer) {
+			entry.waiting = append(entry.waiting, peer)
+		}
+
+		return false
+	}
+
+	u.catchupInFlight.Set(*hash, &catchupInFlightEntry{peer: peer}, ttlcache.DefaultTTL)
+
+	return true
+}
+
+// releaseCatchupInFlight removes the given block from the in-flight catchups once its catchup finished.
+func (u *Server) releaseCatchupInFlight(hash *chainhash.Hash) {
+	if u.catchupInFlight != nil {
+		u.catchupInFlightMu.Lock()
+		u.catchupInFlight.Delete(*hash)
+		u.catchupInFlightMu.Unlock()
+	}
+}
+
+// nextCatchupInFlightPeer returns the next peer that announced the given block while its catchup was in flight,
+// which becomes the peer the catchup is in flight from. The block is removed from the in-flight catchups when
+// no other peer announced it.
+func (u *Server) nextCatchupInFlightPeer(hash *chainhash.Hash) (catchupPeer, bool) {
+	if u.catchupInFlight == nil {
+		return catchupPeer{}, false
+	}
+
+	u.catchupInFlightMu.Lock()
+	defer u.catchupInFlightMu.Unlock()
+
+	item := u.catchupInFlight.Get(*hash)
+	if item == nil {
+		return catchupPeer{}, false
+	}
+
+	entry := item.Value()
+	if len(entry.waiting) == 0 {
+		u.catchupInFlight.Delete(*hash)
+
+		return catchupPeer{}, false
+	}
+
+	entry.peer = entry.waiting[0]
+	entry.waiting = entry.waiting[1:]
+
+	return entry.peer, true
+}
+
+// retryCatchupFromNextPeer queues the failed or skipped catchup again from the next peer that announced the block
+// while the catchup was in flight, or releases the block from the in-flight catchups when there is no such peer.
+func (u *Server) retryCatchupFromNextPeer(c processBlockCatchup) {
+	peer, ok := u.nextCatchupInFlightPeer(c.block.Hash())
+	if !ok {
+		return
+	}
+
+	u.logger.Infof("[catchup][%s] retrying catchup from %s (%s)", c.block.Hash().String(), peer.peerID, peer.baseURL)
+
+	// the catchup channel is read by the caller, do not block on it
+	go func() {
+		u.catchupCh <- processBlockCatchup{
+			block:   c.block,
+			baseURL: peer.baseURL,
+			peerID:  peer.peerID,
+		}
+	}()
+}
+
 // processBlockFoundChannel processes newly found blocks from the block found channel.
 // It implements intelligent routing between normal processing and catchup mode based
 // on the current backlog depth to optimize validation performance.
@@ -634,6 +957,8 @@ func (u *Server) processBlockFoundChannel(ctx context.Context, blockFound proces
 				blockFound.hash.String(), blockFound.baseURL, blockFound.peerID)
 			return errors.NewProcessingError("[processBlockFoundChannel][%s] invalid baseURL - not a valid http/https URL", blockFound.hash.String())
 		}
+
+		u.knownPeers.Record(blockFound.peerID, blockFound.baseURL)
 	}
 
 	// TODO GOKHAN: parameterize this
@@ -668,8 +993,16 @@ func (u *Server) processBlockFoundChannel(ctx context.Context, blockFound proces
 		u.logger.Infof("[Init] peerBlocks: %v", peerBlocks)
 		// add that latest block of each peer to the catchup channel
 		for _, pb := range peerBlocks {
+			// several peers usually announce the same block, which only needs a single catchup
+			if !u.markCatchupInFlight(pb.hash, pb.baseURL, pb.peerID) {
+				u.logger.Infof("[processBlockFoundChannel][%s] catchup to block already in progress, skipping catchup from %s", pb.hash.String(), pb.baseURL)
+				continue
+			}
+
 			block, err := u.fetchSingleBlock(ctx, pb.hash, pb.baseURL)
 			if err != nil {
+				u.releaseCatchupInFlight(pb.hash)
+
 				// acknowledge all errCh channels before returning error
 				for _, item := range allDrainedItems {
 					if item.errCh != nil {
@@ -740,6 +1073,11 @@ func (u *Server) Start(ctx context.Context, readyCh chan<- struct{}) error {
 	// start blocks kafka consumer
 	u.kafkaConsumerClient.Start(ctx, u.consumerMessageHandler(ctx), kafka.WithLogErrorAndMoveOn())
 
+	// start the http listener for the debug endpoints
+	if u.settings.BlockValidation.HTTPListenAddress != "" {
+		u.startHTTPServer(ctx, u.settings.BlockValidation.HTTPListenAddress)
+	}
+
 	// this will block
 	if err := util.StartGRPCServer(ctx, u.logger, u.settings, "blockvalidation", u.settings.BlockValidation.GRPCListenAddress, func(server *grpc.Server) {
 		blockvalidation_api.RegisterBlockValidationAPIServer(server, u)
@@ -755,13 +1093,24 @@ func (u *Server) Start(ctx context.Context, readyCh chan<- struct{}) error {
 // processing components and waiting for ongoing validation operations to complete.
 // It ensures clean termination of all service resources and connections.
 //
+// New blocks are no longer accepted once Stop is called. The blocks and catchups that are
+// already queued are processed, for at most blockvalidation_stop_timeout, so that a block
+// is not abandoned half-validated during a restart. Blocks that are still queued after the
+// timeout are dropped, and recovered through catchup after the restart.
+//
 // Parameters:
 //   - ctx: Context for shutdown operations (currently unused)
 //
 // Returns an error if shutdown encounters issues, though typically returns nil
 func (u *Server) Stop(_ context.Context) error {
+	u.stopBlockProcessing()
+
 	u.processSubtreeNotify.Stop()
 
+	if u.catchupInFlight != nil {
+		u.catchupInFlight.Stop()
+	}
+
 	// Wait for all background tasks in BlockValidation to complete
 	if u.blockValidation != nil {
 		u.blockValidation.Wait()
@@ -775,6 +1124,29 @@ func (u *Server) Stop(_ context.Context) error {
 	return nil
 }
 
+// stopBlockProcessing stops accepting new blocks and signals the block processing loop to process
+// the queued blocks and exit, waiting for at most blockvalidation_stop_timeout for it to finish.
+func (u *Server) stopBlockProcessing() {
+	u.stopping.Store(true)
+
+	// the block processing loop is only started in Init
+	if u.stopCh == nil {
+		return
+	}
+
+	u.stopOnce.Do(func() {
+		close(u.stopCh)
+	})
+
+	select {
+	case <-u.blockLoopDone:
+		u.logger.Infof("[BlockValidation] processed all queued blocks")
+	case <-time.After(u.settings.BlockValidation.StopTimeout):
+		u.logger.Warnf("[BlockValidation] timed out after %s waiting for the queued blocks to be processed, dropping %d queued blocks and %d queued catchups",
+			u.settings.BlockValidation.StopTimeout, len(u.blockFoundCh), len(u.catchupCh))
+	}
+}
+
 // BlockFound notifies the service about a newly discovered block that needs validation.
 // It initiates the block validation process, optionally waiting for completion based
 // on the request parameters.
@@ -803,6 +1175,10 @@ func (u *Server) BlockFound(ctx context.Context, req *blockvalidation_api.BlockF
 			errors.NewProcessingError("[BlockFound][%s] failed to create hash from bytes", utils.ReverseAndHexEncodeSlice(req.Hash), err))
 	}
 
+	if u.stopping.Load() {
+		return nil, errors.WrapGRPC(errors.NewServiceUnavailableError("[BlockFound][%s] service is stopping, not accepting new blocks", hash.String()))
+	}
+
 	// first check if the block exists, it is very expensive to do all the checks below
 	exists, err := u.blockValidation.GetBlockExists(ctx, hash)
 	if err != nil {
@@ -843,6 +1219,94 @@ func (u *Server) BlockFound(ctx context.Context, req *blockvalidation_api.BlockF
 	return &blockvalidation_api.EmptyMessage{}, nil
 }
 
+// SubtreeFound handles the announcement of a new subtree by a peer. The subtree is queued for
+// validation by the subtree validation service, so that the subtree store is warm by the time the
+// block containing it arrives, which cuts block validation latency.
+//
+// The same subtree is usually announced by many miners, the processSubtreeNotify cache makes sure
+// it is only queued once. When the queue is full the announcement is dropped, the subtree will still
+// be validated as part of its block.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - req: Contains the subtree hash, the base URL to fetch it from and the peer ID
+//
+// Returns an EmptyMessage, or an error if the request is invalid
+func (u *Server) SubtreeFound(ctx context.Context, req *blockvalidation_api.SubtreeFoundRequest) (*blockvalidation_api.EmptyMessage, error) {
+	_, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "SubtreeFound",
+		tracing.WithParentStat(u.stats),
+		tracing.WithDebugLogMessage(u.logger, "[SubtreeFound][%s] called from %s", utils.ReverseAndHexEncodeSlice(req.Hash), req.GetBaseUrl()),
+	)
+	defer deferFn()
+
+	hash, err := chainhash.NewHash(req.Hash)
+	if err != nil {
+		return nil, errors.WrapGRPC(
+			errors.NewInvalidArgumentError("[SubtreeFound][%s] failed to create hash from bytes", utils.ReverseAndHexEncodeSlice(req.Hash), err))
+	}
+
+	parsedURL, err := url.Parse(req.GetBaseUrl())
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, errors.WrapGRPC(
+			errors.NewInvalidArgumentError("[SubtreeFound][%s] invalid baseURL %q - not a valid http/https URL", hash.String(), req.GetBaseUrl()))
+	}
+
+	if _, found := u.processSubtreeNotify.GetOrSet(*hash, true); found {
+		prometheusBlockValidationSubtreeFound.WithLabelValues("duplicate").Inc()
+		return &blockvalidation_api.EmptyMessage{}, nil
+	}
+
+	select {
+	case u.subtreeFoundCh <- processSubtreeFound{hash: hash, baseURL: req.GetBaseUrl(), peerID: req.GetPeerId()}:
+		prometheusBlockValidationSubtreeFound.WithLabelValues("queued").Inc()
+	default:
+		// allow a later announcement of the subtree to queue it again
+		u.processSubtreeNotify.Delete(*hash)
+		prometheusBlockValidationSubtreeFound.WithLabelValues("dropped").Inc()
+		u.logger.Debugf("[SubtreeFound][%s] subtree found channel is full, dropping announcement from %s", hash.String(), req.GetBaseUrl())
+	}
+
+	return &blockvalidation_api.EmptyMessage{}, nil
+}
+
+// processSubtreeFound validates an announced subtree through the subtree validation service,
+// unless the subtree is already in the subtree store. The subtree is validated against the
+// next block height, since it is expected to be part of the next block.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - subtreeFound: The announced subtree
+//
+// Returns an error if the subtree could not be validated
+func (u *Server) processSubtreeFound(ctx context.Context, subtreeFound processSubtreeFound) (err error) {
+	defer func() {
+		if err != nil {
+			// the subtree may be announced again, or fetched with its block
+			u.processSubtreeNotify.Delete(*subtreeFound.hash)
+		}
+	}()
+
+	exists, err := u.blockValidation.GetSubtreeExists(ctx, subtreeFound.hash)
+	if err != nil {
+		return errors.NewServiceError("[SubtreeFound][%s] failed to check if subtree exists", subtreeFound.hash.String(), err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	_, bestBlockHeaderMeta, err := u.blockchainClient.GetBestBlockHeader(ctx)
+	if err != nil {
+		return errors.NewServiceError("[SubtreeFound][%s] failed to get best block header", subtreeFound.hash.String(), err)
+	}
+
+	if err = u.blockValidation.subtreeValidationClient.CheckSubtreeFromBlock(ctx, *subtreeFound.hash, subtreeFound.baseURL, bestBlockHeaderMeta.Height+1, nil, nil); err != nil {
+		return errors.NewServiceError("[SubtreeFound][%s] failed to validate subtree", subtreeFound.hash.String(), err)
+	}
+
+	return nil
+}
+
 // ProcessBlock handles validation of a complete block at a specified height.
 // This method is typically used during initial block sync or when receiving blocks
 // through legacy interfaces.
@@ -853,9 +1317,13 @@ func (u *Server) BlockFound(ctx context.Context, req *blockvalidation_api.BlockF
 // - Processes the block through the validation pipeline
 // - Updates chain state if validation succeeds
 //
+// When DryRun is set in the request, the block is only validated against the current stores, as in
+// ValidateBlock: the block is not stored, its transactions are not updated and no notifications are sent.
+// An invalid block returns a block invalid error with the reason.
+//
 // Parameters:
 //   - ctx: Context for the operation
-//   - request: Contains the raw block data and target height
+//   - request: Contains the raw block data, target height and dry run flag
 //
 // Returns an EmptyMessage on successful validation or an error if validation fails.
 func (u *Server) ProcessBlock(ctx context.Context, request *blockvalidation_api.ProcessBlockRequest) (*blockvalidation_api.EmptyMessage, error) {
@@ -891,6 +1359,18 @@ func (u *Server) ProcessBlock(ctx context.Context, request *blockvalidation_api.
 
 	block.Height = height
 
+	if request.DryRun {
+		// validate the block without storing it, so externally constructed blocks can be checked without side effects
+		if err = u.validateBlockWithoutStoring(ctx, block); err != nil {
+			u.logger.Infof("[ProcessBlock][%s] dry run: block is not valid: %v", block.Hash(), err)
+			return nil, errors.WrapGRPC(err)
+		}
+
+		u.logger.Infof("[ProcessBlock][%s] dry run: block is valid", block.Hash())
+
+		return &blockvalidation_api.EmptyMessage{}, nil
+	}
+
 	baseURL := request.BaseUrl
 	if baseURL == "" {
 		baseURL = "legacy" // default to legacy if not provided
@@ -933,15 +1413,37 @@ func (u *Server) ValidateBlock(ctx context.Context, request *blockvalidation_api
 	)
 	defer deferFn()
 
+	if err = u.validateBlockWithoutStoring(ctx, block); err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	return &blockvalidation_api.ValidateBlockResponse{
+		Ok:      true,
+		Message: fmt.Sprintf("Block %s is valid", block.String()),
+	}, nil
+}
+
+// validateBlockWithoutStoring validates the block against the current stores, without storing the block,
+// updating the state of its transactions or sending notifications. The subtrees of the block must already
+// be in the subtree store, since they are not fetched from peers.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - block: The block to validate, with its height set
+//
+// Returns:
+//   - error: A block invalid error with the reason when the block is not valid, or an error if the
+//     validation could not be done
+func (u *Server) validateBlockWithoutStoring(ctx context.Context, block *model.Block) error {
 	// Wait for block assembly to be ready before processing the block
-	if err = blockassemblyutil.WaitForBlockAssemblyReady(ctx, u.logger, u.blockAssemblyClient, block.Height, uint32(u.settings.ChainCfgParams.CoinbaseMaturity/2)); err != nil {
+	if err := blockassemblyutil.WaitForBlockAssemblyReady(ctx, u.logger, u.blockAssemblyClient, block.Height, uint32(u.settings.ChainCfgParams.CoinbaseMaturity/2)); err != nil {
 		// block-assembly is still behind, so we cannot process this block
-		return nil, errors.WrapGRPC(err)
+		return err
 	}
 
 	blockHeaders, blockHeadersMeta, err := u.blockchainClient.GetBlockHeaders(ctx, block.Header.HashPrevBlock, u.settings.BlockValidation.PreviousBlockHeaderCount)
 	if err != nil {
-		return nil, errors.WrapGRPC(errors.NewServiceError("[ValidateBlock][%s] failed to get block headers", block.String(), err))
+		return errors.NewServiceError("[ValidateBlock][%s] failed to get block headers", block.String(), err)
 	}
 
 	blockHeaderIDs := make([]uint32, len(blockHeadersMeta))
@@ -954,20 +1456,109 @@ func (u *Server) ValidateBlock(ctx context.Context, request *blockvalidation_api
 	// only get the bloom filters for the current chain
 	bloomFilters, err := u.blockValidation.collectNecessaryBloomFilters(ctx, block, blockHeaders)
 	if err != nil {
-		return nil, errors.WrapGRPC(errors.NewServiceError("[ValidateBlock][%s] failed to collect necessary bloom filters", block.String(), err))
+		return errors.NewServiceError("[ValidateBlock][%s] failed to collect necessary bloom filters", block.String(), err)
 	}
 
-	if ok, err := block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, nil, u.settings); !ok {
-		return nil, errors.WrapGRPC(errors.NewBlockInvalidError("[ValidateBlock][%s] block is not valid", block.String(), err))
+	if ok, err := block.Valid(ctx, u.logger, u.subtreeStore, u.utxoStore, oldBlockIDsMap, bloomFilters, blockHeaders, blockHeaderIDs, nil, false, u.settings); !ok {
+		return errors.NewBlockInvalidError("[ValidateBlock][%s] block is not valid", block.String(), err)
 	}
 
 	if err = u.blockValidation.checkOldBlockIDs(ctx, oldBlockIDsMap, block); err != nil {
-		return nil, errors.WrapGRPC(errors.NewBlockInvalidError("[ValidateBlock][%s] block is not valid", block.String(), err))
+		return errors.NewBlockInvalidError("[ValidateBlock][%s] block is not valid", block.String(), err)
 	}
 
-	return &blockvalidation_api.ValidateBlockResponse{
-		Ok:      true,
-		Message: fmt.Sprintf("Block %s is valid", block.String()),
+	return nil
+}
+
+// GetCatchupStatus returns the progress of the catchup in progress. When no catchup is running,
+// the response describes the last catchup since the server started, with IsCatchingUp set to false.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - _: Empty request message
+//
+// Returns:
+//   - The status of the current or last catchup
+//   - An error, which is always nil
+func (u *Server) GetCatchupStatus(_ context.Context, _ *blockvalidation_api.EmptyMessage) (*blockvalidation_api.CatchupStatusResponse, error) {
+	status := u.getCatchupStatus()
+
+	response := &blockvalidation_api.CatchupStatusResponse{
+		IsCatchingUp:    status.IsCatchingUp,
+		TargetHeight:    status.TargetHeight,
+		CurrentHeight:   status.CurrentHeight,
+		BlocksValidated: status.BlocksValidated,
+		TotalBlocks:     status.TotalBlocks,
+		PeerId:          status.PeerID,
+	}
+
+	if status.TargetHash != nil {
+		response.TargetHash = status.TargetHash.CloneBytes()
+	}
+
+	if !status.StartTime.IsZero() {
+		response.StartTime = timestamppb.New(status.StartTime)
+	}
+
+	return response, nil
+}
+
+// CancelCatchup aborts the catchup in progress by cancelling its context, without stopping the service.
+// Blocks that were already validated are kept, and the node is returned to the RUNNING state.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - _: Empty request message
+//
+// Returns:
+//   - A response indicating whether a catchup was cancelled
+//   - An error, which is always nil
+func (u *Server) CancelCatchup(_ context.Context, _ *blockvalidation_api.EmptyMessage) (*blockvalidation_api.CancelCatchupResponse, error) {
+	cancelled := u.cancelInFlightCatchup()
+	if cancelled {
+		u.logger.Warnf("[CancelCatchup] cancelling catchup in progress")
+	}
+
+	return &blockvalidation_api.CancelCatchupResponse{
+		Cancelled: cancelled,
+	}, nil
+}
+
+// GetSubtreeMeta returns the serialized subtree meta of a subtree, as stored in the subtree store.
+// The subtree meta holds the parent tx hashes of every transaction in the subtree, which are used
+// to check the transaction order when validating a block.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - req: Contains the hash of the subtree
+//
+// Returns:
+//   - The serialized subtree meta
+//   - A subtree not found error if no subtree meta is stored for the subtree, or an error if retrieval fails
+func (u *Server) GetSubtreeMeta(ctx context.Context, req *blockvalidation_api.GetSubtreeMetaRequest) (*blockvalidation_api.GetSubtreeMetaResponse, error) {
+	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "GetSubtreeMeta",
+		tracing.WithParentStat(u.stats),
+		tracing.WithDebugLogMessage(u.logger, "[GetSubtreeMeta][%s] called", utils.ReverseAndHexEncodeSlice(req.Hash)),
+	)
+	defer deferFn()
+
+	hash, err := chainhash.NewHash(req.Hash)
+	if err != nil {
+		return nil, errors.WrapGRPC(
+			errors.NewProcessingError("[GetSubtreeMeta][%s] failed to create hash from bytes", utils.ReverseAndHexEncodeSlice(req.Hash), err))
+	}
+
+	subtreeMetaBytes, err := u.subtreeStore.Get(ctx, hash[:], fileformat.FileTypeSubtreeMeta)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBlobNotFound) {
+			return nil, errors.WrapGRPC(errors.NewSubtreeNotFoundError("[GetSubtreeMeta][%s] subtree meta not found", hash.String(), err))
+		}
+
+		return nil, errors.WrapGRPC(errors.NewStorageError("[GetSubtreeMeta][%s] failed to get subtree meta", hash.String(), err))
+	}
+
+	return &blockvalidation_api.GetSubtreeMetaResponse{
+		SubtreeMeta: subtreeMetaBytes,
 	}, nil
 }
 
@@ -1011,7 +1602,15 @@ func (u *Server) processBlockFound(ctx context.Context, hash *chainhash.Hash, ba
 		}
 	}
 
-	u.checkParentProcessingComplete(ctx, block, baseURL)
+	parentComplete, err := u.checkParentProcessingComplete(ctx, block, baseURL)
+	if err != nil {
+		return errors.NewContextCanceledError("[processBlockFound][%s] cancelled while waiting for parent block processing", hash.String(), err)
+	}
+
+	if !parentComplete {
+		// the parent is checked below, if it is not stored yet the block goes through catchup
+		u.logger.Warnf("[processBlockFound][%s] continuing without waiting for parent block %s", hash.String(), block.Header.HashPrevBlock.String())
+	}
 
 	// catchup if we are missing the parent block.
 	parentExists, err := u.blockValidation.GetBlockExists(ctx, block.Header.HashPrevBlock)
@@ -1020,6 +1619,11 @@ func (u *Server) processBlockFound(ctx context.Context, hash *chainhash.Hash, ba
 	}
 
 	if !parentExists {
+		if !u.markCatchupInFlight(hash, baseURL, peerID) {
+			u.logger.Infof("[processBlockFound][%s] catchup to block already in progress, not adding to catchup channel", hash.String())
+			return nil
+		}
+
 		// add to catchup channel, which will block processing any new blocks until we have caught up
 		go func() {
 			u.logger.Debugf("[processBlockFound][%s] processBlockFound add to catchup channel", hash.String())
@@ -1066,66 +1670,101 @@ func (u *Server) processBlockFound(ctx context.Context, hash *chainhash.Hash, ba
 	return nil
 }
 
+const (
+	// processSubtreeNotifyTTL is how long an announced subtree is remembered, a subtree announced again
+	// after that is checked against the subtree store again before it is queued
+	processSubtreeNotifyTTL = 10 * time.Minute
+
+	// parentProcessingInitialDelay is the first wait while the parent of a block is being validated
+	parentProcessingInitialDelay = 10 * time.Millisecond
+
+	// parentProcessingMaxDelay caps the wait between two checks of the parent block
+	parentProcessingMaxDelay = 10 * time.Second
+)
+
+// parentProcessingBackoff returns the wait before the next check of the parent block after the given
+// number of retries, doubling from parentProcessingInitialDelay up to parentProcessingMaxDelay.
+func parentProcessingBackoff(retries int) time.Duration {
+	delay := parentProcessingInitialDelay
+
+	for i := 0; i < retries && delay < parentProcessingMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, parentProcessingMaxDelay)
+}
+
 // checkParentProcessingComplete ensures that a block's parent has completed validation
 // before allowing the current block's validation to proceed. This method implements
 // a backoff strategy while waiting for parent block processing to complete.
 //
 // The method:
 // - Verifies parent block validation status
-// - Implements exponential backoff for retry attempts
-// - Monitors both validation and bloom filter creation status
+// - Sleeps once per check, with a capped exponential backoff
+// - Gives up after the configured parent processing timeout
 // - Provides detailed logging of the waiting process
 //
 // Parameters:
 //   - ctx: Context for operation management
 //   - block: The block whose parent requires verification
 //   - baseURL: Source URL for additional data retrieval if needed
-func (u *Server) checkParentProcessingComplete(ctx context.Context, block *model.Block, baseURL string) {
-	_, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "checkParentProcessingComplete",
+//
+// Returns:
+//   - bool: true when the parent is not being validated (anymore), false when the timeout expired first
+//   - error: The context error when the context was cancelled while waiting
+func (u *Server) checkParentProcessingComplete(ctx context.Context, block *model.Block, baseURL string) (bool, error) {
+	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "checkParentProcessingComplete",
 		tracing.WithParentStat(u.stats),
 		tracing.WithDebugLogMessage(u.logger, "[checkParentProcessingComplete][%s] called from %s", block.Hash().String(), baseURL),
 	)
 	defer deferFn()
 
-	delay := 10 * time.Millisecond
-	maxDelay := 10 * time.Second
-
 	// check if the parent block is being validated, then wait for it to finish.
-	blockBeingFinalized := u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock)
+	if !u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock) {
+		return true, nil
+	}
 
-	if blockBeingFinalized {
-		u.logger.Infof("[processBlockFound][%s] parent block is being validated (hash: %s), waiting for it to finish: validated %v",
-			block.Hash().String(),
-			block.Header.HashPrevBlock.String(),
-			u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock),
-		)
+	u.logger.Infof("[processBlockFound][%s] parent block is being validated (hash: %s), waiting for it to finish",
+		block.Hash().String(),
+		block.Header.HashPrevBlock.String(),
+	)
 
-		retries := 0
+	waitCtx := ctx
 
-		for {
-			blockBeingFinalized = u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock)
+	if timeout := u.settings.BlockValidation.ParentProcessingTimeout; timeout > 0 {
+		var cancel context.CancelFunc
 
-			if !blockBeingFinalized {
-				break
-			}
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
-			if (retries % 10) == 0 {
-				u.logger.Infof("[processBlockFound][%s] parent block is still (%d) being validated (hash: %s), waiting for it to finish: validated %v",
-					block.Hash().String(),
-					retries,
-					block.Header.HashPrevBlock.String(),
-					u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock),
-				)
+	for retries := 0; ; retries++ {
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return false, ctx.Err()
 			}
 
-			time.Sleep(delay)
+			u.logger.Warnf("[processBlockFound][%s] parent block %s is still being validated after %s, not waiting any longer",
+				block.Hash().String(),
+				block.Header.HashPrevBlock.String(),
+				u.settings.BlockValidation.ParentProcessingTimeout,
+			)
+
+			return false, nil
+		case <-time.After(parentProcessingBackoff(retries)):
+		}
 
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
+		if !u.blockValidation.blockHashesCurrentlyValidated.Exists(*block.Header.HashPrevBlock) {
+			return true, nil
+		}
 
-			retries++
+		if (retries % 10) == 9 {
+			u.logger.Infof("[processBlockFound][%s] parent block is still (%d) being validated (hash: %s), waiting for it to finish",
+				block.Hash().String(),
+				retries+1,
+				block.Header.HashPrevBlock.String(),
+			)
 		}
 	}
 }
@@ -34,6 +34,8 @@ import (
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/blockvalidation_api"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/catchup"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/testhelpers"
+	"github.com/bitcoin-sv/teranode/services/subtreevalidation"
+	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/stores/blob/memory"
 	blobmemory "github.com/bitcoin-sv/teranode/stores/blob/memory"
 	blockchain_store "github.com/bitcoin-sv/teranode/stores/blockchain"
@@ -54,6 +56,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -72,16 +76,44 @@ func (m *mockBlockValidationInterface) BlockFound(ctx context.Context, blockHash
 	return args.Error(0)
 }
 
+func (m *mockBlockValidationInterface) SubtreeFound(ctx context.Context, subtreeHash *chainhash.Hash, baseURL string, peerID string) error {
+	args := m.Called(ctx, subtreeHash, baseURL, peerID)
+	return args.Error(0)
+}
+
 func (m *mockBlockValidationInterface) ProcessBlock(ctx context.Context, block *model.Block, blockHeight uint32, baseURL string, peerID string) error {
 	args := m.Called(ctx, block, blockHeight)
 	return args.Error(0)
 }
 
+func (m *mockBlockValidationInterface) ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error {
+	args := m.Called(ctx, block, blockHeight)
+	return args.Error(0)
+}
+
 func (m *mockBlockValidationInterface) ValidateBlock(ctx context.Context, block *model.Block, options *ValidateBlockOptions) error {
 	args := m.Called(ctx, block, options)
 	return args.Error(0)
 }
 
+func (m *mockBlockValidationInterface) GetCatchupStatus(ctx context.Context) (*CatchupStatus, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*CatchupStatus), args.Error(1)
+}
+
+func (m *mockBlockValidationInterface) CancelCatchup(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockBlockValidationInterface) GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error) {
+	args := m.Called(ctx, subtreeHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 var (
 	coinbaseTx, _ = bt.NewTxFromString("01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff08044c86041b020602ffffffff0100f2052a010000004341041b0e8c2567c12536aa13357b79a073dc4444acb83c4ec7a0e2f99dd7457516c5817242da796924ca4e99947d087fedf9ce467cb9f7c6287078f801df276fdf84ac00000000")
 
@@ -146,7 +178,7 @@ func TestOneTransaction(t *testing.T) {
 	_ = subtreeStore.Set(ctx, subtrees[0].RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -212,7 +244,7 @@ func TestTwoTransactions(t *testing.T) {
 	_ = subtreeStore.Set(ctx, subtrees[0].RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -301,7 +333,7 @@ func TestMerkleRoot(t *testing.T) {
 	// require.NoError(t, err)
 
 	// loads the subtrees into the block
-	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, ulogger.TestLogger{}, subtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	// err = blockValidationService.CheckMerkleRoot(block)
@@ -1064,6 +1096,86 @@ func Test_Stop_KafkaCloseError(t *testing.T) {
 	mockKafkaConsumer.AssertExpectations(t)
 }
 
+func Test_Stop_QueuedBlocks(t *testing.T) {
+	initPrometheusMetrics()
+
+	newServer := func(t *testing.T) *Server {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.BlockValidation.StopTimeout = 100 * time.Millisecond
+
+		return &Server{
+			logger:        ulogger.TestLogger{},
+			settings:      tSettings,
+			stats:         gocore.NewStat("test"),
+			blockFoundCh:  make(chan processBlockFound, 2),
+			catchupCh:     make(chan processBlockCatchup, 1),
+			stopCh:        make(chan struct{}),
+			blockLoopDone: make(chan struct{}),
+		}
+	}
+
+	t.Run("queued blocks are processed", func(t *testing.T) {
+		server := newServer(t)
+
+		for i := 0; i < 2; i++ {
+			// the invalid base url fails the block without any dependencies
+			server.blockFoundCh <- processBlockFound{hash: &chainhash.Hash{}, baseURL: "invalid"}
+		}
+
+		server.processQueuedBlocks(t.Context())
+
+		assert.Empty(t, server.blockFoundCh)
+	})
+
+	t.Run("waits for the block processing loop", func(t *testing.T) {
+		server := newServer(t)
+
+		go func() {
+			<-server.stopCh
+			close(server.blockLoopDone)
+		}()
+
+		server.stopBlockProcessing()
+
+		assert.True(t, server.stopping.Load())
+		assert.NotPanics(t, server.stopBlockProcessing)
+
+		select {
+		case <-server.blockLoopDone:
+		default:
+			t.Fatal("block processing loop did not exit")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		server := newServer(t)
+		server.blockFoundCh <- processBlockFound{hash: &chainhash.Hash{}, baseURL: "invalid"}
+
+		start := time.Now()
+		server.stopBlockProcessing()
+
+		assert.GreaterOrEqual(t, time.Since(start), server.settings.BlockValidation.StopTimeout)
+		assert.Len(t, server.blockFoundCh, 1)
+	})
+
+	t.Run("new blocks are rejected while stopping", func(t *testing.T) {
+		server := newServer(t)
+		server.stopping.Store(true)
+
+		hash := chainhash.Hash{0x01}
+
+		_, err := server.BlockFound(t.Context(), &blockvalidation_api.BlockFoundRequest{Hash: hash[:], BaseUrl: "http://peer1"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrServiceUnavailable))
+		assert.Empty(t, server.blockFoundCh)
+
+		err = server.blockHandler(&kafkamessage.KafkaBlockTopicMessage{Hash: hash.String(), URL: "http://peer1"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrServiceUnavailable))
+		assert.Empty(t, server.blockFoundCh)
+	})
+}
+
 func Test_BlockFound(t *testing.T) {
 	initPrometheusMetrics()
 
@@ -1342,6 +1454,57 @@ func Test_ProcessBlock(t *testing.T) {
 		require.NotNil(t, resp)
 	})
 
+	t.Run("dry run does not store the block", func(t *testing.T) {
+		// Use actual in-memory stores
+		utxoStore, _, _, txStore, subtreeStore, deferFunc := setup(t)
+		defer deferFunc()
+
+		mockBlockchainClient := &blockchain.Mock{}
+
+		bv := &BlockValidation{
+			blockHashesCurrentlyValidated: txmap.NewSwissMap(0),
+			blockExists:                   expiringmap.New[chainhash.Hash, bool](120 * time.Minute),
+			logger:                        logger,
+			settings:                      tSettings,
+			blockchainClient:              mockBlockchainClient,
+			subtreeStore:                  subtreeStore,
+			txStore:                       txStore,
+			utxoStore:                     utxoStore,
+			recentBlocksBloomFilters:      txmap.NewSyncedMap[chainhash.Hash, *model.BlockBloomFilter](),
+			blockBloomFiltersBeingCreated: txmap.NewSwissMap(0),
+			blocksCurrentlyValidating:     txmap.NewSyncedMap[chainhash.Hash, *validationResult](),
+			stats:                         gocore.NewStat("test"),
+		}
+
+		server := &Server{
+			logger:               logger,
+			settings:             tSettings,
+			blockValidation:      bv,
+			blockchainClient:     mockBlockchainClient,
+			subtreeStore:         subtreeStore,
+			utxoStore:            utxoStore,
+			stats:                gocore.NewStat("test"),
+			processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](),
+		}
+
+		// the subtrees of the test block are not in the subtree store, so the block is not valid
+		mockBlockchainClient.On("GetBlockHeaders", mock.Anything, mock.Anything, mock.Anything).Return([]*model.BlockHeader{}, []*model.BlockHeaderMeta{}, nil)
+
+		req := &blockvalidation_api.ProcessBlockRequest{
+			Block:  blockBytes,
+			Height: 100,
+			DryRun: true,
+		}
+
+		resp, err := server.ProcessBlock(ctx, req)
+		require.Error(t, err)
+		require.Nil(t, resp)
+		require.Contains(t, err.Error(), "block is not valid")
+
+		mockBlockchainClient.AssertNotCalled(t, "GetBlockExists", mock.Anything, mock.Anything)
+		mockBlockchainClient.AssertNotCalled(t, "AddBlock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("invalid block bytes", func(t *testing.T) {
 		server := &Server{
 			logger:   logger,
@@ -1741,3 +1904,383 @@ func TestCatchup_SuccessRateCalculation(t *testing.T) {
 		})
 	}
 }
+
+func TestParentProcessingBackoff(t *testing.T) {
+	expected := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		40 * time.Millisecond,
+		80 * time.Millisecond,
+		160 * time.Millisecond,
+		320 * time.Millisecond,
+		640 * time.Millisecond,
+		1280 * time.Millisecond,
+		2560 * time.Millisecond,
+		5120 * time.Millisecond,
+		10 * time.Second,
+		10 * time.Second,
+	}
+
+	for retries, delay := range expected {
+		assert.Equal(t, delay, parentProcessingBackoff(retries), "retries %d", retries)
+	}
+
+	// the delay stays capped, without overflowing, however long the parent takes
+	assert.Equal(t, parentProcessingMaxDelay, parentProcessingBackoff(1000))
+}
+
+func TestCheckParentProcessingComplete(t *testing.T) {
+	newServer := func(timeout time.Duration) *Server {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.BlockValidation.ParentProcessingTimeout = timeout
+
+		return &Server{
+			logger:   ulogger.TestLogger{},
+			settings: tSettings,
+			stats:    gocore.NewStat("test"),
+			blockValidation: &BlockValidation{
+				blockHashesCurrentlyValidated: txmap.NewSwissMap(0),
+			},
+		}
+	}
+
+	block := &model.Block{
+		Header: &model.BlockHeader{
+			Version:        1,
+			HashPrevBlock:  &chainhash.Hash{1},
+			HashMerkleRoot: &chainhash.Hash{},
+		},
+	}
+
+	t.Run("parent not being validated", func(t *testing.T) {
+		server := newServer(time.Second)
+
+		complete, err := server.checkParentProcessingComplete(context.Background(), block, "http://peer")
+		require.NoError(t, err)
+		assert.True(t, complete)
+	})
+
+	t.Run("parent finishes validation", func(t *testing.T) {
+		server := newServer(10 * time.Second)
+		require.NoError(t, server.blockValidation.blockHashesCurrentlyValidated.Put(*block.Header.HashPrevBlock))
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = server.blockValidation.blockHashesCurrentlyValidated.Delete(*block.Header.HashPrevBlock)
+		}()
+
+		complete, err := server.checkParentProcessingComplete(context.Background(), block, "http://peer")
+		require.NoError(t, err)
+		assert.True(t, complete)
+	})
+
+	t.Run("timeout while parent is being validated", func(t *testing.T) {
+		server := newServer(100 * time.Millisecond)
+		require.NoError(t, server.blockValidation.blockHashesCurrentlyValidated.Put(*block.Header.HashPrevBlock))
+
+		start := time.Now()
+
+		complete, err := server.checkParentProcessingComplete(context.Background(), block, "http://peer")
+		require.NoError(t, err)
+		assert.False(t, complete)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("context cancelled while parent is being validated", func(t *testing.T) {
+		server := newServer(0)
+		require.NoError(t, server.blockValidation.blockHashesCurrentlyValidated.Put(*block.Header.HashPrevBlock))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		complete, err := server.checkParentProcessingComplete(ctx, block, "http://peer")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, complete)
+	})
+}
+
+func TestSubtreeFound(t *testing.T) {
+	initPrometheusMetrics()
+
+	subtreeHash := chainhash.Hash{0x01}
+
+	newServer := func(bufferSize int) *Server {
+		return &Server{
+			logger:               ulogger.TestLogger{},
+			settings:             test.CreateBaseTestSettings(t),
+			stats:                gocore.NewStat("test"),
+			processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](),
+			subtreeFoundCh:       make(chan processSubtreeFound, bufferSize),
+		}
+	}
+
+	t.Run("subtree is queued once", func(t *testing.T) {
+		server := newServer(10)
+
+		req := &blockvalidation_api.SubtreeFoundRequest{Hash: subtreeHash[:], BaseUrl: "http://peer1", PeerId: "peer1"}
+
+		_, err := server.SubtreeFound(t.Context(), req)
+		require.NoError(t, err)
+
+		// announced again by another miner
+		req.BaseUrl = "http://peer2"
+		_, err = server.SubtreeFound(t.Context(), req)
+		require.NoError(t, err)
+
+		require.Len(t, server.subtreeFoundCh, 1)
+
+		subtreeFound := <-server.subtreeFoundCh
+		assert.Equal(t, subtreeHash, *subtreeFound.hash)
+		assert.Equal(t, "http://peer1", subtreeFound.baseURL)
+		assert.Equal(t, "peer1", subtreeFound.peerID)
+	})
+
+	t.Run("announcement is dropped when the queue is full", func(t *testing.T) {
+		server := newServer(0)
+
+		_, err := server.SubtreeFound(t.Context(), &blockvalidation_api.SubtreeFoundRequest{Hash: subtreeHash[:], BaseUrl: "http://peer1"})
+		require.NoError(t, err)
+
+		// a later announcement can queue the subtree again
+		assert.Nil(t, server.processSubtreeNotify.Get(subtreeHash))
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		server := newServer(10)
+
+		_, err := server.SubtreeFound(t.Context(), &blockvalidation_api.SubtreeFoundRequest{Hash: []byte{0x01}, BaseUrl: "http://peer1"})
+		require.Error(t, err)
+		assert.Empty(t, server.subtreeFoundCh)
+	})
+
+	t.Run("invalid base url", func(t *testing.T) {
+		server := newServer(10)
+
+		_, err := server.SubtreeFound(t.Context(), &blockvalidation_api.SubtreeFoundRequest{Hash: subtreeHash[:], BaseUrl: "legacy"})
+		require.Error(t, err)
+		assert.Empty(t, server.subtreeFoundCh)
+		assert.Nil(t, server.processSubtreeNotify.Get(subtreeHash))
+	})
+}
+
+func TestGetSubtreeMeta(t *testing.T) {
+	subtreeHash := chainhash.Hash{0x01}
+
+	newServer := func() *Server {
+		return &Server{
+			logger:       ulogger.TestLogger{},
+			settings:     test.CreateBaseTestSettings(t),
+			stats:        gocore.NewStat("test"),
+			subtreeStore: blobmemory.New(),
+		}
+	}
+
+	t.Run("subtree meta found", func(t *testing.T) {
+		server := newServer()
+		require.NoError(t, server.subtreeStore.Set(t.Context(), subtreeHash[:], fileformat.FileTypeSubtreeMeta, []byte("subtree-meta")))
+
+		resp, err := server.GetSubtreeMeta(t.Context(), &blockvalidation_api.GetSubtreeMetaRequest{Hash: subtreeHash[:]})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("subtree-meta"), resp.SubtreeMeta)
+	})
+
+	t.Run("subtree meta not found", func(t *testing.T) {
+		server := newServer()
+
+		_, err := server.GetSubtreeMeta(t.Context(), &blockvalidation_api.GetSubtreeMetaRequest{Hash: subtreeHash[:]})
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.UnwrapGRPC(err), errors.ErrSubtreeNotFound))
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		server := newServer()
+
+		_, err := server.GetSubtreeMeta(t.Context(), &blockvalidation_api.GetSubtreeMetaRequest{Hash: []byte{0x01}})
+		require.Error(t, err)
+	})
+}
+
+func TestProcessSubtreeFound(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+	subtreeHash := chainhash.Hash{0x01}
+
+	newServer := func(t *testing.T, subtreeValidationClient *subtreevalidation.MockSubtreeValidation) *Server {
+		utxoStore, _, _, txStore, subtreeStore, deferFunc := setup(t)
+		t.Cleanup(deferFunc)
+
+		mockBlockchainClient := &blockchain.Mock{}
+		mockBlockchainClient.On("GetBestBlockHeader", mock.Anything).Return(&model.BlockHeader{}, &model.BlockHeaderMeta{Height: 100}, nil)
+
+		server := &Server{
+			logger:               ulogger.TestLogger{},
+			settings:             tSettings,
+			blockchainClient:     mockBlockchainClient,
+			processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](),
+			blockValidation:      NewBlockValidation(t.Context(), ulogger.TestLogger{}, tSettings, mockBlockchainClient, subtreeStore, txStore, utxoStore, nil, subtreeValidationClient),
+		}
+
+		server.processSubtreeNotify.Set(subtreeHash, true, ttlcache.DefaultTTL)
+
+		return server
+	}
+
+	t.Run("subtree is validated at the next block height", func(t *testing.T) {
+		subtreeValidationClient := &subtreevalidation.MockSubtreeValidation{}
+		subtreeValidationClient.On("CheckSubtreeFromBlock", mock.Anything, subtreeHash, "http://peer1", uint32(101), mock.Anything, mock.Anything).Return(nil)
+
+		server := newServer(t, subtreeValidationClient)
+
+		err := server.processSubtreeFound(t.Context(), processSubtreeFound{hash: &subtreeHash, baseURL: "http://peer1"})
+		require.NoError(t, err)
+
+		subtreeValidationClient.AssertExpectations(t)
+		assert.NotNil(t, server.processSubtreeNotify.Get(subtreeHash))
+	})
+
+	t.Run("validation error allows the subtree to be announced again", func(t *testing.T) {
+		subtreeValidationClient := &subtreevalidation.MockSubtreeValidation{}
+		subtreeValidationClient.On("CheckSubtreeFromBlock", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.NewServiceError("peer unavailable"))
+
+		server := newServer(t, subtreeValidationClient)
+
+		err := server.processSubtreeFound(t.Context(), processSubtreeFound{hash: &subtreeHash, baseURL: "http://peer1"})
+		require.Error(t, err)
+
+		assert.Nil(t, server.processSubtreeNotify.Get(subtreeHash))
+	})
+}
+
+func TestIsRecoverableKafkaError(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	server := &Server{settings: tSettings}
+
+	t.Run("default classification", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			err         error
+			recoverable bool
+		}{
+			{name: "nil", err: nil, recoverable: false},
+			{name: "service error", err: errors.NewServiceError("service error"), recoverable: true},
+			{name: "storage error", err: errors.NewStorageError("storage error"), recoverable: true},
+			{name: "threshold exceeded", err: errors.NewThresholdExceededError("threshold exceeded"), recoverable: true},
+			{name: "context canceled", err: errors.NewContextCanceledError("context canceled"), recoverable: true},
+			{name: "external error", err: errors.NewExternalError("external error"), recoverable: true},
+			{name: "service unavailable", err: errors.NewServiceUnavailableError("service unavailable"), recoverable: true},
+			{name: "unreachable grpc service", err: errors.UnwrapGRPC(status.Error(codes.Unavailable, "connection refused")), recoverable: true},
+			{name: "wrapped service error", err: errors.NewProcessingError("processing", errors.NewServiceError("service error")), recoverable: true},
+			{name: "block invalid", err: errors.NewBlockInvalidError("block invalid"), recoverable: false},
+			{name: "processing error", err: errors.NewProcessingError("processing"), recoverable: false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.recoverable, server.isRecoverableKafkaError(tt.err))
+			})
+		}
+	})
+
+	t.Run("non-recoverable error codes take precedence", func(t *testing.T) {
+		customSettings := test.CreateBaseTestSettings(t)
+		customSettings.BlockValidation.KafkaNonRecoverableErrors = []string{"BLOCK_INVALID"}
+
+		server := &Server{settings: customSettings}
+
+		// a service error caused by an invalid block will never succeed
+		err := errors.NewServiceError("service error", errors.NewBlockInvalidError("block invalid"))
+		assert.False(t, server.isRecoverableKafkaError(err))
+		assert.True(t, server.isRecoverableKafkaError(errors.NewServiceError("service error")))
+	})
+
+	t.Run("custom recoverable error codes", func(t *testing.T) {
+		customSettings := test.CreateBaseTestSettings(t)
+		customSettings.BlockValidation.KafkaRecoverableErrors = []string{"block_not_found", " STORAGE_ERROR "}
+
+		server := &Server{settings: customSettings}
+
+		assert.True(t, server.isRecoverableKafkaError(errors.NewBlockNotFoundError("block not found")))
+		assert.True(t, server.isRecoverableKafkaError(errors.NewStorageError("storage error")))
+		assert.False(t, server.isRecoverableKafkaError(errors.NewServiceError("service error")))
+	})
+
+	t.Run("unknown error code", func(t *testing.T) {
+		_, err := kafkaErrorCodes([]string{"SERVICE_ERROR", "NO_SUCH_ERROR"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NO_SUCH_ERROR")
+
+		codes, err := kafkaErrorCodes([]string{""})
+		require.NoError(t, err)
+		assert.Empty(t, codes)
+	})
+}
+
+func Test_consumerMessageHandler_ErrorClassification(t *testing.T) {
+	initPrometheusMetrics()
+
+	hashStr := "8c14f0db3df150123e6f3dbbf30f8b955a8249b62ac1d1ff16284aefa3d06d87"
+	hash, _ := chainhash.NewHashFromStr(hashStr)
+
+	kafkaMsg := &kafkamessage.KafkaBlockTopicMessage{
+		Hash:   hashStr,
+		URL:    "http://test.com",
+		PeerId: "peer1",
+	}
+	msgBytes, err := proto.Marshal(kafkaMsg)
+	require.NoError(t, err)
+
+	msg := &kafka.KafkaMessage{
+		ConsumerMessage: sarama.ConsumerMessage{
+			Value: msgBytes,
+		},
+	}
+
+	// handleMessage runs the message through the handler, failing the block validation with blockErr
+	handleMessage := func(t *testing.T, tSettings *settings.Settings, blockErr error) (*blockchain.Mock, error) {
+		mockBlockchainClient := &blockchain.Mock{}
+		mockBlockchainClient.On("GetBlockExists", mock.Anything, hash).Return(false, nil)
+		mockBlockchainClient.On("ReportPeerFailure", mock.Anything, hash, "peer1", "block", mock.Anything).Return(nil).Maybe()
+
+		server := &Server{
+			logger:           ulogger.TestLogger{},
+			settings:         tSettings,
+			blockchainClient: mockBlockchainClient,
+			blockFoundCh:     make(chan processBlockFound, 10),
+			blockValidation: &BlockValidation{
+				blockExists:      expiringmap.New[chainhash.Hash, bool](time.Minute),
+				blockchainClient: mockBlockchainClient,
+				logger:           ulogger.TestLogger{},
+			},
+			stats: gocore.NewStat("test"),
+		}
+
+		go func() {
+			blockFound := <-server.blockFoundCh
+			blockFound.errCh <- blockErr
+		}()
+
+		return mockBlockchainClient, server.consumerMessageHandler(t.Context())(msg)
+	}
+
+	t.Run("recoverable error is not committed", func(t *testing.T) {
+		mockBlockchainClient, err := handleMessage(t, test.CreateBaseTestSettings(t), errors.NewServiceError("service unavailable"))
+		require.Error(t, err)
+
+		mockBlockchainClient.AssertNotCalled(t, "ReportPeerFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("non-recoverable error is committed and the peer is reported", func(t *testing.T) {
+		mockBlockchainClient, err := handleMessage(t, test.CreateBaseTestSettings(t), errors.NewBlockInvalidError("block invalid"))
+		require.NoError(t, err)
+
+		mockBlockchainClient.AssertCalled(t, "ReportPeerFailure", mock.Anything, hash, "peer1", "block", mock.Anything)
+	})
+
+	t.Run("error configured as non-recoverable is committed", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.BlockValidation.KafkaNonRecoverableErrors = []string{"SERVICE_ERROR"}
+
+		_, err := handleMessage(t, tSettings, errors.NewServiceError("service unavailable"))
+		require.NoError(t, err)
+	})
+}
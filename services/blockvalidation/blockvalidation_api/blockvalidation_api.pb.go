@@ -189,20 +189,82 @@ func (x *BlockFoundRequest) GetPeerId() string {
 	return ""
 }
 
+// swagger:model SubtreeFoundRequest
+type SubtreeFoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	PeerId        string                 `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"` // P2P peer identifier for peerMetrics tracking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtreeFoundRequest) Reset() {
+	*x = SubtreeFoundRequest{}
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtreeFoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtreeFoundRequest) ProtoMessage() {}
+
+func (x *SubtreeFoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtreeFoundRequest.ProtoReflect.Descriptor instead.
+func (*SubtreeFoundRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubtreeFoundRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *SubtreeFoundRequest) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *SubtreeFoundRequest) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
 // swagger:model ProcessBlockRequest
 type ProcessBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Block         []byte                 `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	BaseUrl       string                 `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
-	PeerId        string                 `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"` // P2P peer identifier for peerMetrics tracking
+	PeerId        string                 `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`  // P2P peer identifier for peerMetrics tracking
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Validates the block against the current stores without storing it or sending notifications
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessBlockRequest) Reset() {
 	*x = ProcessBlockRequest{}
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[3]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +276,7 @@ func (x *ProcessBlockRequest) String() string {
 func (*ProcessBlockRequest) ProtoMessage() {}
 
 func (x *ProcessBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[3]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +289,7 @@ func (x *ProcessBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessBlockRequest.ProtoReflect.Descriptor instead.
 func (*ProcessBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{3}
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ProcessBlockRequest) GetBlock() []byte {
@@ -258,6 +320,13 @@ func (x *ProcessBlockRequest) GetPeerId() string {
 	return ""
 }
 
+func (x *ProcessBlockRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // swagger:model ValidateBlockRequest
 type ValidateBlockRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -270,7 +339,7 @@ type ValidateBlockRequest struct {
 
 func (x *ValidateBlockRequest) Reset() {
 	*x = ValidateBlockRequest{}
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[4]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +351,7 @@ func (x *ValidateBlockRequest) String() string {
 func (*ValidateBlockRequest) ProtoMessage() {}
 
 func (x *ValidateBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[4]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +364,7 @@ func (x *ValidateBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateBlockRequest.ProtoReflect.Descriptor instead.
 func (*ValidateBlockRequest) Descriptor() ([]byte, []int) {
-	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{4}
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ValidateBlockRequest) GetBlock() []byte {
@@ -330,7 +399,7 @@ type ValidateBlockResponse struct {
 
 func (x *ValidateBlockResponse) Reset() {
 	*x = ValidateBlockResponse{}
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[5]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -342,7 +411,7 @@ func (x *ValidateBlockResponse) String() string {
 func (*ValidateBlockResponse) ProtoMessage() {}
 
 func (x *ValidateBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[5]
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +424,7 @@ func (x *ValidateBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateBlockResponse.ProtoReflect.Descriptor instead.
 func (*ValidateBlockResponse) Descriptor() ([]byte, []int) {
-	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{5}
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidateBlockResponse) GetOk() bool {
@@ -372,6 +441,242 @@ func (x *ValidateBlockResponse) GetMessage() string {
 	return ""
 }
 
+// swagger:model CatchupStatusResponse
+type CatchupStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IsCatchingUp    bool                   `protobuf:"varint,1,opt,name=is_catching_up,json=isCatchingUp,proto3" json:"is_catching_up,omitempty"`
+	TargetHash      []byte                 `protobuf:"bytes,2,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty"`
+	TargetHeight    uint32                 `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	CurrentHeight   uint32                 `protobuf:"varint,4,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"` // height of the last validated block
+	BlocksValidated uint32                 `protobuf:"varint,5,opt,name=blocks_validated,json=blocksValidated,proto3" json:"blocks_validated,omitempty"`
+	TotalBlocks     uint32                 `protobuf:"varint,6,opt,name=total_blocks,json=totalBlocks,proto3" json:"total_blocks,omitempty"` // number of blocks to fetch and validate, 0 until the headers are filtered
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	PeerId          string                 `protobuf:"bytes,8,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CatchupStatusResponse) Reset() {
+	*x = CatchupStatusResponse{}
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatchupStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchupStatusResponse) ProtoMessage() {}
+
+func (x *CatchupStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchupStatusResponse.ProtoReflect.Descriptor instead.
+func (*CatchupStatusResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CatchupStatusResponse) GetIsCatchingUp() bool {
+	if x != nil {
+		return x.IsCatchingUp
+	}
+	return false
+}
+
+func (x *CatchupStatusResponse) GetTargetHash() []byte {
+	if x != nil {
+		return x.TargetHash
+	}
+	return nil
+}
+
+func (x *CatchupStatusResponse) GetTargetHeight() uint32 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *CatchupStatusResponse) GetCurrentHeight() uint32 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *CatchupStatusResponse) GetBlocksValidated() uint32 {
+	if x != nil {
+		return x.BlocksValidated
+	}
+	return 0
+}
+
+func (x *CatchupStatusResponse) GetTotalBlocks() uint32 {
+	if x != nil {
+		return x.TotalBlocks
+	}
+	return 0
+}
+
+func (x *CatchupStatusResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CatchupStatusResponse) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+// swagger:model CancelCatchupResponse
+type CancelCatchupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // false when no catchup was in progress
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCatchupResponse) Reset() {
+	*x = CancelCatchupResponse{}
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCatchupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCatchupResponse) ProtoMessage() {}
+
+func (x *CancelCatchupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCatchupResponse.ProtoReflect.Descriptor instead.
+func (*CancelCatchupResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelCatchupResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+// swagger:model GetSubtreeMetaRequest
+type GetSubtreeMetaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"` // hash of the subtree
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubtreeMetaRequest) Reset() {
+	*x = GetSubtreeMetaRequest{}
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtreeMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtreeMetaRequest) ProtoMessage() {}
+
+func (x *GetSubtreeMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtreeMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetSubtreeMetaRequest) Descriptor() ([]byte, []int) {
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSubtreeMetaRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// swagger:model GetSubtreeMetaResponse
+type GetSubtreeMetaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubtreeMeta   []byte                 `protobuf:"bytes,1,opt,name=subtree_meta,json=subtreeMeta,proto3" json:"subtree_meta,omitempty"` // serialized subtree meta
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubtreeMetaResponse) Reset() {
+	*x = GetSubtreeMetaResponse{}
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtreeMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtreeMetaResponse) ProtoMessage() {}
+
+func (x *GetSubtreeMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtreeMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetSubtreeMetaResponse) Descriptor() ([]byte, []int) {
+	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSubtreeMetaResponse) GetSubtreeMeta() []byte {
+	if x != nil {
+		return x.SubtreeMeta
+	}
+	return nil
+}
+
 var File_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto protoreflect.FileDescriptor
 
 const file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDesc = "" +
@@ -386,26 +691,52 @@ const file_services_blockvalidation_blockvalidation_api_blockvalidation_api_prot
 	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x19\n" +
 	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12(\n" +
 	"\x10wait_to_complete\x18\x03 \x01(\bR\x0ewaitToComplete\x12\x17\n" +
-	"\apeer_id\x18\x04 \x01(\tR\x06peerId\"w\n" +
+	"\apeer_id\x18\x04 \x01(\tR\x06peerId\"]\n" +
+	"\x13SubtreeFoundRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x17\n" +
+	"\apeer_id\x18\x03 \x01(\tR\x06peerId\"\x90\x01\n" +
 	"\x13ProcessBlockRequest\x12\x14\n" +
 	"\x05block\x18\x01 \x01(\fR\x05block\x12\x16\n" +
 	"\x06height\x18\x02 \x01(\rR\x06height\x12\x19\n" +
 	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\x12\x17\n" +
-	"\apeer_id\x18\x04 \x01(\tR\x06peerId\"m\n" +
+	"\apeer_id\x18\x04 \x01(\tR\x06peerId\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"m\n" +
 	"\x14ValidateBlockRequest\x12\x14\n" +
 	"\x05block\x18\x01 \x01(\fR\x05block\x12\x16\n" +
 	"\x06height\x18\x02 \x01(\rR\x06height\x12'\n" +
 	"\x0fis_revalidation\x18\x03 \x01(\bR\x0eisRevalidation\"A\n" +
 	"\x15ValidateBlockResponse\x12\x0e\n" +
 	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x90\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xcc\x02\n" +
+	"\x15CatchupStatusResponse\x12$\n" +
+	"\x0eis_catching_up\x18\x01 \x01(\bR\fisCatchingUp\x12\x1f\n" +
+	"\vtarget_hash\x18\x02 \x01(\fR\n" +
+	"targetHash\x12#\n" +
+	"\rtarget_height\x18\x03 \x01(\rR\ftargetHeight\x12%\n" +
+	"\x0ecurrent_height\x18\x04 \x01(\rR\rcurrentHeight\x12)\n" +
+	"\x10blocks_validated\x18\x05 \x01(\rR\x0fblocksValidated\x12!\n" +
+	"\ftotal_blocks\x18\x06 \x01(\rR\vtotalBlocks\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x17\n" +
+	"\apeer_id\x18\b \x01(\tR\x06peerId\"5\n" +
+	"\x15CancelCatchupResponse\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\bR\tcancelled\"+\n" +
+	"\x15GetSubtreeMetaRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\";\n" +
+	"\x16GetSubtreeMetaResponse\x12!\n" +
+	"\fsubtree_meta\x18\x01 \x01(\fR\vsubtreeMeta2\xa3\x06\n" +
 	"\x12BlockValidationAPI\x12V\n" +
 	"\n" +
 	"HealthGRPC\x12!.blockvalidation_api.EmptyMessage\x1a#.blockvalidation_api.HealthResponse\"\x00\x12Y\n" +
 	"\n" +
 	"BlockFound\x12&.blockvalidation_api.BlockFoundRequest\x1a!.blockvalidation_api.EmptyMessage\"\x00\x12]\n" +
+	"\fSubtreeFound\x12(.blockvalidation_api.SubtreeFoundRequest\x1a!.blockvalidation_api.EmptyMessage\"\x00\x12]\n" +
 	"\fProcessBlock\x12(.blockvalidation_api.ProcessBlockRequest\x1a!.blockvalidation_api.EmptyMessage\"\x00\x12h\n" +
-	"\rValidateBlock\x12).blockvalidation_api.ValidateBlockRequest\x1a*.blockvalidation_api.ValidateBlockResponse\"\x00B\x18Z\x16./;blockvalidation_apib\x06proto3"
+	"\rValidateBlock\x12).blockvalidation_api.ValidateBlockRequest\x1a*.blockvalidation_api.ValidateBlockResponse\"\x00\x12c\n" +
+	"\x10GetCatchupStatus\x12!.blockvalidation_api.EmptyMessage\x1a*.blockvalidation_api.CatchupStatusResponse\"\x00\x12`\n" +
+	"\rCancelCatchup\x12!.blockvalidation_api.EmptyMessage\x1a*.blockvalidation_api.CancelCatchupResponse\"\x00\x12k\n" +
+	"\x0eGetSubtreeMeta\x12*.blockvalidation_api.GetSubtreeMetaRequest\x1a+.blockvalidation_api.GetSubtreeMetaResponse\"\x00B\x18Z\x16./;blockvalidation_apib\x06proto3"
 
 var (
 	file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescOnce sync.Once
@@ -419,31 +750,45 @@ func file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto
 	return file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDescData
 }
 
-var file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_goTypes = []any{
-	(*EmptyMessage)(nil),          // 0: blockvalidation_api.EmptyMessage
-	(*HealthResponse)(nil),        // 1: blockvalidation_api.HealthResponse
-	(*BlockFoundRequest)(nil),     // 2: blockvalidation_api.BlockFoundRequest
-	(*ProcessBlockRequest)(nil),   // 3: blockvalidation_api.ProcessBlockRequest
-	(*ValidateBlockRequest)(nil),  // 4: blockvalidation_api.ValidateBlockRequest
-	(*ValidateBlockResponse)(nil), // 5: blockvalidation_api.ValidateBlockResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*EmptyMessage)(nil),           // 0: blockvalidation_api.EmptyMessage
+	(*HealthResponse)(nil),         // 1: blockvalidation_api.HealthResponse
+	(*BlockFoundRequest)(nil),      // 2: blockvalidation_api.BlockFoundRequest
+	(*SubtreeFoundRequest)(nil),    // 3: blockvalidation_api.SubtreeFoundRequest
+	(*ProcessBlockRequest)(nil),    // 4: blockvalidation_api.ProcessBlockRequest
+	(*ValidateBlockRequest)(nil),   // 5: blockvalidation_api.ValidateBlockRequest
+	(*ValidateBlockResponse)(nil),  // 6: blockvalidation_api.ValidateBlockResponse
+	(*CatchupStatusResponse)(nil),  // 7: blockvalidation_api.CatchupStatusResponse
+	(*CancelCatchupResponse)(nil),  // 8: blockvalidation_api.CancelCatchupResponse
+	(*GetSubtreeMetaRequest)(nil),  // 9: blockvalidation_api.GetSubtreeMetaRequest
+	(*GetSubtreeMetaResponse)(nil), // 10: blockvalidation_api.GetSubtreeMetaResponse
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
 }
 var file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_depIdxs = []int32{
-	6, // 0: blockvalidation_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0, // 1: blockvalidation_api.BlockValidationAPI.HealthGRPC:input_type -> blockvalidation_api.EmptyMessage
-	2, // 2: blockvalidation_api.BlockValidationAPI.BlockFound:input_type -> blockvalidation_api.BlockFoundRequest
-	3, // 3: blockvalidation_api.BlockValidationAPI.ProcessBlock:input_type -> blockvalidation_api.ProcessBlockRequest
-	4, // 4: blockvalidation_api.BlockValidationAPI.ValidateBlock:input_type -> blockvalidation_api.ValidateBlockRequest
-	1, // 5: blockvalidation_api.BlockValidationAPI.HealthGRPC:output_type -> blockvalidation_api.HealthResponse
-	0, // 6: blockvalidation_api.BlockValidationAPI.BlockFound:output_type -> blockvalidation_api.EmptyMessage
-	0, // 7: blockvalidation_api.BlockValidationAPI.ProcessBlock:output_type -> blockvalidation_api.EmptyMessage
-	5, // 8: blockvalidation_api.BlockValidationAPI.ValidateBlock:output_type -> blockvalidation_api.ValidateBlockResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	11, // 0: blockvalidation_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 1: blockvalidation_api.CatchupStatusResponse.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: blockvalidation_api.BlockValidationAPI.HealthGRPC:input_type -> blockvalidation_api.EmptyMessage
+	2,  // 3: blockvalidation_api.BlockValidationAPI.BlockFound:input_type -> blockvalidation_api.BlockFoundRequest
+	3,  // 4: blockvalidation_api.BlockValidationAPI.SubtreeFound:input_type -> blockvalidation_api.SubtreeFoundRequest
+	4,  // 5: blockvalidation_api.BlockValidationAPI.ProcessBlock:input_type -> blockvalidation_api.ProcessBlockRequest
+	5,  // 6: blockvalidation_api.BlockValidationAPI.ValidateBlock:input_type -> blockvalidation_api.ValidateBlockRequest
+	0,  // 7: blockvalidation_api.BlockValidationAPI.GetCatchupStatus:input_type -> blockvalidation_api.EmptyMessage
+	0,  // 8: blockvalidation_api.BlockValidationAPI.CancelCatchup:input_type -> blockvalidation_api.EmptyMessage
+	9,  // 9: blockvalidation_api.BlockValidationAPI.GetSubtreeMeta:input_type -> blockvalidation_api.GetSubtreeMetaRequest
+	1,  // 10: blockvalidation_api.BlockValidationAPI.HealthGRPC:output_type -> blockvalidation_api.HealthResponse
+	0,  // 11: blockvalidation_api.BlockValidationAPI.BlockFound:output_type -> blockvalidation_api.EmptyMessage
+	0,  // 12: blockvalidation_api.BlockValidationAPI.SubtreeFound:output_type -> blockvalidation_api.EmptyMessage
+	0,  // 13: blockvalidation_api.BlockValidationAPI.ProcessBlock:output_type -> blockvalidation_api.EmptyMessage
+	6,  // 14: blockvalidation_api.BlockValidationAPI.ValidateBlock:output_type -> blockvalidation_api.ValidateBlockResponse
+	7,  // 15: blockvalidation_api.BlockValidationAPI.GetCatchupStatus:output_type -> blockvalidation_api.CatchupStatusResponse
+	8,  // 16: blockvalidation_api.BlockValidationAPI.CancelCatchup:output_type -> blockvalidation_api.CancelCatchupResponse
+	10, // 17: blockvalidation_api.BlockValidationAPI.GetSubtreeMeta:output_type -> blockvalidation_api.GetSubtreeMetaResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_init() }
@@ -457,7 +802,7 @@ func file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDesc), len(file_services_blockvalidation_blockvalidation_api_blockvalidation_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BlockValidationAPI_HealthGRPC_FullMethodName    = "/blockvalidation_api.BlockValidationAPI/HealthGRPC"
-	BlockValidationAPI_BlockFound_FullMethodName    = "/blockvalidation_api.BlockValidationAPI/BlockFound"
-	BlockValidationAPI_ProcessBlock_FullMethodName  = "/blockvalidation_api.BlockValidationAPI/ProcessBlock"
-	BlockValidationAPI_ValidateBlock_FullMethodName = "/blockvalidation_api.BlockValidationAPI/ValidateBlock"
+	BlockValidationAPI_HealthGRPC_FullMethodName       = "/blockvalidation_api.BlockValidationAPI/HealthGRPC"
+	BlockValidationAPI_BlockFound_FullMethodName       = "/blockvalidation_api.BlockValidationAPI/BlockFound"
+	BlockValidationAPI_SubtreeFound_FullMethodName     = "/blockvalidation_api.BlockValidationAPI/SubtreeFound"
+	BlockValidationAPI_ProcessBlock_FullMethodName     = "/blockvalidation_api.BlockValidationAPI/ProcessBlock"
+	BlockValidationAPI_ValidateBlock_FullMethodName    = "/blockvalidation_api.BlockValidationAPI/ValidateBlock"
+	BlockValidationAPI_GetCatchupStatus_FullMethodName = "/blockvalidation_api.BlockValidationAPI/GetCatchupStatus"
+	BlockValidationAPI_CancelCatchup_FullMethodName    = "/blockvalidation_api.BlockValidationAPI/CancelCatchup"
+	BlockValidationAPI_GetSubtreeMeta_FullMethodName   = "/blockvalidation_api.BlockValidationAPI/GetSubtreeMeta"
 )
 
 // BlockValidationAPIClient is the client API for BlockValidationAPI service.
@@ -32,8 +36,16 @@ type BlockValidationAPIClient interface {
 	// Health returns the health of the API.
 	HealthGRPC(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*HealthResponse, error)
 	BlockFound(ctx context.Context, in *BlockFoundRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	// SubtreeFound queues a subtree announced by a peer for validation, before the block containing it arrives.
+	SubtreeFound(ctx context.Context, in *SubtreeFoundRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	ProcessBlock(ctx context.Context, in *ProcessBlockRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	ValidateBlock(ctx context.Context, in *ValidateBlockRequest, opts ...grpc.CallOption) (*ValidateBlockResponse, error)
+	// GetCatchupStatus returns the progress of the catchup in progress, if any.
+	GetCatchupStatus(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*CatchupStatusResponse, error)
+	// CancelCatchup aborts the catchup in progress, if any.
+	CancelCatchup(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*CancelCatchupResponse, error)
+	// GetSubtreeMeta returns the serialized subtree meta, holding the parent tx hashes of the transactions in the subtree.
+	GetSubtreeMeta(ctx context.Context, in *GetSubtreeMetaRequest, opts ...grpc.CallOption) (*GetSubtreeMetaResponse, error)
 }
 
 type blockValidationAPIClient struct {
@@ -64,6 +76,16 @@ func (c *blockValidationAPIClient) BlockFound(ctx context.Context, in *BlockFoun
 	return out, nil
 }
 
+func (c *blockValidationAPIClient) SubtreeFound(ctx context.Context, in *SubtreeFoundRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, BlockValidationAPI_SubtreeFound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockValidationAPIClient) ProcessBlock(ctx context.Context, in *ProcessBlockRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
@@ -84,6 +106,36 @@ func (c *blockValidationAPIClient) ValidateBlock(ctx context.Context, in *Valida
 	return out, nil
 }
 
+func (c *blockValidationAPIClient) GetCatchupStatus(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*CatchupStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatchupStatusResponse)
+	err := c.cc.Invoke(ctx, BlockValidationAPI_GetCatchupStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockValidationAPIClient) CancelCatchup(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*CancelCatchupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCatchupResponse)
+	err := c.cc.Invoke(ctx, BlockValidationAPI_CancelCatchup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockValidationAPIClient) GetSubtreeMeta(ctx context.Context, in *GetSubtreeMetaRequest, opts ...grpc.CallOption) (*GetSubtreeMetaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubtreeMetaResponse)
+	err := c.cc.Invoke(ctx, BlockValidationAPI_GetSubtreeMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockValidationAPIServer is the server API for BlockValidationAPI service.
 // All implementations must embed UnimplementedBlockValidationAPIServer
 // for forward compatibility.
@@ -91,8 +143,16 @@ type BlockValidationAPIServer interface {
 	// Health returns the health of the API.
 	HealthGRPC(context.Context, *EmptyMessage) (*HealthResponse, error)
 	BlockFound(context.Context, *BlockFoundRequest) (*EmptyMessage, error)
+	// SubtreeFound queues a subtree announced by a peer for validation, before the block containing it arrives.
+	SubtreeFound(context.Context, *SubtreeFoundRequest) (*EmptyMessage, error)
 	ProcessBlock(context.Context, *ProcessBlockRequest) (*EmptyMessage, error)
 	ValidateBlock(context.Context, *ValidateBlockRequest) (*ValidateBlockResponse, error)
+	// GetCatchupStatus returns the progress of the catchup in progress, if any.
+	GetCatchupStatus(context.Context, *EmptyMessage) (*CatchupStatusResponse, error)
+	// CancelCatchup aborts the catchup in progress, if any.
+	CancelCatchup(context.Context, *EmptyMessage) (*CancelCatchupResponse, error)
+	// GetSubtreeMeta returns the serialized subtree meta, holding the parent tx hashes of the transactions in the subtree.
+	GetSubtreeMeta(context.Context, *GetSubtreeMetaRequest) (*GetSubtreeMetaResponse, error)
 	mustEmbedUnimplementedBlockValidationAPIServer()
 }
 
@@ -109,12 +169,24 @@ func (UnimplementedBlockValidationAPIServer) HealthGRPC(context.Context, *EmptyM
 func (UnimplementedBlockValidationAPIServer) BlockFound(context.Context, *BlockFoundRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockFound not implemented")
 }
+func (UnimplementedBlockValidationAPIServer) SubtreeFound(context.Context, *SubtreeFoundRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubtreeFound not implemented")
+}
 func (UnimplementedBlockValidationAPIServer) ProcessBlock(context.Context, *ProcessBlockRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessBlock not implemented")
 }
 func (UnimplementedBlockValidationAPIServer) ValidateBlock(context.Context, *ValidateBlockRequest) (*ValidateBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateBlock not implemented")
 }
+func (UnimplementedBlockValidationAPIServer) GetCatchupStatus(context.Context, *EmptyMessage) (*CatchupStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatchupStatus not implemented")
+}
+func (UnimplementedBlockValidationAPIServer) CancelCatchup(context.Context, *EmptyMessage) (*CancelCatchupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCatchup not implemented")
+}
+func (UnimplementedBlockValidationAPIServer) GetSubtreeMeta(context.Context, *GetSubtreeMetaRequest) (*GetSubtreeMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubtreeMeta not implemented")
+}
 func (UnimplementedBlockValidationAPIServer) mustEmbedUnimplementedBlockValidationAPIServer() {}
 func (UnimplementedBlockValidationAPIServer) testEmbeddedByValue()                            {}
 
@@ -172,6 +244,24 @@ func _BlockValidationAPI_BlockFound_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockValidationAPI_SubtreeFound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtreeFoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockValidationAPIServer).SubtreeFound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockValidationAPI_SubtreeFound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockValidationAPIServer).SubtreeFound(ctx, req.(*SubtreeFoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockValidationAPI_ProcessBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessBlockRequest)
 	if err := dec(in); err != nil {
@@ -208,6 +298,60 @@ func _BlockValidationAPI_ValidateBlock_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockValidationAPI_GetCatchupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockValidationAPIServer).GetCatchupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockValidationAPI_GetCatchupStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockValidationAPIServer).GetCatchupStatus(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockValidationAPI_CancelCatchup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockValidationAPIServer).CancelCatchup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockValidationAPI_CancelCatchup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockValidationAPIServer).CancelCatchup(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockValidationAPI_GetSubtreeMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubtreeMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockValidationAPIServer).GetSubtreeMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockValidationAPI_GetSubtreeMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockValidationAPIServer).GetSubtreeMeta(ctx, req.(*GetSubtreeMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlockValidationAPI_ServiceDesc is the grpc.ServiceDesc for BlockValidationAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +367,10 @@ var BlockValidationAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockFound",
 			Handler:    _BlockValidationAPI_BlockFound_Handler,
 		},
+		{
+			MethodName: "SubtreeFound",
+			Handler:    _BlockValidationAPI_SubtreeFound_Handler,
+		},
 		{
 			MethodName: "ProcessBlock",
 			Handler:    _BlockValidationAPI_ProcessBlock_Handler,
@@ -231,6 +379,18 @@ var BlockValidationAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateBlock",
 			Handler:    _BlockValidationAPI_ValidateBlock_Handler,
 		},
+		{
+			MethodName: "GetCatchupStatus",
+			Handler:    _BlockValidationAPI_GetCatchupStatus_Handler,
+		},
+		{
+			MethodName: "CancelCatchup",
+			Handler:    _BlockValidationAPI_CancelCatchup_Handler,
+		},
+		{
+			MethodName: "GetSubtreeMeta",
+			Handler:    _BlockValidationAPI_GetSubtreeMeta_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/blockvalidation/blockvalidation_api/blockvalidation_api.proto",
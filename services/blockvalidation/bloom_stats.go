@@ -0,0 +1,126 @@
+// This file contains the history of the bloom filter stats of the recently validated blocks.
+package blockvalidation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/model"
+)
+
+// BlockBloomStats describes the bloom filter lookups done while validating a block.
+type BlockBloomStats struct {
+	// Hash is the hash of the validated block
+	Hash string `json:"hash"`
+
+	// Height is the height of the validated block
+	Height uint32 `json:"height"`
+
+	// ValidatedAt is the time the validation of the block finished
+	ValidatedAt time.Time `json:"validatedAt"`
+
+	// Valid indicates whether the block passed validation
+	Valid bool `json:"valid"`
+
+	// QueryCount is the number of transactions checked against the bloom filters of the previous blocks
+	QueryCount uint64 `json:"queryCount"`
+
+	// Positives is the number of lookups that matched a bloom filter
+	Positives uint64 `json:"positives"`
+
+	// FalsePositives is the number of matches that turned out not to be a duplicate transaction
+	FalsePositives uint64 `json:"falsePositives"`
+
+	// FalsePositiveRate is FalsePositives divided by QueryCount, 0 when no lookups were done
+	FalsePositiveRate float64 `json:"falsePositiveRate"`
+}
+
+// bloomStatsHistory is a ring buffer of the bloom filter stats of the last validated blocks.
+// A nil history keeps nothing, so that block validation can run without one.
+type bloomStatsHistory struct {
+	mu      sync.RWMutex
+	entries []BlockBloomStats
+	next    int
+	full    bool
+}
+
+// newBloomStatsHistory creates a history of the given size, or returns nil when size is not positive.
+func newBloomStatsHistory(size int) *bloomStatsHistory {
+	if size <= 0 {
+		return nil
+	}
+
+	return &bloomStatsHistory{
+		entries: make([]BlockBloomStats, size),
+	}
+}
+
+// add stores the stats, overwriting the oldest entry when the history is full.
+func (h *bloomStatsHistory) add(stats BlockBloomStats) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.entries[h.next] = stats
+	h.next = (h.next + 1) % len(h.entries)
+
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// list returns up to limit entries, newest first. A limit that is not positive returns all entries.
+func (h *bloomStatsHistory) list(limit int) []BlockBloomStats {
+	if h == nil {
+		return []BlockBloomStats{}
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	count := h.next
+	if h.full {
+		count = len(h.entries)
+	}
+
+	if limit > 0 && limit < count {
+		count = limit
+	}
+
+	result := make([]BlockBloomStats, 0, count)
+
+	for i := 1; i <= count; i++ {
+		result = append(result, h.entries[(h.next-i+len(h.entries))%len(h.entries)])
+	}
+
+	return result
+}
+
+// recordBloomStats adds the bloom filter stats of a validated block to the history, and to the cumulative
+// stats that are reported to prometheus when they are not nil.
+func (u *BlockValidation) recordBloomStats(block *model.Block, blockStats *model.BloomStats, cumulative *model.BloomStats, valid bool) {
+	if cumulative != nil {
+		cumulative.Add(blockStats)
+	}
+
+	queries, positives, falsePositives := blockStats.Counters()
+
+	var falsePositiveRate float64
+	if queries > 0 {
+		falsePositiveRate = float64(falsePositives) / float64(queries)
+	}
+
+	u.bloomStatsHistory.add(BlockBloomStats{
+		Hash:              block.Hash().String(),
+		Height:            block.Height,
+		ValidatedAt:       time.Now(),
+		Valid:             valid,
+		QueryCount:        queries,
+		Positives:         positives,
+		FalsePositives:    falsePositives,
+		FalsePositiveRate: falsePositiveRate,
+	})
+}
@@ -27,6 +27,9 @@ const (
 	// maxCatchupIterations was the old iteration limit, kept for reference but no longer used
 	// since we now make a single request for headers
 	maxCatchupIterations = 1000
+
+	// maxKnownPeers is the maximum number of peers tracked for the catchup header peer fan-out
+	maxKnownPeers = 64
 )
 
 // CatchupContext holds all the state needed during a catchup operation
@@ -42,9 +45,28 @@ type CatchupContext struct {
 	currentHeight           uint32
 	blockHeaders            []*model.BlockHeader
 	headersFetchResult      *catchup.Result
-	useQuickValidation      bool   // Whether to use quick validation for checkpointed blocks
-	highestCheckpointHeight uint32 // Highest checkpoint height for validation checks
-	catchupError            error  // Any error encountered during catchup
+	useQuickValidation      bool            // Whether to use quick validation for checkpointed blocks
+	highestCheckpointHeight uint32          // Highest checkpoint height for validation checks
+	catchupError            error           // Any error encountered during catchup
+	windowTip               *chainhash.Hash // Last block of the previous header window, nil before the blocks of a window are validated
+	fsmCatchingBlocks       bool            // Whether the FSM was left in CATCHINGBLOCKS for the next header window
+}
+
+// resetWindow clears the state of the previous header window before the headers of the next window are fetched.
+func (c *CatchupContext) resetWindow() {
+	c.commonAncestorHash = nil
+	c.commonAncestorMeta = nil
+	c.commonAncestorIndex = 0
+	c.forkDepth = 0
+	c.blockHeaders = nil
+	c.headersFetchResult = nil
+	c.useQuickValidation = false
+	c.highestCheckpointHeight = 0
+}
+
+// windowReached returns whether the headers of the current window stopped at the catchup header window.
+func (c *CatchupContext) windowReached() bool {
+	return c.headersFetchResult != nil && c.headersFetchResult.WindowReached
 }
 
 // catchup orchestrates the complete blockchain synchronization process.
@@ -61,6 +83,9 @@ type CatchupContext struct {
 // 9. Fetch and validate blocks
 // 10. Clean up resources
 //
+// When blockvalidation_catchup_header_window is set, steps 2 to 9 are repeated for every window of headers,
+// each window continuing from the last block of the previous window, until the node is caught up.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
 //   - blockUpTo: Target block to sync up to
@@ -109,69 +134,130 @@ func (u *Server) catchup(ctx context.Context, blockUpTo *model.Block, baseURL st
 	}
 	defer u.releaseCatchupLock(catchupCtx, &err)
 
+	// the catchup can be cancelled through CancelCatchup independently of the service context
+	ctx = u.startCatchupStatus(ctx, catchupCtx)
+	defer u.finishCatchupStatus()
+
+	// a catchup that stops between header windows returns the node to RUNNING, unless a block failed validation
+	defer func() {
+		if catchupCtx.fsmCatchingBlocks && (catchupCtx.catchupError == nil || u.isCatchupCancelled()) {
+			u.restoreFSMState(context.WithoutCancel(ctx), catchupCtx)
+		}
+	}()
+
+	for window := 1; ; window++ {
+		more, err := u.catchupWindow(ctx, catchupCtx, window)
+		if err != nil {
+			return err
+		}
+
+		if !more {
+			break
+		}
+
+		u.logger.Infof("[catchup][%s] header window %d validated up to %s, continuing with the next window", blockUpTo.Hash().String(), window, catchupCtx.windowTip.String())
+	}
+
+	// Step 11: Clean up resources
+	if catchupCtx.windowTip != nil {
+		u.cleanup(catchupCtx)
+	}
+
+	return nil
+}
+
+// catchupWindow runs steps 2 to 10 of the catchup for a single window of headers.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeouts
+//   - catchupCtx: Catchup context, holding the last block of the previous window
+//   - window: Number of the window, starting at 1
+//
+// Returns:
+//   - bool: Whether the headers stopped at the header window and the catchup continues with the next window
+//   - error: If any step fails or safety checks are violated
+func (u *Server) catchupWindow(ctx context.Context, catchupCtx *CatchupContext, window int) (bool, error) {
+	blockUpTo := catchupCtx.blockUpTo
+
+	catchupCtx.resetWindow()
+
 	// Step 2: Fetch block headers from peer
-	if err = u.fetchHeaders(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.fetchHeaders(ctx, catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Early exit if no headers to process
 	if len(catchupCtx.headersFetchResult.Headers) == 0 {
 		u.logger.Infof("[catchup][%s] block already exists or no headers needed", blockUpTo.Hash().String())
-		return nil
+		return false, nil
 	}
 
 	// Step 3: Find common ancestor between chains
-	if err = u.findCommonAncestor(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.findCommonAncestor(ctx, catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 4: Validate fork depth against coinbase maturity
-	if err = u.validateForkDepth(catchupCtx); err != nil {
-		return err
+	if err := u.validateForkDepth(catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 5: Check for secret mining attempts
-	if err = u.checkSecretMining(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.checkSecretMining(ctx, catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 6: Filter headers to only those we need to catchup
-	if err = u.filterHeaders(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.filterHeaders(ctx, catchupCtx); err != nil {
+		return false, err
+	}
+
+	if window == 1 && catchupCtx.commonAncestorMeta != nil {
+		totalBlocks := len(catchupCtx.blockHeaders)
+		if catchupCtx.windowReached() && blockUpTo.Height > catchupCtx.commonAncestorMeta.Height {
+			// the blocks of the later windows are counted as well
+			totalBlocks = max(totalBlocks, int(blockUpTo.Height-catchupCtx.commonAncestorMeta.Height))
+		}
+
+		u.setCatchupTotalBlocks(totalBlocks, catchupCtx.commonAncestorMeta.Height)
 	}
 
 	// Early exit if no new blocks to process
 	if len(catchupCtx.blockHeaders) == 0 {
 		u.logger.Infof("[catchup][%s] no new blocks to fetch - already synced", blockUpTo.Hash().String())
-		return nil
+		return false, nil
+	}
+
+	// Step 6b: Verify the window continues from the previous window
+	if err := u.verifyWindowContinuity(catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 7: Build header chain cache for validation
-	if err = u.buildHeaderCache(catchupCtx); err != nil {
-		return err
+	if err := u.buildHeaderCache(catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 8: Verify chain continuity
-	if err = u.verifyChainContinuity(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.verifyChainContinuity(ctx, catchupCtx); err != nil {
+		return false, err
 	}
 
 	// Step 9: Verify checkpoints and determine if quick validation can be used
 	// This step ensures we're on the correct chain by validating checkpoint hashes
-	if err = u.verifyCheckpointsInHeaderChain(catchupCtx); err != nil {
+	if err := u.verifyCheckpointsInHeaderChain(catchupCtx); err != nil {
 		u.logger.Errorf("[catchup][%s] Checkpoint verification failed: %v", blockUpTo.Hash().String(), err)
-		return err
+		return false, err
 	}
 
 	// Step 10: Fetch and validate blocks
-	if err = u.fetchAndValidateBlocks(ctx, catchupCtx); err != nil {
-		return err
+	if err := u.fetchAndValidateBlocks(ctx, catchupCtx); err != nil {
+		return false, err
 	}
 
-	// Step 11: Clean up resources
-	u.cleanup(catchupCtx)
+	catchupCtx.windowTip = catchupCtx.blockHeaders[len(catchupCtx.blockHeaders)-1].Hash()
 
-	return nil
+	return catchupCtx.windowReached(), nil
 }
 
 // acquireCatchupLock ensures only one catchup runs at a time.
@@ -551,6 +637,28 @@ func (u *Server) verifyChainContinuity(ctx context.Context, catchupCtx *CatchupC
 	return nil
 }
 
+// verifyWindowContinuity ensures a header window continues from the last block of the previous window.
+// The common ancestor of the window must be that block, and the first new block must build on it.
+//
+// Parameters:
+//   - catchupCtx: Catchup context with the headers of the window
+//
+// Returns:
+//   - error: If the window does not link to the previous window
+func (u *Server) verifyWindowContinuity(catchupCtx *CatchupContext) error {
+	if catchupCtx.windowTip == nil || len(catchupCtx.blockHeaders) == 0 {
+		return nil
+	}
+
+	firstBlock := catchupCtx.blockHeaders[0]
+
+	if !catchupCtx.commonAncestorHash.IsEqual(catchupCtx.windowTip) || !firstBlock.HashPrevBlock.IsEqual(catchupCtx.windowTip) {
+		return errors.NewProcessingError("[catchup][%s] header window starting at %s with parent %s does not link to the last block %s of the previous window", catchupCtx.blockUpTo.Hash().String(), firstBlock.Hash().String(), firstBlock.HashPrevBlock.String(), catchupCtx.windowTip.String())
+	}
+
+	return nil
+}
+
 // fetchAndValidateBlocks fetches full blocks from peer and validates them.
 // Coordinates concurrent fetching and sequential validation for optimal performance.
 //
@@ -587,9 +695,18 @@ func (u *Server) fetchAndValidateBlocks(ctx context.Context, catchupCtx *Catchup
 		}
 
 		defer func() {
-			if catchupCtx.catchupError != nil {
+			switch {
+			case u.isCatchupCancelled():
+				// a catchup cancelled by an operator returns the node to RUNNING, even though the context is cancelled
+				u.logger.Warnf("[catchup][%s] Catchup was cancelled, setting FSM state back to RUNNING", catchupCtx.blockUpTo.Hash().String())
+				u.restoreFSMState(context.WithoutCancel(ctx), catchupCtx)
+			case catchupCtx.catchupError != nil:
 				u.logger.Errorf("[catchup][%s] Catchup failed with error, not setting FSM state back to RUNNING: %v", catchupCtx.blockUpTo.Hash().String(), catchupCtx.catchupError)
-			} else {
+			case catchupCtx.windowReached():
+				// the blocks of the next header window are validated in the same catchup
+				catchupCtx.fsmCatchingBlocks = true
+			default:
+				catchupCtx.fsmCatchingBlocks = false
 				u.restoreFSMState(ctx, catchupCtx)
 			}
 		}()
@@ -815,9 +932,10 @@ func (u *Server) validateBlocksOnChannel(validateBlocksChan chan *model.Block, g
 				// Standard validation path for blocks not verified by checkpoints
 				// Create validation options with cached headers
 				opts := &ValidateBlockOptions{
-					CachedHeaders:           cachedHeaders,
-					IsCatchupMode:           true,
-					DisableOptimisticMining: true,
+					CachedHeaders:              cachedHeaders,
+					IsCatchupMode:              true,
+					DisableOptimisticMining:    true,
+					SkipRecentBlocksBloomCheck: u.isBuriedBelowTrustedDepth(block, blockUpTo),
 				}
 
 				// Validate the block using standard validation
@@ -843,6 +961,8 @@ func (u *Server) validateBlocksOnChannel(validateBlocksChan chan *model.Block, g
 				}
 			}
 
+			u.recordCatchupBlockValidated(block.Height)
+
 			// Update the remaining block count
 			remaining := size.Add(-1)
 			if remaining%100 == 0 && remaining > 0 {
@@ -925,6 +1045,18 @@ func getLowestCheckpointHeight(checkpoints []chaincfg.Checkpoint) uint32 {
 	return lowestHeight
 }
 
+// isBuriedBelowTrustedDepth returns whether the block is at least CatchupTrustedDepth blocks below the block
+// being caught up to, in which case the recent blocks bloom filter check can be skipped when validating it.
+// Always false when CatchupTrustedDepth is 0, the default.
+func (u *Server) isBuriedBelowTrustedDepth(block *model.Block, blockUpTo *model.Block) bool {
+	trustedDepth := u.settings.BlockValidation.CatchupTrustedDepth
+	if trustedDepth == 0 || blockUpTo.Height < trustedDepth {
+		return false
+	}
+
+	return block.Height <= blockUpTo.Height-trustedDepth
+}
+
 // checkSecretMiningFromCommonAncestor detects if a peer withheld blocks (secret mining).
 // Checks if common ancestor is too far behind, indicating potential attack.
 //
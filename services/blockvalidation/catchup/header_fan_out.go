@@ -0,0 +1,137 @@
+package catchup
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+type headerFetchResult struct {
+	index       int
+	headerBytes []byte
+	err         error
+}
+
+// FetchHeadersFromPeers requests the same headers from several peers and returns the first response
+// that is accepted.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+//   - logger: Logger for failed peers
+//   - peers: Peers to request the headers from, the first peer is the peer the catchup syncs from
+//   - requestPath: Path of the request, appended to the base URL of each peer
+//   - maxRetries: Maximum retry attempts per peer
+//   - concurrency: Maximum number of peers requested at the same time, the next peer is requested when a request fails
+//   - accept: Validates a response, a response that is not accepted is ignored
+//
+// Returns:
+//   - []byte: Raw header bytes of the first accepted response
+//   - HeaderPeer: Peer that returned the accepted response
+//   - error: The error of the first peer when no response was accepted, or of the first failing peer if the first peer was not requested
+//
+// The requests that are still in flight are cancelled when a response is accepted.
+func FetchHeadersFromPeers(ctx context.Context, logger ulogger.Logger, peers []HeaderPeer, requestPath string, maxRetries int,
+	concurrency int, accept func(peer HeaderPeer, headerBytes []byte) error) ([]byte, HeaderPeer, error) {
+	if len(peers) == 0 {
+		return nil, HeaderPeer{}, errors.NewInvalidArgumentError("no peers to fetch headers from")
+	}
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered for all peers, so requests that finish after a response was accepted do not block
+	results := make(chan headerFetchResult, len(peers))
+
+	next := 0
+	inFlight := 0
+
+	launch := func() {
+		index := next
+		next++
+		inFlight++
+
+		go func() {
+			headerBytes, err := FetchHeadersWithRetry(ctx, logger, peers[index].BaseURL+requestPath, maxRetries)
+			results <- headerFetchResult{index: index, headerBytes: headerBytes, err: err}
+		}()
+	}
+
+	for next < len(peers) && inFlight < concurrency {
+		launch()
+	}
+
+	peerErrors := make([]error, len(peers))
+
+	for inFlight > 0 {
+		result := <-results
+		inFlight--
+
+		err := result.err
+		if err == nil {
+			err = accept(peers[result.index], result.headerBytes)
+		}
+
+		if err == nil {
+			return result.headerBytes, peers[result.index], nil
+		}
+
+		logger.Debugf("[catchup:FetchHeadersFromPeers] headers from peer %s (%s) not used: %v", peers[result.index].PeerID, peers[result.index].BaseURL, err)
+
+		peerErrors[result.index] = err
+
+		if next < len(peers) {
+			launch()
+		}
+	}
+
+	for _, err := range peerErrors {
+		if err != nil {
+			return nil, peers[0], err
+		}
+	}
+
+	return nil, peers[0], errors.NewProcessingError("no headers received from %d peers", len(peers))
+}
+
+// ValidateHeaderChainLinks verifies that the headers form a chain, which starts at or builds on one of the locator hashes,
+// since the headers returned from the common ancestor include the common ancestor itself.
+//
+// Parameters:
+//   - headers: Headers in ascending order
+//   - locatorHashes: Hashes the first header may be or build on
+//
+// Returns:
+//   - error: NetworkInvalidResponseError if the headers do not link
+func ValidateHeaderChainLinks(headers []*model.BlockHeader, locatorHashes []*chainhash.Hash) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	linksToLocator := false
+
+	for _, locatorHash := range locatorHashes {
+		if headers[0].Hash().IsEqual(locatorHash) || headers[0].HashPrevBlock.IsEqual(locatorHash) {
+			linksToLocator = true
+			break
+		}
+	}
+
+	if !linksToLocator {
+		return errors.NewNetworkInvalidResponseError("first header %s and its parent %s are not in the block locator", headers[0].Hash().String(), headers[0].HashPrevBlock.String())
+	}
+
+	for i := 1; i < len(headers); i++ {
+		if !headers[i].HashPrevBlock.IsEqual(headers[i-1].Hash()) {
+			return errors.NewNetworkInvalidResponseError("header %s at index %d does not link to the previous header %s", headers[i].Hash().String(), i, headers[i-1].Hash().String())
+		}
+	}
+
+	return nil
+}
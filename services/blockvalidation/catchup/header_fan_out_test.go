@@ -0,0 +1,141 @@
+package catchup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHeaderPeerServer(t *testing.T, delay time.Duration, status int, body []byte, requests *atomic.Int32) HeaderPeer {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			requests.Add(1)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return HeaderPeer{BaseURL: server.URL, PeerID: server.URL}
+}
+
+func TestFetchHeadersFromPeers(t *testing.T) {
+	logger := ulogger.TestLogger{}
+	acceptAll := func(HeaderPeer, []byte) error { return nil }
+
+	t.Run("fastest peer is used", func(t *testing.T) {
+		slow := newHeaderPeerServer(t, 2*time.Second, http.StatusOK, []byte("slow"), nil)
+		fast := newHeaderPeerServer(t, 0, http.StatusOK, []byte("fast"), nil)
+
+		start := time.Now()
+		headerBytes, peer, err := FetchHeadersFromPeers(context.Background(), logger, []HeaderPeer{slow, fast}, "/headers", 0, 2, acceptAll)
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("fast"), headerBytes)
+		assert.Equal(t, fast, peer)
+		assert.Less(t, time.Since(start), 2*time.Second)
+	})
+
+	t.Run("rejected response is ignored", func(t *testing.T) {
+		invalid := newHeaderPeerServer(t, 0, http.StatusOK, []byte("invalid"), nil)
+		valid := newHeaderPeerServer(t, 100*time.Millisecond, http.StatusOK, []byte("valid"), nil)
+
+		headerBytes, peer, err := FetchHeadersFromPeers(context.Background(), logger, []HeaderPeer{invalid, valid}, "/headers", 0, 2,
+			func(_ HeaderPeer, headerBytes []byte) error {
+				if bytes.Equal(headerBytes, []byte("invalid")) {
+					return errors.NewNetworkInvalidResponseError("invalid headers")
+				}
+
+				return nil
+			})
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("valid"), headerBytes)
+		assert.Equal(t, valid, peer)
+	})
+
+	t.Run("next peer is requested when a request fails", func(t *testing.T) {
+		var requests atomic.Int32
+
+		failing := newHeaderPeerServer(t, 0, http.StatusInternalServerError, nil, &requests)
+		valid := newHeaderPeerServer(t, 0, http.StatusOK, []byte("valid"), &requests)
+		unused := newHeaderPeerServer(t, 0, http.StatusOK, []byte("unused"), &requests)
+
+		headerBytes, peer, err := FetchHeadersFromPeers(context.Background(), logger, []HeaderPeer{failing, valid, unused}, "/headers", 0, 1, acceptAll)
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("valid"), headerBytes)
+		assert.Equal(t, valid, peer)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("error of the first peer is returned when all peers fail", func(t *testing.T) {
+		first := newHeaderPeerServer(t, 0, http.StatusOK, []byte("first"), nil)
+		second := newHeaderPeerServer(t, 0, http.StatusInternalServerError, nil, nil)
+
+		_, peer, err := FetchHeadersFromPeers(context.Background(), logger, []HeaderPeer{first, second}, "/headers", 0, 2,
+			func(p HeaderPeer, _ []byte) error {
+				return errors.NewNetworkInvalidResponseError("invalid headers from %s", p.PeerID)
+			})
+		require.Error(t, err)
+
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Contains(t, err.Error(), first.PeerID)
+		assert.Equal(t, first, peer)
+	})
+
+	t.Run("no peers", func(t *testing.T) {
+		_, _, err := FetchHeadersFromPeers(context.Background(), logger, nil, "/headers", 0, 2, acceptAll)
+		require.Error(t, err)
+	})
+}
+
+func TestValidateHeaderChainLinks(t *testing.T) {
+	headers := createValidChain(t, 5)
+	ancestorHash := headers[0].Hash()
+
+	t.Run("headers starting at the locator", func(t *testing.T) {
+		require.NoError(t, ValidateHeaderChainLinks(headers, []*chainhash.Hash{randomHash(), ancestorHash}))
+	})
+
+	t.Run("headers building on the locator", func(t *testing.T) {
+		require.NoError(t, ValidateHeaderChainLinks(headers[1:], []*chainhash.Hash{ancestorHash}))
+	})
+
+	t.Run("no headers", func(t *testing.T) {
+		require.NoError(t, ValidateHeaderChainLinks(nil, []*chainhash.Hash{ancestorHash}))
+	})
+
+	t.Run("headers not linking to the locator", func(t *testing.T) {
+		err := ValidateHeaderChainLinks(headers[2:], []*chainhash.Hash{ancestorHash})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+	})
+
+	t.Run("gap in headers", func(t *testing.T) {
+		withGap := []*model.BlockHeader{headers[0], headers[1], headers[3], headers[4]}
+
+		err := ValidateHeaderChainLinks(withGap, []*chainhash.Hash{ancestorHash})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Contains(t, err.Error(), "at index 2 does not link")
+	})
+}
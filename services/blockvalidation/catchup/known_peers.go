@@ -0,0 +1,111 @@
+package catchup
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// HeaderPeer identifies a peer that block headers can be requested from
+type HeaderPeer struct {
+	BaseURL string
+	PeerID  string
+}
+
+type knownPeer struct {
+	baseURL  string
+	lastSeen time.Time
+}
+
+// KnownPeers tracks the base URLs of peers that recently announced blocks, so that catchup
+// can request headers from other peers than the one it syncs from
+type KnownPeers struct {
+	mu       sync.RWMutex
+	maxPeers int
+	peers    map[string]knownPeer // Key is PeerID
+}
+
+// NewKnownPeers creates a new KnownPeers instance, keeping at most maxPeers peers
+func NewKnownPeers(maxPeers int) *KnownPeers {
+	return &KnownPeers{
+		maxPeers: maxPeers,
+		peers:    make(map[string]knownPeer),
+	}
+}
+
+// Record records that the peer announced a block from the given base URL.
+// When the maximum number of peers is reached, the peer that was seen least recently is evicted.
+func (kp *KnownPeers) Record(peerID string, baseURL string) {
+	if kp == nil || peerID == "" || baseURL == "" {
+		return
+	}
+
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	if _, exists := kp.peers[peerID]; !exists && len(kp.peers) >= kp.maxPeers {
+		var (
+			oldestPeerID string
+			oldest       time.Time
+		)
+
+		for id, peer := range kp.peers {
+			if oldestPeerID == "" || peer.lastSeen.Before(oldest) {
+				oldestPeerID = id
+				oldest = peer.lastSeen
+			}
+		}
+
+		delete(kp.peers, oldestPeerID)
+	}
+
+	kp.peers[peerID] = knownPeer{
+		baseURL:  baseURL,
+		lastSeen: time.Now(),
+	}
+}
+
+// Select returns up to n peers, most recently seen first.
+// The excluded peer and the peers for which skip returns true are not returned, skip may be nil.
+func (kp *KnownPeers) Select(n int, excludePeerID string, skip func(peerID string) bool) []HeaderPeer {
+	if kp == nil || n <= 0 {
+		return nil
+	}
+
+	kp.mu.RLock()
+
+	candidates := make([]HeaderPeer, 0, len(kp.peers))
+	lastSeen := make(map[string]time.Time, len(kp.peers))
+
+	for id, peer := range kp.peers {
+		if id == excludePeerID {
+			continue
+		}
+
+		candidates = append(candidates, HeaderPeer{BaseURL: peer.baseURL, PeerID: id})
+		lastSeen[id] = peer.lastSeen
+	}
+
+	kp.mu.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return lastSeen[candidates[i].PeerID].After(lastSeen[candidates[j].PeerID])
+	})
+
+	selected := make([]HeaderPeer, 0, n)
+
+	for _, candidate := range candidates {
+		if len(selected) == n {
+			break
+		}
+
+		// skip is called without holding the lock, it may look up other peer state
+		if skip != nil && skip(candidate.PeerID) {
+			continue
+		}
+
+		selected = append(selected, candidate)
+	}
+
+	return selected
+}
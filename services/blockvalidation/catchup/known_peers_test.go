@@ -0,0 +1,84 @@
+package catchup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownPeers(t *testing.T) {
+	t.Run("select most recently seen first", func(t *testing.T) {
+		kp := NewKnownPeers(10)
+
+		kp.Record("peer1", "http://peer1")
+		time.Sleep(time.Millisecond)
+		kp.Record("peer2", "http://peer2")
+		time.Sleep(time.Millisecond)
+		kp.Record("peer3", "http://peer3")
+
+		peers := kp.Select(2, "", nil)
+		require.Len(t, peers, 2)
+		assert.Equal(t, HeaderPeer{BaseURL: "http://peer3", PeerID: "peer3"}, peers[0])
+		assert.Equal(t, HeaderPeer{BaseURL: "http://peer2", PeerID: "peer2"}, peers[1])
+	})
+
+	t.Run("record updates the base URL of a peer", func(t *testing.T) {
+		kp := NewKnownPeers(10)
+
+		kp.Record("peer1", "http://old")
+		kp.Record("peer1", "http://new")
+
+		peers := kp.Select(10, "", nil)
+		require.Len(t, peers, 1)
+		assert.Equal(t, "http://new", peers[0].BaseURL)
+	})
+
+	t.Run("excluded and skipped peers are not selected", func(t *testing.T) {
+		kp := NewKnownPeers(10)
+
+		kp.Record("peer1", "http://peer1")
+		kp.Record("peer2", "http://peer2")
+		kp.Record("peer3", "http://peer3")
+
+		peers := kp.Select(10, "peer1", func(peerID string) bool {
+			return peerID == "peer2"
+		})
+		require.Len(t, peers, 1)
+		assert.Equal(t, "peer3", peers[0].PeerID)
+	})
+
+	t.Run("least recently seen peer is evicted", func(t *testing.T) {
+		kp := NewKnownPeers(2)
+
+		kp.Record("peer1", "http://peer1")
+		time.Sleep(time.Millisecond)
+		kp.Record("peer2", "http://peer2")
+		time.Sleep(time.Millisecond)
+		kp.Record("peer1", "http://peer1")
+		time.Sleep(time.Millisecond)
+		kp.Record("peer3", "http://peer3")
+
+		peers := kp.Select(10, "", nil)
+		require.Len(t, peers, 2)
+		assert.Equal(t, "peer3", peers[0].PeerID)
+		assert.Equal(t, "peer1", peers[1].PeerID)
+	})
+
+	t.Run("invalid records are ignored", func(t *testing.T) {
+		kp := NewKnownPeers(10)
+
+		kp.Record("", "http://peer1")
+		kp.Record("peer2", "")
+
+		assert.Empty(t, kp.Select(10, "", nil))
+	})
+
+	t.Run("nil known peers", func(t *testing.T) {
+		var kp *KnownPeers
+
+		kp.Record("peer1", "http://peer1")
+		assert.Nil(t, kp.Select(10, "", nil))
+	})
+}
@@ -21,6 +21,7 @@ type Result struct {
 	StartHeight       uint32            // Height of the starting block
 	LastProcessedHash *chainhash.Hash   // Last successfully processed block
 	LocatorHashes     []*chainhash.Hash // Block locator hashes used
+	WindowReached     bool              // Whether the headers stopped at the catchup header window, more headers may follow
 
 	// Performance metrics
 	Duration              time.Duration    // Total time taken for the catchup operation
@@ -127,11 +127,20 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 	chainTipHash := blockUpTo.Hash()
 	currentLocatorHashes := locatorHashes
 
+	// the catchup peer, followed by the other peers the headers are requested from
+	headerPeers := u.catchupHeaderPeers(baseURL, identifier)
+
 	// iteration variables
 	iteration := 0
 	maxAccumulatedHeaders := u.settings.BlockValidation.CatchupMaxAccumulatedHeaders
+	headerWindow := catchupHeaderWindow(u.settings.BlockValidation.CatchupHeaderWindow)
+	windowed := headerWindow > 0 && headerWindow < maxAccumulatedHeaders
+	if windowed {
+		maxAccumulatedHeaders = headerWindow
+	}
 	totalHeadersFetched := 0
 	reachedTarget := false
+	windowReached := false
 	stopReason := ""
 
 	// Iterate until we reach the target or chain tip
@@ -158,10 +167,9 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 		}
 		iterCtx, iterCancel := context.WithTimeout(ctx, iterationTimeout)
 
-		// Build request URL with current block locator
+		// Build request path with current block locator, the path is requested from each header peer
 		blockLocatorStr := catchup.BuildBlockLocatorString(currentLocatorHashes)
-		requestURL := fmt.Sprintf("%s/headers_from_common_ancestor/%s?block_locator_hashes=%s&n=%d",
-			baseURL,
+		requestPath := fmt.Sprintf("/headers_from_common_ancestor/%s?block_locator_hashes=%s&n=%d",
 			chainTipHash.String(),
 			blockLocatorStr,
 			maxBlockHeadersPerRequest,
@@ -170,7 +178,7 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 		u.logger.Debugf("[catchup][%s] iteration %d: requesting headers with locator starting at %s (timeout: %v)", chainTipHash.String(), iteration, currentLocatorHashes[0].String(), iterationTimeout)
 
 		// Fetch with retry using iteration context with timeout
-		blockHeadersBytes, err := catchup.FetchHeadersWithRetry(iterCtx, u.logger, requestURL, maxRetries)
+		blockHeadersBytes, err := u.fetchCatchupHeaders(iterCtx, headerPeers, requestPath, currentLocatorHashes, maxRetries)
 		iterCancel() // Clean up the iteration context
 		if err != nil {
 			// Check if it's specifically a context deadline exceeded from the iteration timeout
@@ -338,7 +346,13 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 				blockHeaders = blockHeaders[:remainingCapacity]
 				allCatchupHeaders = append(allCatchupHeaders, blockHeaders...)
 			}
-			stopReason = fmt.Sprintf("Memory limit reached (%d headers)", maxAccumulatedHeaders)
+
+			if windowed {
+				windowReached = true
+				stopReason = fmt.Sprintf("Header window reached (%d headers)", maxAccumulatedHeaders)
+			} else {
+				stopReason = fmt.Sprintf("Memory limit reached (%d headers)", maxAccumulatedHeaders)
+			}
 			break
 		}
 
@@ -359,6 +373,13 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 			if reachedTarget {
 				break
 			}
+
+			// the blocks of a full window are validated before the next headers are requested
+			if windowed && len(allCatchupHeaders) >= maxAccumulatedHeaders {
+				windowReached = true
+				stopReason = fmt.Sprintf("Header window reached (%d headers)", maxAccumulatedHeaders)
+				break
+			}
 		}
 
 		// If we received fewer headers than max, we've reached the chain tip
@@ -418,6 +439,102 @@ func (u *Server) catchupGetBlockHeaders(ctx context.Context, blockUpTo *model.Bl
 	u.logger.Infof("[catchup][%s] completed: %d headers fetched in %d iterations, reached target: %v, reason: %s", chainTipHash.String(), totalHeadersFetched, iteration, reachedTarget, stopReason)
 
 	result := catchup.CreateCatchupResultWithLocator(allCatchupHeaders, blockUpTo.Hash(), startHash, startHeight, startTime, baseURL, iteration, failedIterations, reachedTarget, stopReason, locatorHashes)
+	result.WindowReached = windowReached
 
 	return result, bestBlockHeader, nil
 }
+
+// catchupHeaderWindow returns the number of headers a catchup accumulates before their blocks are validated, or 0 when
+// the headers up to the target are accumulated at once. A window is at least the headers of a single request, so that
+// every window gets past the headers of a fork, which cannot be deeper than the coinbase maturity.
+func catchupHeaderWindow(headerWindow int) int {
+	if headerWindow <= 0 {
+		return 0
+	}
+
+	return max(headerWindow, maxBlockHeadersPerRequest)
+}
+
+// catchupHeaderPeers returns the peers to request catchup headers from: the catchup peer first, followed by up to
+// CatchupHeaderPeerFanOut-1 peers that recently announced blocks and are not marked as bad, malicious or failing.
+//
+// Parameters:
+//   - baseURL: URL of the catchup peer
+//   - peerID: Identifier of the catchup peer
+//
+// Returns:
+//   - []catchup.HeaderPeer: Peers to request the headers from
+func (u *Server) catchupHeaderPeers(baseURL string, peerID string) []catchup.HeaderPeer {
+	headerPeers := []catchup.HeaderPeer{{BaseURL: baseURL, PeerID: peerID}}
+
+	fanOut := u.settings.BlockValidation.CatchupHeaderPeerFanOut
+	if fanOut <= 1 {
+		return headerPeers
+	}
+
+	return append(headerPeers, u.knownPeers.Select(fanOut-1, peerID, u.skipHeaderPeer)...)
+}
+
+// skipHeaderPeer returns whether headers should not be requested from the peer during a catchup from another peer.
+func (u *Server) skipHeaderPeer(peerID string) bool {
+	if u.peerMetrics != nil {
+		if peerMetric, exists := u.peerMetrics.GetPeerMetrics(peerID); exists && (peerMetric.IsBad() || peerMetric.IsMalicious()) {
+			return true
+		}
+	}
+
+	return u.peerCircuitBreakers != nil && u.peerCircuitBreakers.GetPeerState(peerID) == catchup.StateOpen
+}
+
+// fetchCatchupHeaders requests the headers of a catchup iteration. With a single header peer the headers are requested
+// from the catchup peer only. With several header peers, the request is sent to the peers concurrently, and the first
+// response that parses and links to the block locator is used, so a slow catchup peer does not hold up the iteration.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeouts
+//   - headerPeers: Peers to request the headers from, the catchup peer first
+//   - requestPath: Path of the headers request
+//   - locatorHashes: Block locator of the request, the headers must start at or build on one of them
+//   - maxRetries: Maximum retry attempts per peer
+//
+// Returns:
+//   - []byte: Raw header bytes
+//   - error: The error of the catchup peer if no response was used
+func (u *Server) fetchCatchupHeaders(ctx context.Context, headerPeers []catchup.HeaderPeer, requestPath string, locatorHashes []*chainhash.Hash, maxRetries int) ([]byte, error) {
+	catchupPeer := headerPeers[0]
+
+	if len(headerPeers) == 1 {
+		return catchup.FetchHeadersWithRetry(ctx, u.logger, catchupPeer.BaseURL+requestPath, maxRetries)
+	}
+
+	headerBytes, peer, err := catchup.FetchHeadersFromPeers(ctx, u.logger, headerPeers, requestPath, maxRetries, u.settings.BlockValidation.CatchupHeaderFetchConcurrency,
+		func(peer catchup.HeaderPeer, headerBytes []byte) error {
+			headers, err := catchup.ParseBlockHeaders(headerBytes)
+			if err == nil {
+				switch {
+				case len(headers) > 0:
+					err = catchup.ValidateHeaderChainLinks(headers, locatorHashes)
+				case peer.PeerID != catchupPeer.PeerID:
+					// only the catchup peer is trusted to report that there are no more headers
+					err = errors.NewNotFoundError("no headers received from peer %s", peer.PeerID)
+				}
+			}
+
+			// the errors of the catchup peer are handled by the catchup iteration
+			if err != nil && peer.PeerID != catchupPeer.PeerID && errors.IsMaliciousResponseError(err) && u.peerMetrics != nil {
+				u.peerMetrics.GetOrCreatePeerMetrics(peer.PeerID).RecordMaliciousAttempt()
+			}
+
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if peer.PeerID != catchupPeer.PeerID {
+		u.logger.Debugf("[catchup] headers %s served by peer %s (%s) instead of catchup peer %s", requestPath, peer.PeerID, peer.BaseURL, catchupPeer.PeerID)
+	}
+
+	return headerBytes, nil
+}
@@ -0,0 +1,202 @@
+// This file contains the progress tracking and cancellation of the catchup in progress.
+package blockvalidation
+
+import (
+	"context"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// catchupRateAlpha is the smoothing factor of the exponentially weighted moving average of the catchup rate.
+const catchupRateAlpha = 0.1
+
+// catchupRate tracks the exponentially weighted moving average of the interval between validated catchup blocks.
+// The interval is averaged rather than the rate itself, so that blocks validated in quick succession do not
+// cause spikes in the reported rate.
+type catchupRate struct {
+	lastBlockAt     time.Time
+	avgBlockSeconds float64
+}
+
+// record records a block validated at the given time and returns the current rate in blocks per second.
+// The rate is 0 until the interval between two blocks is known.
+func (r *catchupRate) record(now time.Time) float64 {
+	if !r.lastBlockAt.IsZero() {
+		interval := now.Sub(r.lastBlockAt).Seconds()
+
+		if r.avgBlockSeconds == 0 {
+			r.avgBlockSeconds = interval
+		} else {
+			r.avgBlockSeconds = catchupRateAlpha*interval + (1-catchupRateAlpha)*r.avgBlockSeconds
+		}
+	}
+
+	r.lastBlockAt = now
+
+	if r.avgBlockSeconds <= 0 {
+		return 0
+	}
+
+	return 1 / r.avgBlockSeconds
+}
+
+// CatchupStatus describes the progress of a catchup operation.
+// When no catchup is in progress, it describes the last catchup that ran since the server started.
+type CatchupStatus struct {
+	// IsCatchingUp indicates whether a catchup is currently in progress
+	IsCatchingUp bool
+
+	// TargetHash is the hash of the block the catchup syncs up to
+	TargetHash *chainhash.Hash
+
+	// TargetHeight is the height of the block the catchup syncs up to
+	TargetHeight uint32
+
+	// CurrentHeight is the height of the last validated block, or the common ancestor before any block is validated
+	CurrentHeight uint32
+
+	// BlocksValidated is the number of blocks validated so far
+	BlocksValidated uint32
+
+	// TotalBlocks is the number of blocks to fetch and validate, 0 until the headers are filtered
+	TotalBlocks uint32
+
+	// StartTime is the time the catchup started
+	StartTime time.Time
+
+	// PeerID is the peer the catchup syncs from
+	PeerID string
+}
+
+// startCatchupStatus resets the catchup status for a new catchup and returns a context that is
+// cancelled by CancelCatchup, independently of the service context.
+func (u *Server) startCatchupStatus(ctx context.Context, catchupCtx *CatchupContext) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+
+	u.catchupStatsMu.Lock()
+	defer u.catchupStatsMu.Unlock()
+
+	u.catchupStatus = CatchupStatus{
+		IsCatchingUp: true,
+		TargetHash:   catchupCtx.blockUpTo.Hash(),
+		TargetHeight: catchupCtx.blockUpTo.Height,
+		StartTime:    catchupCtx.startTime,
+		PeerID:       catchupCtx.peerID,
+	}
+	u.cancelCatchupFn = cancel
+	u.catchupCancelled = false
+	u.catchupRate = catchupRate{}
+
+	if prometheusCatchupBlocksPerSecond != nil {
+		prometheusCatchupBlocksPerSecond.Set(0)
+	}
+
+	return ctx
+}
+
+// finishCatchupStatus marks the catchup as finished and releases its context.
+func (u *Server) finishCatchupStatus() {
+	u.catchupStatsMu.Lock()
+	defer u.catchupStatsMu.Unlock()
+
+	if u.cancelCatchupFn != nil {
+		u.cancelCatchupFn()
+		u.cancelCatchupFn = nil
+	}
+
+	u.catchupStatus.IsCatchingUp = false
+
+	if prometheusCatchupBlocksPerSecond != nil {
+		prometheusCatchupBlocksPerSecond.Set(0)
+	}
+
+	if prometheusCatchupBacklog != nil {
+		prometheusCatchupBacklog.Set(0)
+	}
+}
+
+// setCatchupTotalBlocks records the number of blocks the catchup will validate, starting above startHeight.
+func (u *Server) setCatchupTotalBlocks(totalBlocks int, startHeight uint32) {
+	total, err := safeconversion.IntToUint32(totalBlocks)
+	if err != nil {
+		return
+	}
+
+	u.catchupStatsMu.Lock()
+	defer u.catchupStatsMu.Unlock()
+
+	u.catchupStatus.TotalBlocks = total
+	u.catchupStatus.CurrentHeight = startHeight
+
+	u.setCatchupBacklog()
+}
+
+// recordCatchupBlockValidated records that the block at the given height has been validated.
+func (u *Server) recordCatchupBlockValidated(height uint32) {
+	u.catchupStatsMu.Lock()
+	defer u.catchupStatsMu.Unlock()
+
+	u.catchupStatus.BlocksValidated++
+	u.catchupStatus.CurrentHeight = height
+
+	blocksPerSecond := u.catchupRate.record(time.Now())
+
+	if prometheusCatchupBlocksValidated != nil {
+		prometheusCatchupBlocksValidated.Inc()
+	}
+
+	if prometheusCatchupBlocksPerSecond != nil {
+		prometheusCatchupBlocksPerSecond.Set(blocksPerSecond)
+	}
+
+	u.setCatchupBacklog()
+}
+
+// setCatchupBacklog sets the catchup backlog metric to the number of blocks between the current and the target height.
+// The caller must hold catchupStatsMu.
+func (u *Server) setCatchupBacklog() {
+	if prometheusCatchupBacklog == nil {
+		return
+	}
+
+	var backlog uint32
+	if u.catchupStatus.TargetHeight > u.catchupStatus.CurrentHeight {
+		backlog = u.catchupStatus.TargetHeight - u.catchupStatus.CurrentHeight
+	}
+
+	prometheusCatchupBacklog.Set(float64(backlog))
+}
+
+// getCatchupStatus returns a copy of the status of the current or last catchup.
+func (u *Server) getCatchupStatus() CatchupStatus {
+	u.catchupStatsMu.RLock()
+	defer u.catchupStatsMu.RUnlock()
+
+	return u.catchupStatus
+}
+
+// cancelInFlightCatchup cancels the context of the catchup in progress.
+// Returns false when no catchup is in progress.
+func (u *Server) cancelInFlightCatchup() bool {
+	u.catchupStatsMu.Lock()
+	defer u.catchupStatsMu.Unlock()
+
+	if u.cancelCatchupFn == nil {
+		return false
+	}
+
+	u.cancelCatchupFn()
+	u.catchupCancelled = true
+
+	return true
+}
+
+// isCatchupCancelled returns whether the catchup in progress was cancelled through CancelCatchup.
+func (u *Server) isCatchupCancelled() bool {
+	u.catchupStatsMu.RLock()
+	defer u.catchupStatsMu.RUnlock()
+
+	return u.catchupCancelled
+}
@@ -0,0 +1,144 @@
+package blockvalidation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/services/blockvalidation/blockvalidation_api"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatchupStatus(t *testing.T) {
+	newCatchupCtx := func() *CatchupContext {
+		return &CatchupContext{
+			blockUpTo: &model.Block{
+				Header: &model.BlockHeader{
+					Version:        1,
+					HashPrevBlock:  &chainhash.Hash{},
+					HashMerkleRoot: &chainhash.Hash{},
+				},
+				Height: 200,
+			},
+			peerID:    "peer1",
+			startTime: time.Now(),
+		}
+	}
+
+	t.Run("no catchup has run", func(t *testing.T) {
+		server := &Server{logger: ulogger.TestLogger{}}
+
+		resp, err := server.GetCatchupStatus(context.Background(), &blockvalidation_api.EmptyMessage{})
+		require.NoError(t, err)
+
+		assert.False(t, resp.IsCatchingUp)
+		assert.Nil(t, resp.TargetHash)
+		assert.Nil(t, resp.StartTime)
+	})
+
+	t.Run("progress is reported while catching up", func(t *testing.T) {
+		server := &Server{logger: ulogger.TestLogger{}}
+		catchupCtx := newCatchupCtx()
+
+		server.startCatchupStatus(context.Background(), catchupCtx)
+		server.setCatchupTotalBlocks(100, 100)
+		server.recordCatchupBlockValidated(101)
+		server.recordCatchupBlockValidated(102)
+
+		resp, err := server.GetCatchupStatus(context.Background(), &blockvalidation_api.EmptyMessage{})
+		require.NoError(t, err)
+
+		assert.True(t, resp.IsCatchingUp)
+		assert.Equal(t, catchupCtx.blockUpTo.Hash().CloneBytes(), resp.TargetHash)
+		assert.Equal(t, uint32(200), resp.TargetHeight)
+		assert.Equal(t, uint32(102), resp.CurrentHeight)
+		assert.Equal(t, uint32(2), resp.BlocksValidated)
+		assert.Equal(t, uint32(100), resp.TotalBlocks)
+		assert.Equal(t, "peer1", resp.PeerId)
+		assert.True(t, catchupCtx.startTime.Equal(resp.StartTime.AsTime()))
+
+		server.finishCatchupStatus()
+
+		// the last catchup is still reported once it finished
+		status := server.getCatchupStatus()
+		assert.False(t, status.IsCatchingUp)
+		assert.Equal(t, uint32(2), status.BlocksValidated)
+	})
+
+	t.Run("cancel catchup in progress", func(t *testing.T) {
+		server := &Server{logger: ulogger.TestLogger{}}
+
+		ctx := server.startCatchupStatus(context.Background(), newCatchupCtx())
+
+		resp, err := server.CancelCatchup(context.Background(), &blockvalidation_api.EmptyMessage{})
+		require.NoError(t, err)
+		assert.True(t, resp.Cancelled)
+		assert.True(t, server.isCatchupCancelled())
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("catchup context was not cancelled")
+		}
+
+		server.finishCatchupStatus()
+
+		// a new catchup resets the cancelled flag
+		server.startCatchupStatus(context.Background(), newCatchupCtx())
+		assert.False(t, server.isCatchupCancelled())
+		server.finishCatchupStatus()
+	})
+
+	t.Run("cancel without catchup in progress", func(t *testing.T) {
+		server := &Server{logger: ulogger.TestLogger{}}
+
+		resp, err := server.CancelCatchup(context.Background(), &blockvalidation_api.EmptyMessage{})
+		require.NoError(t, err)
+		assert.False(t, resp.Cancelled)
+
+		server.startCatchupStatus(context.Background(), newCatchupCtx())
+		server.finishCatchupStatus()
+
+		resp, err = server.CancelCatchup(context.Background(), &blockvalidation_api.EmptyMessage{})
+		require.NoError(t, err)
+		assert.False(t, resp.Cancelled)
+	})
+
+	t.Run("service context cancels the catchup context", func(t *testing.T) {
+		server := &Server{logger: ulogger.TestLogger{}}
+
+		serviceCtx, cancel := context.WithCancel(context.Background())
+		ctx := server.startCatchupStatus(serviceCtx, newCatchupCtx())
+
+		cancel()
+		<-ctx.Done()
+
+		assert.False(t, server.isCatchupCancelled())
+		server.finishCatchupStatus()
+	})
+}
+
+func TestCatchupRate(t *testing.T) {
+	t.Run("rate is 0 until an interval is known", func(t *testing.T) {
+		var rate catchupRate
+
+		assert.Equal(t, float64(0), rate.record(time.Now()))
+	})
+
+	t.Run("rate is the inverse of the average interval", func(t *testing.T) {
+		var rate catchupRate
+
+		now := time.Now()
+		rate.record(now)
+
+		// the first interval initialises the average
+		assert.InDelta(t, 2.0, rate.record(now.Add(500*time.Millisecond)), 0.0001)
+
+		// a slower block moves the average interval by alpha: 0.1*1.5 + 0.9*0.5 = 0.6 seconds
+		assert.InDelta(t, 1/0.6, rate.record(now.Add(2*time.Second)), 0.0001)
+	})
+}
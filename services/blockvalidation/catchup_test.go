@@ -565,6 +565,97 @@ func TestCatchupGetBlockHeaders(t *testing.T) {
 
 // Commented out tests that depend on sql package
 /*
+func TestCatchupHeaderPeers(t *testing.T) {
+	newServer := func(t *testing.T, fanOut int) *Server {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.BlockValidation.CatchupHeaderPeerFanOut = fanOut
+
+		server := &Server{
+			logger:              ulogger.TestLogger{},
+			settings:            tSettings,
+			knownPeers:          catchup.NewKnownPeers(maxKnownPeers),
+			peerMetrics:         catchup.NewCatchupMetrics(),
+			peerCircuitBreakers: catchup.NewPeerCircuitBreakers(catchup.DefaultCircuitBreakerConfig()),
+		}
+
+		server.knownPeers.Record("peer1", "http://peer1")
+		server.knownPeers.Record("peer2", "http://peer2")
+		server.knownPeers.Record("peer3", "http://peer3")
+
+		return server
+	}
+
+	t.Run("no fan-out", func(t *testing.T) {
+		server := newServer(t, 1)
+
+		peers := server.catchupHeaderPeers("http://peer1", "peer1")
+		assert.Equal(t, []catchup.HeaderPeer{{BaseURL: "http://peer1", PeerID: "peer1"}}, peers)
+	})
+
+	t.Run("fan-out skips malicious peers", func(t *testing.T) {
+		server := newServer(t, 3)
+
+		server.peerMetrics.GetOrCreatePeerMetrics("peer2").RecordMaliciousAttempt()
+
+		peers := server.catchupHeaderPeers("http://peer1", "peer1")
+		require.Len(t, peers, 2)
+		assert.Equal(t, "peer1", peers[0].PeerID)
+		assert.Equal(t, "peer3", peers[1].PeerID)
+	})
+
+	t.Run("headers from another peer are used", func(t *testing.T) {
+		server := newServer(t, 2)
+
+		headers := testhelpers.CreateTestHeaders(t, 3)
+		locator := []*chainhash.Hash{headers[0].HashPrevBlock}
+
+		var validBytes, unlinkedBytes []byte
+		for _, header := range headers {
+			validBytes = append(validBytes, header.Bytes()...)
+		}
+
+		// the catchup peer skips the middle header
+		unlinkedBytes = append(unlinkedBytes, headers[0].Bytes()...)
+		unlinkedBytes = append(unlinkedBytes, headers[2].Bytes()...)
+
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("GET", `=~^http://peer1/headers_from_common_ancestor/.*`, httpmock.NewBytesResponder(200, unlinkedBytes))
+		httpmock.RegisterResponder("GET", `=~^http://peer3/headers_from_common_ancestor/.*`, httpmock.NewBytesResponder(200, validBytes))
+
+		headerPeers := server.catchupHeaderPeers("http://peer1", "peer1")
+		require.Len(t, headerPeers, 2)
+
+		headerBytes, err := server.fetchCatchupHeaders(context.Background(), headerPeers, "/headers_from_common_ancestor/"+headers[2].Hash().String(), locator, 1)
+		require.NoError(t, err)
+		assert.Equal(t, validBytes, headerBytes)
+	})
+
+	t.Run("error of the catchup peer is returned", func(t *testing.T) {
+		server := newServer(t, 2)
+
+		headers := testhelpers.CreateTestHeaders(t, 2)
+		locator := []*chainhash.Hash{headers[0].HashPrevBlock}
+
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder("GET", `=~^http://peer1/headers_from_common_ancestor/.*`, httpmock.NewBytesResponder(200, headers[1].Bytes()))
+		httpmock.RegisterResponder("GET", `=~^http://peer3/headers_from_common_ancestor/.*`, httpmock.NewBytesResponder(200, []byte{}))
+
+		headerPeers := server.catchupHeaderPeers("http://peer1", "peer1")
+
+		_, err := server.fetchCatchupHeaders(context.Background(), headerPeers, "/headers_from_common_ancestor/"+headers[1].Hash().String(), locator, 1)
+		require.Error(t, err)
+		assert.True(t, errors.IsMaliciousResponseError(err))
+
+		// the other peer returning no headers is not malicious
+		_, exists := server.peerMetrics.GetPeerMetrics("peer3")
+		assert.False(t, exists)
+	})
+}
+
 func Test_checkSecretMining(t *testing.T) {
 	t.Run("secret mining 10 blocks", func(t *testing.T) {
 		tSettings := test.CreateBaseTestSettings(t)
@@ -952,6 +1043,107 @@ func TestProcessBlockFoundChannelCatchup(t *testing.T) {
 	assert.Equal(t, 0, len(server.blockFoundCh))
 }
 
+func TestProcessBlockFoundChannelCatchup_Deduplicated(t *testing.T) {
+	initPrometheusMetrics()
+
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.BlockValidation.UseCatchupWhenBehind = true
+
+	blocks := testhelpers.CreateTestBlockChain(t, 4)
+
+	mockBlockchainClient := &blockchain.Mock{}
+	mockBlockchainClient.On("GetBlockExists", mock.Anything, mock.Anything).Return(false, nil)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	blockBytes, err := blocks[3].Bytes()
+	require.NoError(t, err)
+
+	for _, peer := range []string{"peer1", "peer2", "peer3"} {
+		httpmock.RegisterResponder(
+			"GET",
+			fmt.Sprintf("=~^http://%s/block/%s", peer, blocks[3].Header.Hash().String()),
+			httpmock.NewBytesResponder(200, blockBytes),
+		)
+	}
+
+	server := &Server{
+		logger:               ulogger.TestLogger{},
+		settings:             tSettings,
+		blockFoundCh:         make(chan processBlockFound, 10),
+		catchupCh:            make(chan processBlockCatchup, 10),
+		blockValidation:      NewBlockValidation(context.Background(), ulogger.TestLogger{}, tSettings, mockBlockchainClient, nil, nil, nil, nil, nil),
+		blockchainClient:     mockBlockchainClient,
+		processSubtreeNotify: ttlcache.New[chainhash.Hash, bool](),
+		catchupInFlight:      ttlcache.New[chainhash.Hash, *catchupInFlightEntry](),
+		stats:                gocore.NewStat("test"),
+	}
+
+	announce := func(peers ...string) []processBlockFound {
+		items := make([]processBlockFound, 0, len(peers))
+		for _, peer := range peers {
+			items = append(items, processBlockFound{hash: blocks[3].Header.Hash(), baseURL: "http://" + peer, peerID: peer, errCh: make(chan error, 1)})
+		}
+
+		return items
+	}
+
+	// the same block announced by several peers only triggers a single catchup
+	items := announce("peer1", "peer2", "peer3", "peer1", "peer2")
+	for _, item := range items[1:] {
+		server.blockFoundCh <- item
+	}
+
+	require.NoError(t, server.processBlockFoundChannel(context.Background(), items[0]))
+	require.Len(t, server.catchupCh, 1)
+
+	c := <-server.catchupCh
+	assert.Equal(t, blocks[3].Header.Hash(), c.block.Header.Hash())
+
+	for _, item := range items {
+		require.NoError(t, <-item.errCh)
+	}
+
+	// while the catchup is in flight, new announcements of the block are coalesced
+	items = announce("peer2", "peer3", "peer1", "peer2", "peer3")
+	for _, item := range items[1:] {
+		server.blockFoundCh <- item
+	}
+
+	require.NoError(t, server.processBlockFoundChannel(context.Background(), items[0]))
+	assert.Empty(t, server.catchupCh)
+
+	// a failed catchup is retried once from each of the other peers that announced the block
+	retried := make(map[string]bool)
+
+	for i := 0; i < 2; i++ {
+		server.retryCatchupFromNextPeer(c)
+
+		select {
+		case retry := <-server.catchupCh:
+			assert.Equal(t, blocks[3].Header.Hash(), retry.block.Header.Hash())
+			assert.Equal(t, "http://"+retry.peerID, retry.baseURL)
+			assert.NotEqual(t, c.peerID, retry.peerID)
+			assert.False(t, retried[retry.peerID])
+
+			retried[retry.peerID] = true
+		case <-time.After(time.Second):
+			t.Fatal("expected the catchup to be retried from another peer")
+		}
+	}
+
+	// without any other peer left, the block is released and can trigger a new catchup
+	server.retryCatchupFromNextPeer(c)
+	assert.True(t, server.markCatchupInFlight(blocks[3].Header.Hash(), "http://peer1", "peer1"))
+	assert.False(t, server.markCatchupInFlight(blocks[3].Header.Hash(), "http://peer2", "peer2"))
+
+	// once the catchup finished, the block can trigger a new catchup
+	server.releaseCatchupInFlight(blocks[3].Header.Hash())
+	assert.True(t, server.markCatchupInFlight(blocks[3].Header.Hash(), "http://peer1", "peer1"))
+	assert.Empty(t, server.catchupCh)
+}
+
 func TestCatchup(t *testing.T) {
 	initPrometheusMetrics()
 
@@ -2173,6 +2365,69 @@ func TestCatchup_MemoryLimitPreCheck(t *testing.T) {
 }
 
 // TestCatchup_PreventsConcurrentOperations tests that only one catchup can run at a time
+// TestCatchup_HeaderWindow tests the header window that bounds the headers accumulated by a catchup
+func TestCatchup_HeaderWindow(t *testing.T) {
+	t.Run("WindowSize", func(t *testing.T) {
+		assert.Equal(t, 0, catchupHeaderWindow(0), "0 should disable the header window")
+		assert.Equal(t, 0, catchupHeaderWindow(-1), "negative values should disable the header window")
+		assert.Equal(t, maxBlockHeadersPerRequest, catchupHeaderWindow(100), "window should be at least a single request")
+		assert.Equal(t, 50_000, catchupHeaderWindow(50_000))
+	})
+
+	t.Run("WindowReached", func(t *testing.T) {
+		catchupCtx := &CatchupContext{}
+		assert.False(t, catchupCtx.windowReached())
+
+		catchupCtx.headersFetchResult = &catchup.Result{WindowReached: true}
+		assert.True(t, catchupCtx.windowReached())
+
+		catchupCtx.resetWindow()
+		assert.False(t, catchupCtx.windowReached())
+	})
+
+	t.Run("WindowContinuity", func(t *testing.T) {
+		server := &Server{}
+		headers := testhelpers.CreateTestHeaders(t, 4)
+
+		blockUpTo := &model.Block{Header: headers[3]}
+
+		// the first window is not checked against a previous window
+		err := server.verifyWindowContinuity(&CatchupContext{
+			blockUpTo:          blockUpTo,
+			commonAncestorHash: headers[0].Hash(),
+			blockHeaders:       headers[1:],
+		})
+		require.NoError(t, err)
+
+		// the window continues from the last block of the previous window
+		err = server.verifyWindowContinuity(&CatchupContext{
+			blockUpTo:          blockUpTo,
+			commonAncestorHash: headers[1].Hash(),
+			blockHeaders:       headers[2:],
+			windowTip:          headers[1].Hash(),
+		})
+		require.NoError(t, err)
+
+		// the common ancestor is not the last block of the previous window
+		err = server.verifyWindowContinuity(&CatchupContext{
+			blockUpTo:          blockUpTo,
+			commonAncestorHash: headers[2].Hash(),
+			blockHeaders:       headers[3:],
+			windowTip:          headers[1].Hash(),
+		})
+		require.Error(t, err)
+
+		// the first block does not build on the last block of the previous window
+		err = server.verifyWindowContinuity(&CatchupContext{
+			blockUpTo:          blockUpTo,
+			commonAncestorHash: headers[1].Hash(),
+			blockHeaders:       headers[3:],
+			windowTip:          headers[1].Hash(),
+		})
+		require.Error(t, err)
+	})
+}
+
 func TestCatchup_PreventsConcurrentOperations(t *testing.T) {
 	server, _, _, cleanup := setupTestCatchupServer(t)
 	defer cleanup()
@@ -3567,3 +3822,30 @@ func TestSuboptimalCommonAncestorCausesHeightCalculationIssue(t *testing.T) {
 		t.Logf("Checkpoint validation passed - the fix works!")
 	}
 }
+
+func TestIsBuriedBelowTrustedDepth(t *testing.T) {
+	tests := []struct {
+		name            string
+		trustedDepth    uint32
+		blockHeight     uint32
+		blockUpToHeight uint32
+		expected        bool
+	}{
+		{name: "disabled by default", trustedDepth: 0, blockHeight: 10, blockUpToHeight: 1000, expected: false},
+		{name: "buried deeper than trusted depth", trustedDepth: 100, blockHeight: 10, blockUpToHeight: 1000, expected: true},
+		{name: "exactly at trusted depth", trustedDepth: 100, blockHeight: 900, blockUpToHeight: 1000, expected: true},
+		{name: "within trusted depth", trustedDepth: 100, blockHeight: 901, blockUpToHeight: 1000, expected: false},
+		{name: "target below trusted depth", trustedDepth: 100, blockHeight: 0, blockUpToHeight: 50, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tSettings := test.CreateBaseTestSettings(t)
+			tSettings.BlockValidation.CatchupTrustedDepth = tt.trustedDepth
+
+			server := &Server{settings: tSettings}
+
+			assert.Equal(t, tt.expected, server.isBuriedBelowTrustedDepth(&model.Block{Height: tt.blockHeight}, &model.Block{Height: tt.blockUpToHeight}))
+		})
+	}
+}
@@ -107,7 +107,7 @@ func (u *Server) fetchBlocksConcurrently(ctx context.Context, catchupCtx *Catchu
 	// Start batch fetching and work distribution
 	g.Go(func() error {
 		defer close(workQueue)
-		return u.batchFetchAndDistribute(gCtx, blockHeaders, workQueue, baseURL, blockUpTo, largeBatchSize)
+		return u.batchFetchAndDistribute(gCtx, blockHeaders, workQueue, baseURL, catchupCtx.peerID, blockUpTo, largeBatchSize)
 	})
 
 	// Wait for all goroutines to complete
@@ -119,8 +119,9 @@ func (u *Server) fetchBlocksConcurrently(ctx context.Context, catchupCtx *Catchu
 	return g.Wait()
 }
 
-// batchFetchAndDistribute fetches blocks in large batches and immediately distributes them to workers
-func (u *Server) batchFetchAndDistribute(ctx context.Context, blockHeaders []*model.BlockHeader, workQueue chan<- workItem, baseURL string, blockUpTo *model.Block, batchSize int) error {
+// batchFetchAndDistribute fetches blocks in large batches and immediately distributes them to workers.
+// A batch for which the catchup peer returns an invalid response is fetched from the other known peers.
+func (u *Server) batchFetchAndDistribute(ctx context.Context, blockHeaders []*model.BlockHeader, workQueue chan<- workItem, baseURL string, peerID string, blockUpTo *model.Block, batchSize int) error {
 	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "batchFetchAndDistribute",
 		tracing.WithParentStat(u.stats),
 	)
@@ -139,28 +140,11 @@ func (u *Server) batchFetchAndDistribute(ctx context.Context, blockHeaders []*mo
 		u.logger.Debugf("[catchup:batchFetchAndDistribute][%s] fetching batch %d-%d (%d blocks)",
 			blockUpTo.Hash().String(), i, end-1, len(batchHeaders))
 
-		// Fetch entire batch in one HTTP request, from last block, since the data is returned newest-first
-		blocks, err := u.fetchBlocksBatch(ctx, batchHeaders[len(batchHeaders)-1].Hash(), uint32(len(batchHeaders)), baseURL)
+		blocks, err := u.fetchBlocksBatchFromPeers(ctx, batchHeaders, baseURL, peerID, blockUpTo)
 		if err != nil {
 			return errors.NewProcessingError("[catchup:batchFetchAndDistribute][%s] failed to fetch batch starting at %s", blockUpTo.Hash().String(), batchHeaders[0].Hash().String(), err)
 		}
 
-		if len(blocks) != len(batchHeaders) {
-			return errors.NewProcessingError("[catchup:batchFetchAndDistribute][%s] expected %d blocks, got %d", blockUpTo.Hash().String(), len(batchHeaders), len(blocks))
-		}
-
-		// reverse the blocks to match the order of headers
-		for j, k := 0, len(blocks)-1; j < k; j, k = j+1, k-1 {
-			blocks[j], blocks[k] = blocks[k], blocks[j]
-		}
-
-		// Verify each fetched block matches the expected header
-		for j, block := range blocks {
-			if block.Hash().String() != batchHeaders[j].Hash().String() {
-				return errors.NewProcessingError("[catchup:batchFetchAndDistribute][%s] block hash mismatch at index %d: expected %s, got %s", blockUpTo.Hash().String(), j, batchHeaders[j].Hash().String(), block.Hash().String())
-			}
-		}
-
 		// Immediately distribute blocks to workers
 		for _, block := range blocks {
 			select {
@@ -179,6 +163,84 @@ func (u *Server) batchFetchAndDistribute(ctx context.Context, blockHeaders []*mo
 	return nil
 }
 
+// fetchBlocksBatchFromPeers fetches the blocks of the given headers from the catchup peer. When the catchup peer
+// returns an invalid response, the failure is recorded against the peer and the batch is fetched from the other
+// known peers in turn, until one of them returns the blocks of the headers.
+//
+// Returns:
+//   - []*model.Block: The blocks of the headers, in the order of the headers
+//   - error: The error of the catchup peer when no peer returned the blocks, or any other fetch error
+func (u *Server) fetchBlocksBatchFromPeers(ctx context.Context, batchHeaders []*model.BlockHeader, baseURL string, peerID string, blockUpTo *model.Block) ([]*model.Block, error) {
+	blocks, err := u.fetchAndVerifyBlocksBatch(ctx, batchHeaders, baseURL, blockUpTo)
+	if err == nil || !errors.Is(err, errors.ErrNetworkInvalidResponse) {
+		return blocks, err
+	}
+
+	u.recordInvalidBlocksBatch(peerID, baseURL, blockUpTo, err)
+
+	for _, peer := range u.knownPeers.Select(maxKnownPeers, peerID, u.skipHeaderPeer) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		peerBlocks, peerErr := u.fetchAndVerifyBlocksBatch(ctx, batchHeaders, peer.BaseURL, blockUpTo)
+		if peerErr == nil {
+			u.logger.Infof("[catchup:fetchBlocksBatchFromPeers][%s] fetched batch starting at %s from peer %s (%s)", blockUpTo.Hash().String(), batchHeaders[0].Hash().String(), peer.PeerID, peer.BaseURL)
+
+			return peerBlocks, nil
+		}
+
+		if errors.Is(peerErr, errors.ErrNetworkInvalidResponse) {
+			u.recordInvalidBlocksBatch(peer.PeerID, peer.BaseURL, blockUpTo, peerErr)
+		} else {
+			u.logger.Warnf("[catchup:fetchBlocksBatchFromPeers][%s] failed to fetch batch from peer %s (%s): %v", blockUpTo.Hash().String(), peer.PeerID, peer.BaseURL, peerErr)
+		}
+	}
+
+	return nil, err
+}
+
+// recordInvalidBlocksBatch records a failure against the peer that returned an invalid blocks batch.
+func (u *Server) recordInvalidBlocksBatch(peerID string, baseURL string, blockUpTo *model.Block, err error) {
+	u.logger.Warnf("[catchup:fetchBlocksBatchFromPeers][%s] peer %s (%s) returned an invalid blocks batch: %v", blockUpTo.Hash().String(), peerID, baseURL, err)
+
+	if u.peerMetrics != nil && peerID != "" {
+		if peerMetric := u.peerMetrics.GetOrCreatePeerMetrics(peerID); peerMetric != nil {
+			peerMetric.RecordFailure()
+		}
+	}
+}
+
+// fetchAndVerifyBlocksBatch fetches the blocks of the given headers from a peer in one request and verifies that the
+// peer returned exactly the blocks of the headers. An incomplete or mismatching response returns a
+// NetworkInvalidResponseError.
+func (u *Server) fetchAndVerifyBlocksBatch(ctx context.Context, batchHeaders []*model.BlockHeader, baseURL string, blockUpTo *model.Block) ([]*model.Block, error) {
+	// Fetch entire batch in one HTTP request, from last block, since the data is returned newest-first
+	blocks, err := u.fetchBlocksBatch(ctx, batchHeaders[len(batchHeaders)-1].Hash(), uint32(len(batchHeaders)), baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// a short batch would leave a gap in the blocks being validated
+	if len(blocks) != len(batchHeaders) {
+		return nil, errors.NewNetworkInvalidResponseError("[catchup:batchFetchAndDistribute][%s] expected %d blocks, got %d", blockUpTo.Hash().String(), len(batchHeaders), len(blocks))
+	}
+
+	// reverse the blocks to match the order of headers
+	for j, k := 0, len(blocks)-1; j < k; j, k = j+1, k-1 {
+		blocks[j], blocks[k] = blocks[k], blocks[j]
+	}
+
+	// Verify each fetched block matches the expected header
+	for j, block := range blocks {
+		if block.Hash().String() != batchHeaders[j].Hash().String() {
+			return nil, errors.NewNetworkInvalidResponseError("[catchup:batchFetchAndDistribute][%s] block hash mismatch at index %d: expected %s, got %s", blockUpTo.Hash().String(), j, batchHeaders[j].Hash().String(), block.Hash().String())
+		}
+	}
+
+	return blocks, nil
+}
+
 // blockWorker processes blocks and fetches their subtree data in parallel
 func (u *Server) blockWorker(ctx context.Context, workerID int, workQueue <-chan workItem, resultQueue chan<- resultItem, baseURL string, blockUpTo *model.Block) error {
 	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "blockWorker",
@@ -573,8 +635,12 @@ func (u *Server) fetchSubtreeDataFromPeer(ctx context.Context, subtreeHash *chai
 //   - n: Number of blocks to fetch
 //   - baseURL: Peer URL to fetch from
 //
+// The peer returns the blocks newest-first, each block must link to the block following it in the response.
+// A response may contain fewer than n blocks, but a response that ends in the middle of a block, or contains
+// blocks that do not link, returns a NetworkInvalidResponseError.
+//
 // Returns:
-//   - []*model.Block: Fetched blocks
+//   - []*model.Block: Fetched blocks, newest-first
 //   - error: If request fails or blocks are invalid
 func (u *Server) fetchBlocksBatch(ctx context.Context, hash *chainhash.Hash, n uint32, baseURL string) ([]*model.Block, error) {
 	ctx, _, deferFn := tracing.Tracer("blockvalidation").Start(ctx, "fetchBlocksBatch",
@@ -591,14 +657,20 @@ func (u *Server) fetchBlocksBatch(ctx context.Context, hash *chainhash.Hash, n u
 
 	blocks := make([]*model.Block, 0)
 
-	for {
+	// the response ends cleanly when all bytes have been consumed, running out of bytes while reading a block means
+	// the response was truncated
+	for blockReader.Len() > 0 {
 		block, err := model.NewBlockFromReader(blockReader)
 		if err != nil {
 			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
-				break
+				return nil, errors.NewNetworkInvalidResponseError("[catchup:fetchBlocksBatch][%s] truncated response after %d blocks", hash.String(), len(blocks), err)
 			}
 
-			return nil, errors.NewProcessingError("[catchup:fetchBlocksBatch][%s] failed to create block from bytes", hash.String(), err)
+			return nil, errors.NewNetworkInvalidResponseError("[catchup:fetchBlocksBatch][%s] failed to create block from bytes", hash.String(), err)
+		}
+
+		if n := len(blocks); n > 0 && !blocks[n-1].Header.HashPrevBlock.IsEqual(block.Hash()) {
+			return nil, errors.NewNetworkInvalidResponseError("[catchup:fetchBlocksBatch][%s] block %s at index %d does not link to its parent %s at index %d", hash.String(), blocks[n-1].Hash().String(), n-1, block.Hash().String(), n)
 		}
 
 		blocks = append(blocks, block)
@@ -15,6 +15,7 @@ import (
 	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/services/blockvalidation/catchup"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation/testhelpers"
 	"github.com/bitcoin-sv/teranode/stores/blob"
 	"github.com/bitcoin-sv/teranode/stores/blob/memory"
@@ -722,7 +723,7 @@ func TestFetchBlocksBatch_CurrentBehavior(t *testing.T) {
 
 		// Create test blocks
 		blocks := testhelpers.CreateTestBlockChain(t, 4)
-		targetHash := blocks[1].Header.Hash()
+		targetHash := blocks[3].Header.Hash()
 
 		// Set up HTTP mock to return multiple blocks
 		httpmock.Activate()
@@ -732,9 +733,9 @@ func TestFetchBlocksBatch_CurrentBehavior(t *testing.T) {
 			"GET",
 			fmt.Sprintf("http://test-peer/blocks/%s?n=3", targetHash.String()),
 			httpmock.NewBytesResponder(200, func() []byte {
-				// Concatenate multiple block bytes
+				// Concatenate multiple block bytes, newest-first
 				var allBytes []byte
-				for i := 1; i <= 3; i++ {
+				for i := 3; i >= 1; i-- {
 					blockBytes, _ := blocks[i].Bytes()
 					allBytes = append(allBytes, blockBytes...)
 				}
@@ -747,9 +748,9 @@ func TestFetchBlocksBatch_CurrentBehavior(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, fetchedBlocks, 3)
 
-		// Verify blocks are returned in order
+		// Verify blocks are returned in the order of the response
 		for i, block := range fetchedBlocks {
-			assert.Equal(t, blocks[i+1].Header.Hash(), block.Header.Hash())
+			assert.Equal(t, blocks[3-i].Header.Hash(), block.Header.Hash())
 		}
 	})
 
@@ -778,6 +779,95 @@ func TestFetchBlocksBatch_CurrentBehavior(t *testing.T) {
 	})
 }
 
+// TestFetchBlocksBatch_PartialResponses tests that short responses are accepted and truncated or unlinked responses are rejected
+func TestFetchBlocksBatch_PartialResponses(t *testing.T) {
+	blocks := testhelpers.CreateTestBlockChain(t, 4)
+	targetHash := blocks[3].Header.Hash()
+
+	// newestFirst concatenates the bytes of the given blocks in the given order
+	newestFirst := func(indexes ...int) []byte {
+		var allBytes []byte
+
+		for _, i := range indexes {
+			blockBytes, err := blocks[i].Bytes()
+			require.NoError(t, err)
+
+			allBytes = append(allBytes, blockBytes...)
+		}
+
+		return allBytes
+	}
+
+	fetch := func(t *testing.T, response []byte) ([]*model.Block, error) {
+		suite := NewCatchupTestSuite(t)
+		defer suite.Cleanup()
+
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+
+		httpmock.RegisterResponder(
+			"GET",
+			fmt.Sprintf("http://test-peer/blocks/%s?n=3", targetHash.String()),
+			httpmock.NewBytesResponder(200, response),
+		)
+
+		return suite.Server.fetchBlocksBatch(suite.Ctx, targetHash, 3, "http://test-peer")
+	}
+
+	t.Run("Short Response", func(t *testing.T) {
+		fetchedBlocks, err := fetch(t, newestFirst(3, 2))
+		require.NoError(t, err)
+		require.Len(t, fetchedBlocks, 2)
+		assert.Equal(t, blocks[3].Header.Hash(), fetchedBlocks[0].Header.Hash())
+		assert.Equal(t, blocks[2].Header.Hash(), fetchedBlocks[1].Header.Hash())
+	})
+
+	t.Run("Empty Response", func(t *testing.T) {
+		fetchedBlocks, err := fetch(t, []byte{})
+		require.NoError(t, err)
+		assert.Empty(t, fetchedBlocks)
+	})
+
+	t.Run("Truncated In Block Header", func(t *testing.T) {
+		response := newestFirst(3, 2)
+		block3Bytes, err := blocks[3].Bytes()
+		require.NoError(t, err)
+
+		// cut the response in the middle of the header of the second block
+		fetchedBlocks, err := fetch(t, response[:len(block3Bytes)+40])
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Contains(t, err.Error(), "truncated response after 1 blocks")
+		assert.Nil(t, fetchedBlocks)
+	})
+
+	t.Run("Truncated In Block Body", func(t *testing.T) {
+		response := newestFirst(3, 2, 1)
+
+		// cut the last byte of the oldest block
+		fetchedBlocks, err := fetch(t, response[:len(response)-1])
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Nil(t, fetchedBlocks)
+	})
+
+	t.Run("Gap In Response", func(t *testing.T) {
+		// block 2 is missing, block 3 does not link to block 1
+		fetchedBlocks, err := fetch(t, newestFirst(3, 1))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Contains(t, err.Error(), "does not link to its parent")
+		assert.Nil(t, fetchedBlocks)
+	})
+
+	t.Run("Oldest First Response", func(t *testing.T) {
+		fetchedBlocks, err := fetch(t, newestFirst(1, 2, 3))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Nil(t, fetchedBlocks)
+	})
+}
+
 // TestFetchSingleBlock_CurrentBehavior documents the current behavior of fetchSingleBlock function
 func TestFetchSingleBlock_CurrentBehavior(t *testing.T) {
 	t.Run("Successful Fetch", func(t *testing.T) {
@@ -2647,7 +2737,7 @@ func TestFetchBlocksConcurrently_ErrorHandling(t *testing.T) {
 			fmt.Sprintf("http://test-peer/blocks/%s?n=2", blocks[2].Header.Hash().String()),
 			func(req *http.Request) (*http.Response, error) {
 				// Return block2 twice (wrong) instead of block2 and block1
-				// This will cause a hash mismatch when checking against headers
+				// This will cause a linkage failure, since block2 is not its own parent
 				block2Bytes, _ := blocks[2].Bytes()
 				block2Bytes2, _ := blocks[2].Bytes()
 
@@ -2672,9 +2762,10 @@ func TestFetchBlocksConcurrently_ErrorHandling(t *testing.T) {
 		// Call fetchBlocksConcurrently
 		err := suite.Server.fetchBlocksConcurrently(context.Background(), catchupCtx, validateBlocksChan, &size)
 
-		// Should fail with hash mismatch error
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "block hash mismatch")
+		// Should fail with a linkage error
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+		assert.Contains(t, err.Error(), "does not link to its parent")
 	})
 
 	t.Run("EOF Handling with errors.Is", func(t *testing.T) {
@@ -2777,6 +2868,92 @@ func TestOrderedDelivery_StrictOrdering(t *testing.T) {
 	}
 }
 
+// TestFetchBlocksConcurrently_InvalidBatchFromOtherPeer tests that a batch for which the catchup peer returns an
+// invalid response is fetched from another known peer, and that the failure is recorded against the catchup peer
+func TestFetchBlocksConcurrently_InvalidBatchFromOtherPeer(t *testing.T) {
+	blocks := testhelpers.CreateTestBlockChain(t, 4)
+	headers := []*model.BlockHeader{blocks[1].Header, blocks[2].Header, blocks[3].Header}
+
+	newestFirst := func(indexes ...int) []byte {
+		var buffer bytes.Buffer
+
+		for _, i := range indexes {
+			blockBytes, err := blocks[i].Bytes()
+			require.NoError(t, err)
+
+			buffer.Write(blockBytes)
+		}
+
+		return buffer.Bytes()
+	}
+
+	setup := func(t *testing.T, otherPeerResponse []byte) (*CatchupTestSuite, *CatchupContext) {
+		suite := NewCatchupTestSuite(t)
+		t.Cleanup(suite.Cleanup)
+
+		suite.Server.knownPeers = catchup.NewKnownPeers(maxKnownPeers)
+		suite.Server.knownPeers.Record("bad-peer", "http://bad-peer")
+		suite.Server.knownPeers.Record("other-peer", "http://other-peer")
+
+		httpmock.Activate()
+		t.Cleanup(httpmock.DeactivateAndReset)
+
+		// the catchup peer returns a short batch, missing the oldest block
+		httpmock.RegisterResponder("GET", `=~^http://bad-peer/blocks/.*\?n=3$`, httpmock.NewBytesResponder(200, newestFirst(3, 2)))
+		httpmock.RegisterResponder("GET", `=~^http://other-peer/blocks/.*\?n=3$`, httpmock.NewBytesResponder(200, otherPeerResponse))
+
+		httpmock.RegisterResponder("GET", `=~^http://bad-peer/subtree/.*$`, httpmock.NewStringResponder(200, ""))
+		httpmock.RegisterResponder("GET", `=~^http://bad-peer/subtree_data/.*$`, httpmock.NewStringResponder(200, ""))
+
+		return suite, &CatchupContext{
+			blockUpTo:    blocks[3],
+			baseURL:      "http://bad-peer",
+			peerID:       "bad-peer",
+			blockHeaders: headers,
+		}
+	}
+
+	t.Run("batch fetched from other peer", func(t *testing.T) {
+		suite, catchupCtx := setup(t, newestFirst(3, 2, 1))
+
+		var size atomic.Int64
+		size.Store(int64(len(headers)))
+		validateBlocksChan := make(chan *model.Block, 10)
+
+		err := suite.Server.fetchBlocksConcurrently(suite.Ctx, catchupCtx, validateBlocksChan, &size)
+		require.NoError(t, err)
+
+		var deliveredBlocks []*model.Block
+		for block := range validateBlocksChan {
+			deliveredBlocks = append(deliveredBlocks, block)
+		}
+
+		require.Len(t, deliveredBlocks, 3)
+		for i, block := range deliveredBlocks {
+			assert.Equal(t, blocks[i+1].Header.Hash(), block.Header.Hash())
+		}
+
+		peerMetric, exists := suite.Server.peerMetrics.GetPeerMetrics("bad-peer")
+		require.True(t, exists)
+		assert.Equal(t, int64(1), peerMetric.FailedRequests)
+	})
+
+	t.Run("no peer returns the batch", func(t *testing.T) {
+		suite, catchupCtx := setup(t, newestFirst(3, 1))
+
+		var size atomic.Int64
+		size.Store(int64(len(headers)))
+		validateBlocksChan := make(chan *model.Block, 10)
+
+		err := suite.Server.fetchBlocksConcurrently(suite.Ctx, catchupCtx, validateBlocksChan, &size)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrNetworkInvalidResponse))
+
+		_, exists := suite.Server.peerMetrics.GetPeerMetrics("other-peer")
+		assert.True(t, exists)
+	})
+}
+
 // TestFetchSingleBlock_ImprovedErrorHandling tests improved error handling in fetchSingleBlock
 func TestFetchSingleBlock_ImprovedErrorHandling(t *testing.T) {
 	logger := ulogger.TestLogger{}
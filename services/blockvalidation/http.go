@@ -0,0 +1,123 @@
+package blockvalidation
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/util"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/labstack/echo/v4"
+)
+
+// startHTTPServer starts the debug HTTP server of the block validation service.
+//
+// Endpoints:
+//   - GET /block/:hash/validate: Re-runs the checks of Block.Valid for a stored block and returns a JSON report
+//   - GET /bloomstats: Returns the bloom filter stats of the last validated blocks as JSON, newest first
+//   - GET /health: Returns "OK"
+//
+// Parameters:
+//   - ctx: Context for server lifecycle management, cancellation triggers shutdown
+//   - httpAddresses: Address to listen on
+func (u *Server) startHTTPServer(ctx context.Context, httpAddresses string) {
+	u.httpServer = echo.New()
+	u.httpServer.Debug = false
+	u.httpServer.HideBanner = true
+
+	u.httpServer.Server.ReadTimeout = 5 * time.Second
+	u.httpServer.Server.IdleTimeout = 120 * time.Second
+
+	// leave time to write the report of a validation that ran up to the timeout
+	if timeout := u.settings.BlockValidation.HTTPValidateBlockTimeout; timeout > 0 {
+		u.httpServer.Server.WriteTimeout = timeout + 10*time.Second
+	}
+
+	u.httpServer.GET("/block/:hash/validate", u.handleValidateBlock)
+	u.httpServer.GET("/bloomstats", u.handleBloomStats)
+
+	// add a health endpoint that simply returns "OK"
+	u.httpServer.GET("/health", func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+
+	// add a 404 handler with a message for unknown routes
+	u.httpServer.Any("/*", func(c echo.Context) error {
+		return c.String(http.StatusNotFound, "Unknown route")
+	})
+
+	listener, address, _, err := util.GetListener(u.settings.Context, "blockvalidation", "http://", httpAddresses)
+	if err != nil {
+		u.logger.Errorf("[BlockValidation] failed to get http listener: %v", err)
+		return
+	}
+
+	u.logger.Infof("[BlockValidation] HTTP server listening on %s", address)
+	u.httpServer.Listener = listener
+
+	go func() {
+		if err := u.httpServer.Server.Serve(listener); err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				u.logger.Infof("[BlockValidation] http server shutdown")
+			} else {
+				u.logger.Errorf("[BlockValidation] failed to start http server: %v", err)
+			}
+		}
+
+		util.RemoveListener(u.settings.Context, "blockvalidation", "http://")
+	}()
+
+	go func() {
+		<-ctx.Done()
+
+		_ = u.httpServer.Shutdown(context.Background())
+	}()
+}
+
+// handleValidateBlock re-runs the checks of Block.Valid for the block with the given hash against the current
+// stores and returns the report as JSON. The block is not marked as valid or invalid. The validation is aborted
+// after blockvalidation_http_validate_block_timeout.
+func (u *Server) handleValidateBlock(c echo.Context) error {
+	blockHash, err := chainhash.NewHashFromStr(c.Param("hash"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid block hash: "+err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	if timeout := u.settings.BlockValidation.HTTPValidateBlockTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	report, err := u.blockValidation.validateBlockReport(ctx, blockHash)
+	if err != nil {
+		if errors.Is(err, errors.ErrBlockNotFound) || errors.Is(err, errors.ErrNotFound) {
+			return c.String(http.StatusNotFound, err.Error())
+		}
+
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// handleBloomStats returns the bloom filter stats of the last validated blocks as JSON, newest first. The optional
+// n query parameter limits the number of blocks returned, the history holds blockvalidation_bloom_stats_history blocks.
+func (u *Server) handleBloomStats(c echo.Context) error {
+	limit := 0
+
+	if n := c.QueryParam("n"); n != "" {
+		var err error
+
+		if limit, err = strconv.Atoi(n); err != nil || limit <= 0 {
+			return c.String(http.StatusBadRequest, "invalid n, expected a positive number: "+n)
+		}
+	}
+
+	return c.JSON(http.StatusOK, u.blockValidation.bloomStatsHistory.list(limit))
+}
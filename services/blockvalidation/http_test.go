@@ -0,0 +1,171 @@
+package blockvalidation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/services/blockchain"
+	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/labstack/echo/v4"
+	"github.com/ordishs/gocore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleValidateBlock(t *testing.T) {
+	hashStr := "8c14f0db3df150123e6f3dbbf30f8b955a8249b62ac1d1ff16284aefa3d06d87"
+
+	newServer := func(mockBlockchainClient *blockchain.Mock) *Server {
+		tSettings := &settings.Settings{}
+
+		return &Server{
+			logger:   ulogger.TestLogger{},
+			settings: tSettings,
+			blockValidation: &BlockValidation{
+				logger:           ulogger.TestLogger{},
+				settings:         tSettings,
+				blockchainClient: mockBlockchainClient,
+				stats:            gocore.NewStat("test"),
+			},
+		}
+	}
+
+	request := func(t *testing.T, server *Server, hash string) *httptest.ResponseRecorder {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/block/"+hash+"/validate", nil), rec)
+		c.SetParamNames("hash")
+		c.SetParamValues(hash)
+
+		require.NoError(t, server.handleValidateBlock(c))
+
+		return rec
+	}
+
+	t.Run("invalid hash", func(t *testing.T) {
+		rec := request(t, newServer(&blockchain.Mock{}), "xyz")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		mockBlockchainClient := &blockchain.Mock{}
+		mockBlockchainClient.On("GetBlock", mock.Anything, mock.Anything).Return(nil, errors.NewBlockNotFoundError("block not found"))
+
+		rec := request(t, newServer(mockBlockchainClient), hashStr)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("failed to get block", func(t *testing.T) {
+		mockBlockchainClient := &blockchain.Mock{}
+		mockBlockchainClient.On("GetBlock", mock.Anything, mock.Anything).Return(nil, errors.NewServiceError("blockchain unavailable"))
+
+		rec := request(t, newServer(mockBlockchainClient), hashStr)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestHandleBloomStats(t *testing.T) {
+	newServer := func(history *bloomStatsHistory) *Server {
+		return &Server{
+			logger:   ulogger.TestLogger{},
+			settings: &settings.Settings{},
+			blockValidation: &BlockValidation{
+				logger:            ulogger.TestLogger{},
+				bloomStatsHistory: history,
+			},
+		}
+	}
+
+	request := func(t *testing.T, server *Server, query string) *httptest.ResponseRecorder {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/bloomstats"+query, nil), rec)
+
+		require.NoError(t, server.handleBloomStats(c))
+
+		return rec
+	}
+
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) []BlockBloomStats {
+		var stats []BlockBloomStats
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+
+		return stats
+	}
+
+	history := newBloomStatsHistory(3)
+	for height := uint32(1); height <= 4; height++ {
+		history.add(BlockBloomStats{Height: height, QueryCount: 100, FalsePositives: uint64(height)})
+	}
+
+	t.Run("returns the history newest first", func(t *testing.T) {
+		rec := request(t, newServer(history), "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		stats := decode(t, rec)
+		require.Len(t, stats, 3)
+		assert.Equal(t, []uint32{4, 3, 2}, []uint32{stats[0].Height, stats[1].Height, stats[2].Height})
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		rec := request(t, newServer(history), "?n=1")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		stats := decode(t, rec)
+		require.Len(t, stats, 1)
+		assert.Equal(t, uint32(4), stats[0].Height)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		rec := request(t, newServer(history), "?n=abc")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("history disabled", func(t *testing.T) {
+		rec := request(t, newServer(newBloomStatsHistory(0)), "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, decode(t, rec))
+	})
+}
+
+func TestRecordBloomStats(t *testing.T) {
+	u := &BlockValidation{bloomStatsHistory: newBloomStatsHistory(10)}
+
+	block := &model.Block{
+		Header: &model.BlockHeader{HashPrevBlock: &chainhash.Hash{}, HashMerkleRoot: &chainhash.Hash{}},
+		Height: 42,
+	}
+
+	blockStats := model.NewBloomStats()
+	blockStats.QueryCounter = 1000
+	blockStats.PositiveCounter = 5
+	blockStats.FalsePositiveCounter = 4
+
+	cumulative := model.NewBloomStats()
+	cumulative.QueryCounter = 10
+
+	u.recordBloomStats(block, blockStats, cumulative, true)
+
+	queries, positives, falsePositives := cumulative.Counters()
+	assert.Equal(t, uint64(1010), queries)
+	assert.Equal(t, uint64(5), positives)
+	assert.Equal(t, uint64(4), falsePositives)
+
+	stats := u.bloomStatsHistory.list(0)
+	require.Len(t, stats, 1)
+	assert.Equal(t, block.Hash().String(), stats[0].Hash)
+	assert.Equal(t, uint32(42), stats[0].Height)
+	assert.True(t, stats[0].Valid)
+	assert.Equal(t, uint64(1000), stats[0].QueryCount)
+	assert.InDelta(t, 0.004, stats[0].FalsePositiveRate, 1e-9)
+}
@@ -29,6 +29,7 @@ var (
 	prometheusBlockValidationCatchupCh         prometheus.Gauge
 	prometheusBlockValidationCatchup           prometheus.Histogram
 	prometheusBlockValidationProcessBlockFound prometheus.Histogram
+	prometheusBlockValidationSubtreeFound      *prometheus.CounterVec
 
 	// block validation
 	prometheusBlockValidationValidateBlock      prometheus.Histogram
@@ -39,6 +40,8 @@ var (
 	prometheusBlockValidationLastValidatedBlocksCache prometheus.Gauge
 	prometheusBlockValidationBlockExistsCache         prometheus.Gauge
 	prometheusBlockValidationSubtreeExistsCache       prometheus.Gauge
+	prometheusBlockValidationRecentBloomFilters       prometheus.Gauge
+	prometheusBlockValidationRecentBloomFiltersBytes  prometheus.Gauge
 
 	// catchup operation metrics
 	prometheusCatchupDuration       *prometheus.HistogramVec
@@ -46,6 +49,11 @@ var (
 	prometheusCatchupHeadersFetched *prometheus.CounterVec
 	prometheusCatchupErrors         *prometheus.CounterVec
 	prometheusCatchupActive         prometheus.Gauge
+
+	// catchup throughput metrics
+	prometheusCatchupBlocksValidated prometheus.Counter
+	prometheusCatchupBlocksPerSecond prometheus.Gauge
+	prometheusCatchupBacklog         prometheus.Gauge
 )
 
 var (
@@ -128,6 +136,16 @@ func _initPrometheusMetrics() {
 		},
 	)
 
+	prometheusBlockValidationSubtreeFound = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "subtree_found_total",
+			Help:      "Number of subtree found announcements, by result (queued, duplicate or dropped)",
+		},
+		[]string{"result"},
+	)
+
 	prometheusBlockValidationValidateBlock = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "teranode",
@@ -185,6 +203,24 @@ func _initPrometheusMetrics() {
 		},
 	)
 
+	prometheusBlockValidationRecentBloomFilters = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "recent_bloom_filters",
+			Help:      "Number of bloom filters retained for recent blocks",
+		},
+	)
+
+	prometheusBlockValidationRecentBloomFiltersBytes = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "recent_bloom_filters_bytes",
+			Help:      "Approximate memory used by the bloom filters retained for recent blocks",
+		},
+	)
+
 	// Initialize catchup operation metrics
 	prometheusCatchupDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -235,4 +271,31 @@ func _initPrometheusMetrics() {
 			Help:      "Number of active catchup operations (0 or 1)",
 		},
 	)
+
+	prometheusCatchupBlocksValidated = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "catchup_blocks_validated_total",
+			Help:      "Total number of blocks validated during catchup",
+		},
+	)
+
+	prometheusCatchupBlocksPerSecond = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "catchup_blocks_per_second",
+			Help:      "Exponentially weighted moving average of the blocks validated per second by the catchup in progress",
+		},
+	)
+
+	prometheusCatchupBacklog = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "teranode",
+			Subsystem: "blockvalidation",
+			Name:      "catchup_backlog_blocks",
+			Help:      "Number of blocks between the last validated block and the target block of the catchup in progress",
+		},
+	)
 }
@@ -44,8 +44,42 @@ func (m *Mock) ProcessBlock(ctx context.Context, block *model.Block, blockHeight
 	return args.Error(0)
 }
 
+// ProcessBlockDryRun performs a mock dry run block processing.
+func (m *Mock) ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error {
+	args := m.Called(ctx, block, blockHeight)
+	return args.Error(0)
+}
+
 // ValidateBlock performs a mock block validation.
 func (m *Mock) ValidateBlock(ctx context.Context, block *model.Block) error {
 	args := m.Called(ctx, block)
 	return args.Error(0)
 }
+
+// GetCatchupStatus performs a mock catchup status retrieval.
+func (m *Mock) GetCatchupStatus(ctx context.Context) (*CatchupStatus, error) {
+	args := m.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*CatchupStatus), nil
+}
+
+// CancelCatchup performs a mock catchup cancellation.
+func (m *Mock) CancelCatchup(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetSubtreeMeta performs a mock subtree meta retrieval.
+func (m *Mock) GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error) {
+	args := m.Called(ctx, subtreeHash)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]byte), nil
+}
@@ -241,7 +241,8 @@ func (u *BlockValidation) getBlockTransactions(ctx context.Context, block *model
 		return nil, errors.NewProcessingError("[getBlockTransactions][%s] block has no subtrees", block.Hash().String())
 	}
 
-	block.SubtreeSlices = make([]*subtreepkg.Subtree, len(block.Subtrees))
+	// the subtrees are loaded into a new slice, which replaces the subtrees of the block when all are loaded
+	subtreeSlices := make([]*subtreepkg.Subtree, len(block.Subtrees))
 	txs := make([]txWrapper, 0, block.TransactionCount)
 	txsIndex := make(map[chainhash.Hash]int, block.TransactionCount)
 	txsPerSubtree := make([][]txWrapper, len(block.Subtrees))
@@ -359,7 +360,7 @@ func (u *BlockValidation) getBlockTransactions(ctx context.Context, block *model
 					}
 				}
 
-				block.SubtreeSlices[subtreeIdx] = fullSubtree
+				subtreeSlices[subtreeIdx] = fullSubtree
 
 				fullSubtreeBytes, err := fullSubtree.Serialize()
 				if err != nil {
@@ -387,7 +388,7 @@ func (u *BlockValidation) getBlockTransactions(ctx context.Context, block *model
 					return errors.NewProcessingError("[getBlockTransactions][%s] failed to deserialize full subtree %s", block.Hash().String(), subtreeHash.String(), err)
 				}
 
-				block.SubtreeSlices[subtreeIdx] = fullSubtree
+				subtreeSlices[subtreeIdx] = fullSubtree
 
 				// make sure the subtree is not marked for deletion
 				if err = u.subtreeStore.SetDAH(gCtx, subtreeHash[:], fileformat.FileTypeSubtree, 0); err != nil {
@@ -438,6 +439,8 @@ func (u *BlockValidation) getBlockTransactions(ctx context.Context, block *model
 		return nil, errors.NewProcessingError("[getBlockTransactions][%s] failed to retrieve transactions", block.Hash().String(), err)
 	}
 
+	block.SetSubtreeSlices(subtreeSlices)
+
 	// combine all the subtree txs into the main txs slice
 	for _, subtreeTxs := range txsPerSubtree {
 		txs = append(txs, subtreeTxs...)
@@ -483,11 +486,11 @@ func (u *BlockValidation) getBlockTransactions(ctx context.Context, block *model
 	}
 
 	// check that all the subtrees, except the last are the same size
-	for i := 0; i < len(block.SubtreeSlices)-1; i++ {
+	for i := 0; i < len(subtreeSlices)-1; i++ {
 		if i == 0 {
-			subtreeSize = block.SubtreeSlices[i].Length()
-		} else if block.SubtreeSlices[i].Length() != subtreeSize && i != len(block.SubtreeSlices)-1 {
-			return nil, errors.NewProcessingError("[getBlockTransactions][%s] subtree %d size %d does not match previous subtree size %d", block.Hash().String(), i, block.SubtreeSlices[i].Size(), subtreeSize)
+			subtreeSize = subtreeSlices[i].Length()
+		} else if subtreeSlices[i].Length() != subtreeSize && i != len(subtreeSlices)-1 {
+			return nil, errors.NewProcessingError("[getBlockTransactions][%s] subtree %d size %d does not match previous subtree size %d", block.Hash().String(), i, subtreeSlices[i].Size(), subtreeSize)
 		}
 	}
 
@@ -52,6 +52,16 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	// defaultOrphanTransactionsPageSize is the number of orphan transactions returned by GetOrphanTransactions
+	// when the request does not set a limit.
+	defaultOrphanTransactionsPageSize = 100
+
+	// maxOrphanTransactionsPageSize caps the number of orphan transactions returned by a single
+	// GetOrphanTransactions request, larger limits are reduced to it.
+	maxOrphanTransactionsPageSize = 1000
+)
+
 // Server represents the main legacy protocol server structure that implements the peer service interface.
 // It serves as the primary integration point between the legacy Bitcoin protocol and Teranode services.
 //
@@ -424,6 +434,133 @@ func (s *Server) GetPeers(ctx context.Context, _ *emptypb.Empty) (*peer_api.GetP
 	return resp, nil
 }
 
+// ListPeers returns the sync state of the peers known to the sync manager.
+//
+// This method is part of the peer_api.PeerServiceServer gRPC interface and complements
+// GetPeers with the sync manager view of the peers: whether a peer is a sync candidate
+// and whether it is the current sync peer.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - _: Empty message parameter (required by gRPC interface)
+//
+// Returns:
+//   - ListPeersResponse containing the sync state of all peers
+//   - Error if the server is not initialized or the sync manager is shutting down
+func (s *Server) ListPeers(ctx context.Context, _ *emptypb.Empty) (*peer_api.ListPeersResponse, error) {
+	if s.server == nil || s.server.syncManager == nil {
+		return nil, errors.WrapGRPC(errors.NewServiceNotStartedError("server is not initialized"))
+	}
+
+	peerInfos, err := s.server.syncManager.PeerInfos()
+	if err != nil {
+		return nil, errors.WrapGRPC(err)
+	}
+
+	resp := &peer_api.ListPeersResponse{
+		Peers: make([]*peer_api.PeerInfo, 0, len(peerInfos)),
+	}
+
+	for _, peerInfo := range peerInfos {
+		resp.Peers = append(resp.Peers, &peer_api.PeerInfo{
+			Addr:          peerInfo.Addr,
+			UserAgent:     peerInfo.UserAgent,
+			LastBlock:     peerInfo.LastBlock,
+			BytesReceived: peerInfo.BytesReceived,
+			SyncCandidate: peerInfo.SyncCandidate,
+			IsSyncPeer:    peerInfo.SyncPeer,
+		})
+	}
+
+	return resp, nil
+}
+
+// DisconnectPeer disconnects the peers with the given address without banning them.
+//
+// This method is part of the peer_api.PeerServiceServer gRPC interface and allows
+// operators to drop a misbehaving peer at runtime. The disconnect is performed by the
+// sync manager on its block handler goroutine. The peer may reconnect, use BanPeer
+// to keep it away.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - peer: Request containing the peer address (IP:Port)
+//
+// Returns:
+//   - DisconnectPeerResponse with Ok=true if the peer was disconnected
+//   - Error if the peer was not found or the server is not initialized
+func (s *Server) DisconnectPeer(ctx context.Context, peer *peer_api.DisconnectPeerRequest) (*peer_api.DisconnectPeerResponse, error) {
+	if s.server == nil || s.server.syncManager == nil {
+		return nil, errors.WrapGRPC(errors.NewServiceNotStartedError("server is not initialized"))
+	}
+
+	if err := s.server.syncManager.DisconnectPeer(peer.Addr); err != nil {
+		s.logger.Warnf("Attempted to disconnect legacy peer %s: %v", peer.Addr, err)
+		return &peer_api.DisconnectPeerResponse{Ok: false}, errors.WrapGRPC(err)
+	}
+
+	s.logger.Infof("Disconnected legacy peer %s", peer.Addr)
+
+	return &peer_api.DisconnectPeerResponse{Ok: true}, nil
+}
+
+// GetOrphanTransactions returns a page of the orphan transactions held by the sync manager.
+//
+// This method is part of the peer_api.PeerServiceServer gRPC interface and allows operators to
+// inspect the orphan pool, for instance to find out why transactions never confirm. The orphans are
+// ordered by the time they were added to the pool, and every orphan lists its parents and the parents
+// that are orphans themselves, so the graph of stuck orphans can be followed.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - req: Request containing the offset and the page size, capped at 1000 orphans
+//
+// Returns:
+//   - GetOrphanTransactionsResponse containing the orphans of the page and the total number of orphans
+//   - Error if the server is not initialized
+func (s *Server) GetOrphanTransactions(ctx context.Context, req *peer_api.GetOrphanTransactionsRequest) (*peer_api.GetOrphanTransactionsResponse, error) {
+	if s.server == nil || s.server.syncManager == nil {
+		return nil, errors.WrapGRPC(errors.NewServiceNotStartedError("server is not initialized"))
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultOrphanTransactionsPageSize
+	}
+
+	limit = min(limit, maxOrphanTransactionsPageSize)
+
+	orphanTxInfos, total := s.server.syncManager.OrphanTxInfos(int(req.Offset), limit)
+
+	resp := &peer_api.GetOrphanTransactionsResponse{
+		Orphans: make([]*peer_api.OrphanTransaction, 0, len(orphanTxInfos)),
+		Total:   uint32(total), // nolint:gosec
+	}
+
+	for _, orphanTxInfo := range orphanTxInfos {
+		resp.Orphans = append(resp.Orphans, &peer_api.OrphanTransaction{
+			Txid:          orphanTxInfo.TxHash.String(),
+			Size:          uint32(orphanTxInfo.Size), // nolint:gosec
+			AddedAt:       orphanTxInfo.AddedAt.Unix(),
+			Parents:       hashStrings(orphanTxInfo.Parents),
+			OrphanParents: hashStrings(orphanTxInfo.OrphanParents),
+		})
+	}
+
+	return resp, nil
+}
+
+// hashStrings returns the string representations of the hashes.
+func hashStrings(hashes []chainhash.Hash) []string {
+	strs := make([]string, 0, len(hashes))
+
+	for _, hash := range hashes {
+		strs = append(strs, hash.String())
+	}
+
+	return strs
+}
+
 // IsBanned checks if a specific IP address or subnet is currently banned.
 //
 // This method is part of the peer_api.PeerServiceServer gRPC interface and provides
@@ -663,8 +800,9 @@ func (s *Server) Start(ctx context.Context, readyCh chan<- struct{}) error {
 
 	// Define protected methods - use the full gRPC method path
 	protectedMethods := map[string]bool{
-		"/peer_api.PeerService/BanPeer":   true,
-		"/peer_api.PeerService/UnbanPeer": true,
+		"/peer_api.PeerService/BanPeer":        true,
+		"/peer_api.PeerService/UnbanPeer":      true,
+		"/peer_api.PeerService/DisconnectPeer": true,
 	}
 
 	// Create auth options
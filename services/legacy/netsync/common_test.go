@@ -172,6 +172,7 @@ type MockPeerNotifier struct {
 	updatePeerHeightsChan       chan *updatePeerHeightsCall
 	relayInventoryChan          chan *relayInventoryCall
 	transactionConfirmedChan    chan *transactionConfirmedCall
+	addBanScoreChan             chan *addBanScoreCall
 }
 
 type announceNewTransactionsCall struct {
@@ -193,6 +194,13 @@ type transactionConfirmedCall struct {
 	tx *bsvutil.Tx
 }
 
+type addBanScoreCall struct {
+	peer       *peer.Peer
+	persistent uint32
+	transient  uint32
+	reason     string
+}
+
 func (mock *MockPeerNotifier) AnnounceNewTransactions(newTxs []*TxHashAndFee) {
 	mock.announceNewTransactionsChan <- &announceNewTransactionsCall{
 		newTxs: newTxs,
@@ -218,6 +226,10 @@ func (mock *MockPeerNotifier) TransactionConfirmed(tx *bsvutil.Tx) {
 	mock.transactionConfirmedChan <- &transactionConfirmedCall{tx: tx}
 }
 
+func (mock *MockPeerNotifier) AddBanScore(peer *peer.Peer, persistent, transient uint32, reason string) {
+	mock.addBanScoreChan <- &addBanScoreCall{peer: peer, persistent: persistent, transient: transient, reason: reason}
+}
+
 // NewMockPeerNotifier creates a new MockPeerNotifier and initializes the
 // channels.
 func NewMockPeerNotifier() *MockPeerNotifier {
@@ -226,6 +238,7 @@ func NewMockPeerNotifier() *MockPeerNotifier {
 		updatePeerHeightsChan:       make(chan *updatePeerHeightsCall, 10),
 		relayInventoryChan:          make(chan *relayInventoryCall, 10),
 		transactionConfirmedChan:    make(chan *transactionConfirmedCall, 10),
+		addBanScoreChan:             make(chan *addBanScoreCall, 10),
 	}
 }
 
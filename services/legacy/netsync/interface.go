@@ -5,6 +5,8 @@
 package netsync
 
 import (
+	"time"
+
 	"github.com/bitcoin-sv/teranode/services/legacy/bsvutil"
 	"github.com/bitcoin-sv/teranode/services/legacy/peer"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
@@ -31,6 +33,10 @@ type PeerNotifier interface {
 	// TransactionConfirmed notifies peers that a transaction has been confirmed
 	// by inclusion in a block, typically used for cleanup and state updates.
 	TransactionConfirmed(tx *bsvutil.Tx)
+
+	// AddBanScore increases the ban score of the peer for misbehaving, the peer is
+	// banned and disconnected when the score exceeds the ban threshold.
+	AddBanScore(peer *peer.Peer, persistent, transient uint32, reason string)
 }
 
 // Config is a configuration struct used to initialize a new SyncManager.
@@ -54,4 +60,24 @@ type Config struct {
 	// MinSyncPeerNetworkSpeed defines the minimum network speed (in bytes per second)
 	// required for a peer to be considered suitable for blockchain synchronization.
 	MinSyncPeerNetworkSpeed uint64
+
+	// MaxLastBlockTime is the longest time we stay with a sync peer that has not sent
+	// a block while we are below the best height. 0 uses legacy_syncPeerMaxLastBlockTime,
+	// 3 minutes by default. It should be a multiple of the expected time to download and
+	// process a block: too low rotates away from a sync peer that is merely sending a large
+	// block, too high keeps a stalled sync peer for long on networks with sub-second blocks.
+	MaxLastBlockTime time.Duration
+
+	// MinInFlightBlocks is the number of requested blocks below which more blocks are
+	// requested in headers-first mode. 0 uses legacy_syncPeerMinInFlightBlocks, 10 by default.
+	// Higher values keep the sync peer busy when blocks are small and processed quickly,
+	// lower values limit the blocks in flight when blocks are large.
+	MinInFlightBlocks int
+
+	// SyncPeerCheckInterval is how often the sync peer is checked for stalls and slow
+	// network speeds, and the throughput scores of the peers are updated. 0 uses
+	// legacy_syncPeerCheckInterval, 30 seconds by default. Shorter intervals detect a
+	// stalled sync peer sooner, but measure the network speed over a shorter window,
+	// which makes peers with bursty transfers more likely to be flagged as slow.
+	SyncPeerCheckInterval time.Duration
 }
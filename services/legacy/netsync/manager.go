@@ -9,9 +9,10 @@ import (
 	"container/list"
 	"context"
 	"fmt"
-	"math/rand/v2"
 	"net"
+	"net/http"
 	"net/url"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,10 +26,12 @@ import (
 	"github.com/bitcoin-sv/teranode/services/legacy/blockchain"
 	"github.com/bitcoin-sv/teranode/services/legacy/bsvutil"
 	peerpkg "github.com/bitcoin-sv/teranode/services/legacy/peer"
+	"github.com/bitcoin-sv/teranode/services/propagation"
 	"github.com/bitcoin-sv/teranode/services/subtreevalidation"
 	"github.com/bitcoin-sv/teranode/services/validator"
 	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/stores/blob"
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
 	utxostore "github.com/bitcoin-sv/teranode/stores/utxo"
 	"github.com/bitcoin-sv/teranode/stores/utxo/fields"
 	"github.com/bitcoin-sv/teranode/stores/utxo/meta"
@@ -45,15 +48,11 @@ import (
 	txmap "github.com/bsv-blockchain/go-tx-map"
 	"github.com/bsv-blockchain/go-wire"
 	"github.com/ordishs/go-utils/expiringmap"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
-	// minInFlightBlocks is the minimum number of blocks that should be
-	// in the request queue for headers-first mode before requesting
-	// more.
-	minInFlightBlocks = 10
-
 	// maxNetworkViolations is the max number of network violations a
 	// sync peer can have before a new sync peer is found.
 	maxNetworkViolations = 3
@@ -70,18 +69,30 @@ const (
 	// hashes to store in memory.
 	maxRequestedTxns = wire.MaxInvPerMsg
 
-	// maxLastBlockTime is the longest time in seconds that we will
-	// stay with a sync peer while below the current blockchain height.
-	// Set to 3 minutes.
-	maxLastBlockTime = 60 * 3 * time.Second
-
 	// maxMsgQueuePerPeer is the maximum number of messages that can be
 	// queued for a peer. This is the size if the msgChan buffer.
 	maxMsgQueueSize = 10_000
 
-	// syncPeerTickerInterval is how often we check the current
-	// syncPeer. Set to 30 seconds.
-	syncPeerTickerInterval = 30 * time.Second
+	// blockRequestTickerInterval is how often we check for block
+	// requests that have not been answered within the block request timeout.
+	blockRequestTickerInterval = 10 * time.Second
+
+	// legacyKafkaInvHealthCheckInterval is how often we check the health of
+	// the Kafka cluster the tx inv messages are written to.
+	legacyKafkaInvHealthCheckInterval = 10 * time.Second
+
+	// txRateLimitBanScore is the transient ban score added to a peer for
+	// every burst of transactions it sends over its tx rate limit.
+	txRateLimitBanScore = 10
+
+	// maxBlockProcessingAttempts is the maximum number of times a block that
+	// could not be processed because of a problem on our side is requested
+	// again, before it is dropped until the next inv.
+	maxBlockProcessingAttempts = 5
+
+	// blockProcessingRetryBackoff is the delay before a block that could not
+	// be processed is requested again, multiplied by the number of attempts.
+	blockProcessingRetryBackoff = 2 * time.Second
 )
 
 // zeroHash is the zero-value hash (all zeros).  It is defined as a convenience.
@@ -135,6 +146,38 @@ type isCurrentMsg struct {
 	reply chan bool
 }
 
+// getPeerInfosMsg is a message type to be sent across the message channel for
+// retrieving the sync state of the connected peers.
+type getPeerInfosMsg struct {
+	reply chan []PeerInfo
+}
+
+// disconnectPeerMsg is a message type to be sent across the message channel for
+// disconnecting a peer on the block handler goroutine.
+type disconnectPeerMsg struct {
+	addr  string
+	reply chan error
+}
+
+// PeerInfo describes a peer known to the sync manager.
+type PeerInfo struct {
+	Addr          string
+	UserAgent     string
+	LastBlock     int32
+	BytesReceived uint64
+	SyncCandidate bool
+	SyncPeer      bool
+}
+
+// OrphanTxInfo describes an orphan transaction waiting for its parents.
+type OrphanTxInfo struct {
+	TxHash        chainhash.Hash
+	Size          int
+	AddedAt       time.Time
+	Parents       []chainhash.Hash // all parents of the transaction, at least one was missing when it was received
+	OrphanParents []chainhash.Hash // parents that are orphan transactions themselves
+}
+
 // pauseMsg is a message type to be sent across the message channel for
 // pausing the sync manager.  This effectively provides the caller with
 // exclusive access over the manager until a receive is performed on the
@@ -153,10 +196,28 @@ type headerNode struct {
 // peerSyncState stores additional information that the SyncManager tracks
 // about a peer.
 type peerSyncState struct {
-	syncCandidate   bool
-	requestQueue    *txmap.SyncedSlice[wire.InvVect]
-	requestedTxns   *expiringmap.ExpiringMap[chainhash.Hash, struct{}]
-	requestedBlocks *expiringmap.ExpiringMap[chainhash.Hash, struct{}]
+	syncCandidate     bool
+	requestQueue      *txmap.SyncedSlice[wire.InvVect]
+	requestedTxns     *expiringmap.ExpiringMap[chainhash.Hash, struct{}]
+	requestedBlocks   *expiringmap.ExpiringMap[chainhash.Hash, time.Time]
+	invalidBlockCount int // number of invalid blocks received from the peer, only accessed from the blockHandler thread
+
+	// txLimiter limits the transactions accepted from the peer, nil when the limit is disabled
+	txLimiter  *rate.Limiter
+	droppedTxs atomic.Uint64 // number of transactions dropped by txLimiter
+
+	// throughput score fields, only accessed from the blockHandler thread
+	throughputScore   float64   // rolling throughput of the peer in bytes/sec over the recent sync peer ticks
+	scored            bool      // whether the throughput score was measured
+	scoreBaselineSet  bool      // whether lastBytesReceived was set
+	lastBytesReceived uint64    // total bytes received from the peer at the last tick
+	demotedUntil      time.Time // the peer was replaced as sync peer for being slow and is not preferred until then
+
+	// received data accounting, exposed in the peer metrics under metricsLabel
+	metricsLabel   string        // address of the peer, or peerMetricsOtherLabel when over maxPeerMetricsLabels
+	bytesReceived  atomic.Uint64 // size of the blocks and transactions received from the peer
+	blocksReceived atomic.Uint64 // number of blocks received from the peer
+	txsReceived    atomic.Uint64 // number of transactions received from the peer
 }
 
 // syncPeerState stores additional info about the sync peer.
@@ -172,7 +233,7 @@ type syncPeerState struct {
 // validNetworkSpeed checks if the peer is slow and
 // returns an integer representing the number of network
 // violations the sync peer has.
-func (sps *syncPeerState) validNetworkSpeed(minSyncPeerNetworkSpeed uint64) int {
+func (sps *syncPeerState) validNetworkSpeed(minSyncPeerNetworkSpeed uint64, tickInterval time.Duration) int {
 	sps.mu.Lock()
 	defer sps.mu.Unlock()
 
@@ -185,7 +246,7 @@ func (sps *syncPeerState) validNetworkSpeed(minSyncPeerNetworkSpeed uint64) int
 	recvDiff := sps.recvBytes - sps.recvBytesLastTick
 
 	// If the peer was below the threshold, mark a violation and return.
-	if recvDiff/uint64(syncPeerTickerInterval.Seconds()) < minSyncPeerNetworkSpeed {
+	if float64(recvDiff)/tickInterval.Seconds() < float64(minSyncPeerNetworkSpeed) {
 		sps.violations++
 		return sps.violations
 	}
@@ -200,6 +261,8 @@ type orphanTxAndParents struct {
 	tx      *bt.Tx
 	parents *txmap.SyncedMap[chainhash.Hash, struct{}] // map of parent tx hashes
 	addedAt time.Time
+
+	orderElement *list.Element // element of the orphan in the orphanTxOrder list
 }
 
 // updateNetwork updates the received bytes. Just tracks 2 ticks
@@ -271,21 +334,48 @@ type SyncManager struct {
 	blockchainClient  teranodeblockchain.ClientI
 	validationClient  validator.Interface
 	utxoStore         utxostore.Store
+	propagationClient propagation.ClientI // only set when legacy transactions are forwarded to the propagation service
 	subtreeStore      blob.Store
 	subtreeValidation subtreevalidation.Interface
 	blockValidation   blockvalidation.Interface
 	blockAssembly     blockassembly.ClientI
 	legacyKafkaInvCh  chan *kafka.Message
+
+	// legacyKafkaInvDegraded is set while the Kafka cluster of the inv producer is unhealthy, the tx inv
+	// messages are then processed directly instead of being written to Kafka, where nothing would read them
+	legacyKafkaInvDegraded atomic.Bool
+
 	txAnnounceBatcher *batcher.BatcherWithDedup[TxHashAndFee]
 
+	// orphanTxOrder holds the hashes of the orphan transactions in the order they were added, with
+	// orphanTxElements as index, so the oldest orphan can be evicted without scanning the orphan pool
+	orphanTxOrder    *list.List
+	orphanTxElements map[chainhash.Hash]*list.Element
+	orphanTxOrderMu  sync.Mutex
+
+	// recentTxs contains the recently accepted transactions, so haveInventory does not have to
+	// look them up in the utxo store, nil when disabled
+	recentTxs *recentTxFilter
+
 	// These fields should only be accessed from the blockHandler thread.
 	rejectedTxns    *txmap.SyncedMap[chainhash.Hash, struct{}]
 	requestedTxns   *expiringmap.ExpiringMap[chainhash.Hash, struct{}]
-	requestedBlocks *expiringmap.ExpiringMap[chainhash.Hash, struct{}]
+	requestedBlocks *expiringmap.ExpiringMap[chainhash.Hash, time.Time]
 	syncPeer        *peerpkg.Peer
 	syncPeerState   *syncPeerState
 	peerStates      *txmap.SyncedMap[*peerpkg.Peer, *peerSyncState]
 
+	// peerMetricsLabels is the number of peers that have their own label in the peer metrics
+	peerMetricsLabels int
+
+	// bannedPeers contains the hosts of peers that sent too many invalid blocks,
+	// these are not selected as sync peer until the ban expires.
+	bannedPeers *expiringmap.ExpiringMap[string, struct{}]
+
+	// blockProcessingAttempts contains the number of times a block could not be processed because of a
+	// problem on our side, to stop requesting a block that keeps failing.
+	blockProcessingAttempts *expiringmap.ExpiringMap[chainhash.Hash, int]
+
 	// The following fields are used for headers-first mode.
 	headersFirstMode bool
 	headerList       *list.List
@@ -299,6 +389,12 @@ type SyncManager struct {
 	// minSyncPeerNetworkSpeed is the minimum speed allowed for
 	// a sync peer.
 	minSyncPeerNetworkSpeed uint64
+
+	// maxLastBlockTime, minInFlightBlocks and syncPeerCheckInterval control
+	// the rotation of the sync peer, see Config.
+	maxLastBlockTime      time.Duration
+	minInFlightBlocks     int
+	syncPeerCheckInterval time.Duration
 }
 
 // resetHeaderState sets the headers-first mode state to values appropriate for
@@ -348,6 +444,168 @@ func (sm *SyncManager) findNextHeaderCheckpoint(height int32) *chaincfg.Checkpoi
 	return nextCheckpoint
 }
 
+// requeueBlockRequest requests the block from the peer again after the given delay, after the block could not be
+// processed or was not delivered. The block is marked as requested right away, with the time the request is sent.
+func (sm *SyncManager) requeueBlockRequest(peer *peerpkg.Peer, state *peerSyncState, blockHash *chainhash.Hash, delay time.Duration) {
+	gdmsg := wire.NewMsgGetData()
+
+	if err := gdmsg.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, blockHash)); err != nil {
+		sm.logger.Warnf("Unexpected failure when adding inventory to getdata message: %v", err)
+		return
+	}
+
+	requestedAt := time.Now().Add(delay)
+
+	sm.requestedBlocks.Set(*blockHash, requestedAt)
+	state.requestedBlocks.Set(*blockHash, requestedAt)
+
+	if delay <= 0 {
+		peer.QueueMessage(gdmsg, nil)
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		peer.QueueMessage(gdmsg, nil)
+	})
+}
+
+// retryFailedBlock requests a block that could not be processed because of a problem on our side again, with a
+// delay that grows with the number of attempts. It returns false when the block has failed too often, the block
+// is then not requested again until the next inv.
+func (sm *SyncManager) retryFailedBlock(peer *peerpkg.Peer, state *peerSyncState, blockHash *chainhash.Hash) bool {
+	attempts, _ := sm.blockProcessingAttempts.Get(*blockHash)
+	attempts++
+
+	if attempts > maxBlockProcessingAttempts {
+		sm.blockProcessingAttempts.Delete(*blockHash)
+		return false
+	}
+
+	sm.blockProcessingAttempts.Set(*blockHash, attempts)
+
+	sm.requeueBlockRequest(peer, state, blockHash, time.Duration(attempts)*blockProcessingRetryBackoff)
+
+	return true
+}
+
+// newPeerTxLimiter returns a token bucket limiter for the transactions of a peer, with a burst of one second
+// of transactions, or nil when the limit is disabled.
+func newPeerTxLimiter(txsPerSecond float64) *rate.Limiter {
+	if txsPerSecond <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(txsPerSecond), max(1, int(txsPerSecond)))
+}
+
+// allowPeerTx returns whether a transaction from the peer is within its rate limit. Every time the peer
+// has exceeded the limit by a full burst of transactions, its ban score is increased, so a peer that keeps
+// flooding is banned, while the transient score of a peer that exceeds the limit occasionally decays.
+func (sm *SyncManager) allowPeerTx(peer *peerpkg.Peer, state *peerSyncState) bool {
+	if state.txLimiter == nil || state.txLimiter.Allow() {
+		return true
+	}
+
+	prometheusLegacyNetsyncTxRateLimited.Inc()
+
+	if dropped := state.droppedTxs.Add(1); dropped%uint64(state.txLimiter.Burst()) == 0 {
+		sm.peerNotifier.AddBanScore(peer, 0, txRateLimitBanScore, fmt.Sprintf("exceeded the tx rate limit, %d txs dropped", dropped))
+	}
+
+	return false
+}
+
+// handleInvalidBlock records an invalid block received from the peer. When the peer has sent the configured
+// maximum number of invalid blocks, it is disconnected and banned from being selected as sync peer for the
+// configured ban duration.
+func (sm *SyncManager) handleInvalidBlock(peer *peerpkg.Peer, state *peerSyncState, blockHash *chainhash.Hash) {
+	state.invalidBlockCount++
+
+	if sm.settings.Legacy.MaxInvalidBlocks <= 0 || state.invalidBlockCount < sm.settings.Legacy.MaxInvalidBlocks {
+		return
+	}
+
+	sm.bannedPeers.Set(peerBanKey(peer), struct{}{})
+
+	peer.DisconnectWithWarning(fmt.Sprintf("sent %d invalid blocks, last %s, banning for %s", state.invalidBlockCount, blockHash, sm.settings.Legacy.InvalidBlockBanDuration))
+}
+
+// isPeerBanned returns whether the peer has been banned for sending invalid blocks.
+func (sm *SyncManager) isPeerBanned(peer *peerpkg.Peer) bool {
+	_, banned := sm.bannedPeers.Get(peerBanKey(peer))
+
+	return banned
+}
+
+// peerBanKey returns the key a peer is banned under, which is the host of the peer, without
+// the port, so a reconnect of the peer from a different port is banned as well.
+func peerBanKey(peer *peerpkg.Peer) string {
+	host, _, err := net.SplitHostPort(peer.Addr())
+	if err != nil {
+		return peer.Addr()
+	}
+
+	return host
+}
+
+// markBlockDelivered marks a requested block as delivered by the peer, the block is waiting in the block
+// queue to be processed and should not be retried by retryStalledBlockRequests in the meantime.
+func (sm *SyncManager) markBlockDelivered(peer *peerpkg.Peer, blockHash chainhash.Hash) {
+	state, exists := sm.peerStates.Get(peer)
+	if !exists {
+		return
+	}
+
+	if _, requested := state.requestedBlocks.Get(blockHash); requested {
+		state.requestedBlocks.Set(blockHash, time.Time{})
+	}
+}
+
+// retryStalledBlockRequests removes the block requests that have not been answered within the configured
+// block request timeout from the request maps and requests the blocks again, from a different peer if possible.
+// Without this, a peer that never delivers a requested block stalls the sync until the sync peer is rotated.
+func (sm *SyncManager) retryStalledBlockRequests() {
+	timeout := sm.settings.Legacy.BlockRequestTimeout
+	if timeout <= 0 {
+		return
+	}
+
+	peerStates := sm.peerStates.Range()
+
+	for peer, state := range peerStates {
+		for blockHash, requestedAt := range state.requestedBlocks.Items() {
+			if requestedAt.IsZero() || time.Since(requestedAt) < timeout {
+				continue
+			}
+
+			sm.logger.Warnf("[retryStalledBlockRequests][%s] peer %v did not deliver the block within %v, retrying", blockHash, peer, timeout)
+
+			prometheusLegacyNetsyncBlockRequestTimeouts.Inc()
+
+			state.requestedBlocks.Delete(blockHash)
+			sm.requestedBlocks.Delete(blockHash)
+
+			retryPeer, retryState := sm.blockRequestRetryPeer(peer, state, peerStates)
+			sm.requeueBlockRequest(retryPeer, retryState, &blockHash, 0)
+		}
+	}
+}
+
+// blockRequestRetryPeer returns a sync candidate other than the stalled peer to request a block from,
+// falling back to the stalled peer itself when no other candidate is connected.
+func (sm *SyncManager) blockRequestRetryPeer(stalledPeer *peerpkg.Peer, stalledState *peerSyncState,
+	peerStates map[*peerpkg.Peer]*peerSyncState) (*peerpkg.Peer, *peerSyncState) {
+	for peer, state := range peerStates {
+		if peer == stalledPeer || !state.syncCandidate || !peer.Connected() || sm.isPeerBanned(peer) {
+			continue
+		}
+
+		return peer, state
+	}
+
+	return stalledPeer, stalledState
+}
+
 // startSync will choose the best peer among the available candidate peers to
 // download/sync the blockchain from.  When syncing is already running, it
 // simply returns.  It also examines the candidates for any which are no longer
@@ -379,6 +637,12 @@ func (sm *SyncManager) startSync() {
 			continue
 		}
 
+		if sm.isPeerBanned(peer) {
+			sm.logger.Debugf("[startSync] peer %v is banned for sending invalid blocks", peer.String())
+
+			continue
+		}
+
 		// Add any peers on the same block to okPeers. These should
 		// only be used as a last resort.
 
@@ -411,16 +675,14 @@ func (sm *SyncManager) startSync() {
 
 	var bestPeer *peerpkg.Peer
 
-	// Try to select a random peer that is at a higher block height,
-	// if that is not available, then use a random peer at the same
+	// Try to select the fastest peer that is at a higher block height,
+	// if that is not available, then use the fastest peer at the same
 	// height and hope they find blocks.
 	if len(bestPeers) > 0 {
-		// #nosec G404
-		bestPeer = bestPeers[rand.IntN(len(bestPeers))]
+		bestPeer = sm.selectSyncPeer(bestPeers)
 		sm.logger.Debugf("[startSync] selected best peer %s from %d peers ahead of us", bestPeer.String(), len(bestPeers))
 	} else if len(okPeers) > 0 {
-		// #nosec G404
-		bestPeer = okPeers[rand.IntN(len(okPeers))]
+		bestPeer = sm.selectSyncPeer(okPeers)
 		sm.logger.Debugf("[startSync] no peers ahead, selected ok peer %s from %d peers at same height", bestPeer.String(), len(okPeers))
 	}
 
@@ -618,10 +880,14 @@ func (sm *SyncManager) handleNewPeerMsg(peer *peerpkg.Peer) {
 	}
 
 	sm.peerStates.Set(peer, &peerSyncState{
-		syncCandidate:   isSyncCandidate,
-		requestQueue:    txmap.NewSyncedSlice[wire.InvVect](maxRequestedBlocks),
-		requestedTxns:   expiringmap.New[chainhash.Hash, struct{}](10 * time.Second), // allow the node 10 seconds to respond to the tx request
-		requestedBlocks: expiringmap.New[chainhash.Hash, struct{}](60 * time.Minute), // allow the node 1 hour to respond to the requested blocks, needed for legacy sync/checkpoints
+		syncCandidate:     isSyncCandidate,
+		requestQueue:      txmap.NewSyncedSlice[wire.InvVect](maxRequestedBlocks),
+		requestedTxns:     expiringmap.New[chainhash.Hash, struct{}](10 * time.Second),  // allow the node 10 seconds to respond to the tx request
+		requestedBlocks:   expiringmap.New[chainhash.Hash, time.Time](60 * time.Minute), // allow the node 1 hour to respond to the requested blocks, needed for legacy sync/checkpoints
+		scoreBaselineSet:  true,
+		lastBytesReceived: peer.BytesReceived(),
+		txLimiter:         newPeerTxLimiter(sm.settings.Legacy.PeerTxRateLimit),
+		metricsLabel:      sm.assignPeerMetricsLabel(peer.Addr()),
 	})
 
 	// Start syncing by choosing the best candidate if needed.
@@ -636,6 +902,9 @@ func (sm *SyncManager) handleCheckSyncPeer() {
 		return
 	}
 
+	// Update the throughput scores used to select the sync peer.
+	sm.updatePeerScores()
+
 	// If we don't have a sync peer, select a new one and return.
 	if sm.syncPeer == nil {
 		sm.startSync()
@@ -646,21 +915,21 @@ func (sm *SyncManager) handleCheckSyncPeer() {
 	// Update network stats at the end of this tick.
 	defer sm.syncPeerState.updateNetwork(sm.syncPeer)
 
-	validNetworkSpeed := sm.syncPeerState.validNetworkSpeed(sm.minSyncPeerNetworkSpeed)
+	validNetworkSpeed := sm.syncPeerState.validNetworkSpeed(sm.minSyncPeerNetworkSpeed, sm.syncPeerCheckInterval)
 	lastBlockSince := time.Since(sm.syncPeerState.getLastBlockTime())
 
-	sm.logger.Debugf("[CheckSyncPeer] sync peer %s check, network violations: %v (limit %v), time since last block: %v (limit %v)", sm.syncPeer.String(), validNetworkSpeed, maxNetworkViolations, lastBlockSince, maxLastBlockTime)
+	sm.logger.Debugf("[CheckSyncPeer] sync peer %s check, network violations: %v (limit %v), time since last block: %v (limit %v)", sm.syncPeer.String(), validNetworkSpeed, maxNetworkViolations, lastBlockSince, sm.maxLastBlockTime)
 
 	// Check network speed of the sync peer and its last block time. If we're currently
 	// flushing the cache skip this round.
-	if (validNetworkSpeed < maxNetworkViolations) && (lastBlockSince <= maxLastBlockTime) {
+	if (validNetworkSpeed < maxNetworkViolations) && (lastBlockSince <= sm.maxLastBlockTime) {
 		return
 	}
 
 	var reason string
 	if validNetworkSpeed >= maxNetworkViolations {
 		reason = "network speed violation"
-	} else if lastBlockSince > maxLastBlockTime {
+	} else if lastBlockSince > sm.maxLastBlockTime {
 		reason = "last block time out of range"
 	}
 	sm.logger.Debugf("[CheckSyncPeer] sync peer %s is stalled due to %s, updating sync peer", sm.syncPeer.String(), reason)
@@ -670,6 +939,9 @@ func (sm *SyncManager) handleCheckSyncPeer() {
 		return
 	}
 
+	// Demote the peer, so it is not selected again right away while it is still connected.
+	state.demotedUntil = time.Now().Add(slowSyncPeerDemotion)
+
 	sm.logger.Debugf("[CheckSyncPeer] removing sync peer %s", sm.syncPeer.String())
 
 	sm.clearRequestedState(state)
@@ -707,6 +979,8 @@ func (sm *SyncManager) handleDonePeerMsg(peer *peerpkg.Peer) {
 
 	sm.logger.Infof("Lost peer %s (removed from peerStates)", peer)
 
+	sm.releasePeerMetricsLabel(state)
+
 	// Cleanup state of requested items.
 	sm.clearRequestedState(state)
 
@@ -789,6 +1063,13 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 		return
 	}
 
+	state.recordTxReceived(tmsg.tx.MsgTx().SerializeSize())
+
+	if !sm.allowPeerTx(peer, state) {
+		sm.logger.Debugf("Dropping transaction %v from %s, over the rate limit of %.0f txs/sec", tmsg.tx.Hash(), peer, sm.settings.Legacy.PeerTxRateLimit)
+		return
+	}
+
 	// NOTE: BitcoinJ, and possibly other wallets, don't follow the spec of
 	// sending an inventory message and allowing the remote peer to decide
 	// whether or not they want to request the transaction via a getdata
@@ -820,8 +1101,15 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 	var txMeta *meta.Data
 
 	timeStart := time.Now()
-	// passing in block height 0, which will default to utxo store block height in validator
-	txMeta, err = sm.validationClient.Validate(ctx, btTx, 0)
+
+	if sm.propagationClient != nil {
+		if err = sm.propagationClient.ProcessTransaction(ctx, btTx); err == nil {
+			txMeta = sm.getForwardedTxMeta(ctx, btTx)
+		}
+	} else {
+		// passing in block height 0, which will default to utxo store block height in validator
+		txMeta, err = sm.validationClient.Validate(ctx, btTx, 0)
+	}
 
 	prometheusLegacyNetsyncHandleTxMsgValidate.Observe(float64(time.Since(timeStart).Microseconds()) / 1_000_000)
 
@@ -837,19 +1125,19 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 			// this is an orphan transaction, we will accept it when the parent comes in
 			// first check if the transaction already exists in the orphan pool, otherwise add it
 			if _, orphanTxExists := sm.orphanTxs.Get(*txHash); !orphanTxExists {
-				sm.logger.Debugf("orphan transaction %v added from %s", txHash, peer)
-
 				// create a map of the parents of the transaction for faster lookups
 				txParents := txmap.NewSyncedMap[chainhash.Hash, struct{}]()
 				for _, input := range tmsg.tx.MsgTx().TxIn {
 					txParents.Set(input.PreviousOutPoint.Hash, struct{}{})
 				}
 
-				sm.orphanTxs.Set(*txHash, &orphanTxAndParents{
+				if sm.addOrphanTx(*txHash, &orphanTxAndParents{
 					tx:      btTx,
 					parents: txParents,
 					addedAt: time.Now(),
-				})
+				}) {
+					sm.logger.Debugf("orphan transaction %v added from %s", txHash, peer)
+				}
 			}
 
 			return
@@ -865,13 +1153,19 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 			sm.logger.Errorf("Failed to process transaction %v: %v", txHash, err)
 
 			// Convert the error into an appropriate reject message and send it.
-			// TODO better rejection code and message from the error
-			peer.PushRejectMsg(wire.CmdTx, wire.RejectInvalid, "rejected", txHash, false)
+			code, reason := rejectCodeFromError(err, "rejected")
+			peer.PushRejectMsg(wire.CmdTx, code, reason, txHash, false)
 
 			return
 		}
 	}
 
+	if txMeta == nil {
+		// the transaction forwarded to the propagation service is validated asynchronously, it is not announced to our peers
+		sm.logger.Debugf("Transaction %v forwarded to propagation is not validated yet, not announcing", txHash)
+		return
+	}
+
 	// acceptedTxs also should contain any orphan transactions that were accepted when this transaction was processed
 	acceptedTxs := []*TxHashAndFee{{
 		TxHash: *btTx.TxIDChainHash(),
@@ -882,11 +1176,130 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 	// this is a recursive call, but the orphan pool should be limited in size
 	sm.processOrphanTransactions(ctx, btTx.TxIDChainHash(), &acceptedTxs)
 
+	for _, acceptedTx := range acceptedTxs {
+		sm.recentTxs.Add(&acceptedTx.TxHash)
+	}
+
 	if len(acceptedTxs) > 0 {
 		sm.peerNotifier.AnnounceNewTransactions(acceptedTxs)
 	}
 }
 
+// getForwardedTxMeta returns the tx meta of a transaction that was accepted by the propagation service. The
+// propagation service returns the validation error of the transaction, like a missing parent, when it validates
+// the transaction directly, so only the fee needed to announce the transaction is looked up in the utxo store.
+// The returned tx meta is nil when the propagation service validates the transaction asynchronously and it has
+// not been validated yet.
+func (sm *SyncManager) getForwardedTxMeta(ctx context.Context, btTx *bt.Tx) *meta.Data {
+	txMeta, err := sm.utxoStore.Get(ctx, btTx.TxIDChainHash(), fields.Fee, fields.SizeInBytes)
+	if err != nil {
+		if !errors.Is(err, errors.ErrTxNotFound) {
+			sm.logger.Warnf("Failed to get transaction %v forwarded to propagation from the utxo store: %v", btTx.TxIDChainHash(), err)
+		}
+
+		return nil
+	}
+
+	return txMeta
+}
+
+// addOrphanTx adds a transaction to the orphan pool, unless it is already in the pool. When the pool
+// is full the orphan that was added first is evicted, even before it expires. The evicted orphan gets
+// one last validation attempt in the background, so the transaction handler is not held up by it.
+// Returns true when the transaction was added.
+func (sm *SyncManager) addOrphanTx(txHash chainhash.Hash, orphanTx *orphanTxAndParents) bool {
+	sm.orphanTxOrderMu.Lock()
+	defer sm.orphanTxOrderMu.Unlock()
+
+	if _, exists := sm.orphanTxs.Get(txHash); exists {
+		return false
+	}
+
+	if sm.orphanTxOrder == nil {
+		sm.orphanTxOrder = list.New()
+		sm.orphanTxElements = make(map[chainhash.Hash]*list.Element)
+	}
+
+	// the orphan may have expired without being cleaned up yet
+	sm.removeOrphanTxOrder(txHash)
+
+	if sm.settings.Legacy.MaxOrphanTxs > 0 && sm.orphanTxOrder.Len() >= sm.settings.Legacy.MaxOrphanTxs {
+		if evictedHash, evictedTx, ok := sm.evictOldestOrphanTx(); ok {
+			go sm.validateEvictedOrphanTx(evictedHash, evictedTx)
+		}
+	}
+
+	orphanTx.orderElement = sm.orphanTxOrder.PushBack(txHash)
+	sm.orphanTxElements[txHash] = orphanTx.orderElement
+
+	sm.orphanTxs.Set(txHash, orphanTx)
+
+	return true
+}
+
+// removeOrphanTx removes a transaction from the orphan pool.
+func (sm *SyncManager) removeOrphanTx(txHash chainhash.Hash) {
+	sm.orphanTxOrderMu.Lock()
+	defer sm.orphanTxOrderMu.Unlock()
+
+	sm.removeOrphanTxOrder(txHash)
+	sm.orphanTxs.Delete(txHash)
+}
+
+// removeOrphanTxOrder removes a transaction from the orphanTxOrder list, orphanTxOrderMu must be held.
+func (sm *SyncManager) removeOrphanTxOrder(txHash chainhash.Hash) {
+	if element, ok := sm.orphanTxElements[txHash]; ok {
+		sm.orphanTxOrder.Remove(element)
+		delete(sm.orphanTxElements, txHash)
+	}
+}
+
+// evictOldestOrphanTx removes the orphan transaction that was added first from the orphan pool,
+// orphanTxOrderMu must be held.
+//
+// Returns the hash and the orphan that were removed, false when the pool is empty
+func (sm *SyncManager) evictOldestOrphanTx() (chainhash.Hash, *orphanTxAndParents, bool) {
+	if sm.orphanTxOrder == nil || sm.orphanTxOrder.Len() == 0 {
+		return chainhash.Hash{}, nil, false
+	}
+
+	txHash := sm.orphanTxOrder.Front().Value.(chainhash.Hash)
+	sm.removeOrphanTxOrder(txHash)
+
+	orphanTx, ok := sm.orphanTxs.Get(txHash)
+	sm.orphanTxs.Delete(txHash)
+
+	return txHash, orphanTx, ok
+}
+
+// evictOrphanTx is called by the orphan pool when an orphan transaction expires. The orphan is removed
+// from the orphanTxOrder list and gets one last validation attempt in the background, the orphan pool is
+// locked while this function runs.
+func (sm *SyncManager) evictOrphanTx(txHash chainhash.Hash, orphanTx *orphanTxAndParents) bool {
+	go func() {
+		sm.orphanTxOrderMu.Lock()
+		// the transaction may have been added to the pool again in the meantime
+		if element, ok := sm.orphanTxElements[txHash]; ok && element == orphanTx.orderElement {
+			sm.removeOrphanTxOrder(txHash)
+		}
+		sm.orphanTxOrderMu.Unlock()
+
+		sm.validateEvictedOrphanTx(txHash, orphanTx)
+	}()
+
+	return true
+}
+
+// validateEvictedOrphanTx tries to process an orphan transaction that is evicted from the orphan pool one last time.
+func (sm *SyncManager) validateEvictedOrphanTx(txHash chainhash.Hash, orphanTx *orphanTxAndParents) {
+	// passing in block height 0, which will default to utxo store block height in validator
+	if _, err := sm.validationClient.Validate(sm.ctx, orphanTx.tx, 0); err != nil {
+		sm.logger.Debugf("failed to validate orphan transaction when evicting %v: %v", txHash, err)
+	} else {
+		sm.logger.Debugf("evicted orphan transaction %v", txHash)
+	}
+}
+
 // processOrphanTransactions recursively processes orphan transactions that were waiting for a transaction to be accepted
 func (sm *SyncManager) processOrphanTransactions(ctx context.Context, txHash *chainhash.Hash, acceptedTxs *[]*TxHashAndFee) {
 	// check whether any transaction in the orphan pool has this transaction as a parent
@@ -895,81 +1308,79 @@ func (sm *SyncManager) processOrphanTransactions(ctx context.Context, txHash *ch
 	)
 	defer deferFn()
 
-	// remove the transaction from the orphan pool
-	sm.orphanTxs.Delete(*txHash)
-
-	// first we get all the orphan transactions, this will not block the orphan tx pool while processing
-	orphanTxs := sm.orphanTxs.Items()
+	// the accepted transactions are processed from a work queue instead of recursively, a long chain of orphans
+	// would otherwise grow the stack with every generation
+	queue := []chainhash.Hash{*txHash}
 
-	for _, orphanTx := range orphanTxs {
-		// check if the orphan transaction has this transaction as a parent
-		if _, ok := orphanTx.parents.Get(*txHash); !ok {
-			continue
+	for len(queue) > 0 {
+		if ctx.Err() != nil {
+			return
 		}
 
-		// validate the orphan transaction
-		// passing in block height 0, which will default to utxo store block height in validator
-		txMeta, err := sm.validationClient.Validate(ctx, orphanTx.tx, 0)
-		if err != nil {
-			if errors.Is(err, errors.ErrTxMissingParent) || errors.Is(err, errors.ErrTxLocked) {
-				// silently exit, we will accept this transaction when the other parent(s) comes in
-				// or when the transaction is spendable again
+		parentHash := queue[0]
+		queue = queue[1:]
+
+		// remove the transaction from the orphan pool
+		sm.removeOrphanTx(parentHash)
+
+		// first we get all the orphan transactions, this will not block the orphan tx pool while processing
+		orphanTxs := sm.orphanTxs.Items()
+
+		for orphanHash, orphanTx := range orphanTxs {
+			// check if the orphan transaction has this transaction as a parent
+			if _, ok := orphanTx.parents.Get(parentHash); !ok {
 				continue
 			}
 
-			if errors.Is(err, errors.ErrTxConflicting) {
-				// remove the tx from the orphan pool, it is a double spend
-				sm.orphanTxs.Delete(*txHash)
+			// validate the orphan transaction
+			// passing in block height 0, which will default to utxo store block height in validator
+			txMeta, err := sm.validationClient.Validate(ctx, orphanTx.tx, 0)
+			if err != nil {
+				if errors.Is(err, errors.ErrTxMissingParent) || errors.Is(err, errors.ErrTxLocked) {
+					// silently exit, we will accept this transaction when the other parent(s) comes in
+					// or when the transaction is spendable again
+					continue
+				}
+
+				if errors.Is(err, errors.ErrTxConflicting) {
+					// remove the tx from the orphan pool, it is a double spend
+					sm.removeOrphanTx(orphanHash)
+					continue
+				}
+
+				// if the transaction was rejected, we will not process any of the orphan transactions that were waiting for it
+				sm.logger.Errorf("Failed to process orphan transaction %v: %v", orphanHash, err)
+
 				continue
 			}
 
-			// if the transaction was rejected, we will not process any of the orphan transactions that were waiting for it
-			sm.logger.Errorf("Failed to process orphan transaction %v: %v", txHash, err)
-
-			continue
-		}
+			// add the orphan transaction to the list of accepted transactions
+			*acceptedTxs = append(*acceptedTxs, &TxHashAndFee{
+				TxHash: *orphanTx.tx.TxIDChainHash(),
+				Fee:    txMeta.Fee,
+				Size:   txMeta.SizeInBytes,
+			})
 
-		// add the orphan transaction to the list of accepted transactions
-		*acceptedTxs = append(*acceptedTxs, &TxHashAndFee{
-			TxHash: *orphanTx.tx.TxIDChainHash(),
-			Fee:    txMeta.Fee,
-			Size:   txMeta.SizeInBytes,
-		})
+			// add the time it took to process the orphan transaction to the histogram
+			prometheusLegacyNetsyncOrphanTime.Observe(float64(time.Since(orphanTx.addedAt).Microseconds()) / 1_000_000)
 
-		// add the time it took to process the orphan transaction to the histogram
-		prometheusLegacyNetsyncOrphanTime.Observe(float64(time.Since(orphanTx.addedAt).Microseconds()) / 1_000_000)
+			// remove the accepted transaction straight away, so it is not validated again for another parent in the queue
+			sm.removeOrphanTx(orphanHash)
 
-		// process any orphan transactions that were waiting for this transaction to be accepted
-		sm.processOrphanTransactions(ctx, orphanTx.tx.TxIDChainHash(), acceptedTxs)
+			// process any orphan transactions that were waiting for this transaction to be accepted
+			queue = append(queue, *orphanTx.tx.TxIDChainHash())
+		}
 	}
 }
 
 // isCurrent returns whether the sync manager believes it is synced with the chain.
 // this function is a rewrite of the function in the original bsvd blockchain package
 func (sm *SyncManager) isCurrent(bestBlockHeaderMeta *model.BlockHeaderMeta) bool {
-	// Not current if the latest main (best) chain height is before the
-	// latest known good checkpoint (when checkpoints are enabled).
-	if len(sm.chainParams.Checkpoints) > 0 {
-		bestBlockHeightInt32, err := safeconversion.Uint32ToInt32(bestBlockHeaderMeta.Height)
-		if err != nil {
-			sm.logger.Errorf("failed to convert block height to int32: %v", err)
-		}
-
-		checkpoint := &sm.chainParams.Checkpoints[len(sm.chainParams.Checkpoints)-1]
-		if bestBlockHeightInt32 < checkpoint.Height {
-			return false
-		}
-	}
-
-	// Not current if the latest best block has a timestamp before 24 hours ago.
-	//
-	// The chain appears to be current if none of the checks reported otherwise.
-	// minus24Hours := b.timeSource.AdjustedTime().Add(-24 * time.Hour).Unix()
-	minus24Hours := time.Now().Add(-24 * time.Hour).Unix()
-
-	current := int64(bestBlockHeaderMeta.BlockTime) >= minus24Hours
-
-	return current
+	// Not current if the latest main (best) chain height is before the latest known good checkpoint
+	// (when checkpoints are enabled), or if the latest best block is older than the maximum tip age.
+	// The FSM state is not taken into account, since the sync manager uses this to decide whether
+	// the FSM should leave the legacy syncing state.
+	return len(teranodeblockchain.TipNotCurrentReasons(bestBlockHeaderMeta, sm.chainParams, sm.settings.BlockChain.MaxTipAge, time.Now())) == 0
 }
 
 // current returns true if we believe we are synced with our peers, false if we
@@ -1014,6 +1425,8 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockQueueMsg) error {
 		return errors.NewServiceError("[handleBlockMsg] Received block message from unknown peer %s", peer)
 	}
 
+	state.recordBlockReceived(bmsg.block.SerializeSize())
+
 	legacySyncMode := false
 	catchingBlocks := false
 
@@ -1119,18 +1532,36 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockQueueMsg) error {
 		} else if errors.Is(err, context.Canceled) {
 			return nil
 		} else {
-			serviceError := errors.Is(err, errors.ErrServiceError) || errors.Is(err, errors.ErrStorageError)
-			if !legacySyncMode && !catchingBlocks && !serviceError {
-				peer.PushRejectMsg(wire.CmdBlock, wire.RejectInvalid, "block rejected", &bmsg.blockHash, false)
+			if errors.Is(err, errors.ErrServiceError) || errors.Is(err, errors.ErrStorageError) {
+				// the block could not be processed because of a problem on our side, request it again,
+				// otherwise it would be dropped and only requested again on the next inv from the peer
+				if !sm.retryFailedBlock(peer, state, &bmsg.blockHash) {
+					return errors.NewProcessingError("[handleBlockMsg] failed to process block %v after %d attempts, giving up", bmsg.blockHash, maxBlockProcessingAttempts, err)
+				}
+
+				return errors.NewProcessingError("[handleBlockMsg] failed to process block %v, requested again from %s", bmsg.blockHash, peer, err)
+			}
+
+			if !errors.Is(err, errors.ErrBlockInvalid) && !errors.Is(err, errors.ErrTxInvalid) {
+				// other errors, like processing errors and timeouts, do not show that the block is invalid,
+				// the peer is not held responsible for them
+				return errors.NewProcessingError("[handleBlockMsg] failed to process block %v received from %s", bmsg.blockHash, peer, err)
 			}
 
-			sm.logger.Errorf("Failed to process new block in service blockQueueMsg %v: %v", bmsg.blockHash, err)
+			// the block itself is invalid, reject it and move on
+			if !legacySyncMode && !catchingBlocks {
+				code, reason := rejectCodeFromError(err, "block rejected")
+				peer.PushRejectMsg(wire.CmdBlock, code, reason, &bmsg.blockHash, false)
+			}
+
+			sm.handleInvalidBlock(peer, state, &bmsg.blockHash)
 
-			// TODO TEMPORARY: we should not panic here, but return the error
-			panic(err)
+			return errors.NewProcessingError("[handleBlockMsg] invalid block %v received from %s", bmsg.blockHash, peer, err)
 		}
 	} else {
 		sm.logger.Infof("accepted block %v", bmsg.blockHash)
+
+		sm.blockProcessingAttempts.Delete(bmsg.blockHash)
 	}
 
 	// Meta-data about the new block this peer is reporting. We use this
@@ -1205,7 +1636,7 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockQueueMsg) error {
 	// request more blocks using the header list when the request queue is
 	// getting short.
 	if !isCheckpointBlock {
-		if sm.startHeader != nil && state.requestedBlocks.Len() < minInFlightBlocks {
+		if sm.startHeader != nil && state.requestedBlocks.Len() < sm.minInFlightBlocks {
 			sm.fetchHeaderBlocks()
 		} else if !sm.current() && state.requestedBlocks.Len() == 0 {
 			sm.logger.Debugf("Not current, and no headers to sync to, fetching more headers")
@@ -1310,9 +1741,9 @@ func (sm *SyncManager) fetchHeaderBlocks() {
 				break
 			}
 
-			sm.requestedBlocks.Set(*node.hash, struct{}{})
+			sm.requestedBlocks.Set(*node.hash, time.Now())
 			peerState, _ := sm.peerStates.Get(sm.syncPeer)
-			peerState.requestedBlocks.Set(*node.hash, struct{}{})
+			peerState.requestedBlocks.Set(*node.hash, time.Now())
 
 			numRequested++
 		}
@@ -1330,6 +1761,24 @@ func (sm *SyncManager) fetchHeaderBlocks() {
 	}
 }
 
+// validateHeader runs the header only checks of a block on a header received in a headers message.
+func (sm *SyncManager) validateHeader(header *wire.BlockHeader) error {
+	var buf bytes.Buffer
+
+	if err := header.Serialize(&buf); err != nil {
+		return errors.NewProcessingError("failed to serialize block header", err)
+	}
+
+	blockHeader, err := model.NewBlockHeaderFromBytes(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	block := &model.Block{Header: blockHeader}
+
+	return block.ValidateHeaderOnly(sm.ctx, sm.settings)
+}
+
 // handleHeadersMsg handles block header messages from all peers.  Headers are
 // requested when performing a headers-first sync.
 func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
@@ -1402,6 +1851,14 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 			return
 		}
 
+		// Ensure the header has a valid proof of work and timestamp, before
+		// the blocks of the headers are requested.
+		if err := sm.validateHeader(blockHeader); err != nil {
+			peer.DisconnectWithWarning(fmt.Sprintf("Received block header %s that is not valid: %v", blockHash, err))
+
+			return
+		}
+
 		// Ensure the header properly connects to the previous one and
 		// add it to the list of headers.
 		node := headerNode{hash: &blockHash}
@@ -1478,6 +1935,17 @@ func (sm *SyncManager) haveInventory(invVect *wire.InvVect) (bool, error) {
 		return sm.blockchainClient.GetBlockExists(sm.ctx, &invVect.Hash)
 
 	case wire.InvTypeTx:
+		// transactions we accepted recently are known without a utxo store lookup,
+		// a miss falls through to the store, so we never claim not to have a transaction we have
+		if sm.recentTxs != nil {
+			if sm.recentTxs.Has(&invVect.Hash) {
+				prometheusLegacyNetsyncRecentTxFilterHits.Inc()
+				return true, nil
+			}
+
+			prometheusLegacyNetsyncRecentTxFilterMisses.Inc()
+		}
+
 		// check whether this transaction exists in the utxo store
 		// which means it has been processed completely at our end
 		utxo, err := sm.utxoStore.Get(sm.ctx, &invVect.Hash, fields.Fee)
@@ -1610,8 +2078,8 @@ outside:
 					break outside
 				}
 
-				sm.requestedBlocks.Set(iv.Hash, struct{}{})
-				state.requestedBlocks.Set(iv.Hash, struct{}{})
+				sm.requestedBlocks.Set(iv.Hash, time.Now())
+				state.requestedBlocks.Set(iv.Hash, time.Now())
 
 				numRequested++
 			}
@@ -1719,9 +2187,12 @@ type blockQueueMsg struct {
 // important because the sync manager controls which blocks are needed and how
 // the fetching should proceed.
 func (sm *SyncManager) blockHandler() {
-	ticker := time.NewTicker(syncPeerTickerInterval)
+	ticker := time.NewTicker(sm.syncPeerCheckInterval)
 	defer ticker.Stop()
 
+	blockRequestTicker := time.NewTicker(blockRequestTickerInterval)
+	defer blockRequestTicker.Stop()
+
 	// TODO make this configurable
 	maxBlockQueue := 10_000
 
@@ -1738,6 +2209,10 @@ func (sm *SyncManager) blockHandler() {
 				sm.logger.Debugf("[blockHandler][%s] processing block queue message into handleBlockMsg", msg.blockHash)
 
 				err := sm.handleBlockMsg(msg)
+				if err != nil {
+					sm.logger.Errorf("[blockHandler][%s] failed to handle block: %v", msg.blockHash, err)
+				}
+
 				if msg.reply != nil {
 					msg.reply <- err
 				}
@@ -1750,6 +2225,8 @@ out:
 		select {
 		case <-ticker.C:
 			sm.handleCheckSyncPeer()
+		case <-blockRequestTicker.C:
+			sm.retryStalledBlockRequests()
 		case m := <-sm.msgChan:
 			// whenever legacy receives a message, check if we are current
 			// this call should have the current state cached, so it should be fast
@@ -1789,6 +2266,8 @@ out:
 			case *blockMsg:
 				sm.logger.Debugf("[blockHandler][%s] queueing block for validation", msg.block.Hash())
 
+				sm.markBlockDelivered(msg.peer, *msg.block.Hash())
+
 				blockQueue <- &blockQueueMsg{
 					block:       msg.block.MsgBlock(),
 					blockHash:   *msg.block.Hash(),
@@ -1821,6 +2300,12 @@ out:
 				sm.logger.Warnf("isCurrentMsg is deprecated, use current() instead")
 				msg.reply <- sm.current()
 
+			case getPeerInfosMsg:
+				msg.reply <- sm.peerInfos()
+
+			case disconnectPeerMsg:
+				msg.reply <- sm.disconnectPeer(msg.addr)
+
 			case pauseMsg:
 				// Wait until the sender unpauses the manager.
 				<-msg.unpause
@@ -1884,7 +2369,7 @@ func (sm *SyncManager) QueueInv(inv *wire.MsgInv, peer *peerpkg.Peer) {
 
 	// write all tx inv messages to Kafka and read from there
 	// this allows us to stop reading in certain cases, but still have the inv messages to catch up on
-	if sm.legacyKafkaInvCh != nil {
+	if sm.legacyKafkaInvCh != nil && !sm.legacyKafkaInvDegraded.Load() {
 		// split inv message to transactions and blocks
 		invBlockMsg := wire.NewMsgInv()
 		invTxMsg := wire.NewMsgInv()
@@ -1989,6 +2474,136 @@ func (sm *SyncManager) SyncPeerID() int32 {
 	return <-reply
 }
 
+// PeerInfos returns the sync state of the peers known to the sync manager.
+func (sm *SyncManager) PeerInfos() ([]PeerInfo, error) {
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return nil, errors.NewServiceUnavailableError("sync manager is shutting down")
+	}
+
+	reply := make(chan []PeerInfo, 1)
+	sm.msgChan <- getPeerInfosMsg{reply: reply}
+
+	return <-reply, nil
+}
+
+// DisconnectPeer disconnects all peers with the given address. The peers are disconnected on
+// the block handler goroutine, so the disconnect does not race with the processing of their messages.
+func (sm *SyncManager) DisconnectPeer(addr string) error {
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return errors.NewServiceUnavailableError("sync manager is shutting down")
+	}
+
+	reply := make(chan error, 1)
+	sm.msgChan <- disconnectPeerMsg{addr: addr, reply: reply}
+
+	return <-reply
+}
+
+// OrphanTxInfos returns a page of the orphan transactions, ordered by the time they were added to the orphan pool,
+// together with the total number of orphan transactions. The orphan pool is safe for concurrent use, so the page
+// is built from a copy of the pool without going through the block handler goroutine.
+//
+// Parameters:
+//   - offset: Number of orphan transactions to skip
+//   - limit: Maximum number of orphan transactions to return
+//
+// Returns:
+//   - []OrphanTxInfo: The orphan transactions of the page, empty when the offset is past the last orphan
+//   - int: The total number of orphan transactions
+func (sm *SyncManager) OrphanTxInfos(offset, limit int) ([]OrphanTxInfo, int) {
+	orphanTxs := sm.orphanTxs.Items()
+
+	txHashes := make([]chainhash.Hash, 0, len(orphanTxs))
+	for txHash := range orphanTxs {
+		txHashes = append(txHashes, txHash)
+	}
+
+	slices.SortFunc(txHashes, func(a, b chainhash.Hash) int {
+		if c := orphanTxs[a].addedAt.Compare(orphanTxs[b].addedAt); c != 0 {
+			return c
+		}
+
+		return bytes.Compare(a[:], b[:])
+	})
+
+	total := len(txHashes)
+
+	if offset < 0 || offset >= total || limit <= 0 {
+		return []OrphanTxInfo{}, total
+	}
+
+	txHashes = txHashes[offset:min(offset+limit, total)]
+
+	infos := make([]OrphanTxInfo, 0, len(txHashes))
+
+	for _, txHash := range txHashes {
+		orphanTx := orphanTxs[txHash]
+
+		parents := orphanTx.parents.Keys()
+		slices.SortFunc(parents, func(a, b chainhash.Hash) int {
+			return bytes.Compare(a[:], b[:])
+		})
+
+		orphanParents := make([]chainhash.Hash, 0)
+
+		for _, parent := range parents {
+			if _, ok := orphanTxs[parent]; ok {
+				orphanParents = append(orphanParents, parent)
+			}
+		}
+
+		infos = append(infos, OrphanTxInfo{
+			TxHash:        txHash,
+			Size:          orphanTx.tx.Size(),
+			AddedAt:       orphanTx.addedAt,
+			Parents:       parents,
+			OrphanParents: orphanParents,
+		})
+	}
+
+	return infos, total
+}
+
+// peerInfos returns the sync state of the peers, only called from the block handler goroutine.
+func (sm *SyncManager) peerInfos() []PeerInfo {
+	infos := make([]PeerInfo, 0, sm.peerStates.Length())
+
+	for peer, state := range sm.peerStates.Range() {
+		infos = append(infos, PeerInfo{
+			Addr:          peer.Addr(),
+			UserAgent:     peer.UserAgent(),
+			LastBlock:     peer.LastBlock(),
+			BytesReceived: peer.BytesReceived(),
+			SyncCandidate: state.syncCandidate,
+			SyncPeer:      peer == sm.syncPeer,
+		})
+	}
+
+	return infos
+}
+
+// disconnectPeer disconnects the peers with the given address, only called from the block handler goroutine.
+// The peers are removed from the sync state when their done peer message is processed.
+func (sm *SyncManager) disconnectPeer(addr string) error {
+	found := false
+
+	for peer := range sm.peerStates.Range() {
+		if peer.Addr() != addr {
+			continue
+		}
+
+		found = true
+
+		peer.DisconnectWithInfo("disconnect requested through the control API")
+	}
+
+	if !found {
+		return errors.NewInvalidArgumentError("peer %s not found", addr)
+	}
+
+	return nil
+}
+
 // IsCurrent returns whether the sync manager believes it is synced with
 // the connected peers.
 func (sm *SyncManager) IsCurrent() bool {
@@ -2006,6 +2621,39 @@ func (sm *SyncManager) Pause() chan<- struct{} {
 	return c
 }
 
+// setSyncPeerConfig sets the sync peer rotation settings from the config, falling back to the
+// legacy settings for the values that are not set in the config, and validates them.
+func (sm *SyncManager) setSyncPeerConfig(tSettings *settings.Settings, config *Config) error {
+	sm.maxLastBlockTime = config.MaxLastBlockTime
+	if sm.maxLastBlockTime == 0 {
+		sm.maxLastBlockTime = tSettings.Legacy.SyncPeerMaxLastBlockTime
+	}
+
+	sm.minInFlightBlocks = config.MinInFlightBlocks
+	if sm.minInFlightBlocks == 0 {
+		sm.minInFlightBlocks = tSettings.Legacy.SyncPeerMinInFlightBlocks
+	}
+
+	sm.syncPeerCheckInterval = config.SyncPeerCheckInterval
+	if sm.syncPeerCheckInterval == 0 {
+		sm.syncPeerCheckInterval = tSettings.Legacy.SyncPeerCheckInterval
+	}
+
+	if sm.maxLastBlockTime <= 0 {
+		return errors.NewConfigurationError("[netsync] max last block time must be positive, got %s", sm.maxLastBlockTime)
+	}
+
+	if sm.minInFlightBlocks <= 0 || sm.minInFlightBlocks > maxRequestedBlocks {
+		return errors.NewConfigurationError("[netsync] min in flight blocks must be between 1 and %d, got %d", maxRequestedBlocks, sm.minInFlightBlocks)
+	}
+
+	if sm.syncPeerCheckInterval <= 0 {
+		return errors.NewConfigurationError("[netsync] sync peer check interval must be positive, got %s", sm.syncPeerCheckInterval)
+	}
+
+	return nil
+}
+
 // New constructs a new SyncManager. Use Start to begin processing asynchronous
 // block, tx, and inv updates.
 func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Settings, blockchainClient teranodeblockchain.ClientI,
@@ -2019,12 +2667,15 @@ func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Setting
 		settings:     tSettings,
 		peerNotifier: config.PeerNotifier,
 		// txMemPool:     config.TxMemPool,
-		orphanTxs:       expiringmap.New[chainhash.Hash, *orphanTxAndParents](tSettings.Legacy.OrphanEvictionDuration),
-		chainParams:     config.ChainParams,
-		rejectedTxns:    txmap.NewSyncedMap[chainhash.Hash, struct{}](maxRejectedTxns), // limit map size to maxRejectedTxns
-		requestedTxns:   expiringmap.New[chainhash.Hash, struct{}](10 * time.Second),   // give peers 10 seconds to respond
-		requestedBlocks: expiringmap.New[chainhash.Hash, struct{}](60 * time.Second),   // give peers 60 seconds to respond
-		peerStates:      txmap.NewSyncedMap[*peerpkg.Peer, *peerSyncState](),
+		orphanTxs:               expiringmap.New[chainhash.Hash, *orphanTxAndParents](tSettings.Legacy.OrphanEvictionDuration),
+		chainParams:             config.ChainParams,
+		rejectedTxns:            txmap.NewSyncedMap[chainhash.Hash, struct{}](maxRejectedTxns), // limit map size to maxRejectedTxns
+		requestedTxns:           expiringmap.New[chainhash.Hash, struct{}](10 * time.Second),   // give peers 10 seconds to respond
+		requestedBlocks:         expiringmap.New[chainhash.Hash, time.Time](60 * time.Second),  // give peers 60 seconds to respond
+		peerStates:              txmap.NewSyncedMap[*peerpkg.Peer, *peerSyncState](),
+		bannedPeers:             expiringmap.New[string, struct{}](tSettings.Legacy.InvalidBlockBanDuration),
+		blockProcessingAttempts: expiringmap.New[chainhash.Hash, int](time.Hour),
+		recentTxs:               newRecentTxFilter(tSettings.Legacy.RecentTxFilterCapacity, tSettings.Legacy.RecentTxFilterFPRate),
 		// progressLogger:  newBlockProgressLogger("Processed", log),
 		msgChan:    make(chan interface{}, maxMsgQueueSize),
 		headerList: list.New(),
@@ -2043,6 +2694,19 @@ func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Setting
 		blockAssembly:     blockAssembly,
 	}
 
+	if err := sm.setSyncPeerConfig(tSettings, config); err != nil {
+		return nil, err
+	}
+
+	if tSettings.Legacy.TxForwardToPropagation {
+		propagationClient, err := propagation.NewClient(ctx, logger, tSettings)
+		if err != nil {
+			return nil, errors.NewServiceError("failed to create propagation client", err)
+		}
+
+		sm.propagationClient = propagationClient
+	}
+
 	// create the transaction announcement batcher
 	sm.txAnnounceBatcher = batcher.NewWithDeduplication[TxHashAndFee](maxRequestedTxns, 1*time.Second, func(batch []*TxHashAndFee) {
 		sm.logger.Debugf("announcing %d transactions to peers", len(batch))
@@ -2053,17 +2717,7 @@ func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Setting
 
 	// set an eviction function for orphan transactions
 	// this will be called when an orphan transaction is evicted from the map
-	sm.orphanTxs.WithEvictionFunction(func(txHash chainhash.Hash, orphanTx *orphanTxAndParents) bool {
-		// try to process one last time
-		// passing in block height 0, which will default to utxo store block height in validator
-		if _, err := sm.validationClient.Validate(sm.ctx, orphanTx.tx, 0); err != nil {
-			sm.logger.Debugf("failed to validate orphan transaction when evicting %v: %v", txHash, err)
-		} else {
-			sm.logger.Debugf("evicted orphan transaction %v", txHash)
-		}
-
-		return true
-	})
+	sm.orphanTxs.WithEvictionFunction(sm.evictOrphanTx)
 
 	// add the number of orphan transactions to the prometheus metric
 	go func() {
@@ -2076,8 +2730,15 @@ func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Setting
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				// update the number of orphan transactions
+				// update the number and total size of the orphan transactions
+				var orphanBytes int
+
+				for _, orphanTx := range sm.orphanTxs.Items() {
+					orphanBytes += orphanTx.tx.Size()
+				}
+
 				prometheusLegacyNetsyncOrphans.Set(float64(sm.orphanTxs.Len()))
+				prometheusLegacyNetsyncOrphanBytes.Set(float64(orphanBytes))
 			}
 		}
 	}()
@@ -2107,6 +2768,40 @@ func New(ctx context.Context, logger ulogger.Logger, tSettings *settings.Setting
 	return &sm, nil
 }
 
+// monitorLegacyKafkaInvProducer checks the health of the Kafka cluster of the inv producer every
+// legacyKafkaInvHealthCheckInterval until the context is done.
+func (sm *SyncManager) monitorLegacyKafkaInvProducer(ctx context.Context, healthCheck func(ctx context.Context, checkLiveness bool) (int, string, error)) {
+	ticker := time.NewTicker(legacyKafkaInvHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		sm.checkLegacyKafkaInvProducer(ctx, healthCheck)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkLegacyKafkaInvProducer runs the health check of the Kafka cluster of the inv producer and
+// switches QueueInv between writing the tx inv messages to Kafka and processing them directly.
+func (sm *SyncManager) checkLegacyKafkaInvProducer(ctx context.Context, healthCheck func(ctx context.Context, checkLiveness bool) (int, string, error)) {
+	status, details, err := healthCheck(ctx, false)
+	degraded := err != nil || status != http.StatusOK
+
+	if sm.legacyKafkaInvDegraded.Swap(degraded) == degraded {
+		return
+	}
+
+	if degraded {
+		sm.logger.Warnf("[Legacy Manager] kafka inv producer is unhealthy, running in degraded mode, processing tx inv messages without Kafka: %s: %v", details, err)
+	} else {
+		sm.logger.Infof("[Legacy Manager] kafka inv producer is healthy again, writing tx inv messages to Kafka")
+	}
+}
+
 func (sm *SyncManager) startKafkaListeners(ctx context.Context, _ error) {
 	kafkaControlChan := make(chan bool) // true = start, false = stop
 
@@ -2138,23 +2833,25 @@ func (sm *SyncManager) startKafkaListeners(ctx context.Context, _ error) {
 	// Kafka for INV messages
 	legacyInvConfigURL := sm.settings.Kafka.LegacyInvConfig
 	if legacyInvConfigURL != nil {
-		sm.legacyKafkaInvCh = make(chan *kafka.Message, 10_000)
-
 		producer, err := kafka.NewKafkaAsyncProducerFromURL(ctx, sm.logger, legacyInvConfigURL, &sm.settings.Kafka)
 		if err != nil {
-			sm.logger.Errorf("[Legacy Manager] error starting kafka producer: %v", err)
-			return
-		}
+			// leave legacyKafkaInvCh nil, so all inv messages are processed directly
+			sm.logger.Errorf("[Legacy Manager] error starting kafka inv producer, running in degraded mode, processing tx inv messages without Kafka: %v", err)
+		} else {
+			sm.legacyKafkaInvCh = make(chan *kafka.Message, 10_000)
 
-		// start a go routine to start the kafka producer
-		go func() {
-			producer.Start(sm.ctx, sm.legacyKafkaInvCh)
-		}()
+			// start a go routine to start the kafka producer
+			go func() {
+				producer.Start(sm.ctx, sm.legacyKafkaInvCh)
+			}()
 
-		controlCh := make(chan bool)
-		kafkaControlListenersCh = append(kafkaControlListenersCh, controlCh)
+			go sm.monitorLegacyKafkaInvProducer(ctx, kafka.HealthChecker(ctx, producer.BrokersURL()))
 
-		go kafka.StartKafkaControlledListener(ctx, sm.logger, "inv.legacy"+"."+sm.settings.ClientName, controlCh, legacyInvConfigURL, sm.kafkaINVListener)
+			controlCh := make(chan bool)
+			kafkaControlListenersCh = append(kafkaControlListenersCh, controlCh)
+
+			go kafka.StartKafkaControlledListener(ctx, sm.logger, "inv.legacy"+"."+sm.settings.ClientName, controlCh, legacyInvConfigURL, sm.kafkaINVListener)
+		}
 	}
 
 	blocksFinalConfigURL := sm.settings.Kafka.BlocksFinalConfig
@@ -2182,7 +2879,7 @@ func (sm *SyncManager) startKafkaListeners(ctx context.Context, _ error) {
 
 	go func() {
 		// will never return an error
-		blockchainSubscription, _ := sm.blockchainClient.Subscribe(ctx, "legacy/manager")
+		blockchainSubscription, _ := sm.blockchainClient.Subscribe(ctx, "legacy/manager", options.WithNotificationTypes(model.NotificationType_Subtree))
 
 		for {
 			select {
@@ -5,13 +5,16 @@
 package netsync
 
 import (
+	"container/list"
 	"context"
+	"net/http"
 	"net/url"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/services/blockassembly"
 	blockchain2 "github.com/bitcoin-sv/teranode/services/blockchain"
 	"github.com/bitcoin-sv/teranode/services/blockvalidation"
@@ -22,16 +25,22 @@ import (
 	"github.com/bitcoin-sv/teranode/services/validator"
 	blob_memory "github.com/bitcoin-sv/teranode/stores/blob/memory"
 	blockchainstore "github.com/bitcoin-sv/teranode/stores/blockchain"
+	"github.com/bitcoin-sv/teranode/stores/utxo"
+	"github.com/bitcoin-sv/teranode/stores/utxo/meta"
+	"github.com/bitcoin-sv/teranode/stores/utxo/nullstore"
 	"github.com/bitcoin-sv/teranode/stores/utxo/sql"
 	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bitcoin-sv/teranode/util/kafka"
 	kafkamessage "github.com/bitcoin-sv/teranode/util/kafka/kafka_message"
 	"github.com/bitcoin-sv/teranode/util/test"
+	"github.com/bsv-blockchain/go-bt/v2"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/bsv-blockchain/go-chaincfg"
 	txmap "github.com/bsv-blockchain/go-tx-map"
 	"github.com/bsv-blockchain/go-wire"
+	"github.com/ordishs/go-utils/expiringmap"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 )
@@ -388,6 +397,62 @@ func TestSyncManager_QueueInv(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	t.Run("tx message in degraded mode", func(t *testing.T) {
+		msgChan, legacyKafkaInvCh, sm, smPeer := setupQueueInvTests()
+		sm.legacyKafkaInvDegraded.Store(true)
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+
+		go func() {
+			msg := <-msgChan
+			wireInvMsg, ok := msg.(*invMsg)
+			require.True(t, ok)
+			assert.Len(t, wireInvMsg.inv.InvList, 2)
+			wg.Done()
+		}()
+
+		go func() {
+			// no message should be sent here
+			msg := <-legacyKafkaInvCh
+			require.Nil(t, msg)
+		}()
+
+		inv := &wire.MsgInv{}
+		err := inv.AddInvVect(&wire.InvVect{Type: wire.InvTypeTx, Hash: chainhash.Hash{}})
+		require.NoError(t, err)
+		err = inv.AddInvVect(&wire.InvVect{Type: wire.InvTypeBlock, Hash: chainhash.Hash{}})
+		require.NoError(t, err)
+
+		sm.QueueInv(inv, smPeer)
+
+		wg.Wait()
+	})
+}
+
+func TestSyncManager_checkLegacyKafkaInvProducer(t *testing.T) {
+	sm := &SyncManager{logger: ulogger.TestLogger{}}
+
+	healthy := func(_ context.Context, _ bool) (int, string, error) {
+		return http.StatusOK, "Kafka is healthy", nil
+	}
+
+	unhealthy := func(_ context.Context, _ bool) (int, string, error) {
+		return http.StatusServiceUnavailable, "Failed to connect to Kafka", errors.NewServiceError("connection refused")
+	}
+
+	sm.checkLegacyKafkaInvProducer(context.Background(), healthy)
+	assert.False(t, sm.legacyKafkaInvDegraded.Load())
+
+	sm.checkLegacyKafkaInvProducer(context.Background(), unhealthy)
+	assert.True(t, sm.legacyKafkaInvDegraded.Load())
+
+	sm.checkLegacyKafkaInvProducer(context.Background(), unhealthy)
+	assert.True(t, sm.legacyKafkaInvDegraded.Load())
+
+	sm.checkLegacyKafkaInvProducer(context.Background(), healthy)
+	assert.False(t, sm.legacyKafkaInvDegraded.Load())
 }
 
 func setupQueueInvTests() (chan interface{}, chan *kafka.Message, *SyncManager, *peer.Peer) {
@@ -748,3 +813,734 @@ func createSpendingTx(prevTx *bsvutil.Tx, index uint32, scriptSig []byte, addres
 
 	return bsvutil.NewTx(spendTx), nil
 }
+
+func TestSyncManager_handleInvalidBlock(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.MaxInvalidBlocks = 2
+	tSettings.Legacy.InvalidBlockBanDuration = time.Minute
+
+	sm := &SyncManager{
+		settings:    tSettings,
+		peerStates:  txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+		bannedPeers: expiringmap.New[string, struct{}](tSettings.Legacy.InvalidBlockBanDuration),
+	}
+
+	badPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+	require.NoError(t, err)
+
+	reconnectedPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:18333")
+	require.NoError(t, err)
+
+	otherPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.2:8333")
+	require.NoError(t, err)
+
+	state := &peerSyncState{}
+	sm.peerStates.Set(badPeer, state)
+
+	sm.handleInvalidBlock(badPeer, state, &chainhash.Hash{0x01})
+	assert.Equal(t, 1, state.invalidBlockCount)
+	assert.False(t, sm.isPeerBanned(badPeer))
+
+	sm.handleInvalidBlock(badPeer, state, &chainhash.Hash{0x02})
+	assert.Equal(t, 2, state.invalidBlockCount)
+	assert.True(t, sm.isPeerBanned(badPeer))
+
+	// the ban is keyed by host, so a reconnect from another port is banned as well
+	assert.True(t, sm.isPeerBanned(reconnectedPeer))
+	assert.False(t, sm.isPeerBanned(otherPeer))
+}
+
+func TestSyncManager_validateHeader(t *testing.T) {
+	sm := &SyncManager{
+		ctx:      context.Background(),
+		settings: test.CreateBaseTestSettings(t),
+	}
+
+	header := chaincfg.MainNetParams.GenesisBlock.Header
+	require.NoError(t, sm.validateHeader(&header))
+
+	// a different nonce does not meet the target difficulty of the genesis block
+	header.Nonce++
+	require.Error(t, sm.validateHeader(&header))
+}
+
+func TestSyncManager_allowPeerTx(t *testing.T) {
+	initPrometheusMetrics()
+
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.PeerTxRateLimit = 2
+
+	peerNotifier := NewMockPeerNotifier()
+
+	sm := &SyncManager{
+		settings:     tSettings,
+		peerNotifier: peerNotifier,
+	}
+
+	floodingPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+	require.NoError(t, err)
+
+	t.Run("limit disabled", func(t *testing.T) {
+		state := &peerSyncState{txLimiter: newPeerTxLimiter(0)}
+
+		for i := 0; i < 10; i++ {
+			assert.True(t, sm.allowPeerTx(floodingPeer, state))
+		}
+	})
+
+	t.Run("txs over the limit are dropped and add ban score", func(t *testing.T) {
+		state := &peerSyncState{txLimiter: newPeerTxLimiter(tSettings.Legacy.PeerTxRateLimit)}
+
+		// the burst is one second of transactions
+		assert.True(t, sm.allowPeerTx(floodingPeer, state))
+		assert.True(t, sm.allowPeerTx(floodingPeer, state))
+
+		assert.False(t, sm.allowPeerTx(floodingPeer, state))
+		assert.Empty(t, peerNotifier.addBanScoreChan)
+
+		// a full burst of dropped transactions adds ban score
+		assert.False(t, sm.allowPeerTx(floodingPeer, state))
+		assert.Equal(t, uint64(2), state.droppedTxs.Load())
+
+		require.Len(t, peerNotifier.addBanScoreChan, 1)
+
+		call := <-peerNotifier.addBanScoreChan
+		assert.Equal(t, floodingPeer, call.peer)
+		assert.Equal(t, uint32(0), call.persistent)
+		assert.Equal(t, uint32(txRateLimitBanScore), call.transient)
+	})
+}
+
+func TestSyncManager_handleBlockMsg_Errors(t *testing.T) {
+	initPrometheusMetrics()
+
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.MaxInvalidBlocks = 3
+
+	// a block with only a coinbase, whose header does not meet the proof of work target
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0xffffffff), []byte{0x51, 0x51}))
+	coinbase.AddTxOut(wire.NewTxOut(5000000000, []byte{0x51}))
+
+	msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(1, &chainhash.Hash{0x01}, &chainhash.Hash{0x02}, 0x1d00ffff, 0))
+	require.NoError(t, msgBlock.AddTransaction(coinbase))
+
+	blockHash := msgBlock.BlockHash()
+
+	setup := func(t *testing.T, blockExistsErr error) (*SyncManager, *peer.Peer, *peerSyncState) {
+		runningState := blockchain2.FSMStateRUNNING
+
+		blockchainClient := &blockchain2.Mock{}
+		blockchainClient.On("GetFSMCurrentState", mock.Anything).Return(&runningState, nil)
+		blockchainClient.On("GetBlockExists", mock.Anything, mock.Anything).Return(false, blockExistsErr)
+		blockchainClient.On("GetBlockHeader", mock.Anything, mock.Anything).Return(&model.BlockHeader{}, &model.BlockHeaderMeta{Height: 1}, nil)
+
+		sm := &SyncManager{
+			ctx:              context.Background(),
+			settings:         tSettings,
+			logger:           ulogger.TestLogger{},
+			chainParams:      &chaincfg.RegressionNetParams,
+			blockchainClient: blockchainClient,
+			requestedBlocks:  expiringmap.New[chainhash.Hash, time.Time](time.Minute),
+			peerStates:       txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+			bannedPeers:      expiringmap.New[string, struct{}](time.Minute),
+			headerList:       list.New(),
+
+			blockProcessingAttempts: expiringmap.New[chainhash.Hash, int](time.Minute),
+		}
+
+		p, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+		require.NoError(t, err)
+
+		state := &peerSyncState{
+			requestedBlocks: expiringmap.New[chainhash.Hash, time.Time](time.Minute),
+		}
+		sm.peerStates.Set(p, state)
+
+		return sm, p, state
+	}
+
+	t.Run("invalid block is rejected without stopping the manager", func(t *testing.T) {
+		sm, p, state := setup(t, nil)
+
+		for i := 1; i <= 2; i++ {
+			require.NotPanics(t, func() {
+				err := sm.handleBlockMsg(&blockQueueMsg{block: msgBlock, blockHash: blockHash, peer: p})
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, errors.ErrBlockInvalid))
+			})
+
+			assert.Equal(t, i, state.invalidBlockCount)
+		}
+
+		// the block is not requested again
+		_, requested := sm.requestedBlocks.Get(blockHash)
+		assert.False(t, requested)
+		assert.False(t, sm.isPeerBanned(p))
+	})
+
+	t.Run("service error requests the block again", func(t *testing.T) {
+		sm, p, state := setup(t, errors.NewServiceError("blockchain unavailable"))
+
+		require.NotPanics(t, func() {
+			err := sm.handleBlockMsg(&blockQueueMsg{block: msgBlock, blockHash: blockHash, peer: p})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrServiceError))
+		})
+
+		assert.Equal(t, 0, state.invalidBlockCount)
+
+		_, requested := sm.requestedBlocks.Get(blockHash)
+		assert.True(t, requested)
+
+		_, requested = state.requestedBlocks.Get(blockHash)
+		assert.True(t, requested)
+
+		attempts, _ := sm.blockProcessingAttempts.Get(blockHash)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("block that keeps failing is not requested forever", func(t *testing.T) {
+		sm, p, state := setup(t, errors.NewServiceError("blockchain unavailable"))
+		sm.blockProcessingAttempts.Set(blockHash, maxBlockProcessingAttempts)
+
+		err := sm.handleBlockMsg(&blockQueueMsg{block: msgBlock, blockHash: blockHash, peer: p})
+		require.Error(t, err)
+
+		_, requested := sm.requestedBlocks.Get(blockHash)
+		assert.False(t, requested)
+
+		_, requested = state.requestedBlocks.Get(blockHash)
+		assert.False(t, requested)
+
+		_, tracked := sm.blockProcessingAttempts.Get(blockHash)
+		assert.False(t, tracked)
+	})
+
+	t.Run("processing error does not count as invalid block", func(t *testing.T) {
+		sm, p, state := setup(t, errors.NewProcessingError("could not process block"))
+
+		require.NotPanics(t, func() {
+			err := sm.handleBlockMsg(&blockQueueMsg{block: msgBlock, blockHash: blockHash, peer: p})
+			require.Error(t, err)
+			assert.False(t, errors.Is(err, errors.ErrBlockInvalid))
+		})
+
+		assert.Equal(t, 0, state.invalidBlockCount)
+		assert.False(t, sm.isPeerBanned(p))
+	})
+}
+
+func TestSyncManager_addOrphanTx(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.MaxOrphanTxs = 2
+
+	setup := func() (*SyncManager, *validator.MockValidatorClient) {
+		validationClient := &validator.MockValidatorClient{
+			Errors: []error{errors.NewTxMissingParentError("parent still missing")},
+		}
+
+		return &SyncManager{
+			ctx:              context.Background(),
+			settings:         tSettings,
+			logger:           ulogger.TestLogger{},
+			validationClient: validationClient,
+			orphanTxs:        expiringmap.New[chainhash.Hash, *orphanTxAndParents](time.Minute),
+		}, validationClient
+	}
+
+	newOrphanTx := func() *orphanTxAndParents {
+		return &orphanTxAndParents{
+			tx:      bt.NewTx(),
+			parents: txmap.NewSyncedMap[chainhash.Hash, struct{}](),
+			addedAt: time.Now(),
+		}
+	}
+
+	t.Run("oldest orphan is evicted when the pool is full", func(t *testing.T) {
+		sm, validationClient := setup()
+
+		for i := 0; i < 3; i++ {
+			assert.True(t, sm.addOrphanTx(chainhash.Hash{byte(i)}, newOrphanTx()))
+		}
+
+		assert.Equal(t, 2, sm.orphanTxs.Len())
+		assert.Equal(t, 2, sm.orphanTxOrder.Len())
+
+		_, exists := sm.orphanTxs.Get(chainhash.Hash{0})
+		assert.False(t, exists, "the oldest orphan should have been evicted")
+
+		// the evicted orphan is validated one last time in the background
+		assert.Eventually(t, func() bool {
+			validationClient.ErrorsMu.Lock()
+			defer validationClient.ErrorsMu.Unlock()
+
+			return len(validationClient.Errors) == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("orphan already in the pool is not added again", func(t *testing.T) {
+		sm, _ := setup()
+
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{1}, newOrphanTx()))
+		assert.False(t, sm.addOrphanTx(chainhash.Hash{1}, newOrphanTx()))
+
+		assert.Equal(t, 1, sm.orphanTxs.Len())
+		assert.Equal(t, 1, sm.orphanTxOrder.Len())
+	})
+
+	t.Run("removed orphans are not evicted", func(t *testing.T) {
+		sm, _ := setup()
+
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{0}, newOrphanTx()))
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{1}, newOrphanTx()))
+
+		sm.removeOrphanTx(chainhash.Hash{0})
+		assert.Equal(t, 1, sm.orphanTxOrder.Len())
+
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{2}, newOrphanTx()))
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{3}, newOrphanTx()))
+		assert.Equal(t, 2, sm.orphanTxs.Len())
+
+		_, exists := sm.orphanTxs.Get(chainhash.Hash{1})
+		assert.False(t, exists, "the oldest orphan left in the pool should have been evicted")
+
+		_, exists = sm.orphanTxs.Get(chainhash.Hash{2})
+		assert.True(t, exists)
+	})
+
+	t.Run("expired orphan is removed from the order", func(t *testing.T) {
+		sm, validationClient := setup()
+
+		orphanTx := newOrphanTx()
+		assert.True(t, sm.addOrphanTx(chainhash.Hash{0}, orphanTx))
+
+		sm.orphanTxs.Delete(chainhash.Hash{0})
+		assert.True(t, sm.evictOrphanTx(chainhash.Hash{0}, orphanTx))
+
+		assert.Eventually(t, func() bool {
+			sm.orphanTxOrderMu.Lock()
+			defer sm.orphanTxOrderMu.Unlock()
+
+			return sm.orphanTxOrder.Len() == 0
+		}, time.Second, time.Millisecond)
+
+		assert.Eventually(t, func() bool {
+			validationClient.ErrorsMu.Lock()
+			defer validationClient.ErrorsMu.Unlock()
+
+			return len(validationClient.Errors) == 0
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestSyncManager_processOrphanTransactions_LongChain(t *testing.T) {
+	initPrometheusMetrics()
+
+	utxoStore, err := nullstore.NewNullStore()
+	require.NoError(t, err)
+
+	sm := &SyncManager{
+		ctx:              context.Background(),
+		logger:           ulogger.TestLogger{},
+		validationClient: &validator.MockValidatorClient{UtxoStore: utxoStore},
+		orphanTxs:        expiringmap.New[chainhash.Hash, *orphanTxAndParents](time.Minute),
+	}
+
+	const chainLength = 5_000
+
+	rootTx := bt.NewTx()
+	require.NoError(t, rootTx.AddP2PKHOutputFromAddress("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", 1000))
+
+	txs := make([]*bt.Tx, 0, chainLength)
+	parentTx := rootTx
+
+	for i := 0; i < chainLength; i++ {
+		tx := bt.NewTx()
+		tx.Inputs = []*bt.Input{{
+			PreviousTxSatoshis: 1000,
+			PreviousTxOutIndex: 0,
+			SequenceNumber:     0xffffffff,
+		}}
+		require.NoError(t, tx.Inputs[0].PreviousTxIDAdd(parentTx.TxIDChainHash()))
+		require.NoError(t, tx.AddP2PKHOutputFromAddress("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", 1000))
+
+		txs = append(txs, tx)
+		parentTx = tx
+	}
+
+	// the orphans arrive in reverse order, every one of them is missing its parent
+	for i := chainLength - 1; i >= 0; i-- {
+		parents := txmap.NewSyncedMap[chainhash.Hash, struct{}]()
+		parents.Set(*txs[i].Inputs[0].PreviousTxIDChainHash(), struct{}{})
+
+		sm.orphanTxs.Set(*txs[i].TxIDChainHash(), &orphanTxAndParents{
+			tx:      txs[i],
+			parents: parents,
+			addedAt: time.Now(),
+		})
+	}
+
+	acceptedTxs := make([]*TxHashAndFee, 0, chainLength)
+	sm.processOrphanTransactions(context.Background(), rootTx.TxIDChainHash(), &acceptedTxs)
+
+	require.Len(t, acceptedTxs, chainLength)
+	assert.Equal(t, 0, sm.orphanTxs.Len())
+
+	for i, accepted := range acceptedTxs {
+		assert.Equal(t, *txs[i].TxIDChainHash(), accepted.TxHash)
+	}
+}
+
+func TestSyncManager_retryStalledBlockRequests(t *testing.T) {
+	initPrometheusMetrics()
+
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.BlockRequestTimeout = time.Minute
+
+	sm := &SyncManager{
+		ctx:             context.Background(),
+		settings:        tSettings,
+		logger:          ulogger.TestLogger{},
+		requestedBlocks: expiringmap.New[chainhash.Hash, time.Time](time.Hour),
+		peerStates:      txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+		bannedPeers:     expiringmap.New[string, struct{}](time.Minute),
+	}
+
+	p, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+	require.NoError(t, err)
+
+	state := &peerSyncState{
+		syncCandidate:   true,
+		requestedBlocks: expiringmap.New[chainhash.Hash, time.Time](time.Hour),
+	}
+	sm.peerStates.Set(p, state)
+
+	stalledHash := chainhash.Hash{1}
+	pendingHash := chainhash.Hash{2}
+	deliveredHash := chainhash.Hash{3}
+
+	for hash, requestedAt := range map[chainhash.Hash]time.Time{
+		stalledHash:   time.Now().Add(-2 * time.Minute),
+		pendingHash:   time.Now(),
+		deliveredHash: time.Now().Add(-2 * time.Minute),
+	} {
+		sm.requestedBlocks.Set(hash, requestedAt)
+		state.requestedBlocks.Set(hash, requestedAt)
+	}
+
+	sm.markBlockDelivered(p, deliveredHash)
+
+	sm.retryStalledBlockRequests()
+
+	// the stalled block is requested again, there is no other peer to request it from
+	requestedAt, requested := state.requestedBlocks.Get(stalledHash)
+	require.True(t, requested)
+	assert.WithinDuration(t, time.Now(), requestedAt, 10*time.Second)
+
+	requestedAt, requested = sm.requestedBlocks.Get(stalledHash)
+	require.True(t, requested)
+	assert.WithinDuration(t, time.Now(), requestedAt, 10*time.Second)
+
+	// the delivered block is waiting to be processed and is not retried
+	requestedAt, requested = state.requestedBlocks.Get(deliveredHash)
+	require.True(t, requested)
+	assert.True(t, requestedAt.IsZero())
+
+	_, requested = state.requestedBlocks.Get(pendingHash)
+	assert.True(t, requested)
+}
+
+type mockPropagationClient struct {
+	err error
+	txs []*bt.Tx
+}
+
+func (m *mockPropagationClient) ProcessTransaction(_ context.Context, tx *bt.Tx) error {
+	m.txs = append(m.txs, tx)
+
+	return m.err
+}
+
+func TestSyncManager_handleTxMsg_ForwardToPropagation(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+	tSettings.Legacy.TxForwardToPropagation = true
+
+	msgTx := wire.NewMsgTx(1)
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1000, []byte{txscript.OP_TRUE}))
+	tx := bsvutil.NewTx(msgTx)
+
+	setup := func(t *testing.T, propagationErr error) (*SyncManager, *peer.Peer, *mockPropagationClient, *validator.MockValidatorClient) {
+		validationClient := &validator.MockValidatorClient{
+			Errors: []error{errors.NewProcessingError("validator should not be called")},
+		}
+
+		propagationClient := &mockPropagationClient{err: propagationErr}
+
+		sm := &SyncManager{
+			ctx:               context.Background(),
+			settings:          tSettings,
+			logger:            ulogger.TestLogger{},
+			validationClient:  validationClient,
+			propagationClient: propagationClient,
+			utxoStore:         &utxo.MockUtxostore{},
+			peerNotifier:      NewMockPeerNotifier(),
+			rejectedTxns:      txmap.NewSyncedMap[chainhash.Hash, struct{}](),
+			requestedTxns:     expiringmap.New[chainhash.Hash, struct{}](time.Minute),
+			orphanTxs:         expiringmap.New[chainhash.Hash, *orphanTxAndParents](time.Minute),
+			peerStates:        txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+			recentTxs:         newRecentTxFilter(1_000, 1e-6),
+		}
+
+		p, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+		require.NoError(t, err)
+
+		sm.peerStates.Set(p, &peerSyncState{
+			requestedTxns: expiringmap.New[chainhash.Hash, struct{}](time.Minute),
+		})
+
+		return sm, p, propagationClient, validationClient
+	}
+
+	t.Run("missing parent adds the transaction to the orphan pool", func(t *testing.T) {
+		sm, p, propagationClient, validationClient := setup(t, errors.NewTxMissingParentError("parent missing"))
+
+		sm.handleTxMsg(&txMsg{tx: tx, peer: p})
+
+		require.Len(t, propagationClient.txs, 1)
+		assert.Equal(t, *tx.Hash(), *propagationClient.txs[0].TxIDChainHash())
+		assert.Len(t, validationClient.Errors, 1)
+
+		orphanTx, exists := sm.orphanTxs.Get(*tx.Hash())
+		require.True(t, exists)
+
+		_, hasParent := orphanTx.parents.Get(chainhash.Hash{1})
+		assert.True(t, hasParent)
+	})
+
+	t.Run("rejected transaction is not requested again", func(t *testing.T) {
+		sm, p, _, validationClient := setup(t, errors.NewTxInvalidError("invalid"))
+
+		sm.handleTxMsg(&txMsg{tx: tx, peer: p})
+
+		assert.Len(t, validationClient.Errors, 1)
+		assert.Equal(t, 0, sm.orphanTxs.Len())
+
+		_, rejected := sm.rejectedTxns.Get(*tx.Hash())
+		assert.True(t, rejected)
+	})
+
+	t.Run("validated transaction is announced", func(t *testing.T) {
+		sm, p, propagationClient, validationClient := setup(t, nil)
+
+		utxoStore := sm.utxoStore.(*utxo.MockUtxostore)
+		utxoStore.On("Get", mock.Anything, tx.Hash(), mock.Anything).Return(&meta.Data{Fee: 100}, nil).Once()
+
+		sm.handleTxMsg(&txMsg{tx: tx, peer: p})
+
+		require.Len(t, propagationClient.txs, 1)
+		assert.Len(t, validationClient.Errors, 1)
+
+		peerNotifier := sm.peerNotifier.(*MockPeerNotifier)
+		require.Len(t, peerNotifier.announceNewTransactionsChan, 1)
+
+		call := <-peerNotifier.announceNewTransactionsChan
+		require.Len(t, call.newTxs, 1)
+		assert.Equal(t, *tx.Hash(), call.newTxs[0].TxHash)
+		assert.Equal(t, uint64(100), call.newTxs[0].Fee)
+
+		assert.True(t, sm.recentTxs.Has(tx.Hash()))
+		assert.Equal(t, 0, sm.orphanTxs.Len())
+	})
+
+	t.Run("transaction validated asynchronously is not announced or rejected", func(t *testing.T) {
+		sm, p, _, _ := setup(t, nil)
+
+		utxoStore := sm.utxoStore.(*utxo.MockUtxostore)
+		utxoStore.On("Get", mock.Anything, tx.Hash(), mock.Anything).Return(nil, errors.NewTxNotFoundError("not found")).Once()
+
+		sm.handleTxMsg(&txMsg{tx: tx, peer: p})
+
+		// the transaction is looked up once, without waiting for the validation or looking up its parents
+		utxoStore.AssertNumberOfCalls(t, "Get", 1)
+
+		assert.Equal(t, 0, sm.orphanTxs.Len())
+
+		_, rejected := sm.rejectedTxns.Get(*tx.Hash())
+		assert.False(t, rejected)
+		assert.Empty(t, sm.peerNotifier.(*MockPeerNotifier).announceNewTransactionsChan)
+	})
+}
+
+func TestSyncManager_setSyncPeerConfig(t *testing.T) {
+	t.Run("falls back to settings", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		sm := &SyncManager{}
+
+		require.NoError(t, sm.setSyncPeerConfig(tSettings, &Config{}))
+		assert.Equal(t, tSettings.Legacy.SyncPeerMaxLastBlockTime, sm.maxLastBlockTime)
+		assert.Equal(t, tSettings.Legacy.SyncPeerMinInFlightBlocks, sm.minInFlightBlocks)
+		assert.Equal(t, tSettings.Legacy.SyncPeerCheckInterval, sm.syncPeerCheckInterval)
+	})
+
+	t.Run("config overrides settings", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+		sm := &SyncManager{}
+
+		require.NoError(t, sm.setSyncPeerConfig(tSettings, &Config{
+			MaxLastBlockTime:      time.Second,
+			MinInFlightBlocks:     2,
+			SyncPeerCheckInterval: 100 * time.Millisecond,
+		}))
+		assert.Equal(t, time.Second, sm.maxLastBlockTime)
+		assert.Equal(t, 2, sm.minInFlightBlocks)
+		assert.Equal(t, 100*time.Millisecond, sm.syncPeerCheckInterval)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		tSettings := test.CreateBaseTestSettings(t)
+
+		for _, config := range []*Config{
+			{MaxLastBlockTime: -time.Second},
+			{MinInFlightBlocks: -1},
+			{MinInFlightBlocks: maxRequestedBlocks + 1},
+			{SyncPeerCheckInterval: -time.Second},
+		} {
+			err := (&SyncManager{}).setSyncPeerConfig(tSettings, config)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrConfiguration))
+		}
+	})
+}
+
+func TestSyncManager_PeerControl(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	setup := func(t *testing.T) (*SyncManager, *peer.Peer, *peer.Peer) {
+		sm := &SyncManager{
+			logger:     ulogger.TestLogger{},
+			settings:   tSettings,
+			peerStates: txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+		}
+
+		syncPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.1:8333")
+		require.NoError(t, err)
+
+		otherPeer, err := peer.NewOutboundPeer(ulogger.TestLogger{}, tSettings, &peer.Config{}, "10.0.0.2:8333")
+		require.NoError(t, err)
+
+		sm.peerStates.Set(syncPeer, &peerSyncState{syncCandidate: true})
+		sm.peerStates.Set(otherPeer, &peerSyncState{})
+		sm.syncPeer = syncPeer
+
+		return sm, syncPeer, otherPeer
+	}
+
+	t.Run("peer infos", func(t *testing.T) {
+		sm, _, _ := setup(t)
+
+		infos := sm.peerInfos()
+		require.Len(t, infos, 2)
+
+		byAddr := make(map[string]PeerInfo, len(infos))
+		for _, info := range infos {
+			byAddr[info.Addr] = info
+		}
+
+		assert.True(t, byAddr["10.0.0.1:8333"].SyncCandidate)
+		assert.True(t, byAddr["10.0.0.1:8333"].SyncPeer)
+		assert.False(t, byAddr["10.0.0.2:8333"].SyncCandidate)
+		assert.False(t, byAddr["10.0.0.2:8333"].SyncPeer)
+	})
+
+	t.Run("disconnect peer", func(t *testing.T) {
+		sm, syncPeer, otherPeer := setup(t)
+
+		require.NoError(t, sm.disconnectPeer("10.0.0.2:8333"))
+
+		// the peer is only disconnected, it is removed when its done peer message is processed
+		assert.Equal(t, 2, sm.peerStates.Length())
+
+		done := make(chan struct{})
+
+		go func() {
+			otherPeer.WaitForDisconnect()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("peer was not disconnected")
+		}
+
+		assert.Same(t, syncPeer, sm.syncPeer)
+	})
+
+	t.Run("disconnect unknown peer", func(t *testing.T) {
+		sm, _, _ := setup(t)
+
+		err := sm.disconnectPeer("10.0.0.3:8333")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrInvalidArgument))
+	})
+}
+
+func TestSyncManager_OrphanTxInfos(t *testing.T) {
+	sm := &SyncManager{
+		logger:    ulogger.TestLogger{},
+		orphanTxs: expiringmap.New[chainhash.Hash, *orphanTxAndParents](time.Minute),
+	}
+
+	now := time.Now()
+	missingParent := chainhash.Hash{0xff}
+
+	// orphan 2 is waiting for orphan 1, which is waiting for a missing parent
+	for i, addedAt := range []time.Time{now.Add(-time.Minute), now.Add(-2 * time.Minute), now} {
+		parents := txmap.NewSyncedMap[chainhash.Hash, struct{}]()
+		if i == 2 {
+			parents.Set(chainhash.Hash{1}, struct{}{})
+		}
+
+		parents.Set(missingParent, struct{}{})
+
+		sm.orphanTxs.Set(chainhash.Hash{byte(i)}, &orphanTxAndParents{
+			tx:      bt.NewTx(),
+			parents: parents,
+			addedAt: addedAt,
+		})
+	}
+
+	t.Run("ordered by age", func(t *testing.T) {
+		infos, total := sm.OrphanTxInfos(0, 10)
+		assert.Equal(t, 3, total)
+		require.Len(t, infos, 3)
+
+		assert.Equal(t, chainhash.Hash{1}, infos[0].TxHash)
+		assert.Equal(t, chainhash.Hash{0}, infos[1].TxHash)
+		assert.Equal(t, chainhash.Hash{2}, infos[2].TxHash)
+
+		assert.Equal(t, []chainhash.Hash{missingParent}, infos[0].Parents)
+		assert.Empty(t, infos[0].OrphanParents)
+
+		assert.Equal(t, []chainhash.Hash{{1}, missingParent}, infos[2].Parents)
+		assert.Equal(t, []chainhash.Hash{{1}}, infos[2].OrphanParents)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		infos, total := sm.OrphanTxInfos(1, 1)
+		assert.Equal(t, 3, total)
+		require.Len(t, infos, 1)
+		assert.Equal(t, chainhash.Hash{0}, infos[0].TxHash)
+
+		infos, total = sm.OrphanTxInfos(2, 10)
+		assert.Equal(t, 3, total)
+		require.Len(t, infos, 1)
+		assert.Equal(t, chainhash.Hash{2}, infos[0].TxHash)
+
+		infos, total = sm.OrphanTxInfos(3, 10)
+		assert.Equal(t, 3, total)
+		assert.Empty(t, infos)
+	})
+}
@@ -26,7 +26,15 @@ var (
 	prometheusLegacyNetsyncBlockTxExtend                  prometheus.Histogram
 	prometheusLegacyNetsyncBlockTxValidate                prometheus.Histogram
 	prometheusLegacyNetsyncOrphans                        prometheus.Gauge
+	prometheusLegacyNetsyncOrphanBytes                    prometheus.Gauge
 	prometheusLegacyNetsyncOrphanTime                     prometheus.Histogram
+	prometheusLegacyNetsyncBlockRequestTimeouts           prometheus.Counter
+	prometheusLegacyNetsyncRecentTxFilterHits             prometheus.Counter
+	prometheusLegacyNetsyncRecentTxFilterMisses           prometheus.Counter
+	prometheusLegacyNetsyncTxRateLimited                  prometheus.Counter
+	prometheusLegacyNetsyncPeerBytesReceived              *prometheus.CounterVec
+	prometheusLegacyNetsyncPeerBlocksReceived             *prometheus.CounterVec
+	prometheusLegacyNetsyncPeerTxsReceived                *prometheus.CounterVec
 
 	prometheusMetricsInitOnce sync.Once
 )
@@ -196,6 +204,14 @@ func _initPrometheusMetrics() {
 	})
 	prometheus.MustRegister(prometheusLegacyNetsyncOrphans)
 
+	prometheusLegacyNetsyncOrphanBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "orphan_bytes",
+		Help:      "The total size in bytes of the orphan transactions",
+	})
+	prometheus.MustRegister(prometheusLegacyNetsyncOrphanBytes)
+
 	prometheusLegacyNetsyncOrphanTime = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: "teranode",
 		Subsystem: "legacy_netsync",
@@ -204,4 +220,60 @@ func _initPrometheusMetrics() {
 		Buckets:   util.MetricsBucketsSeconds,
 	})
 	prometheus.MustRegister(prometheusLegacyNetsyncOrphanTime)
+
+	prometheusLegacyNetsyncBlockRequestTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "block_request_timeouts",
+		Help:      "The number of block requests that were not answered in time",
+	})
+	prometheus.MustRegister(prometheusLegacyNetsyncBlockRequestTimeouts)
+
+	prometheusLegacyNetsyncRecentTxFilterHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "recent_tx_filter_hits",
+		Help:      "The number of tx inventory checks answered by the recent transactions filter",
+	})
+	prometheus.MustRegister(prometheusLegacyNetsyncRecentTxFilterHits)
+
+	prometheusLegacyNetsyncRecentTxFilterMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "recent_tx_filter_misses",
+		Help:      "The number of tx inventory checks not found in the recent transactions filter, which are looked up in the utxo store",
+	})
+	prometheus.MustRegister(prometheusLegacyNetsyncRecentTxFilterMisses)
+
+	prometheusLegacyNetsyncTxRateLimited = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "tx_rate_limited",
+		Help:      "The number of transactions dropped because the peer exceeded its tx rate limit",
+	})
+	prometheus.MustRegister(prometheusLegacyNetsyncTxRateLimited)
+
+	prometheusLegacyNetsyncPeerBytesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "peer_bytes_received",
+		Help:      "The size of the blocks and transactions received, per peer",
+	}, []string{"peer"})
+	prometheus.MustRegister(prometheusLegacyNetsyncPeerBytesReceived)
+
+	prometheusLegacyNetsyncPeerBlocksReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "peer_blocks_received",
+		Help:      "The number of blocks received, per peer",
+	}, []string{"peer"})
+	prometheus.MustRegister(prometheusLegacyNetsyncPeerBlocksReceived)
+
+	prometheusLegacyNetsyncPeerTxsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "teranode",
+		Subsystem: "legacy_netsync",
+		Name:      "peer_txs_received",
+		Help:      "The number of transactions received, per peer",
+	}, []string{"peer"})
+	prometheus.MustRegister(prometheusLegacyNetsyncPeerTxsReceived)
 }
@@ -0,0 +1,127 @@
+package netsync
+
+import (
+	"math/rand/v2"
+	"time"
+
+	peerpkg "github.com/bitcoin-sv/teranode/services/legacy/peer"
+)
+
+const (
+	// peerScoreSmoothing is the weight of the last tick in the rolling
+	// throughput score of a peer.
+	peerScoreSmoothing = 0.3
+
+	// syncPeerTopTierRatio is the fraction of the best throughput score a
+	// candidate needs to be in the top tier, the sync peer is selected
+	// randomly from the top tier.
+	syncPeerTopTierRatio = 0.9
+
+	// slowSyncPeerDemotion is how long a sync peer that was replaced for
+	// being slow is only selected again when no other candidates are available.
+	slowSyncPeerDemotion = 10 * time.Minute
+)
+
+// updateThroughputScore updates the rolling throughput score of the peer in bytes/sec
+// from the total number of bytes received from the peer, called once per sync peer tick.
+func (state *peerSyncState) updateThroughputScore(bytesReceived uint64, tickInterval time.Duration) {
+	// the first tick only sets the baseline
+	if !state.scoreBaselineSet {
+		state.scoreBaselineSet = true
+		state.lastBytesReceived = bytesReceived
+
+		return
+	}
+
+	var recvDiff uint64
+	if bytesReceived > state.lastBytesReceived {
+		recvDiff = bytesReceived - state.lastBytesReceived
+	}
+
+	state.lastBytesReceived = bytesReceived
+
+	throughput := float64(recvDiff) / tickInterval.Seconds()
+
+	if !state.scored {
+		state.scored = true
+		state.throughputScore = throughput
+
+		return
+	}
+
+	state.throughputScore = peerScoreSmoothing*throughput + (1-peerScoreSmoothing)*state.throughputScore
+}
+
+// isDemoted returns whether the peer was recently replaced as sync peer for being slow.
+func (state *peerSyncState) isDemoted(now time.Time) bool {
+	return now.Before(state.demotedUntil)
+}
+
+// updatePeerScores updates the throughput scores of all peers.
+func (sm *SyncManager) updatePeerScores() {
+	for peer, state := range sm.peerStates.Range() {
+		state.updateThroughputScore(peer.BytesReceived(), sm.syncPeerCheckInterval)
+	}
+}
+
+// selectSyncPeer selects the fastest of the candidates, randomly among the candidates
+// with a throughput score close to the best score. Demoted candidates are only
+// selected when all candidates are demoted.
+func (sm *SyncManager) selectSyncPeer(candidates []*peerpkg.Peer) *peerpkg.Peer {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	eligible := make([]*peerpkg.Peer, 0, len(candidates))
+	scores := make([]float64, 0, len(candidates))
+
+	for _, peer := range candidates {
+		state, exists := sm.peerStates.Get(peer)
+		if !exists || state.isDemoted(now) {
+			continue
+		}
+
+		eligible = append(eligible, peer)
+		scores = append(scores, state.throughputScore)
+	}
+
+	if len(eligible) == 0 {
+		sm.logger.Debugf("[selectSyncPeer] all %d candidates are demoted, ignoring demotion", len(candidates))
+
+		for _, peer := range candidates {
+			var score float64
+			if state, exists := sm.peerStates.Get(peer); exists {
+				score = state.throughputScore
+			}
+
+			eligible = append(eligible, peer)
+			scores = append(scores, score)
+		}
+	}
+
+	var bestScore float64
+
+	for _, score := range scores {
+		if score > bestScore {
+			bestScore = score
+		}
+	}
+
+	topTier := make([]*peerpkg.Peer, 0, len(eligible))
+
+	for i, peer := range eligible {
+		if scores[i] >= bestScore*syncPeerTopTierRatio {
+			topTier = append(topTier, peer)
+		}
+	}
+
+	// #nosec G404
+	selected := topTier[rand.IntN(len(topTier))]
+
+	sm.logger.Debugf("[selectSyncPeer] selected peer %s from %d peers in the top tier of %d eligible candidates, best throughput score %.0f bytes/sec",
+		selected.String(), len(topTier), len(eligible), bestScore)
+
+	return selected
+}
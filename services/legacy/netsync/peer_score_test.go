@@ -0,0 +1,108 @@
+package netsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/services/legacy/peer"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	txmap "github.com/bsv-blockchain/go-tx-map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerSyncState_updateThroughputScore(t *testing.T) {
+	tickInterval := 30 * time.Second
+	tickSeconds := uint64(tickInterval.Seconds())
+
+	t.Run("first tick sets the baseline", func(t *testing.T) {
+		state := &peerSyncState{}
+
+		state.updateThroughputScore(1_000_000, tickInterval)
+		assert.False(t, state.scored)
+		assert.Equal(t, uint64(1_000_000), state.lastBytesReceived)
+
+		state.updateThroughputScore(1_000_000+100*tickSeconds, tickInterval)
+		assert.True(t, state.scored)
+		assert.InDelta(t, 100, state.throughputScore, 0.001)
+	})
+
+	t.Run("score is a rolling average", func(t *testing.T) {
+		state := &peerSyncState{scoreBaselineSet: true}
+
+		state.updateThroughputScore(1000*tickSeconds, tickInterval)
+		assert.InDelta(t, 1000, state.throughputScore, 0.001)
+
+		// no bytes received in the last tick
+		state.updateThroughputScore(1000*tickSeconds, tickInterval)
+		assert.InDelta(t, 1000*(1-peerScoreSmoothing), state.throughputScore, 0.001)
+	})
+}
+
+func TestSyncManager_selectSyncPeer(t *testing.T) {
+	setup := func(scores ...float64) (*SyncManager, []*peer.Peer) {
+		sm := &SyncManager{
+			logger:     ulogger.TestLogger{},
+			peerStates: txmap.NewSyncedMap[*peer.Peer, *peerSyncState](),
+		}
+
+		peers := make([]*peer.Peer, 0, len(scores))
+
+		for _, score := range scores {
+			p := &peer.Peer{}
+			sm.peerStates.Set(p, &peerSyncState{throughputScore: score, scored: true})
+			peers = append(peers, p)
+		}
+
+		return sm, peers
+	}
+
+	t.Run("fastest peer is selected", func(t *testing.T) {
+		sm, peers := setup(100, 5000, 200)
+
+		for i := 0; i < 20; i++ {
+			assert.Same(t, peers[1], sm.selectSyncPeer(peers))
+		}
+	})
+
+	t.Run("random selection within the top tier", func(t *testing.T) {
+		sm, peers := setup(1000, 950, 100)
+
+		selected := make(map[*peer.Peer]struct{})
+
+		for i := 0; i < 200; i++ {
+			p := sm.selectSyncPeer(peers)
+			require.NotSame(t, peers[2], p)
+
+			selected[p] = struct{}{}
+		}
+
+		assert.Len(t, selected, 2)
+	})
+
+	t.Run("demoted peer is not selected", func(t *testing.T) {
+		sm, peers := setup(5000, 100)
+
+		state, _ := sm.peerStates.Get(peers[0])
+		state.demotedUntil = time.Now().Add(slowSyncPeerDemotion)
+
+		for i := 0; i < 20; i++ {
+			assert.Same(t, peers[1], sm.selectSyncPeer(peers))
+		}
+	})
+
+	t.Run("demoted peer is selected when all peers are demoted", func(t *testing.T) {
+		sm, peers := setup(5000)
+
+		state, _ := sm.peerStates.Get(peers[0])
+		state.demotedUntil = time.Now().Add(slowSyncPeerDemotion)
+
+		assert.Same(t, peers[0], sm.selectSyncPeer(peers))
+	})
+
+	t.Run("no candidates", func(t *testing.T) {
+		sm, _ := setup()
+
+		assert.Nil(t, sm.selectSyncPeer(nil))
+	})
+}
@@ -0,0 +1,63 @@
+package netsync
+
+const (
+	// maxPeerMetricsLabels is the maximum number of peers that are exposed with their own
+	// address label in the peer metrics, to bound the cardinality of the metrics.
+	maxPeerMetricsLabels = 64
+
+	// peerMetricsOtherLabel is the label of the peer metrics of all peers that are connected
+	// while maxPeerMetricsLabels peers already have their own label.
+	peerMetricsOtherLabel = "other"
+)
+
+// assignPeerMetricsLabel returns the label of the peer in the peer metrics, the address of the
+// peer or peerMetricsOtherLabel when the limit of labelled peers is reached.
+// Only called from the blockHandler thread.
+func (sm *SyncManager) assignPeerMetricsLabel(addr string) string {
+	if sm.peerMetricsLabels >= maxPeerMetricsLabels {
+		return peerMetricsOtherLabel
+	}
+
+	sm.peerMetricsLabels++
+
+	return addr
+}
+
+// releasePeerMetricsLabel removes the metrics of a disconnected peer that had its own label.
+// Only called from the blockHandler thread.
+func (sm *SyncManager) releasePeerMetricsLabel(state *peerSyncState) {
+	if state.metricsLabel == "" || state.metricsLabel == peerMetricsOtherLabel {
+		return
+	}
+
+	sm.peerMetricsLabels--
+
+	if prometheusLegacyNetsyncPeerBytesReceived != nil {
+		prometheusLegacyNetsyncPeerBytesReceived.DeleteLabelValues(state.metricsLabel)
+		prometheusLegacyNetsyncPeerBlocksReceived.DeleteLabelValues(state.metricsLabel)
+		prometheusLegacyNetsyncPeerTxsReceived.DeleteLabelValues(state.metricsLabel)
+	}
+}
+
+// recordBlockReceived adds a block of the given size to the received data of the peer.
+func (state *peerSyncState) recordBlockReceived(size int) {
+	state.blocksReceived.Add(1)
+	state.bytesReceived.Add(uint64(size)) //nolint:gosec
+
+	if state.metricsLabel != "" && prometheusLegacyNetsyncPeerBytesReceived != nil {
+		prometheusLegacyNetsyncPeerBlocksReceived.WithLabelValues(state.metricsLabel).Inc()
+		prometheusLegacyNetsyncPeerBytesReceived.WithLabelValues(state.metricsLabel).Add(float64(size))
+	}
+}
+
+// recordTxReceived adds a transaction of the given size to the received data of the peer.
+// Called concurrently from the tx handlers.
+func (state *peerSyncState) recordTxReceived(size int) {
+	state.txsReceived.Add(1)
+	state.bytesReceived.Add(uint64(size)) //nolint:gosec
+
+	if state.metricsLabel != "" && prometheusLegacyNetsyncPeerBytesReceived != nil {
+		prometheusLegacyNetsyncPeerTxsReceived.WithLabelValues(state.metricsLabel).Inc()
+		prometheusLegacyNetsyncPeerBytesReceived.WithLabelValues(state.metricsLabel).Add(float64(size))
+	}
+}
@@ -0,0 +1,54 @@
+package netsync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncManager_peerMetricsLabel(t *testing.T) {
+	initPrometheusMetrics()
+
+	t.Run("peers over the limit share the other label", func(t *testing.T) {
+		sm := &SyncManager{}
+
+		for i := 0; i < maxPeerMetricsLabels; i++ {
+			addr := fmt.Sprintf("10.0.0.%d:8333", i)
+			assert.Equal(t, addr, sm.assignPeerMetricsLabel(addr))
+		}
+
+		assert.Equal(t, peerMetricsOtherLabel, sm.assignPeerMetricsLabel("10.0.1.0:8333"))
+
+		// a released label makes room for the next peer
+		sm.releasePeerMetricsLabel(&peerSyncState{metricsLabel: "10.0.0.0:8333"})
+		assert.Equal(t, "10.0.1.1:8333", sm.assignPeerMetricsLabel("10.0.1.1:8333"))
+
+		// releasing the other label does not
+		sm.releasePeerMetricsLabel(&peerSyncState{metricsLabel: peerMetricsOtherLabel})
+		assert.Equal(t, peerMetricsOtherLabel, sm.assignPeerMetricsLabel("10.0.1.2:8333"))
+	})
+
+	t.Run("received data is counted", func(t *testing.T) {
+		sm := &SyncManager{}
+		label := sm.assignPeerMetricsLabel("10.0.2.0:8333")
+		state := &peerSyncState{metricsLabel: label}
+
+		state.recordBlockReceived(1000)
+		state.recordTxReceived(250)
+		state.recordTxReceived(250)
+
+		assert.Equal(t, uint64(1), state.blocksReceived.Load())
+		assert.Equal(t, uint64(2), state.txsReceived.Load())
+		assert.Equal(t, uint64(1500), state.bytesReceived.Load())
+
+		assert.InDelta(t, 1, testutil.ToFloat64(prometheusLegacyNetsyncPeerBlocksReceived.WithLabelValues(label)), 0)
+		assert.InDelta(t, 2, testutil.ToFloat64(prometheusLegacyNetsyncPeerTxsReceived.WithLabelValues(label)), 0)
+		assert.InDelta(t, 1500, testutil.ToFloat64(prometheusLegacyNetsyncPeerBytesReceived.WithLabelValues(label)), 0)
+
+		// the metrics of a disconnected peer are removed
+		sm.releasePeerMetricsLabel(state)
+		assert.InDelta(t, 0, testutil.ToFloat64(prometheusLegacyNetsyncPeerBytesReceived.WithLabelValues(label)), 0)
+	})
+}
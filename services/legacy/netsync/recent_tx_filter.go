@@ -0,0 +1,95 @@
+package netsync
+
+import (
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/greatroar/blobloom"
+)
+
+// recentTxFilter is a bloom filter of the transactions that were recently processed, used to answer
+// haveInventory for transactions without a utxo store lookup.
+//
+// The filter has two generations: when the current generation reaches its capacity, it becomes the
+// previous generation and a new, empty generation is started. Lookups check both generations, so a
+// transaction is remembered for at least capacity additions, while the false positive rate stays
+// bounded by the configured rate of each generation.
+//
+// A hit may be a false positive, a miss is always correct: a transaction that was added is never
+// reported as missing until its generation is dropped.
+type recentTxFilter struct {
+	mu       sync.RWMutex
+	config   blobloom.Config
+	current  *blobloom.SyncFilter
+	previous *blobloom.SyncFilter
+	added    atomic.Uint64 // Number of transactions added to the current generation
+}
+
+// newRecentTxFilter creates a filter that remembers at least capacity transactions at the given false positive rate.
+// Returns nil when capacity is 0, which disables the filter.
+func newRecentTxFilter(capacity uint64, fpRate float64) *recentTxFilter {
+	if capacity == 0 {
+		return nil
+	}
+
+	config := blobloom.Config{
+		Capacity: capacity,
+		FPRate:   fpRate,
+	}
+
+	return &recentTxFilter{
+		config:  config,
+		current: blobloom.NewSyncOptimized(config),
+	}
+}
+
+// Add records that the transaction was processed.
+func (f *recentTxFilter) Add(txHash *chainhash.Hash) {
+	if f == nil {
+		return
+	}
+
+	f.mu.RLock()
+	f.current.Add(recentTxFilterKey(txHash))
+	f.mu.RUnlock()
+
+	if f.added.Add(1) >= f.config.Capacity {
+		f.rotate()
+	}
+}
+
+// Has returns whether the transaction was recently processed, which may be a false positive.
+func (f *recentTxFilter) Has(txHash *chainhash.Hash) bool {
+	if f == nil {
+		return false
+	}
+
+	key := recentTxFilterKey(txHash)
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.current.Has(key) || (f.previous != nil && f.previous.Has(key))
+}
+
+// rotate starts a new generation when the current generation is full.
+func (f *recentTxFilter) rotate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// another Add may have rotated the filter while waiting for the lock
+	if f.added.Load() < f.config.Capacity {
+		return
+	}
+
+	f.previous = f.current
+	f.current = blobloom.NewSyncOptimized(f.config)
+	f.added.Store(0)
+}
+
+// recentTxFilterKey returns the bloom filter key of a transaction, the hash is already uniformly distributed.
+func recentTxFilterKey(txHash *chainhash.Hash) uint64 {
+	return binary.BigEndian.Uint64(txHash[:])
+}
@@ -0,0 +1,65 @@
+package netsync
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recentTxFilterTestHash(i uint64) *chainhash.Hash {
+	hash := chainhash.DoubleHashH(binary.LittleEndian.AppendUint64(nil, i))
+	return &hash
+}
+
+func TestRecentTxFilter(t *testing.T) {
+	t.Run("added transactions are found", func(t *testing.T) {
+		filter := newRecentTxFilter(1_000, 1e-6)
+		require.NotNil(t, filter)
+
+		for i := uint64(0); i < 1_000; i++ {
+			filter.Add(recentTxFilterTestHash(i))
+		}
+
+		for i := uint64(0); i < 1_000; i++ {
+			assert.True(t, filter.Has(recentTxFilterTestHash(i)))
+		}
+
+		assert.False(t, filter.Has(recentTxFilterTestHash(1_000_000)))
+	})
+
+	t.Run("previous generation is kept after rotation", func(t *testing.T) {
+		filter := newRecentTxFilter(100, 1e-6)
+
+		for i := uint64(0); i < 150; i++ {
+			filter.Add(recentTxFilterTestHash(i))
+		}
+
+		require.NotNil(t, filter.previous)
+
+		for i := uint64(0); i < 150; i++ {
+			assert.True(t, filter.Has(recentTxFilterTestHash(i)))
+		}
+	})
+
+	t.Run("oldest generation is dropped", func(t *testing.T) {
+		filter := newRecentTxFilter(100, 1e-6)
+
+		for i := uint64(0); i < 200; i++ {
+			filter.Add(recentTxFilterTestHash(i))
+		}
+
+		assert.False(t, filter.Has(recentTxFilterTestHash(0)))
+		assert.True(t, filter.Has(recentTxFilterTestHash(199)))
+	})
+
+	t.Run("disabled filter", func(t *testing.T) {
+		filter := newRecentTxFilter(0, 1e-6)
+		assert.Nil(t, filter)
+
+		filter.Add(recentTxFilterTestHash(0))
+		assert.False(t, filter.Has(recentTxFilterTestHash(0)))
+	})
+}
@@ -0,0 +1,50 @@
+package netsync
+
+import (
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// rejectReason is the reject code and reason that is sent to a peer for a class of validation errors.
+type rejectReason struct {
+	err    *errors.Error
+	code   wire.RejectCode
+	reason string
+}
+
+// rejectReasons maps the typed validation errors to the reject code and reason that are sent to the peer
+// that relayed the rejected transaction or block. The reasons follow the strings used by the reference
+// node, so that peers that surface reject reasons show something familiar to their users.
+// The table is checked in order, the first error that matches is used.
+var rejectReasons = []rejectReason{
+	{errors.ErrTxExists, wire.RejectDuplicate, "txn-already-known"},
+	{errors.ErrTxInvalidDoubleSpend, wire.RejectDuplicate, "txn-double-spend"},
+	{errors.ErrTxConflicting, wire.RejectDuplicate, "txn-mempool-conflict"},
+	// locked outputs are not spendable while conflicting transactions are processed, handleTxMsg keeps their spends as orphans
+	{errors.ErrTxLocked, wire.RejectDuplicate, "txn-mempool-conflict"},
+	{errors.ErrSpent, wire.RejectDuplicate, "bad-txns-inputs-spent"},
+	{errors.ErrTxMissingParent, wire.RejectInvalid, "missing-inputs"},
+	{errors.ErrTxLockTime, wire.RejectNonstandard, "non-final"},
+	{errors.ErrNonFinal, wire.RejectNonstandard, "non-final"},
+	{errors.ErrTxCoinbaseImmature, wire.RejectInvalid, "bad-txns-premature-spend-of-coinbase"},
+	{errors.ErrFrozen, wire.RejectInvalid, "bad-txns-inputs-frozen"},
+	{errors.ErrTxPolicy, wire.RejectNonstandard, "non-standard"},
+	{errors.ErrTxConsensus, wire.RejectInvalid, "mandatory-script-verify-flag-failed"},
+	{errors.ErrTxInvalid, wire.RejectInvalid, "bad-txns"},
+	{errors.ErrBlockExists, wire.RejectDuplicate, "duplicate"},
+	{errors.ErrBlockInvalidFormat, wire.RejectMalformed, "bad-blk-format"},
+	{errors.ErrBlockCoinbaseMissingHeight, wire.RejectInvalid, "bad-cb-height"},
+	{errors.ErrBlockInvalid, wire.RejectInvalid, "bad-blk"},
+}
+
+// rejectCodeFromError returns the reject code and reason to send to a peer for the given validation error.
+// Errors that are not in the rejectReasons table are rejected as invalid with the given default reason.
+func rejectCodeFromError(err error, defaultReason string) (wire.RejectCode, string) {
+	for _, r := range rejectReasons {
+		if errors.Is(err, r.err) {
+			return r.code, r.reason
+		}
+	}
+
+	return wire.RejectInvalid, defaultReason
+}
@@ -0,0 +1,39 @@
+package netsync
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectCodeFromError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedCode   wire.RejectCode
+		expectedReason string
+	}{
+		{"tx exists", errors.NewTxExistsError("tx exists"), wire.RejectDuplicate, "txn-already-known"},
+		{"double spend", errors.NewTxInvalidDoubleSpendError("double spend"), wire.RejectDuplicate, "txn-double-spend"},
+		{"missing parent", errors.NewTxMissingParentError("missing parent"), wire.RejectInvalid, "missing-inputs"},
+		{"tx locked", errors.NewTxLockedError("tx locked"), wire.RejectDuplicate, "txn-mempool-conflict"},
+		{"lock time", errors.NewTxLockTimeError("lock time"), wire.RejectNonstandard, "non-final"},
+		{"policy", errors.NewTxPolicyError("policy"), wire.RejectNonstandard, "non-standard"},
+		{"tx invalid", errors.NewTxInvalidError("invalid"), wire.RejectInvalid, "bad-txns"},
+		{"block invalid", errors.NewBlockInvalidError("invalid"), wire.RejectInvalid, "bad-blk"},
+		{"block exists", errors.NewBlockExistsError("exists"), wire.RejectDuplicate, "duplicate"},
+		{"wrapped error", errors.NewProcessingError("failed", errors.NewTxLockTimeError("lock time")), wire.RejectNonstandard, "non-final"},
+		{"most specific error first", errors.NewTxInvalidError("invalid", errors.NewTxPolicyError("policy")), wire.RejectNonstandard, "non-standard"},
+		{"unknown error", errors.NewProcessingError("failed"), wire.RejectInvalid, "rejected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, reason := rejectCodeFromError(tt.err, "rejected")
+			assert.Equal(t, tt.expectedCode, code)
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
@@ -76,3 +76,15 @@ func (c *Client) ListBanned(ctx context.Context, _ *emptypb.Empty) (*peer_api.Li
 func (c *Client) ClearBanned(ctx context.Context, _ *emptypb.Empty) (*peer_api.ClearBannedResponse, error) {
 	return c.client.ClearBanned(ctx, &emptypb.Empty{})
 }
+
+func (c *Client) ListPeers(ctx context.Context) (*peer_api.ListPeersResponse, error) {
+	return c.client.ListPeers(ctx, &emptypb.Empty{})
+}
+
+func (c *Client) DisconnectPeer(ctx context.Context, peer *peer_api.DisconnectPeerRequest) (*peer_api.DisconnectPeerResponse, error) {
+	return c.client.DisconnectPeer(ctx, peer)
+}
+
+func (c *Client) GetOrphanTransactions(ctx context.Context, req *peer_api.GetOrphanTransactionsRequest) (*peer_api.GetOrphanTransactionsResponse, error) {
+	return c.client.GetOrphanTransactions(ctx, req)
+}
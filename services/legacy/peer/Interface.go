@@ -14,4 +14,7 @@ type ClientI interface {
 	IsBanned(ctx context.Context, peer *peer_api.IsBannedRequest) (*peer_api.IsBannedResponse, error)
 	ListBanned(ctx context.Context, _ *emptypb.Empty) (*peer_api.ListBannedResponse, error)
 	ClearBanned(ctx context.Context, _ *emptypb.Empty) (*peer_api.ClearBannedResponse, error)
+	ListPeers(ctx context.Context) (*peer_api.ListPeersResponse, error)
+	DisconnectPeer(ctx context.Context, peer *peer_api.DisconnectPeerRequest) (*peer_api.DisconnectPeerResponse, error)
+	GetOrphanTransactions(ctx context.Context, req *peer_api.GetOrphanTransactionsRequest) (*peer_api.GetOrphanTransactionsResponse, error)
 }
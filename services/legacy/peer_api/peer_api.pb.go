@@ -722,6 +722,404 @@ func (x *ClearBannedResponse) GetOk() bool {
 	return false
 }
 
+// PeerInfo describes the sync state of a peer connected to the sync manager
+type PeerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	LastBlock     int32                  `protobuf:"varint,3,opt,name=lastBlock,proto3" json:"lastBlock,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,4,opt,name=bytesReceived,proto3" json:"bytesReceived,omitempty"`
+	SyncCandidate bool                   `protobuf:"varint,5,opt,name=syncCandidate,proto3" json:"syncCandidate,omitempty"`
+	IsSyncPeer    bool                   `protobuf:"varint,6,opt,name=isSyncPeer,proto3" json:"isSyncPeer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerInfo) Reset() {
+	*x = PeerInfo{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerInfo) ProtoMessage() {}
+
+func (x *PeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PeerInfo) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetLastBlock() int32 {
+	if x != nil {
+		return x.LastBlock
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetSyncCandidate() bool {
+	if x != nil {
+		return x.SyncCandidate
+	}
+	return false
+}
+
+func (x *PeerInfo) GetIsSyncPeer() bool {
+	if x != nil {
+		return x.IsSyncPeer
+	}
+	return false
+}
+
+type ListPeersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Peers         []*PeerInfo            `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeersResponse) Reset() {
+	*x = ListPeersResponse{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeersResponse) ProtoMessage() {}
+
+func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeersResponse.ProtoReflect.Descriptor instead.
+func (*ListPeersResponse) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPeersResponse) GetPeers() []*PeerInfo {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type DisconnectPeerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectPeerRequest) Reset() {
+	*x = DisconnectPeerRequest{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPeerRequest) ProtoMessage() {}
+
+func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisconnectPeerRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type DisconnectPeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectPeerResponse) Reset() {
+	*x = DisconnectPeerResponse{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPeerResponse) ProtoMessage() {}
+
+func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DisconnectPeerResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type GetOrphanTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint32                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"` // number of orphan transactions to skip
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // maximum number of orphan transactions to return, 0 uses the default page size
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrphanTransactionsRequest) Reset() {
+	*x = GetOrphanTransactionsRequest{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrphanTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrphanTransactionsRequest) ProtoMessage() {}
+
+func (x *GetOrphanTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrphanTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrphanTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOrphanTransactionsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetOrphanTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// OrphanTransaction describes an orphan transaction waiting for its parents
+type OrphanTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Txid          string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	AddedAt       int64                  `protobuf:"varint,3,opt,name=addedAt,proto3" json:"addedAt,omitempty"`            // time the transaction was added to the orphan pool, in seconds since 1 Jan 1970 GMT
+	Parents       []string               `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`             // parents of the transaction, at least one was missing when it was received
+	OrphanParents []string               `protobuf:"bytes,5,rep,name=orphanParents,proto3" json:"orphanParents,omitempty"` // parents that are orphan transactions themselves
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrphanTransaction) Reset() {
+	*x = OrphanTransaction{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphanTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanTransaction) ProtoMessage() {}
+
+func (x *OrphanTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanTransaction.ProtoReflect.Descriptor instead.
+func (*OrphanTransaction) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrphanTransaction) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *OrphanTransaction) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *OrphanTransaction) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *OrphanTransaction) GetParents() []string {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *OrphanTransaction) GetOrphanParents() []string {
+	if x != nil {
+		return x.OrphanParents
+	}
+	return nil
+}
+
+type GetOrphanTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orphans       []*OrphanTransaction   `protobuf:"bytes,1,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // total number of orphan transactions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrphanTransactionsResponse) Reset() {
+	*x = GetOrphanTransactionsResponse{}
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrphanTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrphanTransactionsResponse) ProtoMessage() {}
+
+func (x *GetOrphanTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_legacy_peer_api_peer_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrphanTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrphanTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_legacy_peer_api_peer_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrphanTransactionsResponse) GetOrphans() []*OrphanTransaction {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+func (x *GetOrphanTransactionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_services_legacy_peer_api_peer_api_proto protoreflect.FileDescriptor
 
 const file_services_legacy_peer_api_peer_api_proto_rawDesc = "" +
@@ -782,7 +1180,34 @@ const file_services_legacy_peer_api_peer_api_proto_rawDesc = "" +
 	"\x12ListBannedResponse\x12\x16\n" +
 	"\x06banned\x18\x01 \x03(\tR\x06banned\"%\n" +
 	"\x13ClearBannedResponse\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok2\xf6\x03\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"\xc6\x01\n" +
+	"\bPeerInfo\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1c\n" +
+	"\tuserAgent\x18\x02 \x01(\tR\tuserAgent\x12\x1c\n" +
+	"\tlastBlock\x18\x03 \x01(\x05R\tlastBlock\x12$\n" +
+	"\rbytesReceived\x18\x04 \x01(\x04R\rbytesReceived\x12$\n" +
+	"\rsyncCandidate\x18\x05 \x01(\bR\rsyncCandidate\x12\x1e\n" +
+	"\n" +
+	"isSyncPeer\x18\x06 \x01(\bR\n" +
+	"isSyncPeer\"=\n" +
+	"\x11ListPeersResponse\x12(\n" +
+	"\x05peers\x18\x01 \x03(\v2\x12.peer_api.PeerInfoR\x05peers\"+\n" +
+	"\x15DisconnectPeerRequest\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\"(\n" +
+	"\x16DisconnectPeerResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"L\n" +
+	"\x1cGetOrphanTransactionsRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\rR\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\x95\x01\n" +
+	"\x11OrphanTransaction\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\rR\x04size\x12\x18\n" +
+	"\aaddedAt\x18\x03 \x01(\x03R\aaddedAt\x12\x18\n" +
+	"\aparents\x18\x04 \x03(\tR\aparents\x12$\n" +
+	"\rorphanParents\x18\x05 \x03(\tR\rorphanParents\"l\n" +
+	"\x1dGetOrphanTransactionsResponse\x125\n" +
+	"\aorphans\x18\x01 \x03(\v2\x1b.peer_api.OrphanTransactionR\aorphans\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total2\xfd\x05\n" +
 	"\vPeerService\x12@\n" +
 	"\bGetPeers\x12\x16.google.protobuf.Empty\x1a\x1a.peer_api.GetPeersResponse\"\x00\x12@\n" +
 	"\aBanPeer\x12\x18.peer_api.BanPeerRequest\x1a\x19.peer_api.BanPeerResponse\"\x00\x12F\n" +
@@ -791,7 +1216,10 @@ const file_services_legacy_peer_api_peer_api_proto_rawDesc = "" +
 	"\n" +
 	"ListBanned\x12\x16.google.protobuf.Empty\x1a\x1c.peer_api.ListBannedResponse\"\x00\x12F\n" +
 	"\vClearBanned\x12\x16.google.protobuf.Empty\x1a\x1d.peer_api.ClearBannedResponse\"\x00\x12H\n" +
-	"\fGetPeerCount\x12\x16.google.protobuf.Empty\x1a\x1e.peer_api.GetPeerCountResponse\"\x00B\rZ\v./;peer_apib\x06proto3"
+	"\fGetPeerCount\x12\x16.google.protobuf.Empty\x1a\x1e.peer_api.GetPeerCountResponse\"\x00\x12B\n" +
+	"\tListPeers\x12\x16.google.protobuf.Empty\x1a\x1b.peer_api.ListPeersResponse\"\x00\x12U\n" +
+	"\x0eDisconnectPeer\x12\x1f.peer_api.DisconnectPeerRequest\x1a .peer_api.DisconnectPeerResponse\"\x00\x12j\n" +
+	"\x15GetOrphanTransactions\x12&.peer_api.GetOrphanTransactionsRequest\x1a'.peer_api.GetOrphanTransactionsResponse\"\x00B\rZ\v./;peer_apib\x06proto3"
 
 var (
 	file_services_legacy_peer_api_peer_api_proto_rawDescOnce sync.Once
@@ -805,42 +1233,57 @@ func file_services_legacy_peer_api_peer_api_proto_rawDescGZIP() []byte {
 	return file_services_legacy_peer_api_peer_api_proto_rawDescData
 }
 
-var file_services_legacy_peer_api_peer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_services_legacy_peer_api_peer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_services_legacy_peer_api_peer_api_proto_goTypes = []any{
-	(*Peer)(nil),                 // 0: peer_api.Peer
-	(*GetPeersResponse)(nil),     // 1: peer_api.GetPeersResponse
-	(*GetPeerCountResponse)(nil), // 2: peer_api.GetPeerCountResponse
-	(*BanPeerRequest)(nil),       // 3: peer_api.BanPeerRequest
-	(*BanPeerResponse)(nil),      // 4: peer_api.BanPeerResponse
-	(*UnbanPeerRequest)(nil),     // 5: peer_api.UnbanPeerRequest
-	(*UnbanPeerResponse)(nil),    // 6: peer_api.UnbanPeerResponse
-	(*IsBannedRequest)(nil),      // 7: peer_api.IsBannedRequest
-	(*IsBannedResponse)(nil),     // 8: peer_api.IsBannedResponse
-	(*ListBannedResponse)(nil),   // 9: peer_api.ListBannedResponse
-	(*ClearBannedResponse)(nil),  // 10: peer_api.ClearBannedResponse
-	(*emptypb.Empty)(nil),        // 11: google.protobuf.Empty
+	(*Peer)(nil),                          // 0: peer_api.Peer
+	(*GetPeersResponse)(nil),              // 1: peer_api.GetPeersResponse
+	(*GetPeerCountResponse)(nil),          // 2: peer_api.GetPeerCountResponse
+	(*BanPeerRequest)(nil),                // 3: peer_api.BanPeerRequest
+	(*BanPeerResponse)(nil),               // 4: peer_api.BanPeerResponse
+	(*UnbanPeerRequest)(nil),              // 5: peer_api.UnbanPeerRequest
+	(*UnbanPeerResponse)(nil),             // 6: peer_api.UnbanPeerResponse
+	(*IsBannedRequest)(nil),               // 7: peer_api.IsBannedRequest
+	(*IsBannedResponse)(nil),              // 8: peer_api.IsBannedResponse
+	(*ListBannedResponse)(nil),            // 9: peer_api.ListBannedResponse
+	(*ClearBannedResponse)(nil),           // 10: peer_api.ClearBannedResponse
+	(*PeerInfo)(nil),                      // 11: peer_api.PeerInfo
+	(*ListPeersResponse)(nil),             // 12: peer_api.ListPeersResponse
+	(*DisconnectPeerRequest)(nil),         // 13: peer_api.DisconnectPeerRequest
+	(*DisconnectPeerResponse)(nil),        // 14: peer_api.DisconnectPeerResponse
+	(*GetOrphanTransactionsRequest)(nil),  // 15: peer_api.GetOrphanTransactionsRequest
+	(*OrphanTransaction)(nil),             // 16: peer_api.OrphanTransaction
+	(*GetOrphanTransactionsResponse)(nil), // 17: peer_api.GetOrphanTransactionsResponse
+	(*emptypb.Empty)(nil),                 // 18: google.protobuf.Empty
 }
 var file_services_legacy_peer_api_peer_api_proto_depIdxs = []int32{
 	0,  // 0: peer_api.GetPeersResponse.peers:type_name -> peer_api.Peer
-	11, // 1: peer_api.PeerService.GetPeers:input_type -> google.protobuf.Empty
-	3,  // 2: peer_api.PeerService.BanPeer:input_type -> peer_api.BanPeerRequest
-	5,  // 3: peer_api.PeerService.UnbanPeer:input_type -> peer_api.UnbanPeerRequest
-	7,  // 4: peer_api.PeerService.IsBanned:input_type -> peer_api.IsBannedRequest
-	11, // 5: peer_api.PeerService.ListBanned:input_type -> google.protobuf.Empty
-	11, // 6: peer_api.PeerService.ClearBanned:input_type -> google.protobuf.Empty
-	11, // 7: peer_api.PeerService.GetPeerCount:input_type -> google.protobuf.Empty
-	1,  // 8: peer_api.PeerService.GetPeers:output_type -> peer_api.GetPeersResponse
-	4,  // 9: peer_api.PeerService.BanPeer:output_type -> peer_api.BanPeerResponse
-	6,  // 10: peer_api.PeerService.UnbanPeer:output_type -> peer_api.UnbanPeerResponse
-	8,  // 11: peer_api.PeerService.IsBanned:output_type -> peer_api.IsBannedResponse
-	9,  // 12: peer_api.PeerService.ListBanned:output_type -> peer_api.ListBannedResponse
-	10, // 13: peer_api.PeerService.ClearBanned:output_type -> peer_api.ClearBannedResponse
-	2,  // 14: peer_api.PeerService.GetPeerCount:output_type -> peer_api.GetPeerCountResponse
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	11, // 1: peer_api.ListPeersResponse.peers:type_name -> peer_api.PeerInfo
+	16, // 2: peer_api.GetOrphanTransactionsResponse.orphans:type_name -> peer_api.OrphanTransaction
+	18, // 3: peer_api.PeerService.GetPeers:input_type -> google.protobuf.Empty
+	3,  // 4: peer_api.PeerService.BanPeer:input_type -> peer_api.BanPeerRequest
+	5,  // 5: peer_api.PeerService.UnbanPeer:input_type -> peer_api.UnbanPeerRequest
+	7,  // 6: peer_api.PeerService.IsBanned:input_type -> peer_api.IsBannedRequest
+	18, // 7: peer_api.PeerService.ListBanned:input_type -> google.protobuf.Empty
+	18, // 8: peer_api.PeerService.ClearBanned:input_type -> google.protobuf.Empty
+	18, // 9: peer_api.PeerService.GetPeerCount:input_type -> google.protobuf.Empty
+	18, // 10: peer_api.PeerService.ListPeers:input_type -> google.protobuf.Empty
+	13, // 11: peer_api.PeerService.DisconnectPeer:input_type -> peer_api.DisconnectPeerRequest
+	15, // 12: peer_api.PeerService.GetOrphanTransactions:input_type -> peer_api.GetOrphanTransactionsRequest
+	1,  // 13: peer_api.PeerService.GetPeers:output_type -> peer_api.GetPeersResponse
+	4,  // 14: peer_api.PeerService.BanPeer:output_type -> peer_api.BanPeerResponse
+	6,  // 15: peer_api.PeerService.UnbanPeer:output_type -> peer_api.UnbanPeerResponse
+	8,  // 16: peer_api.PeerService.IsBanned:output_type -> peer_api.IsBannedResponse
+	9,  // 17: peer_api.PeerService.ListBanned:output_type -> peer_api.ListBannedResponse
+	10, // 18: peer_api.PeerService.ClearBanned:output_type -> peer_api.ClearBannedResponse
+	2,  // 19: peer_api.PeerService.GetPeerCount:output_type -> peer_api.GetPeerCountResponse
+	12, // 20: peer_api.PeerService.ListPeers:output_type -> peer_api.ListPeersResponse
+	14, // 21: peer_api.PeerService.DisconnectPeer:output_type -> peer_api.DisconnectPeerResponse
+	17, // 22: peer_api.PeerService.GetOrphanTransactions:output_type -> peer_api.GetOrphanTransactionsResponse
+	13, // [13:23] is the sub-list for method output_type
+	3,  // [3:13] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_services_legacy_peer_api_peer_api_proto_init() }
@@ -854,7 +1297,7 @@ func file_services_legacy_peer_api_peer_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_legacy_peer_api_peer_api_proto_rawDesc), len(file_services_legacy_peer_api_peer_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
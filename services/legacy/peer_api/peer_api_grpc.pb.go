@@ -20,13 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PeerService_GetPeers_FullMethodName     = "/peer_api.PeerService/GetPeers"
-	PeerService_BanPeer_FullMethodName      = "/peer_api.PeerService/BanPeer"
-	PeerService_UnbanPeer_FullMethodName    = "/peer_api.PeerService/UnbanPeer"
-	PeerService_IsBanned_FullMethodName     = "/peer_api.PeerService/IsBanned"
-	PeerService_ListBanned_FullMethodName   = "/peer_api.PeerService/ListBanned"
-	PeerService_ClearBanned_FullMethodName  = "/peer_api.PeerService/ClearBanned"
-	PeerService_GetPeerCount_FullMethodName = "/peer_api.PeerService/GetPeerCount"
+	PeerService_GetPeers_FullMethodName              = "/peer_api.PeerService/GetPeers"
+	PeerService_BanPeer_FullMethodName               = "/peer_api.PeerService/BanPeer"
+	PeerService_UnbanPeer_FullMethodName             = "/peer_api.PeerService/UnbanPeer"
+	PeerService_IsBanned_FullMethodName              = "/peer_api.PeerService/IsBanned"
+	PeerService_ListBanned_FullMethodName            = "/peer_api.PeerService/ListBanned"
+	PeerService_ClearBanned_FullMethodName           = "/peer_api.PeerService/ClearBanned"
+	PeerService_GetPeerCount_FullMethodName          = "/peer_api.PeerService/GetPeerCount"
+	PeerService_ListPeers_FullMethodName             = "/peer_api.PeerService/ListPeers"
+	PeerService_DisconnectPeer_FullMethodName        = "/peer_api.PeerService/DisconnectPeer"
+	PeerService_GetOrphanTransactions_FullMethodName = "/peer_api.PeerService/GetOrphanTransactions"
 )
 
 // PeerServiceClient is the client API for PeerService service.
@@ -42,6 +45,9 @@ type PeerServiceClient interface {
 	ListBanned(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBannedResponse, error)
 	ClearBanned(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClearBannedResponse, error)
 	GetPeerCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPeerCountResponse, error)
+	ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	GetOrphanTransactions(ctx context.Context, in *GetOrphanTransactionsRequest, opts ...grpc.CallOption) (*GetOrphanTransactionsResponse, error)
 }
 
 type peerServiceClient struct {
@@ -122,6 +128,36 @@ func (c *peerServiceClient) GetPeerCount(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *peerServiceClient) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeersResponse)
+	err := c.cc.Invoke(ctx, PeerService_ListPeers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectPeerResponse)
+	err := c.cc.Invoke(ctx, PeerService_DisconnectPeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) GetOrphanTransactions(ctx context.Context, in *GetOrphanTransactionsRequest, opts ...grpc.CallOption) (*GetOrphanTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrphanTransactionsResponse)
+	err := c.cc.Invoke(ctx, PeerService_GetOrphanTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PeerServiceServer is the server API for PeerService service.
 // All implementations must embed UnimplementedPeerServiceServer
 // for forward compatibility.
@@ -135,6 +171,9 @@ type PeerServiceServer interface {
 	ListBanned(context.Context, *emptypb.Empty) (*ListBannedResponse, error)
 	ClearBanned(context.Context, *emptypb.Empty) (*ClearBannedResponse, error)
 	GetPeerCount(context.Context, *emptypb.Empty) (*GetPeerCountResponse, error)
+	ListPeers(context.Context, *emptypb.Empty) (*ListPeersResponse, error)
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	GetOrphanTransactions(context.Context, *GetOrphanTransactionsRequest) (*GetOrphanTransactionsResponse, error)
 	mustEmbedUnimplementedPeerServiceServer()
 }
 
@@ -166,6 +205,15 @@ func (UnimplementedPeerServiceServer) ClearBanned(context.Context, *emptypb.Empt
 func (UnimplementedPeerServiceServer) GetPeerCount(context.Context, *emptypb.Empty) (*GetPeerCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeerCount not implemented")
 }
+func (UnimplementedPeerServiceServer) ListPeers(context.Context, *emptypb.Empty) (*ListPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedPeerServiceServer) DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (UnimplementedPeerServiceServer) GetOrphanTransactions(context.Context, *GetOrphanTransactionsRequest) (*GetOrphanTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrphanTransactions not implemented")
+}
 func (UnimplementedPeerServiceServer) mustEmbedUnimplementedPeerServiceServer() {}
 func (UnimplementedPeerServiceServer) testEmbeddedByValue()                     {}
 
@@ -313,6 +361,60 @@ func _PeerService_GetPeerCount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerService_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerService_ListPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).ListPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerService_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerService_DisconnectPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerService_GetOrphanTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrphanTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).GetOrphanTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerService_GetOrphanTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).GetOrphanTransactions(ctx, req.(*GetOrphanTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PeerService_ServiceDesc is the grpc.ServiceDesc for PeerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -348,6 +450,18 @@ var PeerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerCount",
 			Handler:    _PeerService_GetPeerCount_Handler,
 		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _PeerService_ListPeers_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _PeerService_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "GetOrphanTransactions",
+			Handler:    _PeerService_GetOrphanTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/legacy/peer_api/peer_api.proto",
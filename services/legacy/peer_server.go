@@ -1261,6 +1261,19 @@ func (s *server) TransactionConfirmed(tx *bsvutil.Tx) {
 	// Rebroadcasting is only necessary when the RPC server is active.
 }
 
+// AddBanScore increases the ban score of the server peer of the given peer, see serverPeer.addBanScore.
+// The peer is looked up asynchronously, so the caller is not blocked by the peer handler.
+func (s *server) AddBanScore(p *peer.Peer, persistent, transient uint32, reason string) {
+	go func() {
+		for _, sp := range s.getPeers() {
+			if sp.Peer == p {
+				sp.addBanScore(persistent, transient, reason)
+				return
+			}
+		}
+	}()
+}
+
 // pushTxMsg sends a tx message for the provided transaction hash to the
 // connected peer.  An error is returned if the transaction hash is not known.
 func (s *server) pushTxMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
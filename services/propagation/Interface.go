@@ -0,0 +1,24 @@
+package propagation
+
+import (
+	"context"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+)
+
+// ClientI defines the interface for submitting transactions to the propagation service.
+// It is implemented by Client and allows services that forward transactions to the
+// propagation service to be tested without a running propagation server.
+type ClientI interface {
+	// ProcessTransaction submits a single transaction to the propagation service.
+	//
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - tx: Bitcoin transaction to process
+	//
+	// Returns:
+	//   - error: Error if the transaction was rejected or could not be submitted
+	ProcessTransaction(ctx context.Context, tx *bt.Tx) error
+}
+
+var _ ClientI = (*Client)(nil)
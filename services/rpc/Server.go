@@ -66,6 +66,7 @@ import (
 	"github.com/bitcoin-sv/teranode/services/p2p"
 	"github.com/bitcoin-sv/teranode/services/rpc/bsvjson"
 	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/stores/blob"
 	"github.com/bitcoin-sv/teranode/stores/utxo"
 	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bitcoin-sv/teranode/util"
@@ -684,6 +685,10 @@ type RPCServer struct {
 	// utxoStore provides access to the UTXO (Unspent Transaction Output) database
 	// Used for transaction validation and UTXO queries
 	utxoStore utxo.Store
+
+	// subtreeStore provides access to the subtrees of blocks
+	// Used for listing the transactions of a block in getblock
+	subtreeStore blob.Store
 }
 
 // httpStatusLine returns a response Status-Line (RFC 2616 Section 6.1)
@@ -1368,11 +1373,12 @@ func (s *RPCServer) Start(ctx context.Context, readyCh chan<- struct{}) error {
 //   - tSettings: Configuration settings for the RPC server and related features
 //   - blockchainClient: Interface to the blockchain service for block and chain operations
 //   - utxoStore: Interface to the UTXO database for transaction validation
+//   - subtreeStore: Store of the subtrees, for the transactions of blocks
 //
 // Returns:
 //   - *RPCServer: Configured server instance ready for initialization
 //   - error: Any error encountered during configuration
-func NewServer(logger ulogger.Logger, tSettings *settings.Settings, blockchainClient blockchain.ClientI, blockValidationClient blockvalidation.Interface, utxoStore utxo.Store, blockAssemblyClient blockassembly.ClientI, peerClient peer.ClientI, p2pClient p2p.ClientI) (*RPCServer, error) {
+func NewServer(logger ulogger.Logger, tSettings *settings.Settings, blockchainClient blockchain.ClientI, blockValidationClient blockvalidation.Interface, utxoStore utxo.Store, subtreeStore blob.Store, blockAssemblyClient blockassembly.ClientI, peerClient peer.ClientI, p2pClient p2p.ClientI) (*RPCServer, error) {
 	initPrometheusMetrics()
 
 	assetHTTPAddress := tSettings.Asset.HTTPAddress
@@ -1396,6 +1402,7 @@ func NewServer(logger ulogger.Logger, tSettings *settings.Settings, blockchainCl
 		assetHTTPURL:           parsedURL,
 		helpCacher:             newHelpCacher(),
 		utxoStore:              utxoStore,
+		subtreeStore:           subtreeStore,
 		blockAssemblyClient:    blockAssemblyClient,
 		peerClient:             peerClient,
 		p2pClient:              p2pClient,
@@ -48,11 +48,14 @@ import (
 	"github.com/bitcoin-sv/teranode/services/legacy/txscript"
 	"github.com/bitcoin-sv/teranode/services/p2p/p2p_api"
 	"github.com/bitcoin-sv/teranode/services/rpc/bsvjson"
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
 	"github.com/bitcoin-sv/teranode/stores/utxo"
+	"github.com/bitcoin-sv/teranode/stores/utxo/fields"
 	"github.com/bitcoin-sv/teranode/util/tracing"
 	"github.com/bsv-blockchain/go-bt/v2"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	safeconversion "github.com/bsv-blockchain/go-safe-conversion"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
 	"github.com/bsv-blockchain/go-wire"
 	"github.com/ordishs/go-utils"
 	cache "github.com/patrickmn/go-cache"
@@ -62,12 +65,19 @@ import (
 // live items expire after 10s, cleanup runs every minute
 var rpcCallCache = cache.New(10*time.Second, time.Minute)
 
+// blockTxsBatchSize is the number of transactions of a block that are read from the utxo store in a single batch
+// for getblock with verbosity 2.
+const blockTxsBatchSize = 1024
+
 // handleGetBlock implements the getblock command, which retrieves information about a block
 // from the blockchain based on its hash.
 //
 // The command supports three verbosity levels that control the amount of information returned:
 // - 0: Returns the serialized block as a hex-encoded string
-// - 1: Returns a JSON object with block header information
+// - 1: Returns a JSON object with block header information and the ids of the transactions
+// - 2: Returns a JSON object with block header information and the decoded transactions
+//
+// The transaction ids are read from the subtrees of the block and the transactions from the utxo store.
 //
 // This handler interfaces with the blockchain service to retrieve block data and performs
 // format conversion appropriate to the requested verbosity level. Response size increases
@@ -80,7 +90,7 @@ var rpcCallCache = cache.New(10*time.Second, time.Minute)
 //   - _: Unused channel for close notification
 //
 // Returns:
-//   - interface{}: Block data in the requested format (string or bsvjson.GetBlockVerboseResult)
+//   - interface{}: Block data in the requested format (string, bsvjson.GetBlockVerboseResult or bsvjson.GetBlockVerboseTxResult)
 //   - error: Any error encountered during processing
 func handleGetBlock(ctx context.Context, s *RPCServer, cmd interface{}, _ <-chan struct{}) (interface{}, error) {
 	ctx, _, deferFn := tracing.Tracer("rpc").Start(ctx, "handleGetBlock",
@@ -236,20 +246,25 @@ func handleGetBlockHeader(ctx context.Context, s *RPCServer, cmd interface{}, _
 			return nil, err
 		}
 
+		_, bestBlockMeta, err := s.blockchainClient.GetBestBlockHeader(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		diff := b.Bits.CalculateDifficulty()
 		diffFloat, _ := diff.Float64()
 		headerReply := &bsvjson.GetBlockHeaderVerboseResult{
-			Hash:         b.Hash().String(),
-			Version:      versionInt32,
-			VersionHex:   fmt.Sprintf("%08x", b.Version),
-			PreviousHash: b.HashPrevBlock.String(),
-			Nonce:        nonceUint64,
-			Time:         timeInt64,
-			Bits:         b.Bits.String(),
-			Difficulty:   diffFloat,
-			MerkleRoot:   b.HashMerkleRoot.String(),
-			// Confirmations: int64(1 + bestBlockMeta.Height - meta.Height),
-			Height: heightInt32,
+			Hash:          b.Hash().String(),
+			Version:       versionInt32,
+			VersionHex:    fmt.Sprintf("%08x", b.Version),
+			PreviousHash:  b.HashPrevBlock.String(),
+			Nonce:         nonceUint64,
+			Time:          timeInt64,
+			Bits:          b.Bits.String(),
+			Difficulty:    diffFloat,
+			MerkleRoot:    b.HashMerkleRoot.String(),
+			Confirmations: int64(bestBlockMeta.Height) - int64(meta.Height) + 1,
+			Height:        heightInt32,
 		}
 
 		return headerReply, nil
@@ -291,12 +306,7 @@ func (s *RPCServer) blockToJSON(ctx context.Context, b *model.Block, verbosity u
 		return nil, err
 	}
 
-	var (
-		blockReply    interface{}
-		nextBlockHash string
-		// 	params      = s.cfg.ChainParams
-		// 	blockHeader = &blk.MsgBlock().Header
-	)
+	var nextBlockHash string
 
 	if nextBlock != nil {
 		nextBlockHash = nextBlock.Hash().String()
@@ -330,43 +340,154 @@ func (s *RPCServer) blockToJSON(ctx context.Context, b *model.Block, verbosity u
 		NextHash:      nextBlockHash,
 	}
 
-	// TODO: we can't add the txs to the block as there could be too many.
-	// A breaking change would be to add the subtrees.
+	// verbosity 1 returns the ids of the transactions of the block, read from the subtrees of the block
+	txHashes, err := s.blockTxHashes(ctx, b)
+	if err != nil {
+		if !isBlockDataNotFound(err) {
+			return nil, err
+		}
+
+		// the subtrees of old blocks are removed over time, the header fields of the block are still returned
+		s.logger.Warnf("[getblock][%s] subtrees of block not found, returning block without transactions: %v", b.Hash(), err)
+
+		txHashes = nil
+	}
+
+	if verbosity == 1 {
+		txIDs := make([]string, len(txHashes))
+
+		for i, txHash := range txHashes {
+			txIDs[i] = txHash.String()
+		}
+
+		return bsvjson.GetBlockVerboseResult{
+			GetBlockBaseVerboseResult: baseBlockReply,
+			Tx:                        txIDs,
+		}, nil
+	}
 
 	// If verbose level does not match 0 or 1
 	// we can consider it 2 (current bitcoin core behavior)
-	if verbosity == 1 { //nolint:wsl
-		// 	transactions := blk.Transactions()
-		// 	txNames := make([]string, len(transactions))
-		// 	for i, tx := range transactions {
-		// 		txNames[i] = tx.Hash().String()
-		// 	}
-
-		// 	blockReply = bsvjson.GetBlockVerboseResult{
-		// 		GetBlockBaseVerboseResult: baseBlockReply,
-
-		// 		Tx: txNames,
-		// 	}
-		// } else {
-		// 	txns := blk.Transactions()
-		// 	rawTxns := make([]bsvjson.TxRawResult, len(txns))
-		// 	for i, tx := range txns {
-		// 		rawTxn, err := createTxRawResult(params, tx.MsgTx(),
-		// 			tx.Hash().String(), blockHeader, hash.String(),
-		// 			blockHeight, best.Height)
-		// 		if err != nil {
-		// 			return nil, err
-		// 		}
-		// 		rawTxns[i] = *rawTxn
-		// 	}
-		blockReply = bsvjson.GetBlockVerboseTxResult{
-			GetBlockBaseVerboseResult: baseBlockReply,
+	txs, err := s.blockTxs(ctx, b, txHashes)
+	if err != nil {
+		if !isBlockDataNotFound(err) {
+			return nil, err
+		}
+
+		s.logger.Warnf("[getblock][%s] transactions of block not found, returning block without transactions: %v", b.Hash(), err)
+
+		txs = nil
+	}
+
+	rawTxs := make([]bsvjson.TxRawResult, len(txs))
+
+	for i, tx := range txs {
+		if rawTxs[i], err = txToRawResult(tx); err != nil {
+			return nil, err
+		}
+
+		rawTxs[i].BlockHash = baseBlockReply.Hash
+		rawTxs[i].Confirmations = uint64(baseBlockReply.Confirmations) // nolint:gosec
+		rawTxs[i].Time = baseBlockReply.Time
+		rawTxs[i].Blocktime = baseBlockReply.Time
+	}
+
+	return bsvjson.GetBlockVerboseTxResult{
+		GetBlockBaseVerboseResult: baseBlockReply,
+		Tx:                        rawTxs,
+	}, nil
+}
+
+// isBlockDataNotFound returns whether the error is caused by subtrees or transactions of a block that are
+// no longer in the stores, as opposed to a failure of the stores themselves.
+func isBlockDataNotFound(err error) bool {
+	return errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBlobNotFound) ||
+		errors.Is(err, errors.ErrSubtreeNotFound) || errors.Is(err, errors.ErrTxNotFound)
+}
+
+// blockTxHashes returns the hashes of the transactions of the block in block order, starting with the coinbase
+// transaction. The other transactions are read from the subtrees of the block in the subtree store.
+func (s *RPCServer) blockTxHashes(ctx context.Context, b *model.Block) ([]chainhash.Hash, error) {
+	txHashes := make([]chainhash.Hash, 0, b.TransactionCount)
+
+	if b.CoinbaseTx != nil {
+		txHashes = append(txHashes, *b.CoinbaseTx.TxIDChainHash())
+	}
+
+	if len(b.Subtrees) == 0 {
+		return txHashes, nil
+	}
+
+	if s.subtreeStore == nil {
+		return nil, errors.NewConfigurationError("subtree store is not configured")
+	}
+
+	subtrees, err := b.GetSubtrees(ctx, s.logger, s.subtreeStore, s.settings.Block.GetAndValidateSubtreesConcurrency)
+	if err != nil {
+		return nil, errors.NewServiceError("failed to get subtrees of block %s", b.Hash(), err)
+	}
+
+	for _, subtree := range subtrees {
+		for _, node := range subtree.Nodes {
+			if node.Hash.Equal(subtreepkg.CoinbasePlaceholderHashValue) {
+				continue
+			}
+
+			txHashes = append(txHashes, node.Hash)
+		}
+	}
+
+	return txHashes, nil
+}
+
+// blockTxs returns the transactions with the given hashes of the block, the coinbase transaction is taken from
+// the block and the other transactions are read from the utxo store in batches.
+func (s *RPCServer) blockTxs(ctx context.Context, b *model.Block, txHashes []chainhash.Hash) ([]*bt.Tx, error) {
+	txs := make([]*bt.Tx, 0, len(txHashes))
+
+	if b.CoinbaseTx != nil && len(txHashes) > 0 {
+		txs = append(txs, b.CoinbaseTx)
+		txHashes = txHashes[1:]
+	}
+
+	if len(txHashes) == 0 {
+		return txs, nil
+	}
 
-			// Tx: rawTxns,
+	if s.utxoStore == nil {
+		return nil, errors.NewConfigurationError("utxo store is not configured")
+	}
+
+	for i := 0; i < len(txHashes); i += blockTxsBatchSize {
+		batch := txHashes[i:min(i+blockTxsBatchSize, len(txHashes))]
+		unresolved := make([]*utxo.UnresolvedMetaData, len(batch))
+
+		for idx, txHash := range batch {
+			unresolved[idx] = &utxo.UnresolvedMetaData{
+				Hash:   txHash,
+				Idx:    idx,
+				Fields: []fields.FieldName{fields.Tx},
+			}
+		}
+
+		if err := s.utxoStore.BatchDecorate(ctx, unresolved, fields.Tx); err != nil {
+			return nil, errors.NewServiceError("failed to get transactions of block %s", b.Hash(), err)
+		}
+
+		for _, txMeta := range unresolved {
+			if txMeta.Err != nil {
+				return nil, errors.NewServiceError("failed to get transaction %s of block %s", txMeta.Hash, b.Hash(), txMeta.Err)
+			}
+
+			if txMeta.Data == nil || txMeta.Data.Tx == nil {
+				return nil, errors.NewTxNotFoundError("transaction %s of block %s not found", txMeta.Hash, b.Hash())
+			}
+
+			txs = append(txs, txMeta.Data.Tx)
 		}
 	}
 
-	return blockReply, nil
+	return txs, nil
 }
 
 // handleGetBestBlockHash implements the getbestblockhash command, which returns the hash
@@ -487,13 +608,30 @@ func handleGetRawTransaction(ctx context.Context, s *RPCServer, cmd interface{},
 		return tx.String(), nil
 	}
 
+	return txToRawResult(tx)
+}
+
+// txToRawResult converts a transaction to the verbose result of getrawtransaction, with the decoded inputs and outputs.
+// The inputs of a coinbase transaction return the unlocking script in the coinbase field, like bitcoind.
+func txToRawResult(tx *bt.Tx) (bsvjson.TxRawResult, error) {
 	// inputs
 	inputs := make([]bsvjson.Vin, len(tx.Inputs))
 
+	isCoinbase := tx.IsCoinbase()
+
 	for i, txIn := range tx.Inputs {
+		if isCoinbase {
+			inputs[i] = bsvjson.Vin{
+				Coinbase: txIn.UnlockingScript.String(),
+				Sequence: txIn.SequenceNumber,
+			}
+
+			continue
+		}
+
 		asm, err := txscript.DisasmString(txIn.UnlockingScript.Bytes())
 		if err != nil {
-			return nil, errors.NewServiceError("Error disassembling script", err)
+			return bsvjson.TxRawResult{}, errors.NewServiceError("Error disassembling script", err)
 		}
 
 		inputs[i] = bsvjson.Vin{
@@ -513,7 +651,7 @@ func handleGetRawTransaction(ctx context.Context, s *RPCServer, cmd interface{},
 	for i, txOut := range tx.Outputs {
 		addresses, err := txOut.LockingScript.Addresses()
 		if err != nil {
-			return nil, errors.NewServiceError("Error extracting script addresses", err)
+			return bsvjson.TxRawResult{}, errors.NewServiceError("Error extracting script addresses", err)
 		}
 
 		// Convert addresses to []string
@@ -525,7 +663,7 @@ func handleGetRawTransaction(ctx context.Context, s *RPCServer, cmd interface{},
 
 		asm, err := txscript.DisasmString(txOut.LockingScript.Bytes())
 		if err != nil {
-			return nil, errors.NewServiceError("Error disassembling script", err)
+			return bsvjson.TxRawResult{}, errors.NewServiceError("Error disassembling script", err)
 		}
 
 		outputs[i] = bsvjson.Vout{
@@ -1580,7 +1718,8 @@ func handleInvalidateBlock(ctx context.Context, s *RPCServer, cmd interface{}, _
 		return nil, rpcDecodeHexError(c.BlockHash)
 	}
 
-	_, err = s.blockchainClient.InvalidateBlock(ctx, ch)
+	// invalidations requested by an operator are limited to the max reorg depth of the blockchain service
+	_, err = s.blockchainClient.InvalidateBlock(ctx, ch, options.WithCheckMaxReorgDepth(true))
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
 	"github.com/bitcoin-sv/teranode/services/blockassembly/blockassembly_api"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
 	"github.com/bitcoin-sv/teranode/services/blockchain/blockchain_api"
@@ -24,13 +25,18 @@ import (
 	"github.com/bitcoin-sv/teranode/services/p2p/p2p_api"
 	"github.com/bitcoin-sv/teranode/services/rpc/bsvjson"
 	"github.com/bitcoin-sv/teranode/settings"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
 	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
+	"github.com/bitcoin-sv/teranode/stores/utxo"
+	"github.com/bitcoin-sv/teranode/stores/utxo/meta"
 	"github.com/bitcoin-sv/teranode/util/test/mocklogger"
+	"github.com/bsv-blockchain/go-bt/v2"
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/bsv-blockchain/go-chaincfg"
 	"github.com/bsv-blockchain/go-subtree"
 	"github.com/bsv-blockchain/go-wire"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -554,8 +560,8 @@ func TestBlockToJSONComprehensive(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
-		// Should return a GetBlockVerboseTxResult
-		blockResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		// Should return a GetBlockVerboseResult
+		blockResult, ok := result.(bsvjson.GetBlockVerboseResult)
 		assert.True(t, ok)
 
 		// Verify basic fields
@@ -567,9 +573,14 @@ func TestBlockToJSONComprehensive(t *testing.T) {
 		assert.Equal(t, nextBlock.Hash().String(), blockResult.NextHash)
 	})
 
-	t.Run("verbosity 2 returns nil (current implementation)", func(t *testing.T) {
+	t.Run("verbosity 2 returns decoded transactions", func(t *testing.T) {
 		mockBlock := createMockBlock(t, 200)
 
+		coinbaseTx, err := bt.NewTxFromString(model.CoinbaseHex)
+		require.NoError(t, err)
+
+		mockBlock.CoinbaseTx = coinbaseTx
+
 		mockBlockchainClient := &mockBlockchainClient{
 			getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
 				return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 250}, nil
@@ -590,8 +601,189 @@ func TestBlockToJSONComprehensive(t *testing.T) {
 		result, err := s.blockToJSON(context.Background(), mockBlock, 2)
 		require.NoError(t, err)
 
-		// Current implementation only handles verbosity 1, returns nil for verbosity 2
-		assert.Nil(t, result)
+		blockResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		require.True(t, ok)
+		require.Len(t, blockResult.Tx, 1)
+
+		assert.Equal(t, coinbaseTx.TxID(), blockResult.Tx[0].Txid)
+		assert.True(t, blockResult.Tx[0].Vin[0].IsCoinBase())
+		assert.Equal(t, mockBlock.Hash().String(), blockResult.Tx[0].BlockHash)
+		assert.Equal(t, uint64(51), blockResult.Tx[0].Confirmations)
+	})
+
+	t.Run("transactions are read from the subtrees", func(t *testing.T) {
+		mockBlock := createMockBlock(t, 100)
+
+		coinbaseTx, err := bt.NewTxFromString(model.CoinbaseHex)
+		require.NoError(t, err)
+
+		tx := bt.NewTx()
+		require.NoError(t, tx.PayToAddress("1NRoySJ9Lvby6DuE2UQYnyT67AASwNZxGb", 1000))
+
+		st, err := subtree.NewTreeByLeafCount(2)
+		require.NoError(t, err)
+		require.NoError(t, st.AddCoinbaseNode())
+		require.NoError(t, st.AddNode(*tx.TxIDChainHash(), 1, uint64(tx.Size())))
+
+		subtreeBytes, err := st.Serialize()
+		require.NoError(t, err)
+
+		subtreeStore := memory.New()
+		require.NoError(t, subtreeStore.Set(context.Background(), st.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+		mockBlock.CoinbaseTx = coinbaseTx
+		mockBlock.Subtrees = []*chainhash.Hash{st.RootHash()}
+
+		utxoStore := &utxo.MockUtxostore{}
+		utxoStore.On("BatchDecorate", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			for _, unresolved := range args.Get(1).([]*utxo.UnresolvedMetaData) {
+				if unresolved.Hash.Equal(*tx.TxIDChainHash()) {
+					unresolved.Data = &meta.Data{Tx: tx}
+				}
+			}
+		}).Return(nil)
+
+		s := &RPCServer{
+			logger: logger,
+			blockchainClient: &mockBlockchainClient{
+				getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+					return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 150}, nil
+				},
+				getBlockByHeightFunc: func(ctx context.Context, height uint32) (*model.Block, error) {
+					return nil, errors.ErrBlockNotFound
+				},
+			},
+			subtreeStore: subtreeStore,
+			utxoStore:    utxoStore,
+			settings: &settings.Settings{
+				ChainCfgParams: &chaincfg.MainNetParams,
+			},
+		}
+
+		result, err := s.blockToJSON(context.Background(), mockBlock, 1)
+		require.NoError(t, err)
+
+		blockResult, ok := result.(bsvjson.GetBlockVerboseResult)
+		require.True(t, ok)
+		assert.Equal(t, []string{coinbaseTx.TxID(), tx.TxID()}, blockResult.Tx)
+
+		result, err = s.blockToJSON(context.Background(), mockBlock, 2)
+		require.NoError(t, err)
+
+		blockTxResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		require.True(t, ok)
+		require.Len(t, blockTxResult.Tx, 2)
+		assert.Equal(t, coinbaseTx.TxID(), blockTxResult.Tx[0].Txid)
+		assert.Equal(t, tx.TxID(), blockTxResult.Tx[1].Txid)
+		assert.Equal(t, float64(1000), blockTxResult.Tx[1].Vout[0].Value)
+	})
+
+	t.Run("subtree store not configured", func(t *testing.T) {
+		mockBlock := createMockBlock(t, 100)
+		mockBlock.Subtrees = []*chainhash.Hash{{0x01}}
+
+		s := &RPCServer{
+			logger: logger,
+			blockchainClient: &mockBlockchainClient{
+				getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+					return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 150}, nil
+				},
+				getBlockByHeightFunc: func(ctx context.Context, height uint32) (*model.Block, error) {
+					return nil, errors.ErrBlockNotFound
+				},
+			},
+			settings: &settings.Settings{
+				ChainCfgParams: &chaincfg.MainNetParams,
+			},
+		}
+
+		_, err := s.blockToJSON(context.Background(), mockBlock, 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrConfiguration))
+	})
+
+	t.Run("block without subtrees in the store is returned without transactions", func(t *testing.T) {
+		mockBlock := createMockBlock(t, 100)
+		mockBlock.Subtrees = []*chainhash.Hash{{0x01}}
+
+		s := &RPCServer{
+			logger: logger,
+			blockchainClient: &mockBlockchainClient{
+				getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+					return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 150}, nil
+				},
+				getBlockByHeightFunc: func(ctx context.Context, height uint32) (*model.Block, error) {
+					return nil, errors.ErrBlockNotFound
+				},
+			},
+			subtreeStore: memory.New(),
+			settings: &settings.Settings{
+				ChainCfgParams: &chaincfg.MainNetParams,
+			},
+		}
+
+		result, err := s.blockToJSON(context.Background(), mockBlock, 1)
+		require.NoError(t, err)
+
+		blockResult, ok := result.(bsvjson.GetBlockVerboseResult)
+		require.True(t, ok)
+		assert.Equal(t, mockBlock.Hash().String(), blockResult.Hash)
+		assert.Empty(t, blockResult.Tx)
+
+		result, err = s.blockToJSON(context.Background(), mockBlock, 2)
+		require.NoError(t, err)
+
+		blockTxResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		require.True(t, ok)
+		assert.Empty(t, blockTxResult.Tx)
+	})
+
+	t.Run("block with transactions missing from the utxo store is returned without transactions", func(t *testing.T) {
+		mockBlock := createMockBlock(t, 100)
+
+		coinbaseTx, err := bt.NewTxFromString(model.CoinbaseHex)
+		require.NoError(t, err)
+
+		st, err := subtree.NewTreeByLeafCount(2)
+		require.NoError(t, err)
+		require.NoError(t, st.AddCoinbaseNode())
+		require.NoError(t, st.AddNode(chainhash.Hash{0x02}, 1, 100))
+
+		subtreeBytes, err := st.Serialize()
+		require.NoError(t, err)
+
+		subtreeStore := memory.New()
+		require.NoError(t, subtreeStore.Set(context.Background(), st.RootHash()[:], fileformat.FileTypeSubtree, subtreeBytes))
+
+		mockBlock.CoinbaseTx = coinbaseTx
+		mockBlock.Subtrees = []*chainhash.Hash{st.RootHash()}
+
+		utxoStore := &utxo.MockUtxostore{}
+		utxoStore.On("BatchDecorate", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		s := &RPCServer{
+			logger: logger,
+			blockchainClient: &mockBlockchainClient{
+				getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+					return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 150}, nil
+				},
+				getBlockByHeightFunc: func(ctx context.Context, height uint32) (*model.Block, error) {
+					return nil, errors.ErrBlockNotFound
+				},
+			},
+			subtreeStore: subtreeStore,
+			utxoStore:    utxoStore,
+			settings: &settings.Settings{
+				ChainCfgParams: &chaincfg.MainNetParams,
+			},
+		}
+
+		result, err := s.blockToJSON(context.Background(), mockBlock, 2)
+		require.NoError(t, err)
+
+		blockTxResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		require.True(t, ok)
+		assert.Empty(t, blockTxResult.Tx)
 	})
 
 	t.Run("block with large size returns size info", func(t *testing.T) {
@@ -618,8 +810,8 @@ func TestBlockToJSONComprehensive(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
-		// Should return a GetBlockVerboseTxResult
-		blockResult, ok := result.(bsvjson.GetBlockVerboseTxResult)
+		// Should return a GetBlockVerboseResult
+		blockResult, ok := result.(bsvjson.GetBlockVerboseResult)
 		assert.True(t, ok)
 
 		// Verify the size field is populated
@@ -1438,6 +1630,9 @@ func TestHandleGetBlockHeaderComprehensive(t *testing.T) {
 			getBlockHeaderFunc: func(ctx context.Context, hash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
 				return blockHeader, blockHeaderMeta, nil
 			},
+			getBestBlockHeaderFunc: func(ctx context.Context) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+				return &model.BlockHeader{}, &model.BlockHeaderMeta{Height: 100009}, nil
+			},
 		}
 
 		s := &RPCServer{
@@ -1473,6 +1668,7 @@ func TestHandleGetBlockHeaderComprehensive(t *testing.T) {
 		assert.NotEmpty(t, headerResult.Bits)
 		assert.NotEmpty(t, headerResult.MerkleRoot)
 		assert.Equal(t, int32(100000), headerResult.Height)
+		assert.Equal(t, int64(10), headerResult.Confirmations)
 		assert.Greater(t, headerResult.Difficulty, 0.0)
 	})
 
@@ -4168,6 +4364,14 @@ func (m *mockBlockchainClient) GetBlockByHeight(ctx context.Context, height uint
 	return nil, errors.New(errors.ERR_ERROR, "not implemented")
 }
 
+func (m *mockBlockchainClient) GetBlocksByHeightRange(ctx context.Context, startHeight, endHeight uint32) ([]*model.Block, error) {
+	return nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
+
+func (m *mockBlockchainClient) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	return nil, nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
+
 func (m *mockBlockchainClient) GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
 	if m.getBlockHeaderFunc != nil {
 		return m.getBlockHeaderFunc(ctx, blockHash)
@@ -4175,6 +4379,10 @@ func (m *mockBlockchainClient) GetBlockHeader(ctx context.Context, blockHash *ch
 	return nil, nil, errors.New(errors.ERR_ERROR, "not implemented")
 }
 
+func (m *mockBlockchainClient) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	return nil, nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
+
 // Add stub implementations for all other interface methods
 func (m *mockBlockchainClient) AddBlock(ctx context.Context, block *model.Block, peerID string, opts ...options.StoreBlockOption) error {
 	return nil
@@ -4197,7 +4405,7 @@ func (m *mockBlockchainClient) GetBlockStats(ctx context.Context) (*model.BlockS
 func (m *mockBlockchainClient) GetBlockGraphData(ctx context.Context, periodMillis uint64) (*model.BlockDataPoints, error) {
 	return nil, nil
 }
-func (m *mockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (m *mockBlockchainClient) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	return nil, nil
 }
 func (m *mockBlockchainClient) GetLastNInvalidBlocks(ctx context.Context, n int64) ([]*model.BlockInfo, error) {
@@ -4212,6 +4420,12 @@ func (m *mockBlockchainClient) GetHashOfAncestorBlock(ctx context.Context, hash
 func (m *mockBlockchainClient) GetNextWorkRequired(ctx context.Context, hash *chainhash.Hash, currentBlockTime int64) (*model.NBit, error) {
 	return nil, nil
 }
+func (m *mockBlockchainClient) GetDifficultyInfo(ctx context.Context) (*blockchain.DifficultyInfo, error) {
+	return nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
+func (m *mockBlockchainClient) GetMiningCandidateContext(ctx context.Context) (*blockchain.MiningCandidateContext, error) {
+	return nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
 func (m *mockBlockchainClient) GetBlockExists(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	return false, nil
 }
@@ -4245,12 +4459,15 @@ func (m *mockBlockchainClient) GetBlockHeadersFromHeight(ctx context.Context, he
 func (m *mockBlockchainClient) GetBlockHeadersByHeight(ctx context.Context, startHeight, endHeight uint32) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	return nil, nil, nil
 }
-func (m *mockBlockchainClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error) {
+func (m *mockBlockchainClient) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash, opts ...options.InvalidateBlockOption) ([]chainhash.Hash, error) {
 	if m.invalidateBlockFunc != nil {
 		return m.invalidateBlockFunc(ctx, blockHash)
 	}
 	return nil, errors.New(errors.ERR_ERROR, "not implemented")
 }
+func (m *mockBlockchainClient) InvalidateBlockDryRun(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	return nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
 func (m *mockBlockchainClient) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
 	if m.revalidateBlockFunc != nil {
 		return m.revalidateBlockFunc(ctx, blockHash)
@@ -4260,7 +4477,7 @@ func (m *mockBlockchainClient) RevalidateBlock(ctx context.Context, blockHash *c
 func (m *mockBlockchainClient) GetBlockHeaderIDs(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]uint32, error) {
 	return nil, nil
 }
-func (m *mockBlockchainClient) Subscribe(ctx context.Context, source string) (chan *blockchain_api.Notification, error) {
+func (m *mockBlockchainClient) Subscribe(ctx context.Context, source string, _ ...options.SubscribeOption) (chan *blockchain_api.Notification, error) {
 	return nil, nil
 }
 func (m *mockBlockchainClient) GetState(ctx context.Context, key string) ([]byte, error) {
@@ -4269,6 +4486,9 @@ func (m *mockBlockchainClient) GetState(ctx context.Context, key string) ([]byte
 func (m *mockBlockchainClient) SetState(ctx context.Context, key string, data []byte) error {
 	return nil
 }
+func (m *mockBlockchainClient) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	return false, nil
+}
 func (m *mockBlockchainClient) SetBlockMinedSet(ctx context.Context, blockHash *chainhash.Hash) error {
 	return nil
 }
@@ -4293,6 +4513,15 @@ func (m *mockBlockchainClient) GetBestHeightAndTime(ctx context.Context) (uint32
 	}
 	return 0, 0, nil
 }
+func (m *mockBlockchainClient) GetMedianTimeForHeight(ctx context.Context, height uint32) (uint32, error) {
+	return 0, nil
+}
+func (m *mockBlockchainClient) GetBlockSubsidy(ctx context.Context, height uint32) (uint64, uint32, error) {
+	return 0, 0, nil
+}
+func (m *mockBlockchainClient) WaitForBlockHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	return nil, nil, nil
+}
 func (m *mockBlockchainClient) CheckBlockIsInCurrentChain(ctx context.Context, blockIDs []uint32) (bool, error) {
 	return false, nil
 }
@@ -4313,6 +4542,9 @@ func (m *mockBlockchainClient) GetFSMCurrentState(ctx context.Context) (*blockch
 func (m *mockBlockchainClient) IsFSMCurrentState(ctx context.Context, state blockchain.FSMStateType) (bool, error) {
 	return false, nil
 }
+func (m *mockBlockchainClient) IsCurrent(ctx context.Context) (bool, []blockchain.NotCurrentReason, error) {
+	return false, nil, errors.New(errors.ERR_ERROR, "not implemented")
+}
 func (m *mockBlockchainClient) WaitForFSMtoTransitionToGivenState(ctx context.Context, state blockchain.FSMStateType) error {
 	return nil
 }
@@ -4323,6 +4555,10 @@ func (m *mockBlockchainClient) WaitUntilFSMTransitionFromIdleState(ctx context.C
 	return nil
 }
 
+func (m *mockBlockchainClient) SubscribeFSMState(ctx context.Context, source string) (<-chan *blockchain_api.FSMStateChange, error) {
+	return make(chan *blockchain_api.FSMStateChange), nil
+}
+
 // mockBlockValidationClient is a mock implementation of blockvalidation.Interface for testing
 type mockBlockValidationClient struct {
 	validateBlockFunc func(context.Context, *model.Block, *blockvalidation.ValidateBlockOptions) error
@@ -4358,6 +4594,22 @@ func (m *mockBlockValidationClient) ValidateBlock(ctx context.Context, block *mo
 	}
 	return nil
 }
+
+func (m *mockBlockValidationClient) GetCatchupStatus(ctx context.Context) (*blockvalidation.CatchupStatus, error) {
+	return &blockvalidation.CatchupStatus{}, nil
+}
+
+func (m *mockBlockValidationClient) CancelCatchup(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (m *mockBlockValidationClient) GetSubtreeMeta(ctx context.Context, subtreeHash *chainhash.Hash) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockBlockValidationClient) ProcessBlockDryRun(ctx context.Context, block *model.Block, blockHeight uint32) error {
+	return nil
+}
 func (m *mockBlockchainClient) IsFullyReady(ctx context.Context) (bool, error) { return false, nil }
 func (m *mockBlockchainClient) Run(ctx context.Context, source string) error   { return nil }
 func (m *mockBlockchainClient) CatchUpBlocks(ctx context.Context) error        { return nil }
@@ -4369,6 +4621,9 @@ func (m *mockBlockchainClient) SendFSMEvent(ctx context.Context, event blockchai
 func (m *mockBlockchainClient) GetBlockLocator(ctx context.Context, blockHeaderHash *chainhash.Hash, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
 	return nil, nil
 }
+func (m *mockBlockchainClient) GetBlockLocatorByHeight(ctx context.Context, blockHeaderHeight uint32) ([]*chainhash.Hash, error) {
+	return nil, nil
+}
 func (m *mockBlockchainClient) LocateBlockHeaders(ctx context.Context, locator []*chainhash.Hash, hashStop *chainhash.Hash, maxHashes uint32) ([]*model.BlockHeader, error) {
 	return nil, nil
 }
@@ -4952,6 +5207,18 @@ func (m *mockLegacyPeerClient) ClearBanned(ctx context.Context, req *emptypb.Emp
 	return &peer_api.ClearBannedResponse{}, nil
 }
 
+func (m *mockLegacyPeerClient) ListPeers(ctx context.Context) (*peer_api.ListPeersResponse, error) {
+	return &peer_api.ListPeersResponse{}, nil
+}
+
+func (m *mockLegacyPeerClient) DisconnectPeer(ctx context.Context, req *peer_api.DisconnectPeerRequest) (*peer_api.DisconnectPeerResponse, error) {
+	return &peer_api.DisconnectPeerResponse{}, nil
+}
+
+func (m *mockLegacyPeerClient) GetOrphanTransactions(ctx context.Context, req *peer_api.GetOrphanTransactionsRequest) (*peer_api.GetOrphanTransactionsResponse, error) {
+	return &peer_api.GetOrphanTransactionsResponse{}, nil
+}
+
 type mockP2PClient struct {
 	getPeersFunc    func(ctx context.Context) (*p2p_api.GetPeersResponse, error)
 	isBannedFunc    func(ctx context.Context, req *p2p_api.IsBannedRequest) (*p2p_api.IsBannedResponse, error)
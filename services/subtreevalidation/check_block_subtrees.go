@@ -15,6 +15,7 @@ import (
 	"github.com/bitcoin-sv/teranode/services/blockchain"
 	"github.com/bitcoin-sv/teranode/services/subtreevalidation/subtreevalidation_api"
 	"github.com/bitcoin-sv/teranode/services/validator"
+	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/stores/blob/options"
 	"github.com/bitcoin-sv/teranode/util"
 	"github.com/bitcoin-sv/teranode/util/tracing"
@@ -28,6 +29,14 @@ import (
 //
 // Pauses subtree processing during validation to avoid conflicts and returns missing
 // subtree information for blocks that reference unavailable subtrees.
+//
+// The subtrees of a block are not independent: a transaction may spend an output of a transaction in an
+// earlier subtree of the same block, so a subtree can only be validated once the parents of its transactions
+// are known. Validating subtrees concurrently is nevertheless safe, since the subtrees are only fetched
+// concurrently, all their transactions are then validated together in dependency order by
+// processTransactionsInLevels, and subtrees that still fail the concurrent validation are validated again
+// one by one, in block order. The concurrency therefore only affects the throughput, see
+// checkBlockSubtreesConcurrency.
 func (u *Server) CheckBlockSubtrees(ctx context.Context, request *subtreevalidation_api.CheckBlockSubtreesRequest) (*subtreevalidation_api.CheckBlockSubtreesResponse, error) {
 	block, err := model.NewBlockFromBytes(request.Block)
 	if err != nil {
@@ -129,9 +138,11 @@ func (u *Server) CheckBlockSubtrees(ctx context.Context, request *subtreevalidat
 		allTransactions = make([]*bt.Tx, 0, block.TransactionCount)
 	)
 
+	concurrency := checkBlockSubtreesConcurrency(&u.settings.SubtreeValidation, len(missingSubtrees))
+
 	// get all the subtrees that are missing from the peer in parallel
 	g, gCtx := errgroup.WithContext(ctx)
-	util.SafeSetLimit(g, u.settings.SubtreeValidation.CheckBlockSubtreesConcurrency)
+	util.SafeSetLimit(g, concurrency)
 
 	dah := u.utxoStore.GetBlockHeight() + u.settings.GetSubtreeValidationBlockHeightRetention()
 
@@ -275,7 +286,7 @@ func (u *Server) CheckBlockSubtrees(ctx context.Context, request *subtreevalidat
 		}
 
 		g, gCtx = errgroup.WithContext(ctx)
-		util.SafeSetLimit(g, u.settings.SubtreeValidation.CheckBlockSubtreesConcurrency)
+		util.SafeSetLimit(g, concurrency)
 
 		var revalidateSubtreesMutex sync.Mutex
 		revalidateSubtrees := make([]chainhash.Hash, 0, len(missingSubtrees))
@@ -361,6 +372,20 @@ func (u *Server) CheckBlockSubtrees(ctx context.Context, request *subtreevalidat
 	}, nil
 }
 
+// checkBlockSubtreesConcurrency returns the number of subtrees of a block that are fetched and validated concurrently.
+// Blocks use the CheckBlockSubtreesConcurrency setting, unless CheckBlockSubtreesMaxConcurrency is higher. The concurrency
+// then scales with the number of subtrees, one worker per CheckBlockSubtreesPerWorker subtrees, between both limits.
+func checkBlockSubtreesConcurrency(tSettings *settings.SubtreeValidationSettings, numSubtrees int) int {
+	concurrency := tSettings.CheckBlockSubtreesConcurrency
+	if tSettings.CheckBlockSubtreesMaxConcurrency <= concurrency || tSettings.CheckBlockSubtreesPerWorker <= 0 {
+		return concurrency
+	}
+
+	workers := (numSubtrees + tSettings.CheckBlockSubtreesPerWorker - 1) / tSettings.CheckBlockSubtreesPerWorker
+
+	return max(concurrency, min(workers, tSettings.CheckBlockSubtreesMaxConcurrency))
+}
+
 // extractAndCollectTransactions extracts all transactions from a subtree's data file
 // and adds them to the shared collection for block-wide processing
 func (u *Server) extractAndCollectTransactions(ctx context.Context, subtree *subtreepkg.Subtree, subtreeTransactions *[]*bt.Tx) error {
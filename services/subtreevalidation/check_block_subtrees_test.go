@@ -1736,3 +1736,33 @@ func TestCheckBlockSubtrees_ParentBlockErrors(t *testing.T) {
 		assert.True(t, response.Blessed)
 	})
 }
+
+func TestCheckBlockSubtreesConcurrency(t *testing.T) {
+	tests := []struct {
+		name           string
+		concurrency    int
+		maxConcurrency int
+		perWorker      int
+		numSubtrees    int
+		expected       int
+	}{
+		{name: "scaling disabled", concurrency: 32, maxConcurrency: 0, perWorker: 4, numSubtrees: 1024, expected: 32},
+		{name: "max below concurrency", concurrency: 32, maxConcurrency: 16, perWorker: 4, numSubtrees: 1024, expected: 32},
+		{name: "no subtrees per worker", concurrency: 32, maxConcurrency: 256, perWorker: 0, numSubtrees: 1024, expected: 32},
+		{name: "small block", concurrency: 32, maxConcurrency: 256, perWorker: 4, numSubtrees: 16, expected: 32},
+		{name: "large block", concurrency: 32, maxConcurrency: 256, perWorker: 4, numSubtrees: 401, expected: 101},
+		{name: "very large block", concurrency: 32, maxConcurrency: 256, perWorker: 4, numSubtrees: 4096, expected: 256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tSettings := &settings.SubtreeValidationSettings{
+				CheckBlockSubtreesConcurrency:    tt.concurrency,
+				CheckBlockSubtreesMaxConcurrency: tt.maxConcurrency,
+				CheckBlockSubtreesPerWorker:      tt.perWorker,
+			}
+
+			assert.Equal(t, tt.expected, checkBlockSubtreesConcurrency(tSettings, tt.numSubtrees))
+		})
+	}
+}
@@ -417,8 +417,11 @@ func (v *Validator) validateInternal(ctx context.Context, tx *bt.Tx, blockHeight
 		// get the block heights of all inputs of the transaction and extend the inputs of not extended transaction.
 		// utxoHeights is a slice of block heights for each input
 		// txInpoints is a struct containing the parent tx hashes and the vout indexes of each input
-		if utxoHeights, err = v.getTransactionInputBlockHeightsAndExtendTx(ctx, tx, txID); err != nil {
-			err = errors.NewProcessingError("[Validate][%s] error getting transaction input block heights", txID, err)
+		if utxoHeights, err = v.getTransactionInputBlockHeightsAndExtendTx(ctx, tx, txID, blockHeight); err != nil {
+			if !errors.Is(err, errors.ErrTxCoinbaseImmature) {
+				err = errors.NewProcessingError("[Validate][%s] error getting transaction input block heights", txID, err)
+			}
+
 			span.RecordError(err)
 
 			return nil, err
@@ -437,8 +440,11 @@ func (v *Validator) validateInternal(ctx context.Context, tx *bt.Tx, blockHeight
 	// if the transaction was extended, we still need to get the block heights of the inputs
 	// since that processing did not happen before the validateTransaction step
 	if len(utxoHeights) == 0 {
-		if utxoHeights, err = v.getTransactionInputBlockHeightsAndExtendTx(ctx, tx, txID); err != nil {
-			err = errors.NewProcessingError("[Validate][%s] error getting transaction input block heights", txID, err)
+		if utxoHeights, err = v.getTransactionInputBlockHeightsAndExtendTx(ctx, tx, txID, blockHeight); err != nil {
+			if !errors.Is(err, errors.ErrTxCoinbaseImmature) {
+				err = errors.NewProcessingError("[Validate][%s] error getting transaction input block heights", txID, err)
+			}
+
 			span.RecordError(err)
 
 			return nil, err
@@ -621,15 +627,16 @@ func (v *Validator) validateInternal(ctx context.Context, tx *bt.Tx, blockHeight
 	return txMetaData, nil
 }
 
-// getTransactionInputBlockHeights returns the block heights for each input of the transaction
-func (v *Validator) getTransactionInputBlockHeightsAndExtendTx(ctx context.Context, tx *bt.Tx, txID string) ([]uint32, error) {
+// getTransactionInputBlockHeights returns the block heights for each input of the transaction and checks that
+// coinbase outputs spent by the transaction are mature at the given block height
+func (v *Validator) getTransactionInputBlockHeightsAndExtendTx(ctx context.Context, tx *bt.Tx, txID string, blockHeight uint32) ([]uint32, error) {
 	ctx, span, endSpan := tracing.Tracer("validator").Start(ctx, "getTransactionInputBlockHeightsAndExtendTx",
 		tracing.WithHistogram(getTransactionInputBlockHeights),
 	)
 	defer endSpan()
 
 	// get the utxo heights for each input
-	utxoHeights, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, txID)
+	utxoHeights, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, txID, blockHeight)
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
@@ -659,7 +666,7 @@ func (v *Validator) twoPhaseCommitTransaction(ctx context.Context, tx *bt.Tx, tx
 }
 
 // getUtxoBlockHeightsAndExtendTx returns the block heights for each input of the transaction
-func (v *Validator) getUtxoBlockHeightsAndExtendTx(ctx context.Context, tx *bt.Tx, txID string) ([]uint32, error) {
+func (v *Validator) getUtxoBlockHeightsAndExtendTx(ctx context.Context, tx *bt.Tx, txID string, blockHeight uint32) ([]uint32, error) {
 	// get the block heights of the input transactions of the transaction
 	g, gCtx := errgroup.WithContext(ctx)
 	util.SafeSetLimit(g, v.settings.UtxoStore.GetBatcherSize)
@@ -684,7 +691,11 @@ func (v *Validator) getUtxoBlockHeightsAndExtendTx(ctx context.Context, tx *bt.T
 		inputIdxs := idxs
 
 		g.Go(func() error {
-			if err := v.getUtxoBlockHeightAndExtendForParentTx(gCtx, parentTxHash, inputIdxs, utxoHeights, tx, extend); err != nil {
+			if err := v.getUtxoBlockHeightAndExtendForParentTx(gCtx, parentTxHash, inputIdxs, utxoHeights, tx, extend, blockHeight); err != nil {
+				if errors.Is(err, errors.ErrTxCoinbaseImmature) {
+					return err
+				}
+
 				if errors.Is(err, errors.ErrTxNotFound) {
 					return errors.NewTxMissingParentError("[Validate][%s] error getting parent transaction %s", txID, parentTxHash, err)
 				}
@@ -705,9 +716,11 @@ func (v *Validator) getUtxoBlockHeightsAndExtendTx(ctx context.Context, tx *bt.T
 
 // getUtxoBlockHeightAndExtendForParentTx retrieves the block height for a parent transaction
 // and extends the inputs of the transaction if it is not already extended.
+// When the parent transaction is a coinbase transaction, it must have been mined at least
+// CoinbaseMaturity blocks before blockHeight.
 func (v *Validator) getUtxoBlockHeightAndExtendForParentTx(gCtx context.Context, parentTxHash chainhash.Hash, idxs []int,
-	utxoHeights []uint32, tx *bt.Tx, extend bool) error {
-	f := []fields.FieldName{fields.BlockIDs, fields.BlockHeights}
+	utxoHeights []uint32, tx *bt.Tx, extend bool, blockHeight uint32) error {
+	f := []fields.FieldName{fields.BlockIDs, fields.BlockHeights, fields.IsCoinbase}
 
 	if extend {
 		// add the parent tx outputs to the fields, to be able to extend the transaction
@@ -729,6 +742,12 @@ func (v *Validator) getUtxoBlockHeightAndExtendForParentTx(gCtx context.Context,
 		}
 	}
 
+	if txMeta.IsCoinbase {
+		if err = v.checkCoinbaseMaturity(tx, parentTxHash, utxoHeights[idxs[0]], blockHeight); err != nil {
+			return err
+		}
+	}
+
 	if extend {
 		// extend the transaction inputs with the parent tx outputs
 		for _, idx := range idxs {
@@ -751,6 +770,20 @@ func (v *Validator) getUtxoBlockHeightAndExtendForParentTx(gCtx context.Context,
 	return nil
 }
 
+// checkCoinbaseMaturity returns a TxCoinbaseImmatureError when the outputs of the coinbase transaction mined at
+// coinbaseHeight are not spendable yet at blockHeight, according to the coinbase maturity of the chain.
+func (v *Validator) checkCoinbaseMaturity(tx *bt.Tx, coinbaseTxHash chainhash.Hash, coinbaseHeight uint32, blockHeight uint32) error {
+	maturity := uint64(v.settings.ChainCfgParams.CoinbaseMaturity)
+
+	// compare with uint64 to avoid an overflow for coinbase transactions near the maximum height
+	if uint64(blockHeight) < uint64(coinbaseHeight)+maturity {
+		return errors.NewTxCoinbaseImmatureError("[Validate][%s] coinbase %s mined at height %d is not spendable before height %d, current height %d",
+			tx.TxIDChainHash().String(), coinbaseTxHash.String(), coinbaseHeight, uint64(coinbaseHeight)+maturity, blockHeight)
+	}
+
+	return nil
+}
+
 func (v *Validator) TriggerBatcher() {
 	// Noop
 }
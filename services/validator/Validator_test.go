@@ -819,7 +819,7 @@ func Test_getUtxoBlockHeights(t *testing.T) {
 			BlockHeights: make([]uint32, 0),
 		}, nil)
 
-		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, tx.TxID())
+		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, tx.TxID(), 1000)
 		require.NoError(t, err)
 
 		expected := []uint32{1000, 1000, 1000}
@@ -857,7 +857,7 @@ func Test_getUtxoBlockHeights(t *testing.T) {
 			BlockHeights: []uint32{768, 769},
 		}, nil).Once()
 
-		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, tx.TxID())
+		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, tx, tx.TxID(), 1000)
 		require.NoError(t, err)
 
 		expected := []uint32{125, 1000, 768}
@@ -924,7 +924,7 @@ func Test_getUtxoBlockHeights(t *testing.T) {
 			},
 		}, nil).Once()
 
-		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, txNonExtended, txNonExtended.TxID())
+		utxoHashes, err := v.getUtxoBlockHeightsAndExtendTx(ctx, txNonExtended, txNonExtended.TxID(), 1000)
 		require.NoError(t, err)
 
 		expected := []uint32{125, 1000, 768}
@@ -945,6 +945,74 @@ func Test_getUtxoBlockHeights(t *testing.T) {
 	})
 }
 
+func Test_checkCoinbaseMaturity(t *testing.T) {
+	// teratestnet params with a coinbase maturity of 10, as set by a network params file
+	teraTestNetParams := chaincfg.TeraTestNetParams
+	teraTestNetParams.CoinbaseMaturity = 10
+
+	spendingTx := bt.NewTx()
+	coinbaseTxHash := chainhash.HashH([]byte("coinbase"))
+
+	newValidator := func(params *chaincfg.Params) *Validator {
+		tSettings := settings.NewSettings()
+		tSettings.ChainCfgParams = params
+
+		return &Validator{settings: tSettings}
+	}
+
+	t.Run("teratestnet", func(t *testing.T) {
+		v := newValidator(&teraTestNetParams)
+
+		err := v.checkCoinbaseMaturity(spendingTx, coinbaseTxHash, 100, 109)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrTxCoinbaseImmature))
+
+		require.NoError(t, v.checkCoinbaseMaturity(spendingTx, coinbaseTxHash, 100, 110))
+	})
+
+	t.Run("mainnet", func(t *testing.T) {
+		v := newValidator(&chaincfg.MainNetParams)
+
+		err := v.checkCoinbaseMaturity(spendingTx, coinbaseTxHash, 1000, 1099)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrTxCoinbaseImmature))
+
+		require.NoError(t, v.checkCoinbaseMaturity(spendingTx, coinbaseTxHash, 1000, 1100))
+	})
+
+	t.Run("spending a coinbase output through the parent lookup", func(t *testing.T) {
+		mockUtxoStore := utxostore.MockUtxostore{}
+
+		v := newValidator(&chaincfg.MainNetParams)
+		v.utxoStore = &mockUtxoStore
+
+		parentTx, err := bt.NewTxFromString(model.CoinbaseHex)
+		require.NoError(t, err)
+
+		childTx := bt.NewTx()
+		require.NoError(t, childTx.FromUTXOs(&bt.UTXO{
+			TxIDHash:      parentTx.TxIDChainHash(),
+			Vout:          0,
+			LockingScript: parentTx.Outputs[0].LockingScript,
+			Satoshis:      parentTx.Outputs[0].Satoshis,
+		}))
+
+		mockUtxoStore.On("GetBlockHeight").Return(uint32(1050))
+		mockUtxoStore.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&meta.Data{
+			BlockHeights: []uint32{1000},
+			IsCoinbase:   true,
+		}, nil)
+
+		_, err = v.getUtxoBlockHeightsAndExtendTx(context.Background(), childTx, childTx.TxID(), 1050)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrTxCoinbaseImmature))
+
+		utxoHeights, err := v.getUtxoBlockHeightsAndExtendTx(context.Background(), childTx, childTx.TxID(), 1100)
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{1000}, utxoHeights)
+	})
+}
+
 var tx, _ = bt.NewTxFromString("010000000000000000ef01c2945d5f275f6eee3a4e0c98382f0851a670e839e7e56453fbe6c78ddc093ab7000000006a4730440220633afe2995ed52b7f67c8c01efc2e4db73490de57e9a619319987e8f850c661b022032f59a4987b5ecee94f1f7c1e0411bea8c7709cdcf358499bc92dffad5646523412103184f5441e86260412485efa64e31b7a6f9f7c078078abe685ca53db35701471effffffff00f2052a010000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88acfdf40180969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac80969800000000001976a914c362d5af234dd4e1f2a1bfbcab90036d38b0aa9f88ac00000000")
 
 func TestFalseOrEmptyTopStackElementScriptError(t *testing.T) {
@@ -0,0 +1,327 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors" //nolint:depguard // refactor needed to use the internal errors package
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-chaincfg"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// builtInChainParams are the networks defined by chaincfg, a custom network may not reuse their magic bytes.
+var builtInChainParams = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNetParams,
+	&chaincfg.RegressionNetParams,
+	&chaincfg.StnParams,
+	&chaincfg.TeraTestNetParams,
+	&chaincfg.TeraScalingTestNetParams,
+}
+
+var (
+	// loadedChainParams caches the params per file, since chaincfg.Register rejects a network that is registered twice
+	loadedChainParams   = make(map[string]*chaincfg.Params)
+	loadedChainParamsMu sync.Mutex
+)
+
+// chainParamsFile is the JSON format of a custom network params file.
+// Optional fields that are not set are inherited from the base network.
+type chainParamsFile struct {
+	Name        string `json:"name"`        // required
+	Net         uint32 `json:"net"`         // required, magic bytes of the network
+	Base        string `json:"base"`        // network to inherit unset fields from, defaults to regtest
+	TopicPrefix string `json:"topicPrefix"` // defaults to teranode/bitcoin/<name>
+	DefaultPort string `json:"defaultPort"`
+
+	DNSSeeds []string `json:"dnsSeeds"`
+
+	GenesisHash  string `json:"genesisHash"`  // required, must match the genesis block
+	GenesisBlock string `json:"genesisBlock"` // hex encoded genesis block, defaults to the genesis block of the base network
+
+	PowLimitBits       *uint32 `json:"powLimitBits"`
+	TargetTimePerBlock string  `json:"targetTimePerBlock"` // duration, e.g. "10m"
+
+	BIP0034Height             *int32  `json:"bip34Height"`
+	BIP0065Height             *int32  `json:"bip65Height"`
+	BIP0066Height             *int32  `json:"bip66Height"`
+	CSVHeight                 *uint32 `json:"csvHeight"`
+	UahfForkHeight            *uint32 `json:"uahfForkHeight"`
+	DaaForkHeight             *uint32 `json:"daaForkHeight"`
+	GenesisActivationHeight   *uint32 `json:"genesisActivationHeight"`
+	ChronicleActivationHeight *uint32 `json:"chronicleActivationHeight"`
+
+	CoinbaseMaturity       *uint16 `json:"coinbaseMaturity"`
+	ReduceMinDifficulty    *bool   `json:"reduceMinDifficulty"`
+	NoDifficultyAdjustment *bool   `json:"noDifficultyAdjustment"`
+	GenerateSupported      *bool   `json:"generateSupported"`
+	RequireStandard        *bool   `json:"requireStandard"`
+
+	Checkpoints []chainParamsCheckpoint `json:"checkpoints"`
+
+	CashAddressPrefix      string `json:"cashAddressPrefix"`
+	LegacyPubKeyHashAddrID *byte  `json:"legacyPubKeyHashAddrID"`
+	LegacyScriptHashAddrID *byte  `json:"legacyScriptHashAddrID"`
+	PrivateKeyID           *byte  `json:"privateKeyID"`
+}
+
+type chainParamsCheckpoint struct {
+	Height int32  `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// LoadChainParamsFromFile parses a JSON network params file into chaincfg.Params and registers the network with chaincfg.
+// This allows running private test networks without recompiling. Loading the same file again returns the params
+// registered the first time.
+func LoadChainParamsFromFile(path string) (*chaincfg.Params, error) {
+	loadedChainParamsMu.Lock()
+	defer loadedChainParamsMu.Unlock()
+
+	if params, ok := loadedChainParams[path]; ok {
+		return params, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading chain params file %s: %w", path, err)
+	}
+
+	var file chainParamsFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing chain params file %s: %w", path, err)
+	}
+
+	params, err := file.toParams()
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain params file %s: %w", path, err)
+	}
+
+	if err = chaincfg.Register(params); err != nil {
+		return nil, fmt.Errorf("error registering network %s from %s: %w", params.Name, path, err)
+	}
+
+	loadedChainParams[path] = params
+
+	return params, nil
+}
+
+func (f *chainParamsFile) toParams() (*chaincfg.Params, error) {
+	if f.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if f.Net == 0 {
+		return nil, errors.New("net is required")
+	}
+
+	if f.GenesisHash == "" {
+		return nil, errors.New("genesisHash is required")
+	}
+
+	for _, builtIn := range builtInChainParams {
+		if uint32(builtIn.Net) == f.Net {
+			return nil, fmt.Errorf("net %#x is already used by %s", f.Net, builtIn.Name)
+		}
+	}
+
+	baseName := f.Base
+	if baseName == "" {
+		baseName = "regtest"
+	}
+
+	base, err := chaincfg.GetChainParams(baseName)
+	if err != nil {
+		return nil, err
+	}
+
+	params := *base
+	params.Name = f.Name
+	params.Net = wire.BitcoinNet(f.Net)
+	params.TopicPrefix = "teranode/bitcoin/" + f.Name
+	params.DNSSeeds = nil
+	params.Checkpoints = nil
+
+	if f.TopicPrefix != "" {
+		params.TopicPrefix = f.TopicPrefix
+	}
+
+	if f.DefaultPort != "" {
+		params.DefaultPort = f.DefaultPort
+	}
+
+	for _, host := range f.DNSSeeds {
+		params.DNSSeeds = append(params.DNSSeeds, chaincfg.DNSSeed{Host: host})
+	}
+
+	if err = f.setGenesis(&params); err != nil {
+		return nil, err
+	}
+
+	if f.PowLimitBits != nil {
+		params.PowLimitBits = *f.PowLimitBits
+		params.PowLimit = compactToBig(*f.PowLimitBits)
+	}
+
+	if f.TargetTimePerBlock != "" {
+		if params.TargetTimePerBlock, err = time.ParseDuration(f.TargetTimePerBlock); err != nil {
+			return nil, fmt.Errorf("invalid targetTimePerBlock: %w", err)
+		}
+	}
+
+	if err = f.setCheckpoints(&params); err != nil {
+		return nil, err
+	}
+
+	if f.CashAddressPrefix != "" {
+		params.CashAddressPrefix = f.CashAddressPrefix
+	}
+
+	setIfNotNil(&params.BIP0034Height, f.BIP0034Height)
+	setIfNotNil(&params.BIP0065Height, f.BIP0065Height)
+	setIfNotNil(&params.BIP0066Height, f.BIP0066Height)
+	setIfNotNil(&params.CSVHeight, f.CSVHeight)
+	setIfNotNil(&params.UahfForkHeight, f.UahfForkHeight)
+	setIfNotNil(&params.DaaForkHeight, f.DaaForkHeight)
+	setIfNotNil(&params.GenesisActivationHeight, f.GenesisActivationHeight)
+	setIfNotNil(&params.ChronicleActivationHeight, f.ChronicleActivationHeight)
+	setIfNotNil(&params.CoinbaseMaturity, f.CoinbaseMaturity)
+	setIfNotNil(&params.ReduceMinDifficulty, f.ReduceMinDifficulty)
+	setIfNotNil(&params.NoDifficultyAdjustment, f.NoDifficultyAdjustment)
+	setIfNotNil(&params.GenerateSupported, f.GenerateSupported)
+	setIfNotNil(&params.RequireStandard, f.RequireStandard)
+	setIfNotNil(&params.LegacyPubKeyHashAddrID, f.LegacyPubKeyHashAddrID)
+	setIfNotNil(&params.LegacyScriptHashAddrID, f.LegacyScriptHashAddrID)
+	setIfNotNil(&params.PrivateKeyID, f.PrivateKeyID)
+
+	return &params, nil
+}
+
+// setGenesis sets the genesis block and verifies that it matches the configured genesis hash.
+func (f *chainParamsFile) setGenesis(params *chaincfg.Params) error {
+	genesisHash, err := chainhash.NewHashFromStr(f.GenesisHash)
+	if err != nil {
+		return fmt.Errorf("invalid genesisHash: %w", err)
+	}
+
+	if f.GenesisBlock != "" {
+		blockBytes, err := hex.DecodeString(f.GenesisBlock)
+		if err != nil {
+			return fmt.Errorf("invalid genesisBlock: %w", err)
+		}
+
+		genesisBlock := &wire.MsgBlock{}
+		if err = genesisBlock.Deserialize(bytes.NewReader(blockBytes)); err != nil {
+			return fmt.Errorf("invalid genesisBlock: %w", err)
+		}
+
+		params.GenesisBlock = genesisBlock
+	}
+
+	if blockHash := params.GenesisBlock.BlockHash(); !blockHash.IsEqual(genesisHash) {
+		return fmt.Errorf("genesisHash %s does not match the hash of the genesis block %s", genesisHash, blockHash)
+	}
+
+	params.GenesisHash = genesisHash
+
+	return nil
+}
+
+// setCheckpoints sets the checkpoints, which must be ordered from oldest to newest.
+func (f *chainParamsFile) setCheckpoints(params *chaincfg.Params) error {
+	for _, checkpoint := range f.Checkpoints {
+		if err := AddCheckpoint(params, checkpoint.Height, checkpoint.Hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCheckpoint appends a checkpoint to the params, so the legacy sync manager can use headers-first sync on
+// networks without built-in checkpoints. Checkpoints must be added from oldest to newest.
+func AddCheckpoint(params *chaincfg.Params, height int32, hashStr string) error {
+	hash, err := chainhash.NewHashFromStr(hashStr)
+	if err != nil {
+		return fmt.Errorf("invalid hash for checkpoint at height %d: %w", height, err)
+	}
+
+	if height < 0 {
+		return fmt.Errorf("invalid height for checkpoint %s: %d", hashStr, height)
+	}
+
+	if n := len(params.Checkpoints); n > 0 && height <= params.Checkpoints[n-1].Height {
+		return fmt.Errorf("checkpoint at height %d is not above the previous checkpoint at height %d", height, params.Checkpoints[n-1].Height)
+	}
+
+	params.Checkpoints = append(params.Checkpoints, chaincfg.Checkpoint{Height: height, Hash: hash})
+
+	return nil
+}
+
+// withCheckpoints returns a copy of the params with the configured checkpoints in the '<height>:<hash>' format added,
+// the built-in params are shared and must not be modified.
+func withCheckpoints(params *chaincfg.Params, checkpoints []string) (*chaincfg.Params, error) {
+	if len(checkpoints) == 0 {
+		return params, nil
+	}
+
+	paramsCopy := *params
+	paramsCopy.Checkpoints = slices.Clone(params.Checkpoints)
+
+	for _, checkpoint := range checkpoints {
+		heightStr, hashStr, found := strings.Cut(checkpoint, ":")
+		if !found {
+			return nil, fmt.Errorf("unable to parse checkpoint %q, use the syntax <height>:<hash>", checkpoint)
+		}
+
+		height, err := strconv.ParseInt(heightStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse checkpoint %q due to malformed height: %w", checkpoint, err)
+		}
+
+		if err = AddCheckpoint(&paramsCopy, int32(height), hashStr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &paramsCopy, nil
+}
+
+func setIfNotNil[T any](target *T, value *T) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+// compactToBig converts the compact representation of a proof of work limit to a big.Int.
+func compactToBig(compact uint32) *big.Int {
+	mantissa := compact & 0x007fffff
+	isNegative := compact&0x00800000 != 0
+	exponent := uint(compact >> 24)
+
+	var bn *big.Int
+
+	if exponent <= 3 {
+		mantissa >>= 8 * (3 - exponent)
+		bn = big.NewInt(int64(mantissa))
+	} else {
+		bn = big.NewInt(int64(mantissa))
+		bn.Lsh(bn, 8*(exponent-3))
+	}
+
+	if isNegative {
+		bn = bn.Neg(bn)
+	}
+
+	return bn
+}
@@ -0,0 +1,226 @@
+package settings
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-chaincfg"
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const regtestGenesisHash = "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"
+
+func writeChainParamsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadChainParamsFromFile(t *testing.T) {
+	t.Run("valid params file", func(t *testing.T) {
+		path := writeChainParamsFile(t, `{
+			"name": "privatenet",
+			"net": 3669344250,
+			"genesisHash": "`+regtestGenesisHash+`",
+			"powLimitBits": 545259519,
+			"targetTimePerBlock": "1m",
+			"csvHeight": 10,
+			"genesisActivationHeight": 20,
+			"coinbaseMaturity": 5,
+			"checkpoints": [
+				{"height": 100, "hash": "`+regtestGenesisHash+`"},
+				{"height": 200, "hash": "`+regtestGenesisHash+`"}
+			],
+			"cashAddressPrefix": "privnet",
+			"legacyPubKeyHashAddrID": 100
+		}`)
+
+		params, err := LoadChainParamsFromFile(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, "privatenet", params.Name)
+		assert.Equal(t, wire.BitcoinNet(3669344250), params.Net)
+		assert.Equal(t, "teranode/bitcoin/privatenet", params.TopicPrefix)
+		assert.Equal(t, regtestGenesisHash, params.GenesisHash.String())
+		assert.Equal(t, uint32(545259519), params.PowLimitBits)
+		assert.Equal(t, new(big.Int).Lsh(big.NewInt(0x7fffff), 8*(0x20-3)), params.PowLimit)
+		assert.Equal(t, time.Minute, params.TargetTimePerBlock)
+		assert.Equal(t, uint32(10), params.CSVHeight)
+		assert.Equal(t, uint32(20), params.GenesisActivationHeight)
+		assert.Equal(t, uint16(5), params.CoinbaseMaturity)
+		assert.Equal(t, byte(100), params.LegacyPubKeyHashAddrID)
+		assert.Equal(t, "privnet", params.CashAddressPrefix)
+		require.Len(t, params.Checkpoints, 2)
+		assert.Equal(t, int32(200), params.Checkpoints[1].Height)
+
+		// unset fields are inherited from regtest
+		assert.Equal(t, chaincfg.RegressionNetParams.DefaultPort, params.DefaultPort)
+		assert.Equal(t, chaincfg.RegressionNetParams.LegacyScriptHashAddrID, params.LegacyScriptHashAddrID)
+
+		// the network is registered with chaincfg
+		assert.True(t, chaincfg.IsPubKeyHashAddrID(params.Net, 100))
+
+		// loading the same file again returns the registered params
+		paramsAgain, err := LoadChainParamsFromFile(path)
+		require.NoError(t, err)
+		assert.Same(t, params, paramsAgain)
+	})
+
+	t.Run("duplicate net", func(t *testing.T) {
+		content := `{"name": "duplicatenet", "net": 3669344251, "genesisHash": "` + regtestGenesisHash + `"}`
+
+		_, err := LoadChainParamsFromFile(writeChainParamsFile(t, content))
+		require.NoError(t, err)
+
+		_, err = LoadChainParamsFromFile(writeChainParamsFile(t, content))
+		require.ErrorIs(t, err, chaincfg.ErrDuplicateNet)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			content string
+			errMsg  string
+		}{
+			{
+				name:    "invalid json",
+				content: `{"name": `,
+				errMsg:  "error parsing chain params file",
+			},
+			{
+				name:    "missing name",
+				content: `{"net": 1, "genesisHash": "` + regtestGenesisHash + `"}`,
+				errMsg:  "name is required",
+			},
+			{
+				name:    "missing net",
+				content: `{"name": "net", "genesisHash": "` + regtestGenesisHash + `"}`,
+				errMsg:  "net is required",
+			},
+			{
+				name:    "missing genesis hash",
+				content: `{"name": "net", "net": 1}`,
+				errMsg:  "genesisHash is required",
+			},
+			{
+				name:    "net of a built-in network",
+				content: `{"name": "net", "net": 3908297187, "genesisHash": "` + regtestGenesisHash + `"}`,
+				errMsg:  "is already used by mainnet",
+			},
+			{
+				name:    "unknown base network",
+				content: `{"name": "net", "net": 1, "base": "unknown", "genesisHash": "` + regtestGenesisHash + `"}`,
+				errMsg:  "unknown network",
+			},
+			{
+				name:    "genesis hash mismatch",
+				content: `{"name": "net", "net": 1, "genesisHash": "` + chaincfg.MainNetParams.GenesisHash.String() + `"}`,
+				errMsg:  "does not match the hash of the genesis block",
+			},
+			{
+				name:    "invalid genesis block",
+				content: `{"name": "net", "net": 1, "genesisHash": "` + regtestGenesisHash + `", "genesisBlock": "0102"}`,
+				errMsg:  "invalid genesisBlock",
+			},
+			{
+				name:    "invalid target time per block",
+				content: `{"name": "net", "net": 1, "genesisHash": "` + regtestGenesisHash + `", "targetTimePerBlock": "soon"}`,
+				errMsg:  "invalid targetTimePerBlock",
+			},
+			{
+				name:    "invalid checkpoint hash",
+				content: `{"name": "net", "net": 1, "genesisHash": "` + regtestGenesisHash + `", "checkpoints": [{"height": 1, "hash": "xyz"}]}`,
+				errMsg:  "invalid hash for checkpoint at height 1",
+			},
+			{
+				name: "checkpoints out of order",
+				content: `{"name": "net", "net": 1, "genesisHash": "` + regtestGenesisHash + `", "checkpoints": [` +
+					`{"height": 2, "hash": "` + regtestGenesisHash + `"}, {"height": 1, "hash": "` + regtestGenesisHash + `"}]}`,
+				errMsg: "checkpoint at height 1 is not above the previous checkpoint at height 2",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params, err := LoadChainParamsFromFile(writeChainParamsFile(t, tt.content))
+				require.Error(t, err)
+				assert.Nil(t, params)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			})
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadChainParamsFromFile(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error reading chain params file")
+	})
+}
+
+func TestAddCheckpoint(t *testing.T) {
+	params := chaincfg.TeraTestNetParams
+	params.Checkpoints = nil
+
+	require.NoError(t, AddCheckpoint(&params, 100, regtestGenesisHash))
+	require.NoError(t, AddCheckpoint(&params, 200, regtestGenesisHash))
+
+	require.Len(t, params.Checkpoints, 2)
+	assert.Equal(t, int32(100), params.Checkpoints[0].Height)
+	assert.Equal(t, regtestGenesisHash, params.Checkpoints[1].Hash.String())
+
+	t.Run("out of order", func(t *testing.T) {
+		err := AddCheckpoint(&params, 150, regtestGenesisHash)
+		require.ErrorContains(t, err, "checkpoint at height 150 is not above the previous checkpoint at height 200")
+
+		err = AddCheckpoint(&params, 200, regtestGenesisHash)
+		require.Error(t, err)
+		assert.Len(t, params.Checkpoints, 2)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		err := AddCheckpoint(&params, 300, "xyz")
+		require.ErrorContains(t, err, "invalid hash for checkpoint at height 300")
+		assert.Len(t, params.Checkpoints, 2)
+	})
+
+	t.Run("negative height", func(t *testing.T) {
+		err := AddCheckpoint(&params, -1, regtestGenesisHash)
+		require.ErrorContains(t, err, "invalid height for checkpoint")
+	})
+}
+
+func TestWithCheckpoints(t *testing.T) {
+	t.Run("no checkpoints returns the same params", func(t *testing.T) {
+		params, err := withCheckpoints(&chaincfg.TeraTestNetParams, nil)
+		require.NoError(t, err)
+		assert.Same(t, &chaincfg.TeraTestNetParams, params)
+	})
+
+	t.Run("checkpoints are added to a copy", func(t *testing.T) {
+		params, err := withCheckpoints(&chaincfg.TeraTestNetParams, []string{"10:" + regtestGenesisHash, "20:" + regtestGenesisHash})
+		require.NoError(t, err)
+
+		require.Len(t, params.Checkpoints, 2)
+		assert.Equal(t, int32(20), params.Checkpoints[1].Height)
+		assert.Equal(t, chaincfg.TeraTestNetParams.Name, params.Name)
+		assert.Empty(t, chaincfg.TeraTestNetParams.Checkpoints)
+	})
+
+	t.Run("invalid checkpoints", func(t *testing.T) {
+		_, err := withCheckpoints(&chaincfg.TeraTestNetParams, []string{regtestGenesisHash})
+		require.ErrorContains(t, err, "use the syntax <height>:<hash>")
+
+		_, err = withCheckpoints(&chaincfg.TeraTestNetParams, []string{"ten:" + regtestGenesisHash})
+		require.ErrorContains(t, err, "malformed height")
+
+		_, err = withCheckpoints(&chaincfg.TeraTestNetParams, []string{"20:" + regtestGenesisHash, "10:" + regtestGenesisHash})
+		require.ErrorContains(t, err, "is not above the previous checkpoint")
+		assert.Empty(t, chaincfg.TeraTestNetParams.Checkpoints)
+	})
+}
@@ -173,8 +173,10 @@ type BlockSettings struct {
 	StateFile                             string
 	CheckDuplicateTransactionsConcurrency int
 	GetAndValidateSubtreesConcurrency     int
+	GetCounterConflictingTxsConcurrency   int
 	KafkaWorkers                          int
 	ValidOrderAndBlessedConcurrency       int
+	ValidOrderAndBlessedCollectAllErrors  bool // validate all transactions of a block and report every failure, instead of stopping at the first
 	StoreCacheEnabled                     bool
 	StoreCacheSize                        int
 	MaxSize                               int
@@ -193,6 +195,19 @@ type BlockSettings struct {
 	BlockPersisterPersistAge              uint32
 	BlockPersisterPersistSleep            time.Duration
 	UtxoStore                             *url.URL
+	VerifyValueConservation               bool
+	VerifyValueConservationSampleRate     float64       // fraction of transactions checked when VerifyValueConservation is enabled
+	GetMetaBatchSize                      int           // batch size for parent tx meta lookups in block validation, 0 disables batching
+	PreloadSubtreeMeta                    bool          // read the subtree meta files of a block concurrently before validating its transactions
+	PreloadSubtreeMetaConcurrency         int           // concurrency of the subtree meta reads when PreloadSubtreeMeta is enabled, <= 0 uses the number of CPUs
+	ParentCheckConcurrency                int           // maximum number of concurrent parent tx checks against the tx meta store, shared by all subtrees of a block
+	MaxFeePerByte                         uint64        // maximum fees in satoshis per byte that a subtree of a block may report, 0 disables the check
+	FetchRetryCount                       int           // retries of the subtree and tx meta fetches of block validation, 0 keeps the defaults
+	FetchRetryBackoff                     time.Duration // base delay between the retries of the fetches of block validation, 0 keeps the defaults
+	FetchRetryMaxBackoff                  time.Duration // maximum delay of the exponential backoff of the fetches of block validation, 0 keeps the linear backoff
+	EnforceMedianTimePast                 bool          // reject blocks with a timestamp that is not after the median time past, disabled on networks that mine quickly
+	MaxFutureBlockTime                    time.Duration // reject blocks with a timestamp further than this ahead of the local clock
+	RecentBloomWindow                     uint32        // number of recent blocks to keep bloom filters for in block validation, 0 derives it from the subtree retention
 }
 
 type BlockChainSettings struct {
@@ -206,6 +221,25 @@ type BlockChainSettings struct {
 	FSMStateChangeDelay   time.Duration // used by tests to delay the state change and have time to capture the state
 	StoreDBTimeoutMillis  int
 	InitializeNodeInState string
+	// CompactionInterval is how often the blockchain store is compacted, 0 disables periodic compaction
+	CompactionInterval time.Duration
+	// CompactionSafetyDepth is the number of blocks below the best height that invalid blocks must be before being removed
+	CompactionSafetyDepth uint32
+	// CompactionStateRetention is how long a state key may go without being updated before it is pruned, 0 disables state pruning
+	CompactionStateRetention time.Duration
+	// CompactionStateKeyPrefixes are the prefixes of the state keys that may be pruned, other state keys are never pruned
+	CompactionStateKeyPrefixes []string
+	// MaxBlocksByHeightRange is the maximum number of blocks that can be requested in a single GetBlocksByHeightRange call
+	MaxBlocksByHeightRange uint32
+	// MaxReorgDepth is the maximum number of confirmations a best chain block may have to be invalidated without force, 0 disables the limit
+	MaxReorgDepth uint32
+	// MaxTipAge is the maximum age of the best block for the node to be considered current
+	MaxTipAge time.Duration
+	// KafkaDeliveryTimeout is how long AddBlock waits for the delivery of a block to Kafka, when asked to wait for it
+	KafkaDeliveryTimeout time.Duration
+	// SubtreesSetNotificationConfirmations is the number of confirmations a block needs on the best chain before its
+	// BlockSubtreesSet notification is sent, 0 sends the notification immediately
+	SubtreesSetNotificationConfirmations uint32
 }
 
 type BlockAssemblySettings struct {
@@ -235,6 +269,7 @@ type BlockAssemblySettings struct {
 	DifficultyCache                     bool
 	UseDynamicSubtreeSize               bool
 	MiningCandidateCacheTimeout         time.Duration
+	MiningNBits                         string // fallback difficulty bits when the next work required cannot be retrieved, no fallback when empty
 }
 
 type BlockValidationSettings struct {
@@ -242,6 +277,8 @@ type BlockValidationSettings struct {
 	RetrySleep                                       time.Duration
 	GRPCAddress                                      string
 	GRPCListenAddress                                string
+	HTTPListenAddress                                string        // debug HTTP server, disabled when empty
+	HTTPValidateBlockTimeout                         time.Duration // maximum duration of a validation report request
 	KafkaWorkers                                     int
 	LocalSetTxMinedConcurrency                       int
 	MaxPreviousBlockHeadersToCheck                   uint64
@@ -261,7 +298,6 @@ type BlockValidationSettings struct {
 	OptimisticMining                                 bool
 	IsParentMinedRetryMaxRetry                       int
 	IsParentMinedRetryBackoffMultiplier              int
-	SubtreeGroupConcurrency                          int
 	BlockFoundChBufferSize                           int
 	CatchupChBufferSize                              int
 	UseCatchupWhenBehind                             bool
@@ -275,11 +311,20 @@ type BlockValidationSettings struct {
 	ArePreviousBlocksProcessedMaxRetry               int
 	ArePreviousBlocksProcessedRetryBackoffMultiplier int
 	PreviousBlockHeaderCount                         uint64
+	ParentProcessingTimeout                          time.Duration // maximum time to wait for the parent of a found block to finish validation
+	KafkaRecoverableErrors                           []string      // error codes for which a kafka block message is consumed again
+	KafkaNonRecoverableErrors                        []string      // error codes for which a kafka block message is committed, takes precedence over KafkaRecoverableErrors
+	StopTimeout                                      time.Duration // maximum time Stop waits for the queued blocks to be processed
+	BloomStatsHistorySize                            int           // number of recently validated blocks whose bloom filter stats are kept for the /bloomstats endpoint
 	// Catchup configuration
-	CatchupMaxRetries            int // Maximum number of retries for catchup operations
-	CatchupIterationTimeout      int // Timeout in seconds for each catchup iteration
-	CatchupOperationTimeout      int // Timeout in seconds for the entire catchup operation
-	CatchupMaxAccumulatedHeaders int // Maximum headers to accumulate during catchup (default: 100000)
+	CatchupMaxRetries             int    // Maximum number of retries for catchup operations
+	CatchupIterationTimeout       int    // Timeout in seconds for each catchup iteration
+	CatchupOperationTimeout       int    // Timeout in seconds for the entire catchup operation
+	CatchupMaxAccumulatedHeaders  int    // Maximum headers to accumulate during catchup (default: 100000)
+	CatchupHeaderWindow           int    // Maximum headers to accumulate before their blocks are validated, the catchup continues with the next window, 0 disables (default: 0)
+	CatchupHeaderPeerFanOut       int    // Number of peers each catchup header request is sent to, including the catchup peer (default: 1)
+	CatchupHeaderFetchConcurrency int    // Maximum number of peers requested at the same time for catchup headers (default: 2)
+	CatchupTrustedDepth           uint32 // Depth below the catchup target from which the recent blocks bloom filter check is skipped, 0 disables (default: 0)
 	// Circuit breaker configuration
 	CircuitBreakerFailureThreshold int // Number of consecutive failures before opening circuit
 	CircuitBreakerSuccessThreshold int // Number of consecutive successes before closing circuit
@@ -446,6 +491,7 @@ type SubtreeValidationSettings struct {
 	QuorumPath                                string
 	QuorumAbsoluteTimeout                     time.Duration
 	SubtreeStore                              *url.URL
+	SubtreeStoreChecksums                     bool
 	FailFastValidation                        bool
 	GetMissingTransactions                    int
 	GRPCAddress                               string
@@ -474,7 +520,9 @@ type SubtreeValidationSettings struct {
 	BlockHeightRetentionAdjustment int32 // Adjustment to GlobalBlockHeightRetention (can be positive or negative)
 	OrphanageTimeout               time.Duration
 	// Concurrency limits
-	CheckBlockSubtreesConcurrency int // Concurrency limit for CheckBlockSubtrees operations (default: 32)
+	CheckBlockSubtreesConcurrency    int // Concurrency limit for CheckBlockSubtrees operations (default: 32)
+	CheckBlockSubtreesMaxConcurrency int // Upper concurrency limit for CheckBlockSubtrees on large blocks, <= CheckBlockSubtreesConcurrency disables scaling (default: 0)
+	CheckBlockSubtreesPerWorker      int // Number of subtrees per worker when scaling the CheckBlockSubtrees concurrency (default: 4)
 }
 
 type LegacySettings struct {
@@ -497,6 +545,17 @@ type LegacySettings struct {
 	TempStore                        *url.URL
 	PeerIdleTimeout                  time.Duration
 	PeerProcessingTimeout            time.Duration
+	MaxInvalidBlocks                 int
+	InvalidBlockBanDuration          time.Duration
+	MaxOrphanTxs                     int
+	BlockRequestTimeout              time.Duration
+	TxForwardToPropagation           bool
+	RecentTxFilterCapacity           uint64
+	RecentTxFilterFPRate             float64
+	SyncPeerMaxLastBlockTime         time.Duration
+	SyncPeerMinInFlightBlocks        int
+	SyncPeerCheckInterval            time.Duration
+	PeerTxRateLimit                  float64 // transactions per second accepted from a single peer, 0 disables the limit
 }
 
 type PropagationSettings struct {
@@ -14,11 +14,27 @@ func NewSettings(alternativeContext ...string) *Settings {
 		settingsContext = alternativeContext[0]
 	}
 
-	params, err := chaincfg.GetChainParams(getString("network", "mainnet", alternativeContext...))
+	var (
+		params *chaincfg.Params
+		err    error
+	)
+
+	// a custom network params file takes precedence over the built-in networks
+	if paramsFile := getString("network_paramsFile", "", alternativeContext...); paramsFile != "" {
+		params, err = LoadChainParamsFromFile(paramsFile)
+	} else {
+		params, err = chaincfg.GetChainParams(getString("network", "mainnet", alternativeContext...))
+	}
+
 	if err != nil {
 		panic(err)
 	}
 
+	// checkpoints can be added to networks without built-in checkpoints, e.g. teratestnet, to enable headers-first sync
+	if params, err = withCheckpoints(params, getMultiString("network_checkpoints", "|", []string{}, alternativeContext...)); err != nil {
+		panic(err)
+	}
+
 	blockMaxSize, err := ParseMemoryUnit(getString("blockmaxsize", "0", alternativeContext...)) // default to 0 - unlimited
 	if err != nil {
 		panic(err)
@@ -168,13 +184,28 @@ func NewSettings(alternativeContext ...string) *Settings {
 			PersisterHTTPListenAddress:            getString("blockPersister_httpListenAddress", ":8083", alternativeContext...),
 			CheckDuplicateTransactionsConcurrency: getInt("block_checkDuplicateTransactionsConcurrency", -1, alternativeContext...),
 			GetAndValidateSubtreesConcurrency:     getInt("block_getAndValidateSubtreesConcurrency", -1, alternativeContext...),
+			GetCounterConflictingTxsConcurrency:   getInt("block_getCounterConflictingTxsConcurrency", -1, alternativeContext...),
 			KafkaWorkers:                          getInt("block_kafkaWorkers", 0, alternativeContext...),
 			ValidOrderAndBlessedConcurrency:       getInt("block_validOrderAndBlessedConcurrency", -1, alternativeContext...),
+			ValidOrderAndBlessedCollectAllErrors:  getBool("block_validOrderAndBlessedCollectAllErrors", false, alternativeContext...),
 			StoreCacheEnabled:                     getBool("blockchain_store_cache_enabled", true, alternativeContext...),
 			StoreCacheSize:                        getInt("blockchain_store_cache_size", 200, alternativeContext...),
 			MaxSize:                               getInt("blockmaxsize", 4294967296, alternativeContext...),
 			BlockStore:                            getURL("blockstore", "file://./data/blockstore", alternativeContext...),
 			FailFastValidation:                    getBool("blockvalidation_fail_fast_validation", true, alternativeContext...),
+			VerifyValueConservation:               getBool("block_verifyValueConservation", false, alternativeContext...),
+			VerifyValueConservationSampleRate:     getFloat64("block_verifyValueConservationSampleRate", 1.0, alternativeContext...),
+			GetMetaBatchSize:                      getInt("block_getMetaBatchSize", 1024, alternativeContext...),
+			PreloadSubtreeMeta:                    getBool("block_preloadSubtreeMeta", false, alternativeContext...),
+			PreloadSubtreeMetaConcurrency:         getInt("block_preloadSubtreeMetaConcurrency", -1, alternativeContext...),
+			ParentCheckConcurrency:                getInt("block_parentCheckConcurrency", 1024*32, alternativeContext...),
+			MaxFeePerByte:                         getUint64("block_maxFeePerByte", 0, alternativeContext...),
+			FetchRetryCount:                       getInt("block_fetchRetryCount", 0, alternativeContext...),
+			FetchRetryBackoff:                     getDuration("block_fetchRetryBackoff", 0, alternativeContext...),
+			FetchRetryMaxBackoff:                  getDuration("block_fetchRetryMaxBackoff", 0, alternativeContext...),
+			EnforceMedianTimePast:                 getBool("block_enforceMedianTimePast", params.Name != chaincfg.RegressionNetParams.Name && params.Name != chaincfg.TeraTestNetParams.Name, alternativeContext...),
+			RecentBloomWindow:                     getUint32("block_recentBloomWindow", 0, alternativeContext...),
+			MaxFutureBlockTime:                    getDuration("block_maxFutureBlockTime", 2*time.Hour, alternativeContext...),
 			FinalizeBlockValidationConcurrency:    getInt("blockvalidation_finalizeBlockValidationConcurrency", 8, alternativeContext...),
 			GetMissingTransactions:                getInt("blockvalidation_getMissingTransactions", 32, alternativeContext...),
 			QuorumTimeout:                         getDuration("block_quorum_timeout", 10*time.Second, alternativeContext...),
@@ -216,24 +247,36 @@ func NewSettings(alternativeContext ...string) *Settings {
 			DifficultyCache:                     getBool("blockassembly_difficultyCache", true, alternativeContext...),
 			UseDynamicSubtreeSize:               getBool("blockassembly_useDynamicSubtreeSize", false, alternativeContext...),
 			MiningCandidateCacheTimeout:         getDuration("blockassembly_miningCandidateCacheTimeout", 5*time.Second),
+			MiningNBits:                         getString("mining_n_bits", "", alternativeContext...),
 		},
 		BlockChain: BlockChainSettings{
-			GRPCAddress:           getString("blockchain_grpcAddress", "localhost:8087", alternativeContext...),
-			GRPCListenAddress:     getString("blockchain_grpcListenAddress", ":8087", alternativeContext...),
-			HTTPListenAddress:     getString("blockchain_httpListenAddress", ":8082", alternativeContext...),
-			MaxRetries:            getInt("blockchain_maxRetries", 3, alternativeContext...),
-			RetrySleep:            getInt("blockchain_retrySleep", 1000, alternativeContext...),
-			StoreURL:              getURL("blockchain_store", "sqlite:///blockchain", alternativeContext...),
-			FSMStateRestore:       getBool("fsm_state_restore", false, alternativeContext...),
-			FSMStateChangeDelay:   getDuration("fsm_state_change_delay", 0, alternativeContext...),
-			StoreDBTimeoutMillis:  getInt("blockchain_store_dbTimeoutMillis", 5000, alternativeContext...),
-			InitializeNodeInState: getString("blockchain_initializeNodeInState", "", alternativeContext...),
+			GRPCAddress:                          getString("blockchain_grpcAddress", "localhost:8087", alternativeContext...),
+			GRPCListenAddress:                    getString("blockchain_grpcListenAddress", ":8087", alternativeContext...),
+			HTTPListenAddress:                    getString("blockchain_httpListenAddress", ":8082", alternativeContext...),
+			MaxRetries:                           getInt("blockchain_maxRetries", 3, alternativeContext...),
+			RetrySleep:                           getInt("blockchain_retrySleep", 1000, alternativeContext...),
+			StoreURL:                             getURL("blockchain_store", "sqlite:///blockchain", alternativeContext...),
+			FSMStateRestore:                      getBool("fsm_state_restore", false, alternativeContext...),
+			FSMStateChangeDelay:                  getDuration("fsm_state_change_delay", 0, alternativeContext...),
+			StoreDBTimeoutMillis:                 getInt("blockchain_store_dbTimeoutMillis", 5000, alternativeContext...),
+			InitializeNodeInState:                getString("blockchain_initializeNodeInState", "", alternativeContext...),
+			CompactionInterval:                   getDuration("blockchain_compactionInterval", 0, alternativeContext...),
+			CompactionSafetyDepth:                getUint32("blockchain_compactionSafetyDepth", 1000, alternativeContext...),
+			CompactionStateRetention:             getDuration("blockchain_compactionStateRetention", 0, alternativeContext...),
+			CompactionStateKeyPrefixes:           getMultiString("blockchain_compactionStateKeyPrefixes", "|", []string{}, alternativeContext...),
+			MaxBlocksByHeightRange:               getUint32("blockchain_maxBlocksByHeightRange", 100, alternativeContext...),
+			MaxReorgDepth:                        getUint32("blockchain_maxReorgDepth", 10, alternativeContext...),
+			MaxTipAge:                            getDuration("blockchain_maxTipAge", 24*time.Hour, alternativeContext...),
+			KafkaDeliveryTimeout:                 getDuration("blockchain_kafkaDeliveryTimeout", 10*time.Second, alternativeContext...),
+			SubtreesSetNotificationConfirmations: getUint32("blockchain_subtreesSetNotificationConfirmations", 0, alternativeContext...),
 		},
 		BlockValidation: BlockValidationSettings{
 			MaxRetries:                                       getInt("blockV	alidationMaxRetries", 3, alternativeContext...),
 			RetrySleep:                                       getDuration("blockValidationRetrySleep", 1*time.Second, alternativeContext...),
 			GRPCAddress:                                      getString("blockvalidation_grpcAddress", "localhost:8088", alternativeContext...),
 			GRPCListenAddress:                                getString("blockvalidation_grpcListenAddress", ":8088", alternativeContext...),
+			HTTPListenAddress:                                getString("blockvalidation_httpListenAddress", "", alternativeContext...),
+			HTTPValidateBlockTimeout:                         getDuration("blockvalidation_http_validate_block_timeout", time.Minute, alternativeContext...),
 			KafkaWorkers:                                     getInt("blockvalidation_kafkaWorkers", 0, alternativeContext...),
 			LocalSetTxMinedConcurrency:                       getInt("blockvalidation_localSetTxMinedConcurrency", 8, alternativeContext...),
 			MaxPreviousBlockHeadersToCheck:                   getUint64("blockvalidation_maxPreviousBlockHeadersToCheck", 100, alternativeContext...),
@@ -253,7 +296,6 @@ func NewSettings(alternativeContext ...string) *Settings {
 			OptimisticMining:                                 getBool("blockvalidation_optimistic_mining", true, alternativeContext...),
 			IsParentMinedRetryMaxRetry:                       getInt("blockvalidation_isParentMined_retry_max_retry", 20, alternativeContext...),
 			IsParentMinedRetryBackoffMultiplier:              getInt("blockvalidation_isParentMined_retry_backoff_multiplier", 30, alternativeContext...),
-			SubtreeGroupConcurrency:                          getInt("blockvalidation_subtreeGroupConcurrency", 1, alternativeContext...),
 			ArePreviousBlocksProcessedMaxRetry:               getInt("blockvalidation_isParentMined_retry_max_retry", 20, alternativeContext...),
 			ArePreviousBlocksProcessedRetryBackoffMultiplier: getInt("blockvalidation_isParentMined_retry_backoff_multiplier", 30, alternativeContext...),
 			BlockFoundChBufferSize:                           getInt("blockvalidation_blockFoundCh_buffer_size", 1000, alternativeContext...),
@@ -267,11 +309,20 @@ func NewSettings(alternativeContext ...string) *Settings {
 			CheckSubtreeFromBlockRetryBackoffDuration:        getDuration("blockvalidation_check_subtree_from_block_retry_backoff_duration", 30*time.Second),
 			SecretMiningThreshold:                            getUint32("blockvalidation_secret_mining_threshold", uint32(params.CoinbaseMaturity-1), alternativeContext...), // golint:nolint
 			PreviousBlockHeaderCount:                         getUint64("blockvalidation_previous_block_header_count", 100, alternativeContext...),
+			ParentProcessingTimeout:                          getDuration("blockvalidation_parent_processing_timeout", time.Minute, alternativeContext...),
+			KafkaRecoverableErrors:                           getMultiString("blockvalidation_kafka_recoverable_errors", "|", []string{"SERVICE_ERROR", "SERVICE_UNAVAILABLE", "STORAGE_ERROR", "THRESHOLD_EXCEEDED", "CONTEXT_CANCELED", "EXTERNAL"}, alternativeContext...),
+			KafkaNonRecoverableErrors:                        getMultiString("blockvalidation_kafka_non_recoverable_errors", "|", []string{}, alternativeContext...),
+			StopTimeout:                                      getDuration("blockvalidation_stop_timeout", 30*time.Second, alternativeContext...),
+			BloomStatsHistorySize:                            getInt("blockvalidation_bloom_stats_history", 100, alternativeContext...),
 			// Catchup configuration
-			CatchupMaxRetries:            getInt("blockvalidation_catchup_max_retries", 3, alternativeContext...),
-			CatchupIterationTimeout:      getInt("blockvalidation_catchup_iteration_timeout", 30, alternativeContext...),
-			CatchupOperationTimeout:      getInt("blockvalidation_catchup_operation_timeout", 300, alternativeContext...),
-			CatchupMaxAccumulatedHeaders: getInt("blockvalidation_max_accumulated_headers", 100000, alternativeContext...),
+			CatchupMaxRetries:             getInt("blockvalidation_catchup_max_retries", 3, alternativeContext...),
+			CatchupIterationTimeout:       getInt("blockvalidation_catchup_iteration_timeout", 30, alternativeContext...),
+			CatchupOperationTimeout:       getInt("blockvalidation_catchup_operation_timeout", 300, alternativeContext...),
+			CatchupMaxAccumulatedHeaders:  getInt("blockvalidation_max_accumulated_headers", 100000, alternativeContext...),
+			CatchupHeaderWindow:           getInt("blockvalidation_catchup_header_window", 0, alternativeContext...),
+			CatchupHeaderPeerFanOut:       getInt("blockvalidation_catchup_header_peer_fan_out", 1, alternativeContext...),
+			CatchupHeaderFetchConcurrency: getInt("blockvalidation_catchup_header_fetch_concurrency", 2, alternativeContext...),
+			CatchupTrustedDepth:           getUint32("blockvalidation_catchup_trusted_depth", 0, alternativeContext...),
 			// Catchup circuit breaker configuration
 			CircuitBreakerFailureThreshold: getInt("blockvalidation_circuit_breaker_failure_threshold", 5, alternativeContext...),
 			CircuitBreakerSuccessThreshold: getInt("blockvalidation_circuit_breaker_success_threshold", 2, alternativeContext...),
@@ -409,6 +460,7 @@ func NewSettings(alternativeContext ...string) *Settings {
 			QuorumAbsoluteTimeout:                     getDuration("subtree_quorum_absolute_timeout", 30*time.Second, alternativeContext...),
 			QuorumPath:                                getString("subtree_quorum_path", "", alternativeContext...),
 			SubtreeStore:                              getURL("subtreestore", "", alternativeContext...),
+			SubtreeStoreChecksums:                     getBool("subtreestore_checksums", false, alternativeContext...),
 			FailFastValidation:                        getBool("subtreevalidation_failfast_validation", true, alternativeContext...),
 			GetMissingTransactions:                    getInt("subtreevalidation_getMissingTransactions", max(4, runtime.NumCPU()/2), alternativeContext...),
 			GRPCAddress:                               getString("subtreevalidation_grpcAddress", "localhost:8089", alternativeContext...),
@@ -436,6 +488,8 @@ func NewSettings(alternativeContext ...string) *Settings {
 			BlockHeightRetentionAdjustment:            getInt32("subtreevalidation_blockHeightRetentionAdjustment", 0, alternativeContext...),
 			OrphanageTimeout:                          getDuration("subtreevalidation_orphanageTimeout", 15*time.Minute, alternativeContext...),
 			CheckBlockSubtreesConcurrency:             getInt("subtreevalidation_check_block_subtrees_concurrency", 32, alternativeContext...),
+			CheckBlockSubtreesMaxConcurrency:          getInt("subtreevalidation_check_block_subtrees_max_concurrency", 0, alternativeContext...),
+			CheckBlockSubtreesPerWorker:               getInt("subtreevalidation_check_block_subtrees_per_worker", 4, alternativeContext...),
 		},
 		Legacy: LegacySettings{
 			WorkingDir:                       getString("legacy_workingDir", "../../data", alternativeContext...),
@@ -457,6 +511,17 @@ func NewSettings(alternativeContext ...string) *Settings {
 			TempStore:                        getURL("temp_store", "file://./data/tempstore", alternativeContext...),
 			PeerIdleTimeout:                  getDuration("legacy_peerIdleTimeout", 125*time.Second, alternativeContext...),     // ping/pong interval is 2 mins, so we set this to 125s to be sure
 			PeerProcessingTimeout:            getDuration("legacy_peerProcessingTimeout", 3*time.Minute, alternativeContext...), // processing a block will be the largest message to process
+			MaxInvalidBlocks:                 getInt("legacy_maxInvalidBlocks", 3, alternativeContext...),
+			InvalidBlockBanDuration:          getDuration("legacy_invalidBlockBanDuration", 24*time.Hour, alternativeContext...),
+			MaxOrphanTxs:                     getInt("legacy_maxOrphanTxs", 100_000, alternativeContext...),
+			BlockRequestTimeout:              getDuration("legacy_blockRequestTimeout", 5*time.Minute, alternativeContext...),
+			TxForwardToPropagation:           getBool("legacy_txForwardToPropagation", false, alternativeContext...),
+			RecentTxFilterCapacity:           getUint64("legacy_recentTxFilterCapacity", 1_000_000, alternativeContext...),
+			PeerTxRateLimit:                  getFloat64("legacy_peerTxRateLimit", 10_000, alternativeContext...),
+			RecentTxFilterFPRate:             getFloat64("legacy_recentTxFilterFPRate", 1e-6, alternativeContext...),
+			SyncPeerMaxLastBlockTime:         getDuration("legacy_syncPeerMaxLastBlockTime", 3*time.Minute, alternativeContext...),
+			SyncPeerMinInFlightBlocks:        getInt("legacy_syncPeerMinInFlightBlocks", 10, alternativeContext...),
+			SyncPeerCheckInterval:            getDuration("legacy_syncPeerCheckInterval", 30*time.Second, alternativeContext...),
 		},
 		Propagation: PropagationSettings{
 			IPv6Addresses:        getString("ipv6_addresses", "", alternativeContext...),
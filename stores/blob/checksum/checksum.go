@@ -0,0 +1,225 @@
+// Package checksum provides a blob.Store wrapper that detects corrupted subtrees.
+//
+// When a subtree is stored, the wrapper also stores a crc32c checksum of the serialized
+// subtree next to it, as a subtreeChecksum file with the same key and options. When a
+// subtree is read, the checksum is recalculated and compared to the stored one, returning
+// an ErrSubtreeCorrupt error on a mismatch.
+//
+// This makes it possible to distinguish storage corruption, like a bit flip in a node hash
+// that still deserializes fine, from genuine consensus mismatches such as an invalid merkle root.
+//
+// Subtrees without a stored checksum, for instance written before the wrapper was enabled,
+// are returned without verification. Verifying requires reading the whole subtree into
+// memory before returning it, which is why the wrapper is only enabled through the
+// subtreestore_checksums setting.
+package checksum
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/ordishs/go-utils"
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// blobStore defines the interface contract for blob storage backends.
+// This interface mirrors the main blob.Store interface to enable transparent wrapping.
+type blobStore interface {
+	Health(ctx context.Context, checkLiveness bool) (int, string, error)
+	Exists(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (bool, error)
+	Get(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) ([]byte, error)
+	GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error)
+	Set(ctx context.Context, key []byte, fileType fileformat.FileType, value []byte, opts ...options.FileOption) error
+	SetFromReader(ctx context.Context, key []byte, fileType fileformat.FileType, value io.ReadCloser, opts ...options.FileOption) error
+	SetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, newDAH uint32, opts ...options.FileOption) error
+	GetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (uint32, error)
+	Del(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) error
+	Close(ctx context.Context) error
+	SetCurrentBlockHeight(height uint32)
+}
+
+// Checksum wraps a blob store, storing and verifying a checksum for every subtree.
+// All other file types are passed through to the underlying store unchanged.
+type Checksum struct {
+	logger ulogger.Logger
+	store  blobStore
+}
+
+// New creates a new Checksum wrapper around the given store.
+//
+// Parameters:
+//   - logger: Logger instance for checksum operations
+//   - store: Underlying blob store to wrap
+//
+// Returns:
+//   - *Checksum: Checksum wrapper implementing the blob store interface
+func New(logger ulogger.Logger, store blobStore) *Checksum {
+	return &Checksum{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// Sum returns the checksum of the serialized subtree bytes, as it is stored in the subtreeChecksum file.
+func Sum(subtreeBytes []byte) []byte {
+	return binary.BigEndian.AppendUint32(nil, crc32.Checksum(subtreeBytes, crc32cTable))
+}
+
+func (c *Checksum) Health(ctx context.Context, checkLiveness bool) (int, string, error) {
+	return c.store.Health(ctx, checkLiveness)
+}
+
+func (c *Checksum) Exists(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (bool, error) {
+	return c.store.Exists(ctx, key, fileType, opts...)
+}
+
+func (c *Checksum) Get(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) ([]byte, error) {
+	value, err := c.store.Get(ctx, key, fileType, opts...)
+	if err != nil || fileType != fileformat.FileTypeSubtree {
+		return value, err
+	}
+
+	if err = c.verify(ctx, key, value, opts); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (c *Checksum) GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error) {
+	reader, err := c.store.GetIoReader(ctx, key, fileType, opts...)
+	if err != nil || fileType != fileformat.FileTypeSubtree {
+		return reader, err
+	}
+
+	defer reader.Close()
+
+	// the whole subtree needs to be read before it can be verified
+	value, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.NewStorageError("[Checksum][%s] failed to read subtree", utils.ReverseAndHexEncodeSlice(key), err)
+	}
+
+	if err = c.verify(ctx, key, value, opts); err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(value)), nil
+}
+
+func (c *Checksum) Set(ctx context.Context, key []byte, fileType fileformat.FileType, value []byte, opts ...options.FileOption) error {
+	if err := c.store.Set(ctx, key, fileType, value, opts...); err != nil || fileType != fileformat.FileTypeSubtree {
+		return err
+	}
+
+	return c.setChecksum(ctx, key, Sum(value), opts)
+}
+
+func (c *Checksum) SetFromReader(ctx context.Context, key []byte, fileType fileformat.FileType, value io.ReadCloser, opts ...options.FileOption) error {
+	if fileType != fileformat.FileTypeSubtree {
+		return c.store.SetFromReader(ctx, key, fileType, value, opts...)
+	}
+
+	reader := &checksumReader{ReadCloser: value, hash: crc32.New(crc32cTable)}
+
+	if err := c.store.SetFromReader(ctx, key, fileType, reader, opts...); err != nil {
+		return err
+	}
+
+	return c.setChecksum(ctx, key, reader.hash.Sum(nil), opts)
+}
+
+// SetDAH sets the DAH on both the subtree and its checksum, so they expire together.
+func (c *Checksum) SetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, newDAH uint32, opts ...options.FileOption) error {
+	if err := c.store.SetDAH(ctx, key, fileType, newDAH, opts...); err != nil || fileType != fileformat.FileTypeSubtree {
+		return err
+	}
+
+	if err := c.store.SetDAH(ctx, key, fileformat.FileTypeSubtreeChecksum, newDAH, opts...); err != nil && !isNotFound(err) {
+		return errors.NewStorageError("[Checksum][%s] failed to set DAH on subtree checksum", utils.ReverseAndHexEncodeSlice(key), err)
+	}
+
+	return nil
+}
+
+func (c *Checksum) GetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (uint32, error) {
+	return c.store.GetDAH(ctx, key, fileType, opts...)
+}
+
+// Del deletes the subtree together with its checksum.
+func (c *Checksum) Del(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) error {
+	if err := c.store.Del(ctx, key, fileType, opts...); err != nil || fileType != fileformat.FileTypeSubtree {
+		return err
+	}
+
+	if err := c.store.Del(ctx, key, fileformat.FileTypeSubtreeChecksum, opts...); err != nil && !isNotFound(err) {
+		c.logger.Warnf("[Checksum][%s] failed to delete subtree checksum: %v", utils.ReverseAndHexEncodeSlice(key), err)
+	}
+
+	return nil
+}
+
+func (c *Checksum) Close(ctx context.Context) error {
+	return c.store.Close(ctx)
+}
+
+func (c *Checksum) SetCurrentBlockHeight(height uint32) {
+	c.store.SetCurrentBlockHeight(height)
+}
+
+// setChecksum stores the checksum with the same options as the subtree. Overwriting is always allowed,
+// since a subtree that is stored again must not be verified against the checksum of its previous contents.
+func (c *Checksum) setChecksum(ctx context.Context, key []byte, sum []byte, opts []options.FileOption) error {
+	checksumOpts := append(append([]options.FileOption{}, opts...), options.WithAllowOverwrite(true))
+
+	if err := c.store.Set(ctx, key, fileformat.FileTypeSubtreeChecksum, sum, checksumOpts...); err != nil {
+		return errors.NewStorageError("[Checksum][%s] failed to store subtree checksum", utils.ReverseAndHexEncodeSlice(key), err)
+	}
+
+	return nil
+}
+
+// verify compares the checksum of the subtree bytes with the stored checksum. Subtrees without a stored
+// checksum are not verified.
+func (c *Checksum) verify(ctx context.Context, key []byte, value []byte, opts []options.FileOption) error {
+	stored, err := c.store.Get(ctx, key, fileformat.FileTypeSubtreeChecksum, opts...)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return errors.NewStorageError("[Checksum][%s] failed to get subtree checksum", utils.ReverseAndHexEncodeSlice(key), err)
+	}
+
+	if computed := Sum(value); !bytes.Equal(stored, computed) {
+		return errors.NewSubtreeCorruptError("[Checksum][%s] subtree checksum mismatch, stored %x, computed %x", utils.ReverseAndHexEncodeSlice(key), stored, computed)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBlobNotFound)
+}
+
+// checksumReader calculates the checksum of everything read through it.
+type checksumReader struct {
+	io.ReadCloser
+	hash hash.Hash32
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	_, _ = r.hash.Write(p[:n])
+
+	return n, err
+}
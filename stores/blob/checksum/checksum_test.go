@@ -0,0 +1,124 @@
+package checksum
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
+	"github.com/bitcoin-sv/teranode/stores/blob/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksum(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("subtree-key")
+	value := []byte("serialized-subtree")
+
+	setup := func() (*Checksum, *memory.Memory) {
+		underlying := memory.New()
+		return New(ulogger.TestLogger{}, underlying), underlying
+	}
+
+	t.Run("Set stores the checksum and Get verifies it", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+		sum, err := underlying.Get(ctx, key, fileformat.FileTypeSubtreeChecksum)
+		require.NoError(t, err)
+		assert.Equal(t, Sum(value), sum)
+
+		got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+
+		reader, err := store.GetIoReader(ctx, key, fileformat.FileTypeSubtree)
+		require.NoError(t, err)
+
+		got, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("SetFromReader stores the checksum", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, store.SetFromReader(ctx, key, fileformat.FileTypeSubtree, io.NopCloser(bytes.NewReader(value))))
+
+		sum, err := underlying.Get(ctx, key, fileformat.FileTypeSubtreeChecksum)
+		require.NoError(t, err)
+		assert.Equal(t, Sum(value), sum)
+	})
+
+	t.Run("corrupted subtree", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+		corrupted := bytes.Clone(value)
+		corrupted[0] ^= 0x01
+		require.NoError(t, underlying.Set(ctx, key, fileformat.FileTypeSubtree, corrupted, options.WithAllowOverwrite(true)))
+
+		_, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrSubtreeCorrupt))
+
+		_, err = store.GetIoReader(ctx, key, fileformat.FileTypeSubtree)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrSubtreeCorrupt))
+	})
+
+	t.Run("overwriting a subtree updates the checksum", func(t *testing.T) {
+		store, _ := setup()
+
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, []byte("other"), options.WithAllowOverwrite(true)))
+
+		got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("other"), got)
+	})
+
+	t.Run("subtree without checksum is not verified", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, underlying.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+		got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("other file types are passed through", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtreeData, value))
+
+		exists, err := underlying.Exists(ctx, key, fileformat.FileTypeSubtreeChecksum)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Del and SetDAH include the checksum", func(t *testing.T) {
+		store, underlying := setup()
+
+		require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+		require.NoError(t, store.SetDAH(ctx, key, fileformat.FileTypeSubtree, 100))
+
+		dah, err := underlying.GetDAH(ctx, key, fileformat.FileTypeSubtreeChecksum)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(100), dah)
+
+		require.NoError(t, store.Del(ctx, key, fileformat.FileTypeSubtree))
+
+		exists, err := underlying.Exists(ctx, key, fileformat.FileTypeSubtreeChecksum)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
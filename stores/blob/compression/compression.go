@@ -0,0 +1,337 @@
+// Package compression provides a blob.Store wrapper that transparently compresses blobs.
+//
+// When a blob is stored, the wrapper compresses it with the configured algorithm and prefixes
+// the compressed data with a small header identifying the algorithm. When a blob is read, the
+// header is detected and the data is decompressed, so callers always see the original bytes.
+//
+// Blobs without a compression header, for instance written before compression was enabled or
+// smaller than the minimum size worth compressing, are returned as they are. Blobs compressed
+// with another supported algorithm than the configured one are decompressed as well, which allows
+// changing the algorithm of an existing store.
+//
+// Blobs written with the SkipHeader file option are never compressed, since they are meant to be
+// readable outside of Teranode.
+//
+// Compression is enabled through the compression query parameter of the store URL, for example
+// s3://bucket/subtrees?compression=zstd.
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ordishs/go-utils"
+)
+
+// Algorithm is a supported compression algorithm.
+type Algorithm string
+
+const (
+	Gzip Algorithm = "gzip"
+	Zstd Algorithm = "zstd"
+)
+
+// headerSize is the size of the header that precedes compressed data.
+const headerSize = 8
+
+// minCompressSize is the size below which blobs are stored uncompressed, since the header and
+// the compression framing would outweigh the savings.
+const minCompressSize = 1024
+
+// Compression headers - exactly 8 ASCII characters (8 bytes), like the file format headers
+var (
+	headerGzip = [headerSize]byte{'C', 'G', 'Z', '-', '1', '.', '0', ' '} // CGZ-1.0
+	headerZstd = [headerSize]byte{'C', 'Z', 'S', '-', '1', '.', '0', ' '} // CZS-1.0
+)
+
+// zstdEncoder and zstdDecoder are safe for concurrent use with EncodeAll and DecodeAll.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// blobStore defines the interface contract for blob storage backends.
+// This interface mirrors the main blob.Store interface to enable transparent wrapping.
+type blobStore interface {
+	Health(ctx context.Context, checkLiveness bool) (int, string, error)
+	Exists(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (bool, error)
+	Get(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) ([]byte, error)
+	GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error)
+	Set(ctx context.Context, key []byte, fileType fileformat.FileType, value []byte, opts ...options.FileOption) error
+	SetFromReader(ctx context.Context, key []byte, fileType fileformat.FileType, value io.ReadCloser, opts ...options.FileOption) error
+	SetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, newDAH uint32, opts ...options.FileOption) error
+	GetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (uint32, error)
+	Del(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) error
+	Close(ctx context.Context) error
+	SetCurrentBlockHeight(height uint32)
+}
+
+// Compression wraps a blob store, compressing blobs on write and decompressing them on read.
+type Compression struct {
+	logger    ulogger.Logger
+	store     blobStore
+	algorithm Algorithm
+}
+
+// New creates a new Compression wrapper around the given store.
+//
+// Parameters:
+//   - logger: Logger instance for compression operations
+//   - store: Underlying blob store to wrap
+//   - algorithm: Compression algorithm for new blobs, gzip or zstd
+//
+// Returns:
+//   - *Compression: Compression wrapper implementing the blob store interface
+//   - error: ConfigurationError if the algorithm is not supported
+func New(logger ulogger.Logger, store blobStore, algorithm Algorithm) (*Compression, error) {
+	if algorithm != Gzip && algorithm != Zstd {
+		return nil, errors.NewConfigurationError("unsupported blob compression algorithm %q, expected %q or %q", algorithm, Gzip, Zstd)
+	}
+
+	return &Compression{
+		logger:    logger,
+		store:     store,
+		algorithm: algorithm,
+	}, nil
+}
+
+func (c *Compression) Health(ctx context.Context, checkLiveness bool) (int, string, error) {
+	return c.store.Health(ctx, checkLiveness)
+}
+
+func (c *Compression) Exists(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (bool, error) {
+	return c.store.Exists(ctx, key, fileType, opts...)
+}
+
+func (c *Compression) Get(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) ([]byte, error) {
+	value, err := c.store.Get(ctx, key, fileType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = Decompress(value)
+	if err != nil {
+		return nil, errors.NewStorageError("[Compression][%s] failed to decompress %s", utils.ReverseAndHexEncodeSlice(key), fileType, err)
+	}
+
+	return value, nil
+}
+
+func (c *Compression) GetIoReader(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (io.ReadCloser, error) {
+	reader, err := c.store.GetIoReader(ctx, key, fileType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	decompressed, err := newDecompressReader(reader)
+	if err != nil {
+		_ = reader.Close()
+		return nil, errors.NewStorageError("[Compression][%s] failed to decompress %s", utils.ReverseAndHexEncodeSlice(key), fileType, err)
+	}
+
+	return decompressed, nil
+}
+
+func (c *Compression) Set(ctx context.Context, key []byte, fileType fileformat.FileType, value []byte, opts ...options.FileOption) error {
+	if len(value) < minCompressSize || options.NewFileOptions(opts...).SkipHeader {
+		return c.store.Set(ctx, key, fileType, value, opts...)
+	}
+
+	compressed, err := Compress(c.algorithm, value)
+	if err != nil {
+		return errors.NewStorageError("[Compression][%s] failed to compress %s", utils.ReverseAndHexEncodeSlice(key), fileType, err)
+	}
+
+	return c.store.Set(ctx, key, fileType, compressed, opts...)
+}
+
+// SetFromReader compresses the data while it is streamed to the underlying store. Since the size of
+// the data is not known up front, streamed blobs are always compressed.
+func (c *Compression) SetFromReader(ctx context.Context, key []byte, fileType fileformat.FileType, value io.ReadCloser, opts ...options.FileOption) error {
+	if options.NewFileOptions(opts...).SkipHeader {
+		return c.store.SetFromReader(ctx, key, fileType, value, opts...)
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+
+	go func() {
+		defer value.Close()
+
+		_ = pipeWriter.CloseWithError(c.compressStream(pipeWriter, value))
+	}()
+
+	err := c.store.SetFromReader(ctx, key, fileType, pipeReader, opts...)
+
+	// unblock the compressing goroutine if the store stopped reading early
+	_ = pipeReader.CloseWithError(io.ErrClosedPipe)
+
+	return err
+}
+
+func (c *Compression) SetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, newDAH uint32, opts ...options.FileOption) error {
+	return c.store.SetDAH(ctx, key, fileType, newDAH, opts...)
+}
+
+func (c *Compression) GetDAH(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) (uint32, error) {
+	return c.store.GetDAH(ctx, key, fileType, opts...)
+}
+
+func (c *Compression) Del(ctx context.Context, key []byte, fileType fileformat.FileType, opts ...options.FileOption) error {
+	return c.store.Del(ctx, key, fileType, opts...)
+}
+
+func (c *Compression) Close(ctx context.Context) error {
+	return c.store.Close(ctx)
+}
+
+func (c *Compression) SetCurrentBlockHeight(height uint32) {
+	c.store.SetCurrentBlockHeight(height)
+}
+
+// compressStream writes the compression header followed by the compressed data of reader to w.
+func (c *Compression) compressStream(w io.Writer, reader io.Reader) error {
+	var (
+		header  [headerSize]byte
+		encoder io.WriteCloser
+		err     error
+	)
+
+	switch c.algorithm {
+	case Gzip:
+		header = headerGzip
+		encoder = gzip.NewWriter(w)
+	default:
+		header = headerZstd
+
+		if encoder, err = zstd.NewWriter(w); err != nil {
+			return err
+		}
+	}
+
+	if _, err = w.Write(header[:]); err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(encoder, reader); err != nil {
+		_ = encoder.Close()
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// Compress returns the compression header of the algorithm followed by the compressed value.
+func Compress(algorithm Algorithm, value []byte) ([]byte, error) {
+	switch algorithm {
+	case Gzip:
+		var buf bytes.Buffer
+
+		buf.Grow(headerSize + len(value)/2)
+		buf.Write(headerGzip[:])
+
+		writer := gzip.NewWriter(&buf)
+
+		if _, err := writer.Write(value); err != nil {
+			return nil, err
+		}
+
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	case Zstd:
+		return zstdEncoder.EncodeAll(value, append(make([]byte, 0, headerSize+len(value)/2), headerZstd[:]...)), nil
+	default:
+		return nil, errors.NewConfigurationError("unsupported blob compression algorithm %q", algorithm)
+	}
+}
+
+// Decompress returns the decompressed value when it starts with a compression header, or the value
+// itself when it does not.
+func Decompress(value []byte) ([]byte, error) {
+	if len(value) < headerSize {
+		return value, nil
+	}
+
+	switch [headerSize]byte(value[:headerSize]) {
+	case headerGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(value[headerSize:]))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	case headerZstd:
+		return zstdDecoder.DecodeAll(value[headerSize:], nil)
+	default:
+		return value, nil
+	}
+}
+
+// newDecompressReader returns a reader of the decompressed data of reader when it starts with a
+// compression header, or a reader of the data itself when it does not. Closing the returned reader
+// closes the underlying reader.
+func newDecompressReader(reader io.ReadCloser) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(reader)
+
+	header, err := buffered.Peek(headerSize)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, err
+	}
+
+	if len(header) < headerSize {
+		return &readCloser{Reader: buffered, closers: []io.Closer{reader}}, nil
+	}
+
+	switch [headerSize]byte(header) {
+	case headerGzip:
+		_, _ = buffered.Discard(headerSize)
+
+		gzipReader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+
+		return &readCloser{Reader: gzipReader, closers: []io.Closer{gzipReader, reader}}, nil
+	case headerZstd:
+		_, _ = buffered.Discard(headerSize)
+
+		zstdReader, err := zstd.NewReader(buffered, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+
+		return &readCloser{Reader: zstdReader, closers: []io.Closer{zstdReader.IOReadCloser(), reader}}, nil
+	default:
+		return &readCloser{Reader: buffered, closers: []io.Closer{reader}}, nil
+	}
+}
+
+// readCloser reads from Reader and closes all closers on Close.
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r *readCloser) Close() error {
+	var firstErr error
+
+	for _, closer := range r.closers {
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,206 @@
+package compression
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/pkg/fileformat"
+	"github.com/bitcoin-sv/teranode/stores/blob/memory"
+	"github.com/bitcoin-sv/teranode/stores/blob/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	subtreepkg "github.com/bsv-blockchain/go-subtree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serializedSubtree returns a serialized subtree with random transaction hashes and realistic fees and sizes.
+func serializedSubtree(t testing.TB, leafCount int) []byte {
+	subtree, err := subtreepkg.NewTreeByLeafCount(leafCount)
+	require.NoError(t, err)
+
+	for i := 0; i < leafCount; i++ {
+		var hash chainhash.Hash
+
+		_, _ = rand.Read(hash[:])
+
+		require.NoError(t, subtree.AddNode(hash, uint64(100+i%400), uint64(200+i%800)))
+	}
+
+	subtreeBytes, err := subtree.Serialize()
+	require.NoError(t, err)
+
+	return subtreeBytes
+}
+
+func TestCompression(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("subtree-key")
+	value := serializedSubtree(t, 1024)
+
+	for _, algorithm := range []Algorithm{Gzip, Zstd} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			setup := func() (*Compression, *memory.Memory) {
+				underlying := memory.New()
+
+				store, err := New(ulogger.TestLogger{}, underlying, algorithm)
+				require.NoError(t, err)
+
+				return store, underlying
+			}
+
+			t.Run("Set compresses and Get decompresses", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+				stored, err := underlying.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+				assert.Less(t, len(stored), len(value))
+				assert.NotEqual(t, value[:headerSize], stored[:headerSize])
+
+				got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+				assert.Equal(t, value, got)
+
+				reader, err := store.GetIoReader(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+
+				got, err = io.ReadAll(reader)
+				require.NoError(t, err)
+				require.NoError(t, reader.Close())
+				assert.Equal(t, value, got)
+			})
+
+			t.Run("SetFromReader compresses", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, store.SetFromReader(ctx, key, fileformat.FileTypeSubtree, io.NopCloser(bytes.NewReader(value))))
+
+				stored, err := underlying.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+				assert.Less(t, len(stored), len(value))
+
+				got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+				assert.Equal(t, value, got)
+			})
+
+			t.Run("uncompressed blobs are returned as they are", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, underlying.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+				got, err := store.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+				assert.Equal(t, value, got)
+
+				reader, err := store.GetIoReader(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+
+				got, err = io.ReadAll(reader)
+				require.NoError(t, err)
+				assert.Equal(t, value, got)
+			})
+
+			t.Run("small blobs are not compressed", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtreeChecksum, []byte{1, 2, 3, 4}))
+
+				stored, err := underlying.Get(ctx, key, fileformat.FileTypeSubtreeChecksum)
+				require.NoError(t, err)
+				assert.Equal(t, []byte{1, 2, 3, 4}, stored)
+
+				reader, err := store.GetIoReader(ctx, key, fileformat.FileTypeSubtreeChecksum)
+				require.NoError(t, err)
+
+				got, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				assert.Equal(t, []byte{1, 2, 3, 4}, got)
+			})
+
+			t.Run("blobs without header are not compressed", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, store.Set(ctx, key, fileformat.FileTypeDat, value, options.WithSkipHeader(true)))
+
+				stored, err := underlying.Get(ctx, key, fileformat.FileTypeDat)
+				require.NoError(t, err)
+				assert.Equal(t, value, stored)
+			})
+
+			t.Run("corrupted compressed data", func(t *testing.T) {
+				store, underlying := setup()
+
+				require.NoError(t, store.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+				stored, err := underlying.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.NoError(t, err)
+
+				corrupted := append(bytes.Clone(stored[:headerSize]), bytes.Repeat([]byte{0xff}, 64)...)
+				require.NoError(t, underlying.Set(ctx, key, fileformat.FileTypeSubtree, corrupted, options.WithAllowOverwrite(true)))
+
+				_, err = store.Get(ctx, key, fileformat.FileTypeSubtree)
+				require.Error(t, err)
+			})
+		})
+	}
+
+	t.Run("blobs compressed with another algorithm are decompressed", func(t *testing.T) {
+		underlying := memory.New()
+
+		gzipStore, err := New(ulogger.TestLogger{}, underlying, Gzip)
+		require.NoError(t, err)
+
+		zstdStore, err := New(ulogger.TestLogger{}, underlying, Zstd)
+		require.NoError(t, err)
+
+		require.NoError(t, gzipStore.Set(ctx, key, fileformat.FileTypeSubtree, value))
+
+		got, err := zstdStore.Get(ctx, key, fileformat.FileTypeSubtree)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := New(ulogger.TestLogger{}, memory.New(), "lz4")
+		require.Error(t, err)
+	})
+}
+
+// BenchmarkDecompress compares the cost of decompressing a serialized subtree with the bytes saved,
+// reported as the compressed size in percent of the original size.
+func BenchmarkDecompress(b *testing.B) {
+	for _, leafCount := range []int{1024, 1024 * 1024} {
+		value := serializedSubtree(b, leafCount)
+
+		b.Run(fmt.Sprintf("none/%d", leafCount), func(b *testing.B) {
+			b.SetBytes(int64(len(value)))
+
+			for i := 0; i < b.N; i++ {
+				_, _ = Decompress(value)
+			}
+		})
+
+		for _, algorithm := range []Algorithm{Gzip, Zstd} {
+			compressed, err := Compress(algorithm, value)
+			require.NoError(b, err)
+
+			b.Run(fmt.Sprintf("%s/%d", algorithm, leafCount), func(b *testing.B) {
+				b.SetBytes(int64(len(value)))
+				b.ReportMetric(100*float64(len(compressed))/float64(len(value)), "%size")
+
+				for i := 0; i < b.N; i++ {
+					if _, err := Decompress(compressed); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -1,6 +1,6 @@
 // Package blob provides blob storage functionality with various storage backend implementations.
 // This file contains the factory functions for creating and configuring blob stores with
-// different backends and optional wrapper functionality like batching and Delete-At-Height (DAH).
+// different backends and optional wrapper functionality like compression, batching and Delete-At-Height (DAH).
 // The factory pattern used here allows for flexible configuration of blob stores through URL parameters,
 // enabling runtime selection of storage backends and features.
 package blob
@@ -12,6 +12,8 @@ import (
 
 	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/stores/blob/batcher"
+	"github.com/bitcoin-sv/teranode/stores/blob/checksum"
+	"github.com/bitcoin-sv/teranode/stores/blob/compression"
 	"github.com/bitcoin-sv/teranode/stores/blob/file"
 	"github.com/bitcoin-sv/teranode/stores/blob/http"
 	"github.com/bitcoin-sv/teranode/stores/blob/localdah"
@@ -25,6 +27,8 @@ import (
 
 var (
 	_ Store = (*batcher.Batcher)(nil)
+	_ Store = (*checksum.Checksum)(nil)
+	_ Store = (*compression.Compression)(nil)
 	_ Store = (*file.File)(nil)
 	_ Store = (*http.HTTPStore)(nil)
 	_ Store = (*localdah.LocalDAH)(nil)
@@ -78,6 +82,13 @@ func NewStore(logger ulogger.Logger, storeURL *url.URL, opts ...options.StoreOpt
 		return nil, errors.NewStorageError("unknown store type: %s", storeURL.Scheme)
 	}
 
+	if algorithm := storeURL.Query().Get("compression"); algorithm != "" {
+		store, err = compression.New(logger, store, compression.Algorithm(algorithm))
+		if err != nil {
+			return nil, errors.NewStorageError("error creating compressed blob store", err)
+		}
+	}
+
 	if storeURL.Query().Get("batch") == "true" {
 		store, err = createBatchedStore(storeURL, store, logger)
 		if err != nil {
@@ -113,14 +113,14 @@ type Store interface {
 	// Returns: BlockDataPoints and any error encountered
 	GetBlockGraphData(ctx context.Context, periodMillis uint64) (*model.BlockDataPoints, error)
 
-	// GetLastNBlocks retrieves the last N blocks from the chain.
+	// GetLastNBlocks retrieves the last N blocks from the chain, ordered by height descending and then by hash.
 	// Parameters:
 	//   - ctx: Context for the operation
 	//   - n: Number of blocks to retrieve
-	//   - includeOrphans: Whether to include orphaned blocks
+	//   - selection: Whether to return main chain blocks, orphaned blocks or both
 	//   - fromHeight: Starting height for retrieval
 	// Returns: Slice of BlockInfo and any error encountered
-	GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error)
+	GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error)
 
 	// GetLastNInvalidBlocks retrieves the last N blocks that were marked as invalid.
 	// Parameters:
@@ -198,10 +198,26 @@ type Store interface {
 	// Returns: BlockHeader, BlockHeaderMeta, and any error encountered
 	GetBlockHeader(ctx context.Context, blockHash *chainhash.Hash) (*model.BlockHeader, *model.BlockHeaderMeta, error)
 
-	// GetBlockHeaders retrieves multiple block headers starting from a specific hash.
+	// GetBlockHeaderByHeight retrieves the block header at a specific height in the current chain.
 	// Parameters:
 	//   - ctx: Context for the operation
-	//   - blockHash: Starting block hash
+	//   - height: Block height
+	// Returns: BlockHeader, BlockHeaderMeta, and any error encountered
+	GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error)
+
+	// GetBlockHeadersByHashes retrieves the block headers of a list of block hashes.
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - blockHashes: Hashes of the blocks
+	// Returns: BlockHeaders and BlockHeaderMetas in the order of the hashes, with nil entries for unknown blocks, and any error encountered
+	GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
+
+	// GetBlockHeaders retrieves multiple block headers starting from a specific hash, walking
+	// backward through the parents of the block. The headers are returned in descending height
+	// order, and fewer headers than requested are returned when genesis is reached first.
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - blockHash: Starting block hash, the highest block returned
 	//   - numberOfHeaders: Number of headers to retrieve
 	// Returns: Slice of BlockHeaders, slice of BlockHeaderMetas, and any error encountered
 	GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error)
@@ -245,6 +261,13 @@ type Store interface {
 	// Returns: Any error encountered
 	InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) ([]chainhash.Hash, error)
 
+	// GetBlocksAffectedByInvalidation returns the blocks that InvalidateBlock would invalidate, without modifying the store.
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - blockHash: Hash of the block that would be invalidated
+	// Returns: The block and all its descendants, with their height and whether they are on the best chain, and any error encountered
+	GetBlocksAffectedByInvalidation(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error)
+
 	// RevalidateBlock marks a previously invalidated block as valid.
 	// Parameters:
 	//   - ctx: Context for the operation
@@ -274,6 +297,15 @@ type Store interface {
 	//   - data: State data to store
 	// Returns: Any error encountered
 	SetState(ctx context.Context, key string, data []byte) error
+
+	// CompareAndSetState atomically replaces the state data of a key, only if the current data equals expectedData.
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - key: State key to set
+	//   - expectedData: State data the key must currently hold, empty if the key must not exist
+	//   - newData: State data to store
+	// Returns: Whether the data was swapped and any error encountered
+	CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error)
 	GetBlockIsMined(ctx context.Context, blockHash *chainhash.Hash) (bool, error)
 
 	// SetBlockMinedSet marks a block as mined.
@@ -320,6 +352,7 @@ type Store interface {
 	// GetChainTips retrieves information about all known tips in the block tree.
 	// This method finds all blocks that have no children (tips) and determines their
 	// relationship to the main chain, including branch lengths and validation status.
+	// The tips are ordered by height descending and then by hash.
 	// Parameters:
 	//   - ctx: Context for the operation
 	// Returns: Slice of ChainTip structures and any error encountered
@@ -363,4 +396,14 @@ type Store interface {
 	//   - clear: Boolean flag to determine if the timestamp should be cleared
 	// Returns: Any error encountered
 	SetBlockProcessedAt(ctx context.Context, blockHash *chainhash.Hash, clear ...bool) error
+
+	// Compact removes invalid blocks buried deeper than safetyDepth below the best block and
+	// state keys starting with one of stateKeyPrefixes that have not been updated within stateRetention.
+	// Parameters:
+	//   - ctx: Context for the operation
+	//   - safetyDepth: Minimum depth below the best block before an invalid block is removed
+	//   - stateRetention: Maximum age of a state key, 0 disables state pruning
+	//   - stateKeyPrefixes: Prefixes of the state keys that may be pruned, no prefixes disables state pruning
+	// Returns: Details of the removed data and any error encountered
+	Compact(ctx context.Context, safetyDepth uint32, stateRetention time.Duration, stateKeyPrefixes []string) (*model.CompactionResult, error)
 }
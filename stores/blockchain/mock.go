@@ -198,7 +198,7 @@ func (m *MockStore) GetNextBlockID(_ context.Context) (uint64, error) {
 	return maxID + 1, nil
 }
 
-func (m *MockStore) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (m *MockStore) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	panic(implementMe)
 }
 
@@ -451,6 +451,37 @@ func (m *MockStore) GetBlockHeader(ctx context.Context, blockHash *chainhash.Has
 	return block.Header, &model.BlockHeaderMeta{Height: block.Height}, nil
 }
 
+// GetBlockHeaderByHeight retrieves the block header and its metadata of the block at the given height.
+func (m *MockStore) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	block, ok := m.BlockByHeight[height]
+	if !ok {
+		return nil, nil, errors.ErrBlockNotFound
+	}
+
+	return block.Header, &model.BlockHeaderMeta{Height: block.Height}, nil
+}
+
+// GetBlockHeadersByHashes retrieves the block headers of a list of block hashes, with nil entries for unknown blocks.
+func (m *MockStore) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	headers := make([]*model.BlockHeader, len(blockHashes))
+	metas := make([]*model.BlockHeaderMeta, len(blockHashes))
+
+	for i, blockHash := range blockHashes {
+		if block, ok := m.Blocks[*blockHash]; ok {
+			headers[i] = block.Header
+			metas[i] = &model.BlockHeaderMeta{Height: block.Height}
+		}
+	}
+
+	return headers, metas, nil
+}
+
 // GetBlockHeaders retrieves multiple block headers starting from a specific block hash.
 func (m *MockStore) GetBlockHeaders(ctx context.Context, blockHash *chainhash.Hash, numberOfHeaders uint64) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
 	m.mu.RLock()
@@ -501,6 +532,10 @@ func (m *MockStore) InvalidateBlock(ctx context.Context, blockHash *chainhash.Ha
 	panic(implementMe)
 }
 
+func (m *MockStore) GetBlocksAffectedByInvalidation(ctx context.Context, blockHash *chainhash.Hash) ([]*model.AffectedBlock, error) {
+	panic(implementMe)
+}
+
 func (m *MockStore) RevalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
 	panic(implementMe)
 }
@@ -518,6 +553,10 @@ func (m *MockStore) SetState(ctx context.Context, key string, data []byte) error
 	panic(implementMe)
 }
 
+func (m *MockStore) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	panic(implementMe)
+}
+
 func (m *MockStore) GetBlockIsMined(ctx context.Context, blockHash *chainhash.Hash) (bool, error) {
 	panic("implement me")
 }
@@ -530,6 +569,11 @@ func (m *MockStore) SetBlockProcessedAt(ctx context.Context, blockHash *chainhas
 	panic(implementMe)
 }
 
+// Compact is a no-op for the mock store, nothing is ever removed.
+func (m *MockStore) Compact(ctx context.Context, safetyDepth uint32, stateRetention time.Duration, stateKeyPrefixes []string) (*model.CompactionResult, error) {
+	return &model.CompactionResult{}, nil
+}
+
 // GetBlocksMinedNotSet retrieves blocks that haven't been marked as mined.
 func (m *MockStore) GetBlocksMinedNotSet(_ context.Context) ([]*model.Block, error) {
 	return []*model.Block{}, nil
@@ -12,6 +12,8 @@
 // numerous method overloads or complex parameter structs.
 package options
 
+import "github.com/bitcoin-sv/teranode/model"
+
 // StoreBlockOptions defines the configuration parameters for storing blocks.
 // It controls metadata flags that affect how blocks are processed and stored.
 type StoreBlockOptions struct {
@@ -23,6 +25,9 @@ type StoreBlockOptions struct {
 	Invalid bool
 	// ID is an optional identifier for the block, instead of incrementing the last known block ID
 	ID uint64
+	// WaitForKafka indicates whether adding the block waits until the block is delivered to Kafka,
+	// only used by the blockchain service and ignored by the stores
+	WaitForKafka bool
 }
 
 // StoreBlockOption is a function type that modifies StoreBlockOptions.
@@ -99,3 +104,89 @@ func WithID(id uint64) StoreBlockOption {
 		opts.ID = id
 	}
 }
+
+// WithWaitForKafka creates an option that sets the WaitForKafka flag.
+// This option makes the blockchain service wait until the block is delivered to the blocks-final Kafka topic,
+// and return the delivery error, instead of sending the block to Kafka in the background.
+//
+// Parameters:
+//   - b: Boolean value to set for WaitForKafka flag
+//
+// Returns:
+//   - StoreBlockOption: Function that applies the configuration
+func WithWaitForKafka(b bool) StoreBlockOption {
+	return func(opts *StoreBlockOptions) {
+		opts.WaitForKafka = b
+	}
+}
+
+// InvalidateBlockOptions defines the configuration parameters for invalidating blocks.
+type InvalidateBlockOptions struct {
+	// CheckMaxReorgDepth indicates whether the invalidation is refused for a block on the best chain with more than
+	// blockchain_maxReorgDepth confirmations, only used by the blockchain service and ignored by the stores
+	CheckMaxReorgDepth bool
+}
+
+// InvalidateBlockOption is a function type that modifies InvalidateBlockOptions.
+type InvalidateBlockOption func(*InvalidateBlockOptions)
+
+// ProcessInvalidateBlockOptions creates an InvalidateBlockOptions instance with default values and applies the provided options.
+func ProcessInvalidateBlockOptions(opts ...InvalidateBlockOption) *InvalidateBlockOptions {
+	options := &InvalidateBlockOptions{
+		CheckMaxReorgDepth: false,
+	}
+
+	for _, o := range opts {
+		o(options)
+	}
+
+	return options
+}
+
+// WithCheckMaxReorgDepth creates an option that sets the CheckMaxReorgDepth flag.
+// This option is meant for invalidations requested by an operator, the invalidations of block validation and the
+// alert system are not limited to the max reorg depth.
+//
+// Parameters:
+//   - b: Boolean value to set for CheckMaxReorgDepth flag
+//
+// Returns:
+//   - InvalidateBlockOption: Function that applies the configuration
+func WithCheckMaxReorgDepth(b bool) InvalidateBlockOption {
+	return func(opts *InvalidateBlockOptions) {
+		opts.CheckMaxReorgDepth = b
+	}
+}
+
+// SubscribeOptions defines the configuration parameters for subscribing to blockchain notifications.
+type SubscribeOptions struct {
+	// NotificationTypes are the notification types sent to the subscriber, an empty list means all types
+	NotificationTypes []model.NotificationType
+}
+
+// SubscribeOption is a function type that modifies SubscribeOptions.
+type SubscribeOption func(*SubscribeOptions)
+
+// ProcessSubscribeOptions creates a SubscribeOptions instance with default values and applies the provided options.
+func ProcessSubscribeOptions(opts ...SubscribeOption) *SubscribeOptions {
+	options := &SubscribeOptions{}
+
+	for _, o := range opts {
+		o(options)
+	}
+
+	return options
+}
+
+// WithNotificationTypes creates an option that limits the subscription to the given notification types.
+//
+// Parameters:
+//   - notificationTypes: Notification types to send to the subscriber, all types when none are given
+//
+// Returns:
+//   - SubscribeOption: Function that applies the configuration
+func WithNotificationTypes(notificationTypes ...model.NotificationType) SubscribeOption {
+	return func(opts *SubscribeOptions) {
+		opts.NotificationTypes = append(opts.NotificationTypes, notificationTypes...)
+	}
+}
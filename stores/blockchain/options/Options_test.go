@@ -3,6 +3,7 @@ package options
 import (
 	"testing"
 
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -284,6 +285,61 @@ func TestWithID(t *testing.T) {
 	})
 }
 
+// TestWithWaitForKafka tests the WithWaitForKafka option
+func TestWithWaitForKafka(t *testing.T) {
+	t.Run("default is false", func(t *testing.T) {
+		assert.False(t, ProcessStoreBlockOptions().WaitForKafka)
+	})
+
+	t.Run("set to true", func(t *testing.T) {
+		opts := ProcessStoreBlockOptions(WithWaitForKafka(true))
+
+		assert.True(t, opts.WaitForKafka)
+		assert.False(t, opts.MinedSet, "Other fields should remain default")
+		assert.False(t, opts.SubtreesSet)
+		assert.False(t, opts.Invalid)
+		assert.Equal(t, uint64(0), opts.ID)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		opts := ProcessStoreBlockOptions(WithWaitForKafka(true), WithWaitForKafka(false))
+		assert.False(t, opts.WaitForKafka, "Last option should win")
+	})
+}
+
+// TestWithCheckMaxReorgDepth tests the WithCheckMaxReorgDepth option
+func TestWithCheckMaxReorgDepth(t *testing.T) {
+	t.Run("default is false", func(t *testing.T) {
+		assert.False(t, ProcessInvalidateBlockOptions().CheckMaxReorgDepth)
+	})
+
+	t.Run("set to true", func(t *testing.T) {
+		assert.True(t, ProcessInvalidateBlockOptions(WithCheckMaxReorgDepth(true)).CheckMaxReorgDepth)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		opts := ProcessInvalidateBlockOptions(WithCheckMaxReorgDepth(true), WithCheckMaxReorgDepth(false))
+		assert.False(t, opts.CheckMaxReorgDepth, "Last option should win")
+	})
+}
+
+// TestWithNotificationTypes tests the WithNotificationTypes option
+func TestWithNotificationTypes(t *testing.T) {
+	t.Run("default value", func(t *testing.T) {
+		assert.Empty(t, ProcessSubscribeOptions().NotificationTypes)
+	})
+
+	t.Run("set types", func(t *testing.T) {
+		opts := ProcessSubscribeOptions(WithNotificationTypes(model.NotificationType_Block, model.NotificationType_Subtree))
+		assert.Equal(t, []model.NotificationType{model.NotificationType_Block, model.NotificationType_Subtree}, opts.NotificationTypes)
+	})
+
+	t.Run("combine", func(t *testing.T) {
+		opts := ProcessSubscribeOptions(WithNotificationTypes(model.NotificationType_Block), WithNotificationTypes(model.NotificationType_Subtree))
+		assert.Equal(t, []model.NotificationType{model.NotificationType_Block, model.NotificationType_Subtree}, opts.NotificationTypes)
+	})
+}
+
 // TestCombinedOptions tests combining multiple options
 func TestCombinedOptions(t *testing.T) {
 	t.Run("all options set to true", func(t *testing.T) {
@@ -0,0 +1,213 @@
+// Package sql implements the blockchain.Store interface using SQL database backends.
+// It provides concrete SQL-based implementations for all blockchain operations
+// defined in the interface, with support for different SQL engines.
+//
+// This file implements the Compact method, which removes data from the blockchain
+// database that can no longer influence consensus: invalidated blocks that are buried
+// deep below the current best block, and state keys with a configured prefix that have
+// not been written to for longer than a configured retention period. Without this maintenance operation the
+// blocks table keeps every invalidated side chain ever seen, which grows without bound
+// on long-running nodes.
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/util"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	safe "github.com/bsv-blockchain/go-safe-conversion"
+)
+
+// Compact removes stale data from the blockchain database.
+// This implements the blockchain.Store.Compact interface method.
+//
+// Only blocks that are marked as invalid and whose height is more than safetyDepth
+// below the current best block are removed. Blocks on the best chain are never invalid,
+// and side chains within the safety horizon are left untouched, since they could still
+// be revalidated and become the best chain. Blocks are deleted leaf-first, so an invalid
+// block that still has a child in the database is only removed once that child is gone,
+// which keeps the parent_id references intact.
+//
+// When stateRetention is greater than zero, state keys that start with one of the given
+// prefixes and have not been inserted or updated within that period are removed as well.
+// Pruning state keys is opt-in, keys that do not match a prefix, like the FSM state or the
+// block assembler state, are never removed, and empty prefixes are ignored.
+//
+// Parameters:
+//   - ctx: Context for the database operation, allowing for cancellation and timeouts
+//   - safetyDepth: Number of blocks below the best block an invalid block must be before it is removed
+//   - stateRetention: Maximum age of a state key before it is removed, 0 disables state pruning
+//   - stateKeyPrefixes: Prefixes of the state keys that may be removed, no prefixes disables state pruning
+//
+// Returns:
+//   - *model.CompactionResult: The removed block hashes, state keys and the approximate bytes reclaimed
+//   - error: Any error encountered during compaction, specifically:
+//   - StorageError for database errors or cache invalidation failures
+func (s *SQL) Compact(ctx context.Context, safetyDepth uint32, stateRetention time.Duration, stateKeyPrefixes []string) (result *model.CompactionResult, err error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:Compact")
+	defer deferFn()
+
+	result = &model.CompactionResult{}
+
+	_, bestBlockMeta, err := s.GetBestBlockHeader(ctx)
+	if err != nil {
+		return nil, errors.NewStorageError("error getting best block header", err)
+	}
+
+	defer func() {
+		if len(result.RemovedBlocks) == 0 {
+			return
+		}
+
+		s.ResetResponseCache()
+
+		if resetErr := s.ResetBlocksCache(ctx); resetErr != nil {
+			err = errors.Join(err, errors.NewStorageError("error clearing caches", resetErr))
+		}
+	}()
+
+	if bestBlockMeta.Height > safetyDepth {
+		if err = s.compactInvalidBlocks(ctx, bestBlockMeta.Height-safetyDepth, result); err != nil {
+			return result, err
+		}
+	}
+
+	if stateRetention > 0 {
+		for _, prefix := range stateKeyPrefixes {
+			if prefix == "" {
+				continue
+			}
+
+			if err = s.compactStateKeys(ctx, prefix, stateRetention, result); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// compactInvalidBlocks deletes invalid blocks below maxHeight, starting at the leaves of each
+// invalid side chain and repeating until no more blocks qualify.
+func (s *SQL) compactInvalidBlocks(ctx context.Context, maxHeight uint32, result *model.CompactionResult) error {
+	q := `
+		DELETE FROM blocks
+		WHERE invalid = true
+		  AND height < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM blocks c
+			WHERE c.parent_id = blocks.id
+			  AND c.id <> blocks.id
+		  )
+		RETURNING hash, LENGTH(subtrees) + LENGTH(coinbase_tx) + LENGTH(chain_work)
+	`
+
+	for {
+		removed, err := s.deleteInvalidBlockLeaves(ctx, q, maxHeight, result)
+		if err != nil {
+			return err
+		}
+
+		if removed == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *SQL) deleteInvalidBlockLeaves(ctx context.Context, q string, maxHeight uint32, result *model.CompactionResult) (removed int, err error) {
+	var rows *sql.Rows
+
+	if rows, err = s.db.QueryContext(ctx, q, maxHeight); err != nil {
+		return 0, errors.NewStorageError("error removing invalid blocks", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+
+	var (
+		hashBytes []byte
+		rowBytes  int64
+		hash      *chainhash.Hash
+	)
+
+	for rows.Next() {
+		if err = rows.Scan(&hashBytes, &rowBytes); err != nil {
+			return removed, errors.NewStorageError("error scanning removed block", err)
+		}
+
+		if hash, err = chainhash.NewHash(hashBytes); err != nil {
+			return removed, errors.NewStorageError("error creating hash from bytes", err)
+		}
+
+		result.RemovedBlocks = append(result.RemovedBlocks, *hash)
+		result.ReclaimedBytes += rowSize(rowBytes, len(hashBytes))
+		removed++
+	}
+
+	return removed, rows.Err()
+}
+
+// compactStateKeys deletes the state keys starting with prefix that have not been updated within the retention period.
+func (s *SQL) compactStateKeys(ctx context.Context, prefix string, retention time.Duration, result *model.CompactionResult) (err error) {
+	var q string
+
+	if s.engine == util.Postgres {
+		q = `
+			DELETE FROM state
+			WHERE SUBSTR(key, 1, LENGTH($1)) = $1
+			  AND COALESCE(updated_at, inserted_at) < CURRENT_TIMESTAMP - make_interval(secs => $2)
+			RETURNING key, LENGTH(data)
+		`
+	} else {
+		q = `
+			DELETE FROM state
+			WHERE SUBSTR(key, 1, LENGTH($1)) = $1
+			  AND COALESCE(updated_at, inserted_at) < datetime('now', '-' || $2 || ' seconds')
+			RETURNING key, LENGTH(data)
+		`
+	}
+
+	var rows *sql.Rows
+
+	if rows, err = s.db.QueryContext(ctx, q, prefix, int64(retention.Seconds())); err != nil {
+		return errors.NewStorageError("error removing stale state keys", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+
+	var (
+		key       string
+		dataBytes int64
+	)
+
+	for rows.Next() {
+		if err = rows.Scan(&key, &dataBytes); err != nil {
+			return errors.NewStorageError("error scanning removed state key", err)
+		}
+
+		result.RemovedStateKeys = append(result.RemovedStateKeys, key)
+		result.ReclaimedBytes += rowSize(dataBytes, len(key))
+	}
+
+	return rows.Err()
+}
+
+// rowSize returns the approximate size of a removed row from the length of its variable data and key.
+func rowSize(dataBytes int64, keyBytes int) uint64 {
+	size, err := safe.Int64ToUint64(dataBytes)
+	if err != nil {
+		return 0
+	}
+
+	keySize, _ := safe.IntToUint64(keyBytes)
+
+	return size + keySize
+}
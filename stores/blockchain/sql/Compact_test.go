@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bitcoin-sv/teranode/util/test"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQL_Compact(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	setupStore := func(t *testing.T) *SQL {
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block1, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block2, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block3, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), blockAlternative2, "", options.WithMinedSet(true))
+		require.NoError(t, err)
+
+		_, err = s.InvalidateBlock(context.Background(), blockAlternative2.Hash())
+		require.NoError(t, err)
+
+		return s
+	}
+
+	t.Run("nothing to compact", func(t *testing.T) {
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		result, err := s.Compact(context.Background(), 0, time.Hour, []string{"ephemeral/"})
+		require.NoError(t, err)
+
+		assert.Empty(t, result.RemovedBlocks)
+		assert.Empty(t, result.RemovedStateKeys)
+		assert.Equal(t, uint64(0), result.ReclaimedBytes)
+	})
+
+	t.Run("invalid block within safety horizon is kept", func(t *testing.T) {
+		s := setupStore(t)
+
+		result, err := s.Compact(context.Background(), 1, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.RemovedBlocks)
+
+		exists, err := s.GetBlockExists(context.Background(), blockAlternative2.Hash())
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("invalid block below safety horizon is removed", func(t *testing.T) {
+		s := setupStore(t)
+
+		result, err := s.Compact(context.Background(), 0, 0, nil)
+		require.NoError(t, err)
+
+		require.Len(t, result.RemovedBlocks, 1)
+		assert.Equal(t, *blockAlternative2.Hash(), result.RemovedBlocks[0])
+		assert.Greater(t, result.ReclaimedBytes, uint64(0))
+
+		exists, err := s.GetBlockExists(context.Background(), blockAlternative2.Hash())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// the best chain must be untouched
+		for _, block := range []*chainhash.Hash{block1.Hash(), block2.Hash(), block3.Hash()} {
+			exists, err = s.GetBlockExists(context.Background(), block)
+			require.NoError(t, err)
+			assert.True(t, exists)
+		}
+
+		header, _, err := s.GetBestBlockHeader(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, block3.Hash(), header.Hash())
+	})
+
+	t.Run("stale state keys with a prunable prefix are removed", func(t *testing.T) {
+		s := setupStore(t)
+
+		require.NoError(t, s.SetState(context.Background(), "ephemeral/stale", []byte("stale data")))
+		require.NoError(t, s.SetState(context.Background(), "ephemeral/fresh", []byte("fresh data")))
+		require.NoError(t, s.SetState(context.Background(), "BlockAssembler", []byte("block assembler state")))
+		require.NoError(t, s.SetFSMState(context.Background(), "RUNNING"))
+
+		_, err := s.db.ExecContext(context.Background(),
+			"UPDATE state SET inserted_at = '2020-01-01 00:00:00', updated_at = NULL WHERE key IN ('ephemeral/stale', 'BlockAssembler', 'fsm_state')")
+		require.NoError(t, err)
+
+		result, err := s.Compact(context.Background(), 1, time.Hour, []string{"", "ephemeral/"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"ephemeral/stale"}, result.RemovedStateKeys)
+		assert.Equal(t, uint64(len("ephemeral/stale")+len("stale data")), result.ReclaimedBytes)
+
+		_, err = s.GetState(context.Background(), "ephemeral/stale")
+		require.Error(t, err)
+
+		state, err := s.GetState(context.Background(), "ephemeral/fresh")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("fresh data"), state)
+
+		// stale state keys without a prunable prefix are retained
+		state, err = s.GetState(context.Background(), "BlockAssembler")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("block assembler state"), state)
+
+		fsmState, err := s.GetFSMState(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "RUNNING", fsmState)
+	})
+
+	t.Run("state keys are not pruned without prefixes", func(t *testing.T) {
+		s := setupStore(t)
+
+		require.NoError(t, s.SetState(context.Background(), "stale", []byte("stale data")))
+
+		_, err := s.db.ExecContext(context.Background(), "UPDATE state SET inserted_at = '2020-01-01 00:00:00', updated_at = NULL")
+		require.NoError(t, err)
+
+		result, err := s.Compact(context.Background(), 1, time.Hour, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.RemovedStateKeys)
+
+		_, err = s.GetState(context.Background(), "stale")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,97 @@
+// Package sql implements the blockchain.Store interface using SQL database backends.
+// It provides concrete SQL-based implementations for all blockchain operations
+// defined in the interface, with support for different SQL engines.
+//
+// This file implements the GetBlockHeaderByHeight method, which retrieves the header of the
+// block at a specific height in the current chain. It is the lightweight counterpart of
+// GetBlockByHeight for callers that only need the header and its metadata, like difficulty
+// calculation and the miner, and avoids loading the coinbase and subtree hashes of the block.
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// GetBlockHeaderByHeight retrieves the header of the block at a specific height in the current chain.
+// This implements the blockchain.Store.GetBlockHeaderByHeight interface method.
+//
+// The current chain is determined in the same way as in GetBlockByHeight, following the parents of
+// the valid block with the most chainwork. The header is first looked up in the blocksCache, which
+// holds the headers of the most recent blocks of the current chain. When it is not cached, the hash
+// of the block at the height is looked up in the database and the header and metadata are read with
+// GetBlockHeader, so the metadata is the same as when the header is requested by hash.
+//
+// Parameters:
+//   - ctx: Context for the database operation, allowing for cancellation and timeouts
+//   - height: The blockchain height at which to retrieve the block header
+//
+// Returns:
+//   - *model.BlockHeader: The header of the block at the specified height in the current chain
+//   - *model.BlockHeaderMeta: Metadata of the block, including its height, transaction count and chainwork
+//   - error: Any error encountered during retrieval, specifically:
+//   - BlockNotFoundError if no valid block exists at the specified height in the current chain
+//   - StorageError for database access or query execution errors
+//   - ProcessingError for errors during header reconstruction
+func (s *SQL) GetBlockHeaderByHeight(ctx context.Context, height uint32) (*model.BlockHeader, *model.BlockHeaderMeta, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:GetBlockHeaderByHeight")
+	defer deferFn()
+
+	headers, metas := s.blocksCache.GetBlockHeadersFromHeight(height, 1)
+	if len(headers) == 1 {
+		return headers[0], metas[0], nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	q := `
+		SELECT b.hash
+		FROM blocks b
+		WHERE id IN (
+			WITH RECURSIVE ChainBlocks AS (
+				SELECT id, parent_id, height
+				FROM blocks
+				WHERE invalid = false
+				AND hash = (
+					SELECT b.hash
+					FROM blocks b
+					WHERE b.invalid = false
+					ORDER BY chain_work DESC, peer_id ASC, id ASC
+					LIMIT 1
+				)
+				UNION ALL
+				SELECT bb.id, bb.parent_id, bb.height
+				FROM blocks bb
+				JOIN ChainBlocks cb ON bb.id = cb.parent_id
+				WHERE bb.id != cb.id
+				  AND bb.invalid = false
+			)
+			SELECT id FROM ChainBlocks
+			WHERE height = $1
+			LIMIT 1
+		)
+	`
+
+	var hashBytes []byte
+
+	if err := s.db.QueryRowContext(ctx, q, height).Scan(&hashBytes); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errors.NewBlockNotFoundError("failed to get block header by height for height %d", height, err)
+		}
+
+		return nil, nil, errors.NewStorageError("failed to get block header by height", err)
+	}
+
+	hash, err := chainhash.NewHash(hashBytes)
+	if err != nil {
+		return nil, nil, errors.NewProcessingError("failed to convert block hash", err)
+	}
+
+	return s.GetBlockHeader(ctx, hash)
+}
@@ -0,0 +1,68 @@
+package sql
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bitcoin-sv/teranode/util/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLGetBlockHeaderByHeight(t *testing.T) {
+	for _, cacheSize := range []int{0, 200} {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.StoreCacheSize = cacheSize
+
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		t.Run("genesis block", func(t *testing.T) {
+			header, meta, err := s.GetBlockHeaderByHeight(context.Background(), 0)
+			require.NoError(t, err)
+
+			assertRegtestGenesis(t, header)
+			assert.Equal(t, uint32(0), meta.Height)
+		})
+
+		t.Run("blocks in the current chain", func(t *testing.T) {
+			_, _, err = s.StoreBlock(context.Background(), block1, "")
+			require.NoError(t, err)
+
+			_, _, err = s.StoreBlock(context.Background(), block2, "")
+			require.NoError(t, err)
+
+			_, _, err = s.StoreBlock(context.Background(), blockAlternative2, "")
+			require.NoError(t, err)
+
+			_, _, err = s.StoreBlock(context.Background(), block3, "")
+			require.NoError(t, err)
+
+			header, meta, err := s.GetBlockHeaderByHeight(context.Background(), 2)
+			require.NoError(t, err)
+
+			// the header of the block in the current chain is returned, not the fork
+			assert.Equal(t, block2.Hash(), header.Hash())
+			assert.Equal(t, uint32(2), meta.Height)
+			assert.Equal(t, block2.TransactionCount, meta.TxCount)
+
+			header, meta, err = s.GetBlockHeaderByHeight(context.Background(), 3)
+			require.NoError(t, err)
+
+			assert.Equal(t, block3.Hash(), header.Hash())
+			assert.Equal(t, uint32(3), meta.Height)
+		})
+
+		t.Run("height above the tip", func(t *testing.T) {
+			_, _, err := s.GetBlockHeaderByHeight(context.Background(), 4)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errors.ErrBlockNotFound))
+		})
+	}
+}
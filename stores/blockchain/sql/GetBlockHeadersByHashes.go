@@ -0,0 +1,131 @@
+// Package sql implements the blockchain.Store interface using SQL database backends.
+// It provides concrete SQL-based implementations for all blockchain operations
+// defined in the interface, with support for different SQL engines.
+//
+// This file implements the GetBlockHeadersByHashes method, which retrieves the block headers
+// of a list of specific block hashes. This is used when a caller already knows the hashes it
+// is interested in, for instance the hashes of a block locator, and avoids a round-trip to the
+// database for every single hash. Headers that are present in the blocks cache are served from
+// it, the remaining headers are fetched with batched queries on the indexed hash column.
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// blockHeadersByHashesBatchSize is the maximum number of hashes queried at once, which keeps the
+// number of query parameters well below the limits of the supported SQL engines.
+const blockHeadersByHashesBatchSize = 1000
+
+// GetBlockHeadersByHashes retrieves the block headers and metadata of the given block hashes.
+// This implements the blockchain.Store.GetBlockHeadersByHashes interface method.
+//
+// The returned slices have the same length and order as blockHashes. Blocks that do not exist
+// in the database do not fail the request, instead the header and meta at their position are nil.
+// Duplicate hashes are allowed and return the same header at every position they appear.
+//
+// Parameters:
+//   - ctx: Context for the database operation, allows for cancellation and timeouts
+//   - blockHashes: The hashes of the block headers to retrieve
+//
+// Returns:
+//   - []*model.BlockHeader: The block headers in the order of blockHashes, nil for unknown blocks
+//   - []*model.BlockHeaderMeta: The block header metadata in the order of blockHashes, nil for unknown blocks
+//   - error: StorageError for database errors, ProcessingError for data conversion errors
+func (s *SQL) GetBlockHeadersByHashes(ctx context.Context, blockHashes []*chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:GetBlockHeadersByHashes")
+	defer deferFn()
+
+	blockHeaders := make([]*model.BlockHeader, len(blockHashes))
+	blockHeaderMetas := make([]*model.BlockHeaderMeta, len(blockHashes))
+
+	// positions of the hashes that are not in the cache, a hash can appear more than once
+	missing := make(map[chainhash.Hash][]int)
+	missingHashes := make([]chainhash.Hash, 0, len(blockHashes))
+
+	for i, blockHash := range blockHashes {
+		header, meta := s.blocksCache.GetBlockHeader(*blockHash)
+		if header != nil {
+			blockHeaders[i] = header
+			blockHeaderMetas[i] = meta
+
+			continue
+		}
+
+		if _, ok := missing[*blockHash]; !ok {
+			missingHashes = append(missingHashes, *blockHash)
+		}
+
+		missing[*blockHash] = append(missing[*blockHash], i)
+	}
+
+	for start := 0; start < len(missingHashes); start += blockHeadersByHashesBatchSize {
+		end := min(start+blockHeadersByHashesBatchSize, len(missingHashes))
+
+		headers, metas, err := s.getBlockHeadersByHashesBatch(ctx, missingHashes[start:end])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for j, header := range headers {
+			for _, i := range missing[*header.Hash()] {
+				blockHeaders[i] = header
+				blockHeaderMetas[i] = metas[j]
+			}
+		}
+	}
+
+	return blockHeaders, blockHeaderMetas, nil
+}
+
+// getBlockHeadersByHashesBatch fetches the block headers of the given hashes in a single query,
+// in no particular order. Hashes that are not found are not part of the result.
+func (s *SQL) getBlockHeadersByHashesBatch(ctx context.Context, blockHashes []chainhash.Hash) ([]*model.BlockHeader, []*model.BlockHeaderMeta, error) {
+	placeholders := make([]string, len(blockHashes))
+	args := make([]interface{}, len(blockHashes))
+
+	for i := range blockHashes {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = blockHashes[i][:]
+	}
+
+	q := fmt.Sprintf(`
+		SELECT
+			 b.version
+			,b.block_time
+			,b.nonce
+			,b.previous_hash
+			,b.merkle_root
+			,b.n_bits
+			,b.id
+			,b.height
+			,b.tx_count
+			,b.size_in_bytes
+			,b.peer_id
+			,b.block_time
+			,b.inserted_at
+			,b.chain_work
+			,b.mined_set
+			,b.subtrees_set
+			,b.invalid
+			,b.coinbase_tx
+		FROM blocks b
+		WHERE b.hash IN (%s)
+	`, strings.Join(placeholders, ","))
+
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, nil, errors.NewStorageError("failed to get headers by hashes", err)
+	}
+
+	defer rows.Close()
+
+	return s.processBlockHeadersRows(rows, uint64(len(blockHashes)))
+}
@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bitcoin-sv/teranode/util/test"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLGetBlockHeadersByHashes(t *testing.T) {
+	setupStore := func(t *testing.T, cacheSize int) *SQL {
+		tSettings := test.CreateBaseTestSettings(t)
+		tSettings.Block.StoreCacheSize = cacheSize
+
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(t.Context(), block1, "test_peer")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(t.Context(), block2, "test_peer")
+		require.NoError(t, err)
+
+		return s
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		s := setupStore(t, 0)
+
+		headers, metas, err := s.GetBlockHeadersByHashes(t.Context(), []*chainhash.Hash{})
+		require.NoError(t, err)
+		assert.Empty(t, headers)
+		assert.Empty(t, metas)
+	})
+
+	for _, cacheSize := range []int{0, 100} {
+		name := "without cache"
+		if cacheSize > 0 {
+			name = "with cache"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			s := setupStore(t, cacheSize)
+
+			headers, metas, err := s.GetBlockHeadersByHashes(t.Context(), []*chainhash.Hash{
+				block2.Hash(),
+				block3.Hash(),
+				block1.Hash(),
+				block2.Hash(),
+			})
+			require.NoError(t, err)
+			require.Len(t, headers, 4)
+			require.Len(t, metas, 4)
+
+			assert.Equal(t, block2.Hash(), headers[0].Hash())
+			assert.Equal(t, uint32(2), metas[0].Height)
+			assert.Equal(t, "test_peer", metas[0].PeerID)
+
+			assert.Nil(t, headers[1], "unknown block should have a nil header")
+			assert.Nil(t, metas[1], "unknown block should have a nil meta")
+
+			assert.Equal(t, block1.Hash(), headers[2].Hash())
+			assert.Equal(t, uint32(1), metas[2].Height)
+
+			assert.Equal(t, block2.Hash(), headers[3].Hash())
+			assert.Equal(t, uint32(2), metas[3].Height)
+		})
+	}
+
+	t.Run("matches GetBlockHeader", func(t *testing.T) {
+		s := setupStore(t, 0)
+
+		header, meta, err := s.GetBlockHeader(t.Context(), block2.Hash())
+		require.NoError(t, err)
+
+		headers, metas, err := s.GetBlockHeadersByHashes(t.Context(), []*chainhash.Hash{block2.Hash()})
+		require.NoError(t, err)
+		require.Len(t, headers, 1)
+
+		assert.Equal(t, header, headers[0])
+		assertBlockHeaderMetaEqual(t, meta, metas[0])
+	})
+}
+
+func assertBlockHeaderMetaEqual(t *testing.T, expected, actual *model.BlockHeaderMeta) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.Height, actual.Height)
+	assert.Equal(t, expected.TxCount, actual.TxCount)
+	assert.Equal(t, expected.ChainWork, actual.ChainWork)
+	assert.Equal(t, expected.Miner, actual.Miner)
+	assert.Equal(t, expected.PeerID, actual.PeerID)
+	assert.Equal(t, expected.Invalid, actual.Invalid)
+}
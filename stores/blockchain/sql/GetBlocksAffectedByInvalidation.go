@@ -0,0 +1,115 @@
+// Package sql implements the blockchain.Store interface using SQL database backends.
+// It provides concrete SQL-based implementations for all blockchain operations
+// defined in the interface, with support for different SQL engines.
+//
+// This file implements the GetBlocksAffectedByInvalidation method, which computes the
+// blocks that InvalidateBlock would mark as invalid without modifying the database.
+// Operators use it to assess the impact of an invalidation, i.e. how many blocks would
+// be invalidated and whether the current best chain would be reorganized, before
+// actually invalidating a block.
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
+	"github.com/bitcoin-sv/teranode/util/tracing"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// GetBlocksAffectedByInvalidation returns the block and all its descendants that would be
+// marked as invalid by InvalidateBlock, without modifying the database.
+// This implements the blockchain.Store.GetBlocksAffectedByInvalidation interface method.
+//
+// The descendants are found with the same recursive Common Table Expression (CTE) that
+// InvalidateBlock uses. A second recursive CTE walks the current best chain back from the
+// best block to the height of the given block, so every affected block can be flagged as
+// being on the best chain or not. Affected blocks on the best chain mean the invalidation
+// would reorganize the chain.
+//
+// Parameters:
+//   - ctx: Context for the database operation, allowing for cancellation and timeouts
+//   - blockHash: The unique hash identifier of the block that would be invalidated
+//
+// Returns:
+//   - []*model.AffectedBlock: The affected blocks ordered by height, starting with the given block
+//   - error: Any error encountered during the query, specifically:
+//   - BlockNotFoundError if the specified block doesn't exist in the database
+//   - StorageError for other database errors
+func (s *SQL) GetBlocksAffectedByInvalidation(ctx context.Context, blockHash *chainhash.Hash) (affectedBlocks []*model.AffectedBlock, err error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:GetBlocksAffectedByInvalidation")
+	defer deferFn()
+
+	blockHeight, err := s.GetBlockHeight(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, bestBlockMeta, err := s.GetBestBlockHeader(ctx)
+	if err != nil {
+		return nil, errors.NewStorageError("error getting best block header", err)
+	}
+
+	q := `
+		WITH RECURSIVE children AS (
+			SELECT id, hash, previous_hash, height
+			FROM blocks
+			WHERE hash = $1
+			UNION
+			SELECT b.id, b.hash, b.previous_hash, b.height
+			FROM blocks b
+			INNER JOIN children c ON c.hash = b.previous_hash
+		),
+		best_chain AS (
+			SELECT id, parent_id, height
+			FROM blocks
+			WHERE id = $2
+			UNION
+			SELECT b.id, b.parent_id, b.height
+			FROM blocks b
+			INNER JOIN best_chain bc ON b.id = bc.parent_id
+			WHERE bc.height > $3
+		)
+		SELECT c.hash, c.height, EXISTS (SELECT 1 FROM best_chain bc WHERE bc.id = c.id)
+		FROM children c
+		ORDER BY c.height, c.id
+	`
+
+	var rows *sql.Rows
+
+	if rows, err = s.db.QueryContext(ctx, q, blockHash.CloneBytes(), bestBlockMeta.ID, blockHeight); err != nil {
+		return nil, errors.NewStorageError("error querying blocks affected by invalidation", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+
+	var (
+		hashBytes []byte
+		hash      *chainhash.Hash
+	)
+
+	for rows.Next() {
+		affectedBlock := &model.AffectedBlock{}
+
+		if err = rows.Scan(&hashBytes, &affectedBlock.Height, &affectedBlock.OnBestChain); err != nil {
+			return nil, errors.NewStorageError("error scanning affected block", err)
+		}
+
+		if hash, err = chainhash.NewHash(hashBytes); err != nil {
+			return nil, errors.NewStorageError("error creating hash from bytes", err)
+		}
+
+		affectedBlock.Hash = hash
+		affectedBlocks = append(affectedBlocks, affectedBlock)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewStorageError("error iterating affected blocks", err)
+	}
+
+	return affectedBlocks, nil
+}
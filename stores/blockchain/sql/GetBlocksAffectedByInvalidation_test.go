@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/stores/blockchain/options"
+	"github.com/bitcoin-sv/teranode/ulogger"
+	"github.com/bitcoin-sv/teranode/util/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLGetBlocksAffectedByInvalidation(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	setupStore := func(t *testing.T) *SQL {
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block1, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block2, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), block3, "")
+		require.NoError(t, err)
+
+		_, _, err = s.StoreBlock(context.Background(), blockAlternative2, "", options.WithMinedSet(true))
+		require.NoError(t, err)
+
+		return s
+	}
+
+	t.Run("block not found", func(t *testing.T) {
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		affectedBlocks, err := s.GetBlocksAffectedByInvalidation(context.Background(), block2.Hash())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errors.ErrBlockNotFound))
+		assert.Nil(t, affectedBlocks)
+	})
+
+	t.Run("block on best chain with children", func(t *testing.T) {
+		s := setupStore(t)
+
+		affectedBlocks, err := s.GetBlocksAffectedByInvalidation(context.Background(), block1.Hash())
+		require.NoError(t, err)
+		require.Len(t, affectedBlocks, 4)
+
+		assert.Equal(t, block1.Hash(), affectedBlocks[0].Hash)
+		assert.Equal(t, uint32(1), affectedBlocks[0].Height)
+		assert.True(t, affectedBlocks[0].OnBestChain)
+
+		assert.Equal(t, block2.Hash(), affectedBlocks[1].Hash)
+		assert.Equal(t, uint32(2), affectedBlocks[1].Height)
+		assert.True(t, affectedBlocks[1].OnBestChain)
+
+		assert.Equal(t, blockAlternative2.Hash(), affectedBlocks[2].Hash)
+		assert.Equal(t, uint32(2), affectedBlocks[2].Height)
+		assert.False(t, affectedBlocks[2].OnBestChain)
+
+		assert.Equal(t, block3.Hash(), affectedBlocks[3].Hash)
+		assert.Equal(t, uint32(3), affectedBlocks[3].Height)
+		assert.True(t, affectedBlocks[3].OnBestChain)
+	})
+
+	t.Run("block on side chain", func(t *testing.T) {
+		s := setupStore(t)
+
+		affectedBlocks, err := s.GetBlocksAffectedByInvalidation(context.Background(), blockAlternative2.Hash())
+		require.NoError(t, err)
+		require.Len(t, affectedBlocks, 1)
+
+		assert.Equal(t, blockAlternative2.Hash(), affectedBlocks[0].Hash)
+		assert.False(t, affectedBlocks[0].OnBestChain)
+	})
+
+	t.Run("store is not modified", func(t *testing.T) {
+		s := setupStore(t)
+
+		_, err := s.GetBlocksAffectedByInvalidation(context.Background(), block2.Hash())
+		require.NoError(t, err)
+
+		header, _, err := s.GetBestBlockHeader(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, block3.Hash(), header.Hash())
+
+		invalidBlocks, err := s.GetLastNInvalidBlocks(context.Background(), 10)
+		require.NoError(t, err)
+		assert.Empty(t, invalidBlocks)
+	})
+}
@@ -27,6 +27,9 @@ const (
 // chain_work) and calculates branch lengths for side chains by tracing back to
 // find the common ancestor with the main chain.
 //
+// The tips are returned in a stable order, by height descending and then by block hash
+// ascending, in the byte order the hash is stored in, like GetLastNBlocks.
+//
 // Parameters:
 //   - ctx: Context for the database operation, allows for cancellation and timeouts
 //
@@ -56,7 +59,7 @@ func (s *SQL) GetChainTips(ctx context.Context) ([]*model.ChainTip, error) {
 			SELECT 1 FROM blocks children 
 			WHERE children.parent_id = b.id AND children.id != b.id
 		)
-		ORDER BY b.chain_work DESC, b.id ASC
+		ORDER BY b.height DESC, b.hash ASC
 	`
 
 	rows, err := s.db.QueryContext(ctx, q)
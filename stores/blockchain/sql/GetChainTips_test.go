@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"net/url"
 	"testing"
@@ -94,20 +95,21 @@ func Test_SqlGetChainTips(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, tips, 2)
 
-		// Sort tips by height for consistent testing
-		if tips[0].Height < tips[1].Height {
-			tips[0], tips[1] = tips[1], tips[0]
+		// Tips at the same height are ordered by hash
+		assertChainTipsOrdered(t, tips)
+
+		mainTip, forkTip := tips[0], tips[1]
+		if forkTip.Status == "active" {
+			mainTip, forkTip = forkTip, mainTip
 		}
 
 		// Main chain tip (block2) should be active
-		mainTip := tips[0]
 		assert.Equal(t, uint32(2), mainTip.Height)
 		assert.Equal(t, "484e58c7bf0208d787314710535ef7be8ca31748bc9fef5e1ee2de67ebda757a", mainTip.Hash)
 		assert.Equal(t, uint32(0), mainTip.Branchlen)
 		assert.Equal(t, "active", mainTip.Status)
 
 		// Alternative chain tip should be a valid fork
-		forkTip := tips[1]
 		assert.Equal(t, uint32(2), forkTip.Height)
 		assert.Equal(t, blockAlternative2.Header.Hash().String(), forkTip.Hash)
 		assert.Equal(t, uint32(1), forkTip.Branchlen) // 1 block away from main chain
@@ -147,10 +149,8 @@ func Test_SqlGetChainTips(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, tips, 2)
 
-		// Sort tips by height for consistent testing
-		if tips[0].Height < tips[1].Height {
-			tips[0], tips[1] = tips[1], tips[0]
-		}
+		// Tips are ordered by height descending
+		assertChainTipsOrdered(t, tips)
 
 		// The longer fork should now be the active chain
 		activeTip := tips[0]
@@ -315,20 +315,21 @@ func Test_SqlGetChainTips(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, tips, 2)
 
-		// Sort by height
-		if tips[0].Height < tips[1].Height {
-			tips[0], tips[1] = tips[1], tips[0]
+		// Tips at the same height are ordered by hash
+		assertChainTipsOrdered(t, tips)
+
+		mainTip, forkTip := tips[0], tips[1]
+		if forkTip.Status == "active" {
+			mainTip, forkTip = forkTip, mainTip
 		}
 
 		// Main chain tip
-		mainTip := tips[0]
 		assert.Equal(t, uint32(3), mainTip.Height)
 		assert.Equal(t, block3.Header.Hash().String(), mainTip.Hash)
 		assert.Equal(t, uint32(0), mainTip.Branchlen)
 		assert.Equal(t, "active", mainTip.Status)
 
 		// Fork tip should have branch length of 2 (forked 2 blocks ago)
-		forkTip := tips[1]
 		assert.Equal(t, uint32(3), forkTip.Height)
 		assert.Equal(t, forkBlock3.Header.Hash().String(), forkTip.Hash)
 		assert.Equal(t, uint32(2), forkTip.Branchlen)
@@ -443,6 +444,26 @@ func createAlternativeBlock2() *model.Block {
 	}
 }
 
+// assertChainTipsOrdered asserts that the tips are ordered by height descending and then by the stored hash bytes.
+func assertChainTipsOrdered(t *testing.T, tips []*model.ChainTip) {
+	t.Helper()
+
+	for i := 1; i < len(tips); i++ {
+		if tips[i-1].Height != tips[i].Height {
+			assert.Greater(t, tips[i-1].Height, tips[i].Height)
+			continue
+		}
+
+		previousHash, err := chainhash.NewHashFromStr(tips[i-1].Hash)
+		require.NoError(t, err)
+
+		hash, err := chainhash.NewHashFromStr(tips[i].Hash)
+		require.NoError(t, err)
+
+		assert.Negative(t, bytes.Compare(previousHash[:], hash[:]), "tips at the same height should be ordered by hash")
+	}
+}
+
 func BenchmarkGetChainTipsPerformance(b *testing.B) {
 	tSettings := test.CreateBaseTestSettings(b)
 
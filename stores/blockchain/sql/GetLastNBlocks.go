@@ -6,7 +6,7 @@
 // most recent blocks in the blockchain. This functionality is essential for blockchain
 // explorers, monitoring tools, and diagnostic interfaces that need to display recent
 // blockchain activity. The implementation includes efficient caching to optimize performance
-// for repeated queries, support for including or excluding orphaned blocks, and filtering
+// for repeated queries, support for selecting the main chain, orphaned blocks or both, and filtering
 // by maximum height. It also includes custom time handling to accommodate differences
 // between PostgreSQL and SQLite timestamp representations, ensuring consistent behavior
 // across different database backends.
@@ -26,22 +26,28 @@ import (
 // This implements the blockchain.Store.GetLastNBlocks interface method.
 //
 // The method retrieves detailed information about the N most recent blocks, with options
-// to include orphaned blocks and filter by maximum height. This functionality is essential
-// for blockchain explorers, monitoring tools, and diagnostic interfaces that need to display
-// recent blockchain activity. In Teranode's high-throughput architecture, efficient access
-// to recent block information is critical for monitoring system health and performance.
+// to select the main chain, orphaned blocks or both, and to filter by maximum height. This
+// functionality is essential for blockchain explorers, monitoring tools, and diagnostic
+// interfaces that need to display recent blockchain activity. In Teranode's high-throughput
+// architecture, efficient access to recent block information is critical for monitoring
+// system health and performance.
+//
+// The blocks are returned in a stable order, by height descending and then by block hash
+// ascending, in the byte order the hash is stored in. An orphaned block is therefore always
+// returned at the same position relative to the main chain block at the same height, and
+// repeated calls return the blocks in the same order.
 //
 // The implementation uses a response cache to optimize performance for repeated queries,
 // which is particularly important for frequently accessed recent block data. It constructs
 // SQL queries dynamically based on the provided parameters, with different query paths for
-// including or excluding orphaned blocks. The method also handles database engine differences
+// the different block selections. The method also handles database engine differences
 // between PostgreSQL and SQLite, particularly for timestamp handling.
 //
 // Parameters:
 //   - ctx: Context for the database operation, allowing for cancellation and timeouts
 //   - n: The number of most recent blocks to retrieve
-//   - includeOrphans: If true, includes orphaned blocks (blocks not in the main chain);
-//     if false, only includes blocks in the main chain
+//   - selection: Which blocks to return, only the main chain, only orphaned blocks (blocks
+//     not in the main chain), or both
 //   - fromHeight: Optional maximum height filter; if greater than 0, only blocks with
 //     height less than or equal to this value will be included
 //
@@ -50,12 +56,12 @@ import (
 //     such as hash, height, timestamp, transaction count, and size for each block
 //   - error: Any error encountered during retrieval, specifically:
 //   - StorageError for database errors or processing failures
-func (s *SQL) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool, fromHeight uint32) ([]*model.BlockInfo, error) {
+func (s *SQL) GetLastNBlocks(ctx context.Context, n int64, selection model.BlockSelection, fromHeight uint32) ([]*model.BlockInfo, error) {
 	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:GetLastNBlocks")
 	defer deferFn()
 
 	// the cache will be invalidated by the StoreBlock function when a new block is added, or after cacheTTL seconds
-	cacheID := chainhash.HashH([]byte(fmt.Sprintf("GetLastNBlocks-%d-%t-%d", n, includeOrphans, fromHeight)))
+	cacheID := chainhash.HashH([]byte(fmt.Sprintf("GetLastNBlocks-%d-%d-%d", n, selection, fromHeight)))
 
 	cached := s.responseCache.Get(cacheID)
 	if cached != nil && cached.Value() != nil {
@@ -69,14 +75,14 @@ func (s *SQL) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool,
 	defer cancel()
 
 	fromHeightQuery := ""
+	chainFromHeightQuery := ""
+
 	if fromHeight > 0 {
-		fromHeightQuery = fmt.Sprintf("WHERE height <= %d", fromHeight)
+		fromHeightQuery = fmt.Sprintf("AND height <= %d", fromHeight)
+		chainFromHeightQuery = fmt.Sprintf("WHERE height <= %d", fromHeight)
 	}
 
-	var q string
-
-	if includeOrphans {
-		q = `
+	const blockColumns = `
 		SELECT
 		 b.version
 		,b.block_time
@@ -90,52 +96,62 @@ func (s *SQL) GetLastNBlocks(ctx context.Context, n int64, includeOrphans bool,
 		,b.height
 		,b.inserted_at
 		FROM blocks b
+	`
+
+	const mainChainBlocks = `
+		WITH RECURSIVE ChainBlocks AS (
+			SELECT id, parent_id, height
+			FROM blocks
+			WHERE invalid = false
+			AND hash = (
+				SELECT b.hash
+				FROM blocks b
+				WHERE b.invalid = false
+				ORDER BY chain_work DESC, peer_id ASC, id ASC
+				LIMIT 1
+			)
+			UNION ALL
+			SELECT bb.id, bb.parent_id, bb.height
+			FROM blocks bb
+			JOIN ChainBlocks cb ON bb.id = cb.parent_id
+			WHERE bb.id != cb.id
+			  AND bb.invalid = false
+		)
+	`
+
+	var q string
+
+	switch selection {
+	case model.BlockSelection_MainChainAndOrphans:
+		q = blockColumns + `
 		WHERE invalid = false
-		ORDER BY height DESC
+		` + fromHeightQuery + `
+		ORDER BY height DESC, hash ASC
 	  LIMIT $1
 	`
-	} else {
-		q = `
-		SELECT
-		 b.version
-		,b.block_time
-		,b.n_bits
-	  ,b.nonce
-		,b.previous_hash
-		,b.merkle_root
-	  ,b.tx_count
-		,b.size_in_bytes
-		,b.coinbase_tx
-		,b.height
-		,b.inserted_at
-		FROM blocks b
+	case model.BlockSelection_OrphansOnly:
+		q = blockColumns + `
+		WHERE invalid = false
+		` + fromHeightQuery + `
+		AND id NOT IN (
+			` + mainChainBlocks + `
+			SELECT id FROM ChainBlocks
+		)
+		ORDER BY height DESC, hash ASC
+	  LIMIT $1
+	`
+	default:
+		q = blockColumns + `
 		WHERE id IN (
 			SELECT id FROM blocks
 			WHERE id IN (
-				WITH RECURSIVE ChainBlocks AS (
-					SELECT id, parent_id, height
-					FROM blocks
-					WHERE invalid = false
-					AND hash = (
-						SELECT b.hash
-						FROM blocks b
-						WHERE b.invalid = false
-						ORDER BY chain_work DESC, peer_id ASC, id ASC
-						LIMIT 1
-					)
-					UNION ALL
-					SELECT bb.id, bb.parent_id, bb.height
-					FROM blocks bb
-					JOIN ChainBlocks cb ON bb.id = cb.parent_id
-					WHERE bb.id != cb.id
-					  AND bb.invalid = false
-				)
+				` + mainChainBlocks + `
 				SELECT id FROM ChainBlocks
-				` + fromHeightQuery + `
+				` + chainFromHeightQuery + `
 				LIMIT $1
 			)
 		)
-		ORDER BY height DESC
+		ORDER BY height DESC, hash ASC
 	`
 	}
 
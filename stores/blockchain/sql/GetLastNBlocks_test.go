@@ -1,11 +1,13 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/bitcoin-sv/teranode/model"
 	time2 "github.com/bitcoin-sv/teranode/model/time"
 	"github.com/bitcoin-sv/teranode/ulogger"
 	"github.com/bitcoin-sv/teranode/util/test"
@@ -25,7 +27,7 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
 		require.NoError(t, err)
 
-		blocks, err := s.GetLastNBlocks(context.Background(), 10, false, 0)
+		blocks, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChain, 0)
 		require.NoError(t, err)
 		assert.Len(t, blocks, 1) // Genesis block
 	})
@@ -46,7 +48,7 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// Get last 2 blocks
-		blocks, err := s.GetLastNBlocks(context.Background(), 2, false, 0)
+		blocks, err := s.GetLastNBlocks(context.Background(), 2, model.BlockSelection_MainChain, 0)
 		require.NoError(t, err)
 		assert.Len(t, blocks, 2)
 		assert.Equal(t, block3.Header.Bytes(), blocks[0].BlockHeader)
@@ -69,7 +71,7 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// Get blocks from height 1
-		blocks, err := s.GetLastNBlocks(context.Background(), 10, false, 2)
+		blocks, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChain, 2)
 		require.NoError(t, err)
 		assert.Len(t, blocks, 3)
 		assert.Equal(t, uint32(2), blocks[0].Height)
@@ -97,13 +99,68 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// Get last blocks including orphans
-		blocks, err := s.GetLastNBlocks(context.Background(), 10, true, 0)
+		blocks, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChainAndOrphans, 0)
 		require.NoError(t, err)
 
 		// Should include all blocks including orphans
 		assert.GreaterOrEqual(t, len(blocks), 4) // Genesis + 3 blocks + alternative
 	})
 
+	t.Run("get last blocks by selection", func(t *testing.T) {
+		storeURL, err := url.Parse("sqlitememory:///")
+		require.NoError(t, err)
+
+		s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+		require.NoError(t, err)
+
+		// Store blocks 1, 2 and 3, and an alternative block at height 2 that is not on the main chain
+		_, _, err = s.StoreBlock(context.Background(), block1, "")
+		require.NoError(t, err)
+		_, _, err = s.StoreBlock(context.Background(), block2, "")
+		require.NoError(t, err)
+		_, _, err = s.StoreBlock(context.Background(), block3, "")
+		require.NoError(t, err)
+		_, _, err = s.StoreBlock(context.Background(), blockAlternative2, "")
+		require.NoError(t, err)
+
+		orphans, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_OrphansOnly, 0)
+		require.NoError(t, err)
+		require.Len(t, orphans, 1)
+		assert.Equal(t, blockAlternative2.Header.Bytes(), orphans[0].BlockHeader)
+
+		mainChain, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChain, 0)
+		require.NoError(t, err)
+		require.Len(t, mainChain, 4)
+
+		for _, block := range mainChain {
+			assert.NotEqual(t, blockAlternative2.Header.Bytes(), block.BlockHeader)
+		}
+
+		all, err := s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChainAndOrphans, 0)
+		require.NoError(t, err)
+		require.Len(t, all, 5)
+
+		// blocks are ordered by height descending, blocks at the same height by hash
+		first, second := block2, blockAlternative2
+		if bytes.Compare(first.Hash()[:], second.Hash()[:]) > 0 {
+			first, second = second, first
+		}
+
+		assert.Equal(t, block3.Header.Bytes(), all[0].BlockHeader)
+		assert.Equal(t, first.Header.Bytes(), all[1].BlockHeader)
+		assert.Equal(t, second.Header.Bytes(), all[2].BlockHeader)
+		assert.Equal(t, block1.Header.Bytes(), all[3].BlockHeader)
+
+		// fromHeight applies to orphans as well
+		all, err = s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChainAndOrphans, 1)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		orphans, err = s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_OrphansOnly, 1)
+		require.NoError(t, err)
+		assert.Empty(t, orphans)
+	})
+
 	t.Run("cache hit", func(t *testing.T) {
 		storeURL, err := url.Parse("sqlitememory:///")
 		require.NoError(t, err)
@@ -118,12 +175,12 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// First call will populate the cache
-		blocks1, err := s.GetLastNBlocks(context.Background(), 2, false, 0)
+		blocks1, err := s.GetLastNBlocks(context.Background(), 2, model.BlockSelection_MainChain, 0)
 		require.NoError(t, err)
 		assert.Len(t, blocks1, 2)
 
 		// Second call should hit the cache
-		blocks2, err := s.GetLastNBlocks(context.Background(), 2, false, 0)
+		blocks2, err := s.GetLastNBlocks(context.Background(), 2, model.BlockSelection_MainChain, 0)
 		require.NoError(t, err)
 		assert.Len(t, blocks2, 2)
 
@@ -143,7 +200,7 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 		require.NoError(t, err)
 
 		// Call GetLastNBlocks and expect an error
-		_, err = s.GetLastNBlocks(context.Background(), 10, false, 0)
+		_, err = s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChain, 0)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to get blocks")
 	})
@@ -170,7 +227,7 @@ func TestSQLGetLastNBlocks(t *testing.T) {
 
 		// Call GetLastNBlocks which will use processBlockRows internally
 		// The scanBlockRow function will fail when trying to convert the invalid hash values
-		_, err = s.GetLastNBlocks(context.Background(), 10, false, 0)
+		_, err = s.GetLastNBlocks(context.Background(), 10, model.BlockSelection_MainChain, 0)
 		require.Error(t, err)
 		// The error should be from processBlockRows
 		assert.Contains(t, err.Error(), "failed to convert")
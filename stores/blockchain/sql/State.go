@@ -6,6 +6,7 @@ package sql
 import (
 	"context"
 
+	"github.com/bitcoin-sv/teranode/errors"
 	"github.com/bitcoin-sv/teranode/util/tracing"
 )
 
@@ -86,3 +87,58 @@ func (s *SQL) SetState(ctx context.Context, key string, data []byte) error {
 
 	return nil
 }
+
+// CompareAndSetState atomically replaces the value of a key in the state key-value store,
+// only if the current value equals expectedData. An empty expectedData means the key must not
+// exist yet, in which case the key is created. The check and the write are done in a single
+// conditional statement, so concurrent writers of the same key cannot overwrite each other.
+//
+// Parameters:
+//   - ctx: Context for the database operation, allows for cancellation and timeouts
+//   - key: The unique key identifier for the state item to swap
+//   - expectedData: The data the key must currently hold, empty if the key must not exist
+//   - newData: The binary data to store for the given key
+//
+// Returns:
+//   - bool: Whether the value was swapped, false if the current value did not match
+//   - error: Any error encountered during the storage operation
+func (s *SQL) CompareAndSetState(ctx context.Context, key string, expectedData, newData []byte) (bool, error) {
+	ctx, _, deferFn := tracing.Tracer("blockchain").Start(ctx, "sql:CompareAndSetState")
+	defer deferFn()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		q    string
+		args []interface{}
+	)
+
+	if len(expectedData) == 0 {
+		q = `
+		INSERT INTO state (key, data)
+		VALUES ($1, $2)
+		ON CONFLICT (key) DO NOTHING
+	`
+		args = []interface{}{key, newData}
+	} else {
+		q = `
+		UPDATE state
+		SET data = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE key = $1 AND data = $2
+	`
+		args = []interface{}{key, expectedData, newData}
+	}
+
+	res, err := s.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, errors.NewStorageError("failed to compare and set state %s", key, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.NewStorageError("failed to get the rows affected of compare and set state %s", key, err)
+	}
+
+	return rowsAffected == 1, nil
+}
@@ -35,3 +35,47 @@ func TestSQLGetState(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSQLCompareAndSetState(t *testing.T) {
+	tSettings := test.CreateBaseTestSettings(t)
+
+	storeURL, err := url.Parse("sqlitememory:///")
+	require.NoError(t, err)
+
+	s, err := New(ulogger.TestLogger{}, storeURL, tSettings)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	ctx := context.Background()
+
+	// the key does not exist, an empty expected value creates it
+	swapped, err := s.CompareAndSetState(ctx, "test", nil, []byte("v1"))
+	require.NoError(t, err)
+	require.True(t, swapped)
+
+	// the key exists now, it is not created again
+	swapped, err = s.CompareAndSetState(ctx, "test", nil, []byte("v2"))
+	require.NoError(t, err)
+	require.False(t, swapped)
+
+	// the expected value does not match
+	swapped, err = s.CompareAndSetState(ctx, "test", []byte("v0"), []byte("v2"))
+	require.NoError(t, err)
+	require.False(t, swapped)
+
+	state, err := s.GetState(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), state)
+
+	// the expected value matches
+	swapped, err = s.CompareAndSetState(ctx, "test", []byte("v1"), []byte("v2"))
+	require.NoError(t, err)
+	require.True(t, swapped)
+
+	state, err = s.GetState(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), state)
+}
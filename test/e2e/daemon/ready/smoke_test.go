@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/bitcoin-sv/teranode/daemon"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/services/blockchain"
 	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/stores/utxo/fields"
@@ -144,7 +145,7 @@ func TestSendTxAndCheckState(t *testing.T) {
 
 	block := td.MineAndWait(t, 1)
 
-	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	err = block.CheckMerkleRoot(ctx)
@@ -479,7 +480,7 @@ func TestSendTxAndCheckStateWithDuplicateTxSentSimultaneously(t *testing.T) {
 
 	block := td.MineAndWait(t, 1)
 
-	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	err = block.CheckMerkleRoot(ctx)
@@ -659,7 +660,7 @@ func TestDuplicateTransactionAfterMining(t *testing.T) {
 	t.Logf("Transaction mined in block: %s", block.Hash().String())
 
 	// Verify transaction is in the block
-	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency)
+	err = block.GetAndValidateSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	subtree, err := block.GetSubtrees(ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/bitcoin-sv/teranode/daemon"
 	"github.com/bitcoin-sv/teranode/errors"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/settings"
 	"github.com/bitcoin-sv/teranode/test/testcontainers"
 	helper "github.com/bitcoin-sv/teranode/test/utils"
@@ -188,7 +189,7 @@ func TestOrphanTx(t *testing.T) {
 	block3, err := node1.BlockchainClient.GetBlockByHeight(node1Ctx, 3)
 	require.NoError(t, err)
 
-	err = block3.GetAndValidateSubtrees(node1Ctx, node1.Logger, node1.SubtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency)
+	err = block3.GetAndValidateSubtrees(node1Ctx, node1.Logger, node1.SubtreeStore, tSettings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	err = block3.CheckMerkleRoot(node1Ctx)
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/bitcoin-sv/teranode/daemon"
+	"github.com/bitcoin-sv/teranode/model"
 	"github.com/bitcoin-sv/teranode/settings"
 	postgres "github.com/bitcoin-sv/teranode/test/longtest/util/postgres"
 	helper "github.com/bitcoin-sv/teranode/test/utils"
@@ -129,7 +130,7 @@ func TestShouldAllowFairTxUseRpcWithPostgres(t *testing.T) {
 	block102, err := td.BlockchainClient.GetBlockByHeight(td.Ctx, 102)
 	require.NoError(t, err)
 
-	err = block102.GetAndValidateSubtrees(td.Ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency)
+	err = block102.GetAndValidateSubtrees(td.Ctx, td.Logger, td.SubtreeStore, td.Settings.Block.GetAndValidateSubtreesConcurrency, model.RetryPolicy{})
 	require.NoError(t, err)
 
 	err = block102.CheckMerkleRoot(td.Ctx)
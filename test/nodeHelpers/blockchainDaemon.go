@@ -96,7 +96,7 @@ func (m *BlockchainDaemon) StartBlockchainService() error {
 	m.serviceManager = servicemanager.NewServiceManager(m.ctx, m.Logger)
 
 	// Get Kafka producer
-	blocksFinalKafkaAsyncProducer, err := kafka.NewKafkaAsyncProducerFromURL(m.ctx, ulogger.New("kpbf"), m.Settings.Kafka.BlocksFinalConfig, &m.Settings.Kafka)
+	blocksFinalKafkaAsyncProducer, err := kafka.NewKafkaAsyncProducerFromURLWithOptions(m.ctx, ulogger.New("kpbf"), m.Settings.Kafka.BlocksFinalConfig, &m.Settings.Kafka, kafka.WithDeliveryReports())
 	if err != nil {
 		return err
 	}
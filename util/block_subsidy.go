@@ -2,6 +2,7 @@ package util
 
 import (
 	"log"
+	"math"
 
 	"github.com/bsv-blockchain/go-chaincfg"
 )
@@ -51,3 +52,25 @@ func GetBlockSubsidyForHeight(height uint32, params *chaincfg.Params) uint64 {
 
 	return subsidy
 }
+
+// GetNextSubsidyReductionHeight returns the height of the first block after the given height with a lower subsidy,
+// which is the next multiple of SubsidyReductionInterval. Returns 0 when the subsidy at the given height is already 0
+// and is not reduced any further, when the reduction height does not fit in a uint32, or if chain parameters are invalid.
+func GetNextSubsidyReductionHeight(height uint32, params *chaincfg.Params) uint32 {
+	if params == nil || params.SubsidyReductionInterval == 0 {
+		return 0
+	}
+
+	if GetBlockSubsidyForHeight(height, params) == 0 {
+		return 0
+	}
+
+	interval := uint64(params.SubsidyReductionInterval)
+	nextReductionHeight := (uint64(height)/interval + 1) * interval
+
+	if nextReductionHeight > math.MaxUint32 {
+		return 0
+	}
+
+	return uint32(nextReductionHeight)
+}
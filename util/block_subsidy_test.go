@@ -53,3 +53,32 @@ func TestSubsidyHalvingInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestGetNextSubsidyReductionHeight(t *testing.T) {
+	tests := []struct {
+		name         string
+		height       uint32
+		params       *chaincfg.Params
+		expectHeight uint32
+	}{
+		{"Genesis block", 0, &chaincfg.MainNetParams, 210000},
+		{"Block before first halving", 209999, &chaincfg.MainNetParams, 210000},
+		{"First halving block", 210000, &chaincfg.MainNetParams, 420000},
+		{"Random check 1", 820133, &chaincfg.MainNetParams, 840000},
+		{"Last block with a subsidy", 6929999, &chaincfg.MainNetParams, 6930000},
+		{"First block without a subsidy", 6930000, &chaincfg.MainNetParams, 0},
+		{"Regtest", 149, &chaincfg.RegressionNetParams, 150},
+		{"Nil params", 100, nil, 0},
+		{"Zero interval", 100, &chaincfg.Params{SubsidyReductionInterval: 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height := GetNextSubsidyReductionHeight(tt.height, tt.params)
+			if height != tt.expectHeight {
+				t.Errorf("GetNextSubsidyReductionHeight(%d) = %d, want %d",
+					tt.height, height, tt.expectHeight)
+			}
+		})
+	}
+}
@@ -51,6 +51,19 @@ type KafkaProducerConfig struct {
 	FlushBytes            int            // Flush threshold in bytes
 	FlushMessages         int            // Number of messages before flush
 	FlushFrequency        time.Duration  // Time between flushes
+	DeliveryReports       bool           // Report the delivery of messages through Message.Delivered
+}
+
+// ProducerOption configures optional behaviour of an async producer.
+type ProducerOption func(*KafkaProducerConfig)
+
+// WithDeliveryReports makes the producer report the delivery of messages through Message.Delivered.
+// The broker acknowledgements are only returned by producers with this option, since every producer
+// would otherwise have to drain the acknowledgements of all its messages.
+func WithDeliveryReports() ProducerOption {
+	return func(cfg *KafkaProducerConfig) {
+		cfg.DeliveryReports = true
+	}
 }
 
 // MessageStatus represents the status of a produced message.
@@ -64,6 +77,12 @@ type MessageStatus struct {
 type Message struct {
 	Key   []byte
 	Value []byte
+
+	// Delivered is called with the delivery result of the message when set, with nil once the message is
+	// acknowledged by the broker or with the error the producer failed to deliver the message with.
+	// It is called from the producer goroutines and must not block. Only producers created with
+	// WithDeliveryReports report successful deliveries.
+	Delivered func(err error)
 }
 
 // KafkaAsyncProducer implements asynchronous Kafka producer functionality.
@@ -87,6 +106,30 @@ type KafkaAsyncProducer struct {
 //   - *KafkaAsyncProducer: Configured async producer
 //   - error: Any error encountered during setup
 func NewKafkaAsyncProducerFromURL(ctx context.Context, logger ulogger.Logger, url *url.URL, kafkaSettings ...*settings.KafkaSettings) (*KafkaAsyncProducer, error) {
+	// Get kafkaSettings from variadic parameter
+	var settings *settings.KafkaSettings
+	if len(kafkaSettings) > 0 {
+		settings = kafkaSettings[0]
+	}
+
+	return NewKafkaAsyncProducerFromURLWithOptions(ctx, logger, url, settings)
+}
+
+// NewKafkaAsyncProducerFromURLWithOptions creates a new async producer from a URL configuration,
+// with the given producer options applied.
+//
+// Parameters:
+//   - ctx: Context for producer operations
+//   - logger: Logger instance
+//   - url: URL containing Kafka configuration
+//   - kafkaSettings: Kafka settings for authentication (can be nil for no auth)
+//   - opts: Producer options
+//
+// Returns:
+//   - *KafkaAsyncProducer: Configured async producer
+//   - error: Any error encountered during setup
+func NewKafkaAsyncProducerFromURLWithOptions(ctx context.Context, logger ulogger.Logger, url *url.URL, kafkaSettings *settings.KafkaSettings,
+	opts ...ProducerOption) (*KafkaAsyncProducer, error) {
 	partitionsInt32, err := safeconversion.IntToInt32(util.GetQueryParamInt(url, "partitions", 1))
 	if err != nil {
 		return nil, err
@@ -111,14 +154,12 @@ func NewKafkaAsyncProducerFromURL(ctx context.Context, logger ulogger.Logger, ur
 		FlushFrequency:        util.GetQueryParamDuration(url, "flush_frequency", 10*time.Second),
 	}
 
-	// Get kafkaSettings from variadic parameter
-	var settings *settings.KafkaSettings
-	if len(kafkaSettings) > 0 {
-		settings = kafkaSettings[0]
+	for _, opt := range opts {
+		opt(&producerConfig)
 	}
 
 	producer, err := retry.Retry(ctx, logger, func() (*KafkaAsyncProducer, error) {
-		return NewKafkaAsyncProducer(logger, producerConfig, settings)
+		return NewKafkaAsyncProducer(logger, producerConfig, kafkaSettings)
 	}, retry.WithMessage(fmt.Sprintf("[P2P] error starting kafka async producer for topic %s", producerConfig.Topic)))
 	if err != nil {
 		logger.Fatalf("[P2P] failed to start kafka async producer for topic %s: %v", producerConfig.Topic, err)
@@ -165,7 +206,8 @@ func NewKafkaAsyncProducer(logger ulogger.Logger, cfg KafkaProducerConfig, kafka
 	config.Producer.Flush.Bytes = cfg.FlushBytes
 	config.Producer.Flush.Messages = cfg.FlushMessages
 	config.Producer.Flush.Frequency = cfg.FlushFrequency
-	// config.Producer.Return.Successes = true
+	// the successes are needed to report the delivery of messages through Message.Delivered
+	config.Producer.Return.Successes = cfg.DeliveryReports
 
 	// Apply authentication settings if provided
 	if kafkaSettings != nil {
@@ -253,6 +295,10 @@ func (c *KafkaAsyncProducer) Start(ctx context.Context, ch chan *Message) {
 
 				c.Config.Logger.Debugf("Successfully sent message to topic %s, offset: %d, key: %v, value: %v",
 					s.Topic, s.Offset, key, value)
+
+				if delivered, ok := s.Metadata.(func(err error)); ok {
+					delivered(nil)
+				}
 			}
 		}()
 
@@ -263,6 +309,10 @@ func (c *KafkaAsyncProducer) Start(ctx context.Context, ch chan *Message) {
 
 				c.Config.Logger.Errorf("Failed to deliver message to topic %s: %v, Key: %v, Value: %v",
 					err.Msg.Topic, err.Err, key, value)
+
+				if delivered, ok := err.Msg.Metadata.(func(err error)); ok {
+					delivered(errors.NewServiceError("failed to deliver message to topic %s", err.Msg.Topic, err.Err))
+				}
 			}
 		}()
 
@@ -289,6 +339,10 @@ func (c *KafkaAsyncProducer) Start(ctx context.Context, ch chan *Message) {
 					Value: sarama.ByteEncoder(msgBytes.Value),
 				}
 
+				if msgBytes.Delivered != nil {
+					message.Metadata = msgBytes.Delivered
+				}
+
 				c.Producer.Input() <- message
 			}
 		}()
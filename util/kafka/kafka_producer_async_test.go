@@ -177,6 +177,26 @@ func TestNewKafkaAsyncProducerFromURLDefaultValues(t *testing.T) {
 	assert.Equal(t, 10*time.Second, producer.Config.FlushFrequency)  // default
 }
 
+func TestNewKafkaAsyncProducerFromURLWithOptions(t *testing.T) {
+	logger := &mockAsyncLogger{}
+	ctx := context.Background()
+	kafkaURL, err := url.Parse("memory://localhost/test-topic")
+	require.NoError(t, err)
+
+	t.Run("delivery reports are off by default", func(t *testing.T) {
+		producer, err := NewKafkaAsyncProducerFromURL(ctx, logger, kafkaURL)
+		require.NoError(t, err)
+		assert.False(t, producer.Config.DeliveryReports)
+	})
+
+	t.Run("with delivery reports", func(t *testing.T) {
+		producer, err := NewKafkaAsyncProducerFromURLWithOptions(ctx, logger, kafkaURL, nil, WithDeliveryReports())
+		require.NoError(t, err)
+		assert.True(t, producer.Config.DeliveryReports)
+		assert.Equal(t, "test-topic", producer.Config.Topic)
+	})
+}
+
 func TestNewKafkaAsyncProducerFromURLInvalidConversion(t *testing.T) {
 	logger := &mockAsyncLogger{}
 	ctx := context.Background()